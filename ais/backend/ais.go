@@ -228,7 +228,7 @@ func (m *AISbp) GetInfoInternal() (res meta.RemAisVec) {
 func (m *AISbp) GetInfo(clusterConf cmn.BackendConfAIS) (res meta.RemAisVec) {
 	var (
 		cfg              = cmn.GCO.Get()
-		cliPlain, cliTLS = remaisClients(&cfg.Client)
+		cliPlain, cliTLS = remaisClients(&cfg.ClusterConfig)
 	)
 
 	m.mu.RLock()
@@ -277,8 +277,17 @@ func (m *AISbp) GetInfo(clusterConf cmn.BackendConfAIS) (res meta.RemAisVec) {
 	return res
 }
 
-func remaisClients(clientConf *cmn.ClientConf) (client, clientTLS *http.Client) {
-	return cmn.NewDefaultClients(clientConf.Timeout.D())
+// with backend.ais_tls configured, HTTPS client presents the configured certificate
+// (mutual TLS) and verifies remote clusters as specified
+func remaisClients(cfg *cmn.ClusterConfig) (client, clientTLS *http.Client) {
+	tlsConf := cfg.Backend.RemAisTLS()
+	if tlsConf == nil {
+		return cmn.NewDefaultClients(cfg.Client.Timeout.D())
+	}
+	cargs := cmn.TransportArgs{ClientTimeout: cfg.Client.Timeout.D()}
+	client = cmn.NewClient(cargs)
+	clientTLS = cmn.NewClientTLS(cargs, tlsConf.TLSArgs(), false /*intra-cluster*/)
+	return client, clientTLS
 }
 
 // A list of remote AIS URLs can contains both HTTP and HTTPS links at the
@@ -290,7 +299,7 @@ func (r *remAis) init(alias string, confURLs []string, cfg *cmn.ClusterConfig) (
 		url          string
 		remSmap      *meta.Smap
 		clientL      http.Client
-		cliH, cliTLS = remaisClients(&cfg.Client)
+		cliH, cliTLS = remaisClients(cfg)
 	)
 
 	for _, u := range confURLs {
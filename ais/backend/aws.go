@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	aiss3 "github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -42,6 +43,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 )
 
 type (
@@ -83,7 +85,10 @@ var (
 )
 
 // interface guard
-var _ core.Backend = (*s3bp)(nil)
+var (
+	_ core.Backend = (*s3bp)(nil)
+	_ MDGetter     = (*s3bp)(nil)
+)
 
 // environment variables => static defaults that can still be overridden via bck.Props.Extra.AWS
 // in addition to these two (below), default bucket region = env.AwsDefaultRegion()
@@ -131,7 +136,7 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (cos.StrKVs, int, erro
 	}
 	if sessConf.region == "" {
 		var region string
-		if region, err = _location(svc, cloudBck.Name); err != nil {
+		if region, err = _location(svc, cloudBck); err != nil {
 			ecode, errV := awsErrorToAISError(err, cloudBck, "", sessConf.detail())
 			return nil, ecode, errV
 		}
@@ -163,10 +168,10 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (cos.StrKVs, int, erro
 	return bckProps, 0, nil
 }
 
-func _location(svc *s3.Client, bckName string) (string, error) {
+func _location(svc *s3.Client, bck *cmn.Bck) (string, error) {
 	resp, err := svc.HeadBucket(context.Background(), &s3.HeadBucketInput{
-		Bucket: aws.String(bckName),
-	})
+		Bucket: aws.String(bck.Name),
+	}, _payerOpts(bck)...)
 	if err != nil {
 		return "", err
 	}
@@ -178,7 +183,7 @@ func _location(svc *s3.Client, bckName string) (string, error) {
 
 func _versioning(svc *s3.Client, bck *cmn.Bck) (enabled bool, errV error) {
 	input := &s3.GetBucketVersioningInput{Bucket: aws.String(bck.Name)}
-	result, err := svc.GetBucketVersioning(context.Background(), input)
+	result, err := svc.GetBucketVersioning(context.Background(), input, _payerOpts(bck)...)
 	if err != nil {
 		return false, err
 	}
@@ -207,7 +212,7 @@ func (*s3bp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMsg, ls
 	if err != nil {
 		return 0, err
 	}
-	params := &s3.ListObjectsV2Input{Bucket: aws.String(cloudBck.Name)}
+	params := &s3.ListObjectsV2Input{Bucket: aws.String(cloudBck.Name), RequestPayer: _payer(cloudBck)}
 
 	// in re: `apc.LsNoDirs` and `apc.LsNoRecursion`, see:
 	// https://github.com/NVIDIA/aistore/blob/main/docs/howto_virt_dirs.md
@@ -295,7 +300,7 @@ func (*s3bp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMsg, ls
 	// - set the `ListObjectVersionsInput.Prefix` to the object's full name
 	// - get the versions and lookup the latest one
 	var (
-		verParams = &s3.ListObjectVersionsInput{Bucket: aws.String(cloudBck.Name)}
+		verParams = &s3.ListObjectVersionsInput{Bucket: aws.String(cloudBck.Name), RequestPayer: _payer(cloudBck)}
 		num       int
 	)
 	for _, en := range lst.Entries {
@@ -389,8 +394,9 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 		return nil, 0, err
 	}
 	headOutput, err = svc.HeadObject(context.Background(), &s3.HeadObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		RequestPayer: _payer(cloudBck),
 	})
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName, sessConf.detail())
@@ -421,6 +427,9 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 
 	// unlike other custom attrs, "Content-Type" is not getting stored w/ LOM
 	// - only shown via list-objects and HEAD when not present
+	if v := headOutput.ContentEncoding; v != nil && *v != "" {
+		oa.SetCustomKey(cmn.ContentEncodingObjMD, *v)
+	}
 	if v := headOutput.ContentType; v != nil {
 		oa.SetCustomKey(cos.HdrContentType, *v)
 	}
@@ -439,6 +448,69 @@ exit:
 	return oa, 0, nil
 }
 
+// storage class and ACL (grants) - two additional requests
+// (see apc.TCBMsg.PreserveBackendMeta)
+func (*s3bp) GetObjBackendMD(ctx context.Context, lom *core.LOM) (cos.StrKVs, int, error) {
+	const tag = "[get_object_md]"
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		sessConf = sessConf{bck: cloudBck}
+	)
+	svc, err := sessConf.s3client(tag)
+	if err != nil {
+		return nil, 0, err
+	}
+	headOutput, err := svc.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		RequestPayer: _payer(cloudBck),
+	})
+	if err != nil {
+		ecode, err := awsErrorToAISError(err, cloudBck, lom.ObjName, sessConf.detail())
+		return nil, ecode, err
+	}
+	aclOutput, err := svc.GetObjectAcl(ctx, &s3.GetObjectAclInput{
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		RequestPayer: _payer(cloudBck),
+	})
+	if err != nil {
+		ecode, err := awsErrorToAISError(err, cloudBck, lom.ObjName, sessConf.detail())
+		return nil, ecode, err
+	}
+
+	md := make(cos.StrKVs, 2)
+	if v := headOutput.StorageClass; v != "" {
+		md[cmn.StorageClassObjMD] = string(v)
+	}
+	grants := make(map[string][]string, 4)
+	for _, g := range aclOutput.Grants {
+		if g.Grantee == nil {
+			continue
+		}
+		var grantee string
+		switch {
+		case g.Grantee.ID != nil:
+			grantee = "id=\"" + *g.Grantee.ID + "\""
+		case g.Grantee.URI != nil:
+			grantee = "uri=\"" + *g.Grantee.URI + "\""
+		case g.Grantee.EmailAddress != nil:
+			grantee = "emailAddress=\"" + *g.Grantee.EmailAddress + "\""
+		default:
+			continue
+		}
+		perm := string(g.Permission)
+		grants[perm] = append(grants[perm], grantee)
+	}
+	if v := encodeACL(grants); v != "" {
+		md[cmn.ACLObjMD] = v
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infoln(tag, cloudBck.Cname(lom.ObjName), md)
+	}
+	return md, 0, nil
+}
+
 //
 // GET OBJECT
 //
@@ -485,8 +557,9 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 		cloudBck = lom.Bck().RemoteBck()
 		sessConf = sessConf{bck: cloudBck}
 		input    = s3.GetObjectInput{
-			Bucket: aws.String(cloudBck.Name),
-			Key:    aws.String(lom.ObjName),
+			Bucket:       aws.String(cloudBck.Name),
+			Key:          aws.String(lom.ObjName),
+			RequestPayer: _payer(cloudBck),
 		}
 	)
 	svc, err := sessConf.s3client("[get_obj_reader]")
@@ -547,6 +620,9 @@ func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput) (md5 *cos.Cksum) {
 	for k, v := range h.EncodeMetadata(obj.Metadata) {
 		lom.SetCustomKey(k, v)
 	}
+	if v := obj.ContentEncoding; v != nil && *v != "" {
+		lom.SetCustomKey(cmn.ContentEncodingObjMD, *v)
+	}
 	mtime := *(obj.LastModified)
 
 	// double down
@@ -566,6 +642,7 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 		svc                   *s3.Client
 		uploader              *s3manager.Uploader
 		uploadOutput          *s3manager.UploadOutput
+		input                 *s3.PutObjectInput
 		h                     = cmn.BackendHelpers.Amazon
 		cksumType, cksumValue = lom.Checksum().Get()
 		cloudBck              = lom.Bck().RemoteBck()
@@ -598,6 +675,13 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 	if oreq != nil {
 		dm := cmn.BackendHelpers.Amazon.DecodeMetadata(oreq.Header)
 		maps.Copy(md, dm)
+	} else {
+		// copying (ais => s3): restore user metadata retained at (s3 => ais) time
+		for k, v := range lom.GetCustomMD() {
+			if strings.HasPrefix(k, cmn.AwsHeaderMetaPrefix) {
+				md[strings.TrimPrefix(k, cmn.AwsHeaderMetaPrefix)] = v
+			}
+		}
 	}
 
 	uploader = s3manager.NewUploader(svc)
@@ -611,12 +695,18 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 		uploader.PartSize = partSize
 	}
 
-	uploadOutput, err = uploader.Upload(ctx, &s3.PutObjectInput{
-		Bucket:   aws.String(cloudBck.Name),
-		Key:      aws.String(lom.ObjName),
-		Body:     r,
-		Metadata: md,
-	})
+	input = &s3.PutObjectInput{
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		Body:         r,
+		Metadata:     md,
+		StorageClass: _storageClass(lom),
+		RequestPayer: _payer(cloudBck),
+	}
+	if oreq == nil {
+		_setGrants(lom, input)
+	}
+	uploadOutput, err = uploader.Upload(ctx, input)
 	cos.Close(r)
 
 	if err != nil {
@@ -648,6 +738,36 @@ setmd:
 	return 0, nil
 }
 
+// restore storage class retained at (s3 => ais) copy time (see apc.TCBMsg.PreserveBackendMeta);
+// an empty value means the bucket's default
+func _storageClass(lom *core.LOM) types.StorageClass {
+	if src, _ := lom.GetCustomKey(cmn.SourceObjMD); src != apc.AWS {
+		return ""
+	}
+	v, _ := lom.GetCustomKey(cmn.StorageClassObjMD)
+	return types.StorageClass(v)
+}
+
+// ditto ACL (grants)
+func _setGrants(lom *core.LOM, input *s3.PutObjectInput) {
+	if src, _ := lom.GetCustomKey(cmn.SourceObjMD); src != apc.AWS {
+		return
+	}
+	v, _ := lom.GetCustomKey(cmn.ACLObjMD)
+	for perm, grantees := range decodeACL(v) {
+		switch types.Permission(perm) {
+		case types.PermissionFullControl:
+			input.GrantFullControl = aws.String(grantees)
+		case types.PermissionRead:
+			input.GrantRead = aws.String(grantees)
+		case types.PermissionReadAcp:
+			input.GrantReadACP = aws.String(grantees)
+		case types.PermissionWriteAcp:
+			input.GrantWriteACP = aws.String(grantees)
+		}
+	}
+}
+
 //
 // DELETE OBJECT
 //
@@ -669,8 +789,9 @@ func (*s3bp) DeleteObj(ctx context.Context, lom *core.LOM) (ecode int, err error
 		return
 	}
 	_, err = svc.DeleteObject(ctx, &s3.DeleteObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		RequestPayer: _payer(cloudBck),
 	})
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName, sessConf.detail())
@@ -686,6 +807,24 @@ func (*s3bp) DeleteObj(ctx context.Context, lom *core.LOM) (ecode int, err error
 // static helpers
 //
 
+// requester-pays buckets (see bprops `extra.aws.requester_pays`)
+func _payer(bck *cmn.Bck) types.RequestPayer {
+	if bck.Props != nil && bck.Props.Extra.AWS.RequesterPays {
+		return types.RequestPayerRequester
+	}
+	return ""
+}
+
+// (for S3 APIs that do not have `RequestPayer` in their respective inputs)
+func _payerOpts(bck *cmn.Bck) []func(*s3.Options) {
+	if _payer(bck) == "" {
+		return nil
+	}
+	return []func(*s3.Options){
+		s3.WithAPIOptions(smithyhttp.AddHeaderValue("X-Amz-Request-Payer", string(types.RequestPayerRequester))),
+	}
+}
+
 // s3client creates or loads an existing S3 client for each triplet of profile/region/endpoint.
 // Note that each property is configurable per-bucket.
 // From S3 SDK:
@@ -784,17 +923,32 @@ func (sc *sessConf) _cid() string {
 
 // awsLoadConfig create config using default creds from ~/.aws/credentials and environment variables.
 func (sc *sessConf) awsLoadConfig() (aws.Config, error) {
-	// Disable SDK rate limiting to rely on configured backend.rate_limit
+	aisConf := cmn.GCO.Get()
+
+	// Disable SDK rate limiting to rely on configured backend.rate_limit;
+	// honor backend.aws.retry, if configured
 	retryConfig := retry.NewStandard(func(o *retry.StandardOptions) {
 		o.RateLimiter = ratelimit.None
+		if rconf := aisConf.Backend.Retry(apc.AWS); rconf != nil {
+			if rconf.MaxAttempts > 0 {
+				o.MaxAttempts = rconf.MaxAttempts
+			}
+			if rconf.MaxDelay > 0 {
+				o.MaxBackoff = rconf.MaxDelay.D()
+			}
+			o.Backoff = retry.BackoffDelayerFunc(func(attempt int, _ error) (time.Duration, error) {
+				return rconf.Delay(attempt, time.Second, o.MaxBackoff), nil
+			})
+		}
 	})
 	confFiles, credFiles := getS3ConfFiles()
 	nlog.Infoln("Loading config for profile:", sc.profile, "config files:", confFiles, "credential files:", credFiles)
 
-	// honor configured BackendIdleConnTimeout
+	// honor configured BackendIdleConnTimeout and backend.aws.max_conns
 	// TODO: other transport limits remain cmn.NewClient defaults - can be added if there's explicit need
 	client := cmn.NewClient(cmn.TransportArgs{
-		IdleConnTimeout: cmn.GCO.Get().Net.HTTP.BackendIdleConnTimeout.D(),
+		IdleConnTimeout: aisConf.Net.HTTP.BackendIdleConnTimeout.D(),
+		MaxConnsPerHost: aisConf.Backend.MaxConns(apc.AWS),
 	})
 	cfg, err := config.LoadDefaultConfig(
 		context.Background(),
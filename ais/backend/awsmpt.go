@@ -53,9 +53,10 @@ func (*s3bp) StartMpt(lom *core.LOM, oreq *http.Request) (id string, ecode int,
 	}
 
 	input := s3.CreateMultipartUploadInput{
-		Bucket:   aws.String(cloudBck.Name),
-		Key:      aws.String(lom.ObjName),
-		Metadata: metadata,
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		Metadata:     metadata,
+		RequestPayer: _payer(cloudBck),
 	}
 	out, err := svc.CreateMultipartUpload(context.Background(), &input)
 	if err == nil {
@@ -96,6 +97,7 @@ func (*s3bp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, oreq *http.Request,
 		UploadId:      aws.String(uploadID),
 		PartNumber:    &partNum,
 		ContentLength: &size,
+		RequestPayer:  _payer(cloudBck),
 	}
 
 	// disable retries if the reader is not seekable (to avoid "failed to rewind transport stream for retry")
@@ -152,9 +154,10 @@ func (*s3bp) CompleteMpt(lom *core.LOM, oreq *http.Request, uploadID string, obo
 	var (
 		s3parts types.CompletedMultipartUpload
 		input   = s3.CompleteMultipartUploadInput{
-			Bucket:   aws.String(cloudBck.Name),
-			Key:      aws.String(lom.ObjName),
-			UploadId: aws.String(uploadID),
+			Bucket:       aws.String(cloudBck.Name),
+			Key:          aws.String(lom.ObjName),
+			UploadId:     aws.String(uploadID),
+			RequestPayer: _payer(cloudBck),
 		}
 	)
 
@@ -198,9 +201,10 @@ func (*s3bp) AbortMpt(lom *core.LOM, oreq *http.Request, uploadID string) (ecode
 		return http.StatusInternalServerError, errN
 	}
 	input := s3.AbortMultipartUploadInput{
-		Bucket:   aws.String(cloudBck.Name),
-		Key:      aws.String(lom.ObjName),
-		UploadId: aws.String(uploadID),
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		UploadId:     aws.String(uploadID),
+		RequestPayer: _payer(cloudBck),
 	}
 	if _, err = svc.AbortMultipartUpload(context.Background(), &input); err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
@@ -60,9 +60,12 @@ const (
 
 type (
 	azbp struct {
-		t     core.TargetPut
-		creds *azblob.SharedKeyCredential
-		u     string
+		t core.TargetPut
+		// all clients share a single pipeline and, therefore, a single (bounded) connection pool;
+		// the shared credential can be updated in place (SharedKeyCredential.SetAccountKey)
+		// to rotate the account key without re-creating clients
+		creds  *azblob.SharedKeyCredential
+		client *azblob.Client
 		base
 	}
 )
@@ -106,11 +109,38 @@ func NewAzure(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Bac
 	if err != nil {
 		return nil, cmn.NewErrFailedTo(nil, azErrPrefix+": init]", "credentials", err)
 	}
+	// NOTE: honoring backend.azure.max_conns (see also: cmn.BackendConfCloud)
+	var (
+		config = cmn.GCO.Get()
+		cargs  = cmn.TransportArgs{
+			IdleConnTimeout: config.Net.HTTP.BackendIdleConnTimeout.D(),
+			MaxConnsPerHost: config.Backend.MaxConns(apc.Azure),
+		}
+		opts = &azblob.ClientOptions{
+			ClientOptions: azcore.ClientOptions{Transport: cmn.NewClient(cargs)},
+		}
+	)
+	// NOTE: ditto backend.azure.retry (the SDK always randomizes delays)
+	if rconf := config.Backend.Retry(apc.Azure); rconf != nil {
+		ropts := &opts.ClientOptions.Retry
+		switch {
+		case rconf.MaxAttempts == 1:
+			ropts.MaxRetries = -1 // (zero means SDK default)
+		case rconf.MaxAttempts > 1:
+			ropts.MaxRetries = int32(rconf.MaxAttempts - 1)
+		}
+		ropts.RetryDelay = rconf.BaseDelay.D()
+		ropts.MaxRetryDelay = rconf.MaxDelay.D()
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(blurl, creds, opts)
+	if err != nil {
+		return nil, cmn.NewErrFailedTo(nil, azErrPrefix+": init]", "client", err)
+	}
 	bp := &azbp{
-		t:     t,
-		creds: creds,
-		u:     blurl,
-		base:  base{provider: apc.Azure},
+		t:      t,
+		creds:  creds,
+		client: client,
+		base:   base{provider: apc.Azure},
 	}
 	// register metrics
 	bp.base.init(t.Snode(), tstats, startingUp)
@@ -204,6 +234,15 @@ func _azureErr(azureError error, stgErr *azcore.ResponseError) (int, error) {
 	return status, azureError
 }
 
+// (cached) clients
+func (azbp *azbp) cntClient(cntName string) *container.Client {
+	return azbp.client.ServiceClient().NewContainerClient(cntName)
+}
+
+func (azbp *azbp) blobClient(cntName, objName string) *blockblob.Client {
+	return azbp.cntClient(cntName).NewBlockBlobClient(objName)
+}
+
 // as core.Backend --------------------------------------------------------------
 
 //
@@ -213,13 +252,8 @@ func _azureErr(azureError error, stgErr *azcore.ResponseError) (int, error) {
 func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
 	var (
 		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
+		client   = azbp.cntClient(cloudBck.Name)
 	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
-	if err != nil {
-		status, err := azureErrorToAISError(err, cloudBck, "")
-		return nil, status, err
-	}
 	resp, err := client.GetProperties(ctx, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, "")
@@ -251,16 +285,12 @@ func (azbp *azbp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMs
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
+		client   = azbp.cntClient(cloudBck.Name)
 		num      = int32(msg.PageSize)
 		opts     = container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num}
 	)
 	lst.ContinuationToken = ""
 
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
-	if err != nil {
-		return azureErrorToAISError(err, cloudBck, "")
-	}
 	if cmn.Rom.V(4, cos.ModBackend) {
 		nlog.Infof("list_objects %s", cloudBck.Name)
 	}
@@ -327,12 +357,7 @@ func (azbp *azbp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMs
 //
 
 func (azbp *azbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, _ int, _ error) {
-	serviceClient, err := service.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
-	if err != nil {
-		status, err := azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
-		return nil, status, err
-	}
-	pager := serviceClient.NewListContainersPager(&service.ListContainersOptions{})
+	pager := azbp.client.ServiceClient().NewListContainersPager(&service.ListContainersOptions{})
 	for pager.More() {
 		resp, err := pager.NextPage(context.TODO())
 		if err != nil {
@@ -360,13 +385,8 @@ func (azbp *azbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+		client   = azbp.blobClient(cloudBck.Name, lom.ObjName)
 	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
-	if err != nil {
-		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
-		return nil, status, err
-	}
 	resp, err := client.GetProperties(ctx, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
@@ -396,6 +416,9 @@ func (azbp *azbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (
 		// - only shown via list-objects and HEAD when not present
 		oa.SetCustomKey(cos.HdrContentType, *v)
 	}
+	if v := resp.ContentEncoding; v != nil && *v != "" {
+		oa.SetCustomKey(cmn.ContentEncodingObjMD, *v)
+	}
 	if cmn.Rom.V(5, cos.ModBackend) {
 		nlog.Infof("[head_object] %s", lom)
 	}
@@ -425,13 +448,8 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+		client   = azbp.blobClient(cloudBck.Name, lom.ObjName)
 	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
-	if err != nil {
-		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
-		return res
-	}
 
 	// Get checksum
 	respProps, err := client.GetProperties(ctx, nil)
@@ -468,6 +486,9 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 			lom.SetCustomKey(cmn.MD5ObjMD, md5)
 			res.ExpCksum = cos.NewCksum(cos.ChecksumMD5, md5)
 		}
+		if v := respProps.ContentEncoding; v != nil && *v != "" {
+			lom.SetCustomKey(cmn.ContentEncodingObjMD, *v)
+		}
 	}
 
 	res.R = resp.Body
@@ -481,10 +502,6 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
 	defer cos.Close(r)
 
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
-	if err != nil {
-		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
-	}
 	cloudBck := lom.Bck().RemoteBck()
 
 	opts := azblob.UploadStreamOptions{}
@@ -492,7 +509,7 @@ func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 		opts.Concurrency = int(min((size+cos.MiB-1)/cos.MiB, 8))
 	}
 
-	resp, err := client.UploadStream(ctx, cloudBck.Name, lom.ObjName, r, &opts)
+	resp, err := azbp.client.UploadStream(ctx, cloudBck.Name, lom.ObjName, r, &opts)
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, lom.ObjName)
 	}
@@ -518,13 +535,9 @@ func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 //
 
 func (azbp *azbp) DeleteObj(ctx context.Context, lom *core.LOM) (int, error) {
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
-	if err != nil {
-		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
-	}
 	cloudBck := lom.Bck().RemoteBck()
 
-	_, err = client.DeleteBlob(ctx, cloudBck.Name, lom.ObjName, nil)
+	_, err := azbp.client.DeleteBlob(ctx, cloudBck.Name, lom.ObjName, nil)
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, lom.ObjName)
 	}
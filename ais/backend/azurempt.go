@@ -13,8 +13,6 @@ import (
 	"io"
 	"net/http"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
-
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -56,23 +54,16 @@ func (*azbp) StartMpt(lom *core.LOM, _ *http.Request) (id string, ecode int, err
 func (azbp *azbp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, _ *http.Request, uploadID string, _ int64, partNum int32) (string, int, error) {
 	var (
 		cloudBck = lom.Bck().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+		client   = azbp.blobClient(cloudBck.Name, lom.ObjName)
 	)
 
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
-	if err != nil {
-		cos.Close(r)
-		ecode, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
-		return "", ecode, err
-	}
-
 	blockID := azureBlockID(uploadID, int(partNum))
 
 	// StageBlock requires io.ReadSeekCloser
 	rsc, ok := r.(io.ReadSeekCloser)
 	debug.Assertf(ok, "Azure backend requires io.ReadSeekCloser, but got %T", r)
 
-	_, err = client.StageBlock(context.Background(), blockID, rsc, nil)
+	_, err := client.StageBlock(context.Background(), blockID, rsc, nil)
 
 	if err != nil {
 		ecode, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
@@ -90,15 +81,9 @@ func (azbp *azbp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, _ *http.Reques
 func (azbp *azbp) CompleteMpt(lom *core.LOM, _ *http.Request, uploadID string, _ []byte, parts apc.MptCompletedParts) (version, etag string, _ int, _ error) {
 	var (
 		cloudBck = lom.Bck().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+		client   = azbp.blobClient(cloudBck.Name, lom.ObjName)
 	)
 
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
-	if err != nil {
-		ecode, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
-		return "", "", ecode, err
-	}
-
 	// Build the list of block IDs by reconstructing them from part numbers
 	blockIDs := make([]string, len(parts))
 	for i, part := range parts {
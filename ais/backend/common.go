@@ -5,7 +5,10 @@
 package backend
 
 import (
+	"context"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -21,6 +24,12 @@ import (
 
 const numBackendMetricks = 12
 
+// optional: backend-specific metadata of the remote object, such as storage class and ACL
+// (see apc.TCBMsg.PreserveBackendMeta); costs additional request(s) to the backend
+type MDGetter interface {
+	GetObjBackendMD(ctx context.Context, lom *core.LOM) (md cos.StrKVs, ecode int, err error)
+}
+
 type base struct {
 	metrics  cos.StrKVs // this backend's metric names (below)
 	provider string
@@ -253,3 +262,42 @@ func allocPutParams(res core.GetReaderResult, owt cmn.OWT) *core.PutParams {
 	}
 	return params
 }
+
+// object ACL as custom metadata (cmn.ACLObjMD): grantees by permission,
+// sorted by permission and separated by ';', e.g.:
+// `FULL_CONTROL:id="abc";READ:uri="http://acs.amazonaws.com/groups/global/AllUsers"`
+// (grantees of a given permission are comma-separated - same as x-amz-grant-* header values)
+func encodeACL(grants map[string][]string) string {
+	perms := make([]string, 0, len(grants))
+	for perm, grantees := range grants {
+		if len(grantees) > 0 {
+			perms = append(perms, perm)
+		}
+	}
+	slices.Sort(perms)
+	var sb strings.Builder
+	for i, perm := range perms {
+		if i > 0 {
+			sb.WriteByte(';')
+		}
+		sb.WriteString(perm)
+		sb.WriteByte(':')
+		sb.WriteString(strings.Join(grants[perm], ", "))
+	}
+	return sb.String()
+}
+
+// reverse of encodeACL: permission => comma-separated grantees
+func decodeACL(s string) cos.StrKVs {
+	if s == "" {
+		return nil
+	}
+	grants := make(cos.StrKVs, 4)
+	for item := range strings.SplitSeq(s, ";") {
+		perm, grantees, ok := strings.Cut(item, ":")
+		if ok && perm != "" && grantees != "" {
+			grants[perm] = grantees
+		}
+	}
+	return grants
+}
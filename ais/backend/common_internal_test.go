@@ -0,0 +1,36 @@
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestEncodeACL(t *testing.T) {
+	grants := map[string][]string{
+		"READ":         {`uri="http://acs.amazonaws.com/groups/global/AllUsers"`, `id="def"`},
+		"FULL_CONTROL": {`id="abc"`},
+		"WRITE_ACP":    nil,
+	}
+	s := encodeACL(grants)
+	const expected = `FULL_CONTROL:id="abc";READ:uri="http://acs.amazonaws.com/groups/global/AllUsers", id="def"`
+	tassert.Fatalf(t, s == expected, "expecting %q, got %q", expected, s)
+
+	decoded := decodeACL(s)
+	tassert.Fatalf(t, len(decoded) == 2, "expecting 2 permissions, got %v", decoded)
+	for perm, grantees := range grants {
+		if len(grantees) == 0 {
+			continue
+		}
+		v := strings.Join(grantees, ", ")
+		tassert.Errorf(t, decoded[perm] == v, "%s: expecting %q, got %q", perm, v, decoded[perm])
+	}
+
+	tassert.Errorf(t, encodeACL(nil) == "", "expecting empty")
+	tassert.Errorf(t, decodeACL("") == nil, "expecting nil")
+}
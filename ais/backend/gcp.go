@@ -27,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/tracing"
 
 	"cloud.google.com/go/storage"
+	"github.com/googleapis/gax-go/v2"
 	jsoniter "github.com/json-iterator/go"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
@@ -417,6 +418,9 @@ func (gsbp *gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 	}
 
 	expCksum := gcpSetCustom(lom, attrs)
+	if attrs.ContentEncoding != "" && !rc.Attrs.Decompressed {
+		lom.SetCustomKey(cmn.ContentEncodingObjMD, attrs.ContentEncoding)
+	}
 	if res.ExpCksum == nil {
 		res.ExpCksum = expCksum
 	}
@@ -564,7 +568,8 @@ func gcpCreateSess(ctx context.Context, credPath string, bck *cmn.Bck) (*gcpSess
 
 func (sess *gcpSess) init(ctx context.Context, opts []option.ClientOption) error {
 	// HTTP transport
-	transport, err := htransport.NewTransport(ctx, cmn.NewTransport(cmn.TransportArgs{}), opts...)
+	cargs := cmn.TransportArgs{MaxConnsPerHost: cmn.GCO.Get().Backend.MaxConns(apc.GCP)}
+	transport, err := htransport.NewTransport(ctx, cmn.NewTransport(cargs), opts...)
 	if err != nil {
 		return cmn.NewErrFailedTo(nil, "gcp-backend: create", "http transport", err)
 	}
@@ -579,6 +584,22 @@ func (sess *gcpSess) init(ctx context.Context, opts []option.ClientOption) error
 		return cmn.NewErrFailedTo(nil, "gcp-backend: create", "client", err)
 	}
 
+	// backend.gcp.retry, if configured (the SDK always randomizes delays)
+	if rconf := cmn.GCO.Get().Backend.Retry(apc.GCP); rconf != nil {
+		var ropts []storage.RetryOption
+		if rconf.MaxAttempts > 0 {
+			ropts = append(ropts, storage.WithMaxAttempts(rconf.MaxAttempts))
+		}
+		if rconf.BaseDelay > 0 || rconf.MaxDelay > 0 {
+			ropts = append(ropts, storage.WithBackoff(gax.Backoff{
+				Initial:    rconf.BaseDelay.D(), // (zero: SDK default)
+				Max:        rconf.MaxDelay.D(),
+				Multiplier: 2,
+			}))
+		}
+		client.SetRetry(ropts...)
+	}
+
 	sess.client = client
 	return nil
 }
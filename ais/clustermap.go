@@ -5,12 +5,14 @@
 package ais
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"strconv"
 	"sync"
 	ratomic "sync/atomic"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -63,6 +65,11 @@ type (
 		mpath   string // target's 2nd copy
 		immSize int64
 		mu      sync.Mutex
+		// closed (and replaced) upon each put (see waitNewer)
+		changed struct {
+			ch chan struct{}
+			mu sync.Mutex
+		}
 		// ditto
 		isTarget bool
 	}
@@ -633,6 +640,44 @@ func (r *smapOwner) put(smap *smapX) {
 	// put and notify
 	r.smap.Store(smap)
 	r.sls.notify(smap.version())
+
+	r.changed.mu.Lock()
+	if r.changed.ch != nil {
+		close(r.changed.ch)
+		r.changed.ch = nil
+	}
+	r.changed.mu.Unlock()
+}
+
+// returns a channel that gets closed upon the next put
+func (r *smapOwner) changedCh() <-chan struct{} {
+	r.changed.mu.Lock()
+	if r.changed.ch == nil {
+		r.changed.ch = make(chan struct{})
+	}
+	ch := r.changed.ch
+	r.changed.mu.Unlock()
+	return ch
+}
+
+// wait for Smap version > ver, or until timeout or ctx done - whichever comes first
+func (r *smapOwner) waitNewer(ctx context.Context, ver int64, timeout time.Duration) *smapX {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		ch := r.changedCh()
+		// (check after getting the channel - not to miss the change)
+		if smap := r.get(); smap.Version > ver {
+			return smap
+		}
+		select {
+		case <-ch:
+		case <-timer.C:
+			return r.get()
+		case <-ctx.Done():
+			return r.get()
+		}
+	}
 }
 
 func (r *smapOwner) get() *smapX { return r.smap.Load() }
@@ -0,0 +1,63 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSmapWaitNewer(t *testing.T) {
+	owner := newSmapOwner(cmn.GCO.Get(), false /*isTarget*/)
+	smap := newSmap()
+	smap.Version = 10
+	owner.put(smap)
+
+	// already newer
+	started := time.Now()
+	got := owner.waitNewer(context.Background(), 9, time.Minute)
+	tassert.Errorf(t, got.Version == 10 && time.Since(started) < time.Second, "expected immediate v10, got v%d", got.Version)
+
+	// timeout
+	got = owner.waitNewer(context.Background(), 10, 50*time.Millisecond)
+	tassert.Errorf(t, got.Version == 10, "timeout: expected v10, got v%d", got.Version)
+
+	// canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	got = owner.waitNewer(ctx, 10, time.Minute)
+	tassert.Errorf(t, got.Version == 10 && ctx.Err() != nil, "canceled: expected v10, got v%d", got.Version)
+
+	// many waiters, all woken up by a single put; no goroutines left behind
+	const numWaiters = 32
+	var (
+		wg       sync.WaitGroup
+		versions = make([]int64, numWaiters)
+		baseline = runtime.NumGoroutine()
+	)
+	for i := range numWaiters {
+		wg.Add(1)
+		go func(i int) {
+			versions[i] = owner.waitNewer(context.Background(), 10, time.Minute).Version
+			wg.Done()
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	next := newSmap()
+	next.Version = 11
+	owner.put(next)
+	wg.Wait()
+	for i, v := range versions {
+		tassert.Errorf(t, v == 11, "waiter %d: expected v11, got v%d", i, v)
+	}
+	time.Sleep(10 * time.Millisecond)
+	tassert.Errorf(t, runtime.NumGoroutine() <= baseline, "goroutines: %d (baseline %d)", runtime.NumGoroutine(), baseline)
+}
@@ -81,14 +81,23 @@ type (
 		dontAddRemote bool // QparamDontAddRemote
 		silent        bool // QparamSilent
 		latestVer     bool // QparamLatestVer
+		warmCache     bool // QparamWarmCache
+		decodeCE      bool // QparamDecodeContentEncoding
 		sync          bool // QparamSync
 		system        bool // QparamSystem (allow system buckets)
 
 		// Special use (internal context)
-		isS3 bool // frontend S3 API
+		isS3 bool   // frontend S3 API
+		aof  string // alias being followed locally (see dpq.aliasOf)
 
 		// GetBatch apc.ColocLevel
 		coloc uint8
+
+		// GET: number of chunks to read ahead (QparamReadAhead)
+		readAhead uint8
+
+		// GET: transmit rate cap, bytes per second (QparamMaxBps)
+		maxBps int64
 	}
 )
 
@@ -183,6 +192,10 @@ func (dpq *dpq) parse(rawQuery string) error {
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
+		case apc.QparamWarmCache:
+			dpq.warmCache = cos.IsParseBool(value)
+		case apc.QparamDecodeContentEncoding:
+			dpq.decodeCE = cos.IsParseBool(value)
 		case apc.QparamSync:
 			dpq.sync = cos.IsParseBool(value)
 		case apc.QparamSystem:
@@ -192,6 +205,15 @@ func (dpq *dpq) parse(rawQuery string) error {
 			var coloc uint64
 			coloc, err = strconv.ParseUint(value, 10, 8)
 			dpq.coloc = uint8(coloc)
+		case apc.QparamReadAhead:
+			var ra uint64
+			ra, err = strconv.ParseUint(value, 10, 8)
+			dpq.readAhead = uint8(ra)
+		case apc.QparamMaxBps:
+			dpq.maxBps, err = strconv.ParseInt(value, 10, 64)
+			if err == nil && dpq.maxBps < 0 {
+				err = strconv.ErrRange
+			}
 
 		// System fields
 		case apc.QparamUnixTime:
@@ -219,9 +241,11 @@ func (dpq *dpq) parse(rawQuery string) error {
 		case apc.QparamMptUploads, apc.QparamMptPartNo,
 			apc.QparamFltPresence, apc.QparamBinfoWithOrWithoutRemote,
 			apc.QparamETLName,
-			apc.QparamAppendType,
+			apc.QparamAppendType, apc.QparamSparseSize, apc.QparamSparseOffset,
+			apc.QparamGenSize, apc.QparamGenPattern,
+			apc.QparamBckHeadTimeout,
 			apc.QparamNewCustom,
-			apc.QparamKeepRemote,
+			apc.QparamKeepRemote, apc.QparamSoftDelete,
 			apc.QparamTID:
 			dpq.m[key] = value
 
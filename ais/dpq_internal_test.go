@@ -5,10 +5,13 @@
 package ais
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
 
@@ -30,3 +33,65 @@ func TestDpqETLTransformArgs(t *testing.T) {
 		dpqFree(dpq)
 	}
 }
+
+func TestDpqDecodeContentEncoding(t *testing.T) {
+	for val, expected := range map[string]bool{"true": true, "false": false, "": false} {
+		q := url.Values{}
+		q.Set(apc.QparamWarmCache, "true")
+		if val != "" {
+			q.Set(apc.QparamDecodeContentEncoding, val)
+		}
+
+		dpq := dpqAlloc()
+		err := dpq.parse(q.Encode())
+		tassert.CheckFatal(t, err)
+
+		tassert.Errorf(t, dpq.decodeCE == expected, "%q: expected decodeCE=%t", val, expected)
+		tassert.Errorf(t, dpq.warmCache, "%q: expected warmCache", val)
+		dpqFree(dpq)
+	}
+}
+
+func TestDpqMaxBps(t *testing.T) {
+	for val, expected := range map[string]int64{"1048576": cos.MiB, "0": 0} {
+		q := url.Values{}
+		q.Set(apc.QparamMaxBps, val)
+
+		dpq := dpqAlloc()
+		err := dpq.parse(q.Encode())
+		tassert.CheckFatal(t, err)
+
+		tassert.Errorf(t, dpq.maxBps == expected, "%q: expected maxBps=%d, got %d", val, expected, dpq.maxBps)
+		dpqFree(dpq)
+	}
+	for _, val := range []string{"-1", "1MiB"} {
+		q := url.Values{}
+		q.Set(apc.QparamMaxBps, val)
+
+		dpq := dpqAlloc()
+		err := dpq.parse(q.Encode())
+		tassert.Errorf(t, err != nil, "%q: expected error", val)
+		dpqFree(dpq)
+	}
+}
+
+// apc.QparamAliasOf: honored on intra-cluster requests only
+func TestDpqAliasOf(t *testing.T) {
+	q := url.Values{}
+	q.Set(apc.QparamAliasOf, "alias")
+
+	dpq := dpqAlloc()
+	tassert.CheckFatal(t, dpq.parse(q.Encode()))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/objects/bck/obj?"+q.Encode(), http.NoBody)
+	tassert.Errorf(t, dpq.aliasOf(r) == "", "external request: expected %q ignored", apc.QparamAliasOf)
+
+	r.Header.Set(apc.HdrSenderID, "t1")
+	tassert.Errorf(t, dpq.aliasOf(r) == "alias", "intra-cluster request: expected %q, got %q", "alias", dpq.aliasOf(r))
+
+	// following locally (see t.getAliased)
+	r.Header.Del(apc.HdrSenderID)
+	dpq.aof = "local"
+	tassert.Errorf(t, dpq.aliasOf(r) == "local", "following locally: expected %q, got %q", "local", dpq.aliasOf(r))
+	dpqFree(dpq)
+}
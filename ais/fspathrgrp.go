@@ -60,7 +60,7 @@ func (g *fsprungroup) attachMpath(mpath string, label cos.MountpathLabel) (added
 }
 
 func (g *fsprungroup) _postAdd(action string, ami *fs.Mountpath) {
-	fspathsConfigAddDel(ami.Path, true /*add*/)
+	fspathsConfigAddDel(ami, true /*add*/)
 
 	g.preempt(action, ami)
 
@@ -83,6 +83,24 @@ func (g *fsprungroup) _postAdd(action string, ami *fs.Mountpath) {
 	}
 }
 
+//
+// label
+//
+
+// labelMpath (re)labels available or disabled mountpath and persists the label
+// in VMD and - for available mountpaths - in the local config (fspaths)
+func (g *fsprungroup) labelMpath(mpath string, label cos.MountpathLabel) (*fs.Mountpath, error) {
+	mi, err := fs.SetMpathLabel(mpath, label, g.redistributeMD)
+	if err != nil || mi == nil {
+		return mi, err
+	}
+	if avail := fs.GetAvail(); avail[mi.Path] == mi {
+		fspathsConfigAddDel(mi, true /*add*/)
+	}
+	nlog.Infoln(apc.ActMountpathLabel, mi.String())
+	return mi, nil
+}
+
 //
 // remove | disable
 //
@@ -234,7 +252,7 @@ func (g *fsprungroup) postDD(rmi *fs.Mountpath, action string, xres *xs.Resilver
 		nlog.Errorln(err)
 		return
 	}
-	fspathsConfigAddDel(rmi.Path, false /*add*/)
+	fspathsConfigAddDel(rmi, false /*add*/)
 	nlog.Infof("%s: %s %q %s done", g.t, rmi, action, xres)
 
 	// 3. the case of multiple overlapping detach _or_ disable operations
@@ -255,23 +273,23 @@ func (g *fsprungroup) postDD(rmi *fs.Mountpath, action string, xres *xs.Resilver
 			nlog.Errorln(err)
 			return
 		}
-		fspathsConfigAddDel(mi.Path, false /*add*/)
+		fspathsConfigAddDel(mi, false /*add*/)
 		nlog.Infof("%s: %s %s %s was previously aborted and now done", g.t, action, mi, xres)
 	}
 }
 
 // store updated fspaths locally as part of the 'OverrideConfigFname'
 // and commit new version of the config
-func fspathsConfigAddDel(mpath string, add bool) {
+func fspathsConfigAddDel(mi *fs.Mountpath, add bool) {
 	if cmn.Rom.TestingEnv() { // since testing fspaths are counted, not enumerated
 		return
 	}
 	config := cmn.GCO.BeginUpdate()
 	localConfig := &config.LocalConfig
 	if add {
-		localConfig.AddPath(mpath)
+		localConfig.AddPath(mi.Path, string(mi.Label))
 	} else {
-		localConfig.DelPath(mpath)
+		localConfig.DelPath(mi.Path)
 	}
 	if err := localConfig.FSP.Validate(config); err != nil {
 		debug.AssertNoErr(err)
@@ -410,9 +410,10 @@ func (h *htrun) initPhase1(config *cmn.Config) {
 
 	// 4. new Snode
 	h.si = &meta.Snode{
-		PubNet:     pubAddr,
-		ControlNet: ctrlAddr,
-		DataNet:    dataAddr,
+		PubNet:        pubAddr,
+		ControlNet:    ctrlAddr,
+		DataNet:       dataAddr,
+		FailureDomain: config.FailureDomain,
 	}
 	if l := len(pubExtra); l > 0 {
 		h.si.PubExtra = make([]meta.NetInfo, l)
@@ -1350,6 +1351,8 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		out = *config
 		out.Auth = config.Auth.PublicClone()
 		body = &out
+	case apc.WhatNodeConfigDiff:
+		body = cmn.GCO.Get().ClusterConfig.DiffDefaults()
 	case apc.WhatSmap:
 		body = h.owner.smap.get()
 	case apc.WhatBMD:
@@ -1383,6 +1386,19 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		body = statsNode
 	case apc.WhatMetricNames:
 		body = h.statsT.GetMetricNames()
+	case apc.WhatKeepalive:
+		body = h.keepalive.status()
+	case apc.WhatRecentErrors:
+		var limit int
+		if s := query.Get(apc.QparamLimit); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n < 0 {
+				h.writeErrf(w, r, "invalid '%s=%s' query", apc.QparamLimit, s)
+				return
+			}
+			limit = n
+		}
+		body = h.statsT.GetRecentErrs(limit)
 	case apc.WhatCertificate: // (see also: daeLoadX509, cluLoadX509)
 		body = certloader.Props()
 	default:
@@ -2888,6 +2904,10 @@ func (rl *ratelim) housekeep(now int64) time.Duration {
 }
 
 func (rl *ratelim) cleanup(k, v any) bool {
+	if s, ok := v.(*coldSem); ok {
+		s.prune(rl, k)
+		return true
+	}
 	r := v.(cos.Rater)
 	if time.Duration(rl.now-r.LastUsed()) >= hk.PruneRateLimiters>>1 {
 		rl.Delete(k)
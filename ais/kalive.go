@@ -45,6 +45,7 @@ type (
 		paused() bool
 		cfg(config *cmn.Config) *cmn.KeepaliveTrackerConf
 		cluUptime(int64) time.Duration
+		status() stats.KaliveStatus
 	}
 	talive struct {
 		t *target
@@ -76,11 +77,15 @@ type (
 		HeardFrom(id string, now int64) int64 // callback for 'id' to respond
 		TimedOut(id string) bool              // true if 'id' didn't keepalive or called (via "heard") within the interval (above)
 
+		Failed(id string) int64 // increments and returns the number of consecutive failures
+
 		reg(id string)
 		set(interval time.Duration) bool
+		status() stats.KaliveStatus
 	}
 	heartBeat struct {
-		last     sync.Map
+		last     sync.Map      // id => mono-time of the last successful keep-alive
+		fails    sync.Map      // id => number of consecutive failures (reset upon HeardFrom)
 		interval time.Duration // timeout
 	}
 )
@@ -266,6 +271,7 @@ func (pkr *palive) updateSmap(config *cmn.Config) (stopped bool) {
 			nlog.Warningln(pkr.p.String(), "failed to fast-kalive", si.StringEx(), "err: [", err, status, "]")
 
 			pkr.statsT.Inc(stats.ErrKaliveCount)
+			pkr.hb.Failed(si.ID())
 			wg.Add(1)
 			go pkr.goping(si, wg, smap, config)
 		}
@@ -328,6 +334,7 @@ func (pkr *palive) _pingRetry(si *meta.Snode, smap *smapX, config *cmn.Config) (
 	tout = config.Timeout.MaxKeepalive.D()
 	nlog.Warningln(pname, "failed to slow-kalive", sname, "- retrying [", err, status, tout, smap.StringEx(), "]")
 	pkr.statsT.Inc(stats.ErrKaliveCount)
+	pkr.hb.Failed(si.ID())
 
 	ticker := time.NewTicker(cmn.KeepaliveRetryDuration(config))
 	ok, stopped = pkr.retry(si, ticker, tout, config.Keepalive.NumRetries)
@@ -432,6 +439,7 @@ func (pkr *palive) retry(si *meta.Snode, ticker *time.Ticker, tout time.Duration
 			}
 
 			pkr.statsT.Inc(stats.ErrKaliveCount)
+			pkr.hb.Failed(si.ID())
 			i++
 
 			if i >= kaNumRetries {
@@ -585,6 +593,7 @@ func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped
 	}
 
 	k.statsT.Inc(stats.ErrKaliveCount)
+	k.hb.Failed(pid)
 
 	debug.Assert(cpid == pid && cpid != si.ID())
 	nlog.Warningln(sname, "=>", pname, "failure - retrying: [", fast, tout, err, status, "]")
@@ -616,6 +625,10 @@ func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped
 				nlog.Infoln(sname, "=>", pname, "OK after", i, "attempt"+cos.Plural(i), "tout", tout)
 				return false
 			}
+			if pid != "" {
+				k.hb.Failed(pid)
+			}
+
 			// repeat up to `kaNumRetries` times with max-keepalive timeout
 			tout = config.Timeout.MaxKeepalive.D()
 
@@ -657,6 +670,8 @@ func (k *keepalive) ctrl(msg string) {
 
 func (k *keepalive) paused() bool { return k.tickerPaused.Load() }
 
+func (k *keepalive) status() stats.KaliveStatus { return k.hb.status() }
+
 ///////////////
 // heartBeat //
 ///////////////
@@ -678,9 +693,17 @@ func (hb *heartBeat) HeardFrom(id string, now int64) int64 {
 		hb.last.Store(id, val)
 	}
 	ratomic.StoreInt64(val, now)
+	if f, ok := hb.fails.Load(id); ok {
+		ratomic.StoreInt64(f.(*int64), 0)
+	}
 	return now
 }
 
+func (hb *heartBeat) Failed(id string) int64 {
+	f, _ := hb.fails.LoadOrStore(id, new(int64))
+	return ratomic.AddInt64(f.(*int64), 1)
+}
+
 func (hb *heartBeat) TimedOut(id string) bool {
 	v, ok := hb.last.Load(id)
 	if !ok {
@@ -699,3 +722,29 @@ func (hb *heartBeat) set(interval time.Duration) (changed bool) {
 	hb.interval = interval
 	return
 }
+
+func (hb *heartBeat) status() stats.KaliveStatus {
+	var (
+		out = make(stats.KaliveStatus, 8)
+		now = time.Now()
+	)
+	hb.last.Range(func(k, v any) bool {
+		pk := &stats.PeerKalive{}
+		if tim := ratomic.LoadInt64(v.(*int64)); tim != 0 {
+			pk.LastOK = now.Add(-mono.Since(tim))
+		}
+		out[k.(string)] = pk
+		return true
+	})
+	hb.fails.Range(func(k, v any) bool {
+		id := k.(string)
+		pk, ok := out[id]
+		if !ok {
+			pk = &stats.PeerKalive{}
+			out[id] = pk
+		}
+		pk.NumFails = ratomic.LoadInt64(v.(*int64))
+		return true
+	})
+	return out
+}
@@ -48,4 +48,18 @@ func TestHB(t *testing.T) {
 	if !hb.TimedOut(id1) {
 		t.Fatal("Expecting timeout")
 	}
+
+	// consecutive failures
+	hb.Failed(id1)
+	if n := hb.Failed(id1); n != 2 {
+		t.Fatalf("Expecting 2 consecutive failures, got %d", n)
+	}
+	st := hb.status()
+	if st[id1] == nil || st[id1].NumFails != 2 || st[id1].LastOK.IsZero() {
+		t.Fatalf("Unexpected keepalive status %+v", st[id1])
+	}
+	hb.HeardFrom(id1, 0 /*now*/)
+	if st = hb.status(); st[id1].NumFails != 0 {
+		t.Fatalf("Expecting failures to reset, got %d", st[id1].NumFails)
+	}
 }
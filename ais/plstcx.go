@@ -106,10 +106,18 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 		lsmsg.UUID = cos.GenUUID()
 		newls = true
 	}
+	// case-insensitive bucket: listed names are lowercase (see cmn.Bprops.CaseInsensitiveNames)
+	lsmsg.Prefix = bck.NormObjName(lsmsg.Prefix)
+	lsmsg.StartAfter = bck.NormObjName(lsmsg.StartAfter)
+
 	fc, err := p._lsofc(bck, lsmsg, smap)
 	if err != nil {
 		return nil, err
 	}
+	var pageSize int64
+	if !lsmsg.IsFlagSet(apc.LsNBI) { // (inventory pages are approximate - see apc.LsNBI)
+		pageSize = _lsoPageSize(bck, lsmsg, fc.listRemote)
+	}
 
 	if fc.listRemote {
 		// R-flow
@@ -141,10 +149,30 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 		// A-flow
 		lst, err = p.lsObjsA(bck, lsmsg, hdr, smap)
 	}
-
+	if err == nil {
+		lst.PageSize = pageSize
+	}
 	return lst, err
 }
 
+// effective page size: (user-requested or the bucket's maximum) auto-reduced
+// when listing expensive props (see apc.MaxPageSizeCustom)
+func _lsoPageSize(bck *meta.Bck, lsmsg *apc.LsoMsg, listRemote bool) int64 {
+	maxPageSize := int64(apc.MaxPageSizeAIS)
+	if listRemote {
+		maxPageSize = bck.MaxPageSize()
+	}
+	pageSize := lsmsg.PageSize
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if lsmsg.WantProp(apc.GetPropsCustom) {
+		pageSize = min(pageSize, apc.MaxPageSizeCustom)
+	}
+	lsmsg.PageSize = pageSize
+	return pageSize
+}
+
 // list-objects: flow control helper
 // - decide: R-flow or A-flow
 // - designate target for R-flow, etc.
@@ -472,11 +500,16 @@ func finLsoA(objs *cmn.LsoRes, lsmsg *apc.LsoMsg) {
 }
 
 // - remove adjacent entries with the same Name (the input must already be sorted by Name)
+// - virtual directories: sum up per-target summaries, if any (apc.GetPropsDirSummary)
 // - stop after producing maxSize entries
 func dedupLso(entries cmn.LsoEntries, maxSize int) cmn.LsoEntries {
 	var j int
 	for _, en := range entries {
 		if j > 0 && entries[j-1].Name == en.Name {
+			if prev := entries[j-1]; en.Count > 0 && prev.IsAnyFlagSet(apc.EntryIsDir) {
+				prev.Size += en.Size
+				prev.Count += en.Count
+			}
 			continue
 		}
 
@@ -452,6 +452,9 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
 	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
+	if err == nil {
+		objName = bck.NormObjName(objName)
+	}
 
 	apiReqFree(apireq)
 	freeBctx(bckArgs) // caller does alloc
@@ -613,8 +616,8 @@ func (p *proxy) easyURLHandler(w http.ResponseWriter, r *http.Request) {
 // +gen:payload apc.ActList={"action": "list", "value": {"prefix": "images/", "props": "name,size,checksum", "pagesize": 1000}}
 // +gen:payload apc.ActSummaryBck={"action": "summary-bck", "value": {"prefix": "images/", "cached": true}}
 // +gen:payload apc.ActSummaryShard={"action": "summary-shard", "value": {"prefix": "images/"}}
-// +gen:endpoint GET /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string] action=[apc.ActList=apc.LsoMsg|apc.ActSummaryBck=apc.BsummCtrlMsg|apc.ActSummaryShard=apc.ShardSummMsg|apc.ActShowNBI=apc.ActMsg]
-// List bucket contents, compute a bucket summary, or show a bucket inventory
+// +gen:endpoint GET /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string] action=[apc.ActList=apc.LsoMsg|apc.ActSummaryBck=apc.BsummCtrlMsg|apc.ActSummaryShard=apc.ShardSummMsg|apc.ActShowNBI=apc.ActMsg|apc.ActApproxCount=apc.ActMsg|apc.ActProbeObjects=apc.ActMsg|apc.ActObjDist=apc.ActMsg|apc.ActListUploads=apc.ActMsg]
+// List bucket contents, compute a bucket summary, show a bucket inventory, or probe objects
 func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	var (
 		msg     *apc.ActMsg
@@ -662,6 +665,14 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		p.bgetSumm(w, r, qbck, msg, dpq)
 	case msg.Action == apc.ActShowNBI:
 		p.bgetNBI(w, r, qbck, msg, dpq)
+	case msg.Action == apc.ActApproxCount:
+		p.bgetApproxCount(w, r, qbck, msg, dpq)
+	case msg.Action == apc.ActProbeObjects:
+		p.bgetProbe(w, r, qbck, msg, dpq)
+	case msg.Action == apc.ActObjDist:
+		p.bgetObjDist(w, r, qbck, msg, dpq)
+	case msg.Action == apc.ActListUploads:
+		p.bgetUploads(w, r, qbck, msg, dpq)
 
 	case msg.Action != apc.ActList:
 		p.writeErrAct(w, r, msg.Action)
@@ -751,6 +762,189 @@ func (p *proxy) bgetShardSumm(w http.ResponseWriter, r *http.Request, qbck *cmn.
 	p.shardSummAct(w, r, bck, msg, &summMsg)
 }
 
+// sum of the per-target approximate counts (see core/bcount)
+func (p *proxy) bgetApproxCount(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	results, ok := p._bcastBckQuery(w, r, qbck, msg, dpq, cresjGeneric[int64]{})
+	if !ok {
+		return
+	}
+	var total int64
+	for _, res := range results {
+		total += *res.v.(*int64)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, total, msg.Action)
+}
+
+// per-target object counts and sizes (see apc.DistStats)
+func (p *proxy) bgetObjDist(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	results, ok := p._bcastBckQuery(w, r, qbck, msg, dpq, cresjGeneric[apc.DistStats]{})
+	if !ok {
+		return
+	}
+	out := make(map[string]*apc.DistStats, len(results))
+	for _, res := range results {
+		out[res.si.ID()] = res.v.(*apc.DistStats)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, msg.Action)
+}
+
+// incomplete multipart uploads (see apc.MptUploadInfo), by target ID;
+// multi-bucket query (e.g., all ais:// buckets) requires cluster-level access
+func (p *proxy) bgetUploads(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	var (
+		results sliceResults
+		ok      bool
+		cresv   = cresjGeneric[[]apc.MptUploadInfo]{}
+	)
+	if qbck.IsBucket() {
+		results, ok = p._bcastBckQuery(w, r, qbck, msg, dpq, cresv)
+	} else if err := p.checkAccess(w, r, nil, apc.AceShowCluster); err == nil {
+		results, ok = p._bcastQuery(w, r, msg, (*cmn.Bck)(qbck).AddToQuery(nil), cresv)
+	}
+	if !ok {
+		return
+	}
+	out := make(map[string][]apc.MptUploadInfo, len(results))
+	for _, res := range results {
+		out[res.si.ID()] = *res.v.(*[]apc.MptUploadInfo)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, msg.Action)
+}
+
+// broadcast bucket query to all targets;
+// returns false when failed (having already written the error)
+func (p *proxy) _bcastBckQuery(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq,
+	cresv cresv) (sliceResults, bool) {
+	if !qbck.IsBucket() {
+		p.writeErr(w, r, cmn.NewErrNotImpl(msg.Action, "bucket queries"))
+		return nil, false
+	}
+	bck := meta.CloneBck((*cmn.Bck)(qbck))
+	bckArgs := allocBctx()
+	{
+		bckArgs.p = p
+		bckArgs.w = w
+		bckArgs.r = r
+		bckArgs.msg = msg
+		bckArgs.perms = apc.AceBckHEAD
+		bckArgs.bck = bck
+		bckArgs.dpq = dpq
+		bckArgs.createAIS = false
+		bckArgs.dontHeadRemote = true
+		bckArgs.dontAddRemote = true
+	}
+	bck, err := bckArgs.initAndTry()
+	freeBctx(bckArgs)
+	if err != nil {
+		return nil, false
+	}
+	return p._bcastQuery(w, r, msg, bck.AddToQuery(nil), cresv)
+}
+
+func (p *proxy) _bcastQuery(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, query url.Values, cresv cresv) (sliceResults, bool) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   r.URL.Path,
+		Body:   cos.MustMarshal(p.newAmsg(msg, nil /*bmd*/)),
+		Header: http.Header{cos.HdrContentType: []string{cos.ContentJSON}},
+		Query:  query,
+	}
+	args.cresv = cresv
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return nil, false
+		}
+	}
+	return results, true
+}
+
+// apc.ActProbeObjects: each target handles the probes it owns (HRW);
+// the results are then merged in the original order
+func (p *proxy) bgetProbe(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	if !qbck.IsBucket() {
+		p.writeErr(w, r, cmn.NewErrNotImpl(msg.Action, "bucket queries"))
+		return
+	}
+	var probes []apc.ObjProbe
+	if err := cos.MorphMarshal(msg.Value, &probes); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	for i := range probes {
+		err := cos.ValidateOname(probes[i].Name)
+		if err == nil {
+			err = cos.ValidateCksumType(probes[i].CksumType, true /*empty ok*/)
+		}
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	bck := meta.CloneBck((*cmn.Bck)(qbck))
+	bckArgs := allocBctx()
+	{
+		bckArgs.p = p
+		bckArgs.w = w
+		bckArgs.r = r
+		bckArgs.msg = msg
+		bckArgs.perms = apc.AceObjHEAD
+		bckArgs.bck = bck
+		bckArgs.dpq = dpq
+		bckArgs.createAIS = false
+		bckArgs.dontHeadRemote = true
+		bckArgs.dontAddRemote = true
+	}
+	bck, err := bckArgs.initAndTry()
+	freeBctx(bckArgs)
+	if err != nil {
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   r.URL.Path,
+		Body:   cos.MustMarshal(p.newAmsg(msg, nil /*bmd*/)),
+		Header: http.Header{cos.HdrContentType: []string{cos.ContentJSON}},
+		Query:  bck.AddToQuery(nil),
+	}
+	args.cresv = cresjGeneric[[]string]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	out := make([]string, len(probes))
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		for i, status := range *res.v.(*[]string) {
+			if status != "" && i < len(out) {
+				out[i] = status
+			}
+		}
+	}
+	freeBcastRes(results)
+	for i := range out {
+		if out[i] == "" {
+			out[i] = apc.ProbeNotFound // (e.g., owner target joined mid-request)
+		}
+	}
+	p.writeJSON(w, r, out, msg.Action)
+}
+
 func (p *proxy) bgetBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
 	if qbck.Name != "" && qbck.Name != msg.Name {
 		p.writeErrf(w, r, "bad list-buckets request: %q vs %q (%+v, %+v)", qbck.Name, msg.Name, qbck, msg)
@@ -794,6 +988,18 @@ func (p *proxy) bgetObjects(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		p.writeErr(w, r, err)
 		return
 	}
+	if err := lsmsg.ValidateSizeRange(); err != nil {
+		p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+		p.writeErr(w, r, err)
+		return
+	}
+	lsmsg.NormalizeSizeRange()
+	if _, err := lsmsg.ParseMetaFilter(); err != nil {
+		p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+		p.writeErr(w, r, err)
+		return
+	}
+	lsmsg.NormalizeMetaFilter()
 
 	bckArgs := allocBctx()
 	{
@@ -869,6 +1075,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 	}
 
 	// 4. redirect
+	objName = bck.NormObjName(objName)
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		p.statsT.IncBck(stats.ErrGetCount, bck.Bucket())
@@ -961,6 +1168,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	}
 
 	if nodeID == "" {
+		objName = bck.NormObjName(objName)
 		tsi, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
 		if err != nil {
 			p.statsT.IncWith(errcnt, vlabs)
@@ -988,7 +1196,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	p.statsT.IncWith(scnt, vlabs)
 }
 
-// +gen:endpoint DELETE /v1/objects/{bucket-name}/{object-name}[apc.QparamProvider=string,apc.QparamNamespace=string]
+// +gen:endpoint DELETE /v1/objects/{bucket-name}/{object-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamSoftDelete=bool]
 // Delete an object with the given uname
 func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 	bckArgs := allocBctx()
@@ -1461,6 +1669,15 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := archMsg.MaxErrs.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if c := archMsg.Collision; c != "" && c != apc.CollisionOverwrite && c != apc.CollisionFail {
+			p.writeErrf(w, r, "%s: collision policy %q is not supported (expecting %s or %s)",
+				msg.Action, c, apc.CollisionOverwrite, apc.CollisionFail)
+			return
+		}
 		bckTo := meta.CloneBck(&archMsg.ToBck)
 		if bckTo.IsEmpty() {
 			bckTo = bckFrom
@@ -1474,7 +1691,17 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 		//
 		// NOTE: strict enforcement of the standard & supported file extensions
 		//
-		if _, err := archive.Strict(archMsg.Mime, archMsg.ArchName); err != nil {
+		mime, err := archive.Strict(archMsg.Mime, archMsg.ArchName)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if archMsg.EmitTOC && (mime != archive.ExtTar || archMsg.AppendIfExists) {
+			p.writeErrf(w, r, "%s: table of contents (emit-toc) requires %s format and no append (have %q, append=%t)",
+				msg.Action, archive.ExtTar, mime, archMsg.AppendIfExists)
+			return
+		}
+		if _, err := archMsg.CompileWdsKey(); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
@@ -1489,7 +1716,7 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool] action=[apc.ActCreateBck=cmn.BpropsToSet|apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActPrefetchObjects=apc.PrefetchMsg|apc.ActMakeNCopies=int|apc.ActECEncode=cmn.ECConfToSet|apc.ActRechunk=apc.RechunkMsg|apc.ActCreateNBI=apc.CreateNBIMsg]
+// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool] action=[apc.ActCreateBck=cmn.BpropsToSet|apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActPrefetchObjects=apc.PrefetchMsg|apc.ActSyncRemote=apc.SyncRemoteMsg|apc.ActMakeNCopies=int|apc.ActECEncode=cmn.ECConfToSet|apc.ActRechunk=apc.RechunkMsg|apc.ActCreateNBI=apc.CreateNBIMsg|apc.ActScrub=apc.ScrubMsg|apc.ActVerifyChunks=apc.VerifyChunksMsg]
 // +gen:payload apc.ActCopyBck={"action": "copy-bck", "value": {"prefix": "images/", "prepend": "backup/", "latest-ver": true, "num-workers": 8}}
 // +gen:payload apc.ActETLBck={"action": "etl-bck", "value": {"id": "ETL_NAME", "prefix": "images/", "num-workers": 8}}
 // +gen:payload apc.ActCopyObjects={"action": "copy-objects", "value": {"tobck": {"name": "destination-bucket", "provider": "ais"}, "template": "shard-{001..100}.tar"}}
@@ -1498,8 +1725,11 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 // +gen:payload apc.ActMakeNCopies={"action": "make-n-copies", "value": 2}
 // +gen:payload apc.ActECEncode={"action": "ec-encode", "value": {"data_slices": 4, "parity_slices": 2}}
 // +gen:payload apc.ActCreateBck={"action": "create-bck", "value": {"versioning": {"enabled": true}, "mirror": {"enabled": true, "copies": 2}}}
+// +gen:payload apc.ActSyncRemote={"action": "sync-remote", "value": {"prefix": "images/", "prefetch-new": true, "evict-deleted": true}}
 // +gen:payload apc.ActRechunk={"action": "rechunk", "value": {"chunk-size": 4194304, "objsize-limit": 1048576}}
 // +gen:payload apc.ActCreateNBI={"action": "create-inventory", "value": {"name": "my-inventory"}}
+// +gen:payload apc.ActScrub={"action": "scrub", "value": {"prefix": "images/", "repair": true}}
+// +gen:payload apc.ActVerifyChunks={"action": "verify-chunks", "value": {"prefix": "images/"}}
 // +gen:name apc.ActECEncode="Set to \"recover\" to validate and rebuild missing or corrupted EC slices"
 // +gen:value apc.ActMakeNCopies="Target n-way replication level: total number of copies to maintain for each object in the bucket"
 // Create, rename, copy, transform, or manage a bucket
@@ -1620,6 +1850,14 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := tcbmsg.MaxErrs.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := apc.ValidateCollision(tcbmsg.Collision); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		if msg.Action == apc.ActETLBck {
 			if err := p.etlExists(tcbmsg.Transform.Name); err != nil {
 				p.writeErr(w, r, err, http.StatusNotFound)
@@ -1708,6 +1946,14 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err = tcomsg.MaxErrs.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err = apc.ValidateCollision(tcomsg.Collision); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		if msg.Action == apc.ActETLObjects {
 			if err := p.etlExists(tcomsg.Transform.Name); err != nil {
 				p.writeErr(w, r, err, http.StatusNotFound)
@@ -1770,12 +2016,48 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActSyncRemote:
+		if err := cmn.ValidateRemoteBck(apc.ActSyncRemote, bck.Bucket()); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if xid, err = p.bcastBckAction(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActRechunk:
 		// re-chunk bucket objects according to provided args
 		if xid, err = p.bcastBckAction(r.Method, bucket, msg, query); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActScrub:
+		// validate checksums of present objects; when repairing, also re-write them
+		scrubMsg := &apc.ScrubMsg{}
+		if err := cos.MorphMarshal(msg.Value, scrubMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		perms := apc.AceGET
+		if scrubMsg.Repair {
+			perms |= apc.AcePUT
+		}
+		if err := p.checkAccess(w, r, bck, perms); err != nil {
+			return
+		}
+		if xid, err = p.bcastBckAction(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActVerifyChunks:
+		// read-only consistency check of chunked objects
+		if err := p.checkAccess(w, r, bck, apc.AceGET); err != nil {
+			return
+		}
+		if xid, err = p.bcastBckAction(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActIndexShard:
 		// ensure the system bucket for shard indices exists before starting the xaction
 		if err = p.initTrySysBck(w, r, msg, meta.SysBckShardIdx()); err != nil {
@@ -1954,6 +2236,11 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 		}
 	}
 
+	if propsToUpdate != nil && propsToUpdate.PrefetchOnAttach && (!bck.IsAIS() || bck.Backend() == nil) {
+		p.writeErrf(w, r, "cannot create %s: prefetch-on-attach requires ais:// bucket with backend_bck", bck.Cname(""))
+		return
+	}
+
 	// 3. plain ais://bucket-name with no nothing
 	if !bck.IsRemote() {
 		if err := p.createBucket(msg, bck, nil); err != nil {
@@ -2020,6 +2307,18 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 	}
 	if err := p.createBucket(msg, bck, remoteHdr); err != nil {
 		p.writeErr(w, r, err, crerrStatus(err))
+		return
+	}
+
+	// 8. optionally, prefetch the entire backend bucket (long-running; abortable via xid)
+	if propsToUpdate != nil && propsToUpdate.PrefetchOnAttach {
+		amsg := &apc.ActMsg{Action: apc.ActPrefetchObjects, Value: &apc.PrefetchMsg{}}
+		xid, err := p.bcastBckAction(http.MethodPost, bck.Name, amsg, bck.NewQuery())
+		if err != nil {
+			p.writeErrf(w, r, "created %s but failed to start prefetching: %v", bck.Cname(""), err)
+			return
+		}
+		writeXid(w, xid)
 	}
 }
 
@@ -2033,16 +2332,17 @@ func crerrStatus(err error) (ecode int) {
 	return
 }
 
-// +gen:endpoint POST /v1/objects/{bucket-name}/{object-name}[apc.QparamProvider=string,apc.QparamNamespace=string] action=[apc.ActPromote=apc.PromoteArgs|apc.ActBlobDl=apc.BlobMsg]
+// +gen:endpoint POST /v1/objects/{bucket-name}/{object-name}[apc.QparamProvider=string,apc.QparamNamespace=string] action=[apc.ActPromote=apc.PromoteArgs|apc.ActBlobDl=apc.BlobMsg|apc.ActECStatus=apc.ActMsg]
 // +gen:payload apc.ActBlobDl={"action": "blob-download", "value": {"chunk-size": 10485760, "num-workers": 4}}
-// Perform actions on objects (rename, promote, blob download, check lock)
+// Perform actions on objects (rename, move to target, restore, create alias, promote, blob download, check lock, chunk manifest, EC status)
 func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	msg, err := p.readActionMsg(w, r)
 	if err != nil {
 		return
 	}
 	switch msg.Action {
-	case apc.ActRenameObject, apc.ActCheckLock, apc.ActMptUpload, apc.ActMptAbort, apc.ActMptComplete:
+	case apc.ActRenameObject, apc.ActMoveObject, apc.ActRestoreObject, apc.ActCreateAlias, apc.ActCheckLock, apc.ActObjManifest,
+		apc.ActECStatus, apc.ActMptUpload, apc.ActMptAbort, apc.ActMptComplete:
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -2066,7 +2366,8 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		// for actions that either don't support remote buckets, or don't require that the target remote bucket exists in the cluster,
 		// set dontHeadRemote to skip adding remote bucket.
 		switch msg.Action {
-		case apc.ActRenameObject, apc.ActCheckLock:
+		case apc.ActRenameObject, apc.ActMoveObject, apc.ActRestoreObject, apc.ActCreateAlias, apc.ActCheckLock, apc.ActObjManifest,
+			apc.ActECStatus:
 			bckArgs.dontHeadRemote = true
 		}
 	}
@@ -2090,6 +2391,37 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		p.redirectAction(w, r, bck, apireq.items[1], msg)
 		p.statsT.IncBck(stats.RenameCount, bck.Bucket())
+	case apc.ActMoveObject:
+		if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+			return
+		}
+		if err := p._checkObjMvTo(bck, msg); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		// NOTE: redirecting to the HRW target that is expected to have the object
+		p.redirectAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActRestoreObject:
+		if err := p.checkAccess(w, r, bck, apc.AcePUT); err != nil {
+			return
+		}
+		if !bck.IsAIS() || bck.Backend() != nil {
+			p.writeErrf(w, r, "%s: cannot restore %s: soft delete is supported only for ais:// buckets without remote backend",
+				p.si, bck.Cname(apireq.items[1]))
+			return
+		}
+		// NOTE: same as above (HRW target that soft-deleted the object)
+		p.redirectAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActCreateAlias:
+		if err := p.checkAccess(w, r, bck, apc.AcePUT); err != nil {
+			return
+		}
+		if err := _checkAlias(bck, msg, apireq); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		// NOTE: redirecting to the HRW target of the object being aliased (msg.Name)
+		p.redirectAction(w, r, bck, msg.Name, msg)
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			p.statsT.IncBck(stats.ErrRenameCount, bck.Bucket())
@@ -2146,11 +2478,60 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 		p.redirectAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActObjManifest:
+		if err := p.checkAccess(w, r, bck, apc.AceObjHEAD); err != nil {
+			return
+		}
+		p.redirectAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActECStatus:
+		if err := p.checkAccess(w, r, bck, apc.AceObjHEAD); err != nil {
+			return
+		}
+		p.objECStatus(w, r, bck, apireq.items[1])
 	default:
 		p.writeErrAct(w, r, msg.Action)
 	}
 }
 
+func (p *proxy) _checkObjMvTo(bck *meta.Bck, msg *apc.ActMsg) error {
+	if bck.IsRemote() {
+		err := fmt.Errorf("invalid action %q: not supported for remote buckets (%s)", msg.Action, bck.String())
+		return cmn.NewErrUnsuppErr(err)
+	}
+	if bck.Props.EC.Enabled {
+		err := fmt.Errorf("invalid action %q: not supported for erasure-coded buckets (%s)", msg.Action, bck.String())
+		return cmn.NewErrUnsuppErr(err)
+	}
+	args := &apc.MoveObjMsg{}
+	if err := cos.MorphMarshal(msg.Value, args); err != nil {
+		return fmt.Errorf(cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+	}
+	smap := p.owner.smap.get()
+	if smap.GetTarget(args.DaemonID) == nil {
+		return &errNodeNotFound{p.si, smap, msg.Action + " failure:", args.DaemonID}
+	}
+	return nil
+}
+
+func _checkAlias(bck *meta.Bck, msg *apc.ActMsg, apireq *apiRequest) error {
+	if !bck.IsAIS() || bck.Backend() != nil || bck.Props.EC.Enabled {
+		err := fmt.Errorf("invalid action %q: supported only for ais:// buckets without remote backend and erasure coding (%s)",
+			msg.Action, bck.String())
+		return cmn.NewErrUnsuppErr(err)
+	}
+	aliasName, objName := apireq.items[1], msg.Name
+	if err := cos.ValidateWname(aliasName); err != nil {
+		return err
+	}
+	if err := cos.ValidateOname(objName); err != nil {
+		return err
+	}
+	if aliasName == objName {
+		return fmt.Errorf("cannot alias %s to itself", bck.Cname(objName))
+	}
+	return nil
+}
+
 func _checkObjMv(bck *meta.Bck, msg *apc.ActMsg, apireq *apiRequest) error {
 	if bck.IsRemote() {
 		err := fmt.Errorf("invalid action %q: not supported for remote buckets (%s)", msg.Action, bck.String())
@@ -2196,6 +2577,14 @@ func (p *proxy) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiR
 
 	bckArgs := bctx{p: p, w: w, r: r, bck: apireq.bck, perms: apc.AceBckHEAD, dpq: apireq.dpq, query: apireq.query}
 	bckArgs.dontAddRemote = apireq.dpq.dontAddRemote // QparamDontAddRemote
+	if s := apireq.dpq.get(apc.QparamBckHeadTimeout); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			p.writeErrf(w, r, "%s: invalid %s %q (expecting positive duration)", p, apc.QparamBckHeadTimeout, s)
+			return
+		}
+		bckArgs.headTimeout = d
+	}
 
 	var (
 		info        *cmn.BsummResult
@@ -2315,6 +2704,7 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 	if err := p.parseReq(w, r, apireq); err != nil {
 		return
 	}
+	validateOnly := cos.IsParseBool(apireq.query.Get(apc.QparamValidateOnly))
 	if msg, err = p.readActionMsg(w, r); err != nil {
 		return
 	}
@@ -2344,6 +2734,7 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 		bckArgs.dpq = apireq.dpq
 		bckArgs.query = apireq.query
 		bckArgs.createAIS = false
+		bckArgs.dontAddRemote = validateOnly
 	}
 	bck, err = bckArgs.initAndTry()
 	freeBctx(bckArgs)
@@ -2360,6 +2751,15 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 		p.writeErr(w, r, err)
 		return
 	}
+	if validateOnly {
+		// no side effects: not initializing (and not adding to BMD) backend bucket
+		if !nprops.BackendBck.IsEmpty() {
+			if err := nprops.BackendBck.Validate(); err != nil {
+				p.writeErr(w, r, err)
+			}
+		}
+		return
+	}
 	if !nprops.BackendBck.IsEmpty() {
 		// backend must exist, must init itself
 		backendBck := meta.CloneBck(&nprops.BackendBck)
@@ -2388,7 +2788,12 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
-	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
+	if cos.IsParseBool(apireq.query.Get(apc.QparamIfChanged)) && nprops.Equal(bck.Props) {
+		w.WriteHeader(http.StatusNoContent) // identical: not bumping BMD version
+		return
+	}
+	checkBackend := cos.IsParseBool(apireq.query.Get(apc.QparamCheckBackend))
+	if xid, err = p.setBprops(msg, bck, nprops, checkBackend); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
@@ -2643,7 +3048,8 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			p.handlePendingRenamedLB(renamedBucket)
 		}
 		fallthrough // fallthrough
-	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog, apc.WhatNodeStats, apc.WhatMetricNames:
+	case apc.WhatNodeConfig, apc.WhatNodeConfigDiff, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog, apc.WhatNodeStats,
+		apc.WhatMetricNames, apc.WhatKeepalive, apc.WhatRecentErrors:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 
 	case apc.WhatNodeStatsAndStatus:
@@ -2689,6 +3095,16 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 		}
+		if s := query.Get(apc.QparamSmapNewer); s != "" {
+			ver, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				p.writeErrf(w, r, "invalid %s=%q: %v", apc.QparamSmapNewer, s, err)
+				return
+			}
+			if smap.Version <= ver {
+				smap = p.owner.smap.waitNewer(r.Context(), ver, apc.SmapLongPoll)
+			}
+		}
 		p.writeJSON(w, r, smap, what)
 	default:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
@@ -2753,7 +3169,8 @@ func (p *proxy) httpdaeput(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, err)
 		}
 	case apc.ActRotateLogs:
-		nlog.Flush(nlog.ActRotate)
+		freed := stats.RotateLogs()
+		p.writeJSON(w, r, freed, msg.Action)
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		p.statsT.ResetStats(errorsOnly)
@@ -3206,6 +3623,12 @@ func (p *proxy) _bhead(bck *cmn.Bck, hreq *cmn.HreqArgs) (http.Header, int, erro
 		cargs.req = *hreq
 		cargs.timeout = apc.DefaultTimeout
 	}
+	if s := hreq.Query.Get(apc.QparamBckHeadTimeout); s != "" {
+		// (validated by the caller) target enforces the timeout; allow it to respond
+		if d, err := time.ParseDuration(s); err == nil {
+			cargs.timeout = max(cargs.timeout, d+time.Second)
+		}
+	}
 
 	res := p.call(cargs, smap)
 	ecode := res.status
@@ -407,6 +407,11 @@ func aceErrToCode(err error) (status int) {
 // NOTE:
 // - access() is reached  only from pub-net handlers; intra-cluster auth lives in htrun checkIntra/parseReq.
 func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+	// cluster-wide read-only mode
+	if ace&apc.AccessMutate != 0 && p.owner.smap.get().ReadOnly {
+		return cmn.ErrClusterReadOnly
+	}
+
 	// auth is not enabled: check bucket properties
 	if !cmn.Rom.AuthEnabled() {
 		if bck == nil || bck.Props == nil {
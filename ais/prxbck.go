@@ -48,6 +48,8 @@ type bctx struct {
 	dontHeadRemote  bool // do not HEAD remote bucket (to find out whether it exists and/or get properties)
 	probeHeadRemote bool // probe remote bucket existence via HEAD, but do not fail the request (e.g., when listing anonymously)
 
+	headTimeout time.Duration // when non-zero, bounds HEAD(remote bucket) - see apc.QparamBckHeadTimeout
+
 	// out
 	isPresent bool // the bucket is confirmed to be present (in the cluster's BMD) // caution wrt mem-pool
 	exists    bool // remote bucket is confirmed to exist                          // ditto; httpbckhead
@@ -460,6 +462,9 @@ func (bctx *bctx) lookup(bck *meta.Bck) (hdr http.Header, code int, err error) {
 	if bctx.probeHeadRemote {
 		q.Set(apc.QparamSilent, "true")
 	}
+	if bctx.headTimeout > 0 {
+		q.Set(apc.QparamBckHeadTimeout, bctx.headTimeout.String())
+	}
 retry:
 	hdr, code, err = bctx.p.headRemoteBck(bck.Bucket(), q)
 
@@ -91,12 +91,16 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request, isPub bool) {
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
+	case apc.WhatXactObjErrs:
+		p.xobjErrs(w, r, what, query)
 	case apc.WhatNodeStats:
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatBackendProbe:
+		p.qcluBackendProbe(w, r, what, query)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -191,6 +195,47 @@ func (p *proxy) xquery(w http.ResponseWriter, r *http.Request, what string, quer
 	p.writeJSON(w, r, resRaw, what)
 }
 
+// apc.WhatXactObjErrs
+func (p *proxy) xobjErrs(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	xid := query.Get(apc.QparamUUID)
+	if err := xact.CheckValidUUID(xid); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathXactions.S, Query: query}
+	args.to = core.Targets
+	args.timeout = apc.DefaultTimeout
+	args.cresv = cresjGeneric[[]core.ObjErr]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var (
+		out   = make([]core.ObjErr, 0, 8)
+		found bool
+	)
+	for _, res := range results {
+		if res.err != nil {
+			if cos.IsNotExist(res.err, res.status) {
+				continue // not running (and did not run) on this target
+			}
+			p.writeErr(w, r, res.toErr(), res.status)
+			freeBcastRes(results)
+			return
+		}
+		found = true
+		if errs := res.v.(*[]core.ObjErr); errs != nil {
+			out = append(out, *errs...)
+		}
+	}
+	freeBcastRes(results)
+	if !found {
+		p.writeErr(w, r, cmn.NewErrXactNotFoundError("["+xid+"]"), http.StatusNotFound, Silent)
+		return
+	}
+	p.writeJSON(w, r, out, what)
+}
+
 // apc.WhatAllRunningXacts
 func (p *proxy) xgetRunning(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
 	var xactMsg xact.QueryMsg
@@ -331,6 +376,41 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+// apc.WhatBackendProbe: have each target reach out to the (configured) remote backend
+// - target-side failures are reported in the respective results, not as errors
+func (p *proxy) qcluBackendProbe(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	provider, err := cmn.NormalizeProvider(query.Get(apc.QparamProvider))
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	config := cmn.GCO.Get()
+	if config.Backend.Get(provider) == nil {
+		p.writeErr(w, r, &cmn.ErrMissingBackend{Provider: provider})
+		return
+	}
+	query.Set(apc.QparamProvider, provider)
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = config.Client.Timeout.D() // (remote roundtrip)
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	out := make(cos.JSONRawMsgs, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		out[res.si.ID()] = res.bytes
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -491,7 +571,7 @@ func (p *proxy) _syncFinal(ctx *smapModifier, clone *smapX) {
 // - cluster membership, including maintenance and decommission
 // - rebalance
 // - set-primary
-// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActMsg|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
+// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActMsg|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActXactPause=apc.ActMsg|apc.ActXactResume=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
 // +gen:payload apc.ActDecommissionCluster={"action": "decommission", "value": {"sid": "target_id", "skip_rebalance": false, "rm_user_data": true}}
 // +gen:payload apc.ActResetStats={"action": "reset-stats", "value": false}
 // Administrative cluster operations: configuration changes, node management, log rotation, shutdown/decommission operations.
@@ -619,10 +699,15 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 		p.bcastAndRespond(w, r, args)
 		freeBcArgs(args)
 
+	case apc.ActClusterReadOnly:
+		p.setReadOnly(w, r, msg)
+
 	case apc.ActXactStart:
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActXactPause, apc.ActXactResume:
+		p.xpause(w, r, msg)
 
 	case apc.ActReloadBackendCreds:
 		if msg.Name != "" {
@@ -650,6 +735,39 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (p *proxy) setReadOnly(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var ro bool
+	if err := cos.MorphMarshal(msg.Value, &ro); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if p.owner.smap.get().ReadOnly == ro {
+		return // nothing to do
+	}
+	msg.Value = ro
+	ctx := &smapModifier{
+		pre:   p._readOnlyPre,
+		final: p._syncFinal,
+		msg:   msg,
+	}
+	if err := p.owner.smap.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+	}
+}
+
+func (p *proxy) _readOnlyPre(ctx *smapModifier, clone *smapX) error {
+	if !clone.isPrimary(p.si) {
+		return newErrNotPrimary(p.si, clone)
+	}
+	ro := ctx.msg.Value.(bool)
+	if clone.ReadOnly == ro {
+		return nil
+	}
+	clone.ReadOnly = ro
+	nlog.Warningln(p.String(), "cluster read-only (maintenance) mode:", ro, "-", clone.StringEx())
+	return nil
+}
+
 // +gen:payload apc.ActSetConfig={"action": "set-config", "value": {"timeout": {"send_file_time": "10m"}}}
 func (p *proxy) setCluCfgPersistent(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToSet, msg *apc.ActMsg) {
 	ctx := &configModifier{
@@ -1010,6 +1128,45 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	freeBcastRes(results)
 }
 
+// +gen:payload apc.ActXactPause={"action": "pause", "value": {"id": "<xaction-id>"}}
+// +gen:payload apc.ActXactResume={"action": "resume", "value": {"id": "<xaction-id>"}}
+func (p *proxy) xpause(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var xargs xact.ArgsMsg
+	if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := xact.CheckValidUUID(xargs.ID); err != nil {
+		p.writeErrf(w, r, "cannot %s xaction: %v", msg.Action, err)
+		return
+	}
+
+	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xact.ArgsMsg{ID: xargs.ID}})
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var found bool
+	for _, res := range results {
+		if res.status == http.StatusNotFound {
+			continue // not running on this target
+		}
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		found = true
+	}
+	freeBcastRes(results)
+	if !found {
+		err := cmn.NewErrXactNotFoundError("[" + xargs.ID + "]")
+		p.writeErr(w, r, err, http.StatusNotFound)
+	}
+}
+
 func (p *proxy) _checkMaint(xargs *xact.ArgsMsg) error {
 	smap := p.owner.smap.get()
 	for _, tsi := range smap.Tmap {
@@ -1141,6 +1298,10 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		p.writeErrf(w, r, "%s is the current primary, cannot perform action %q on itself", p, msg.Action)
 		return
 	}
+	if opts.Deadline < 0 || (opts.Deadline > 0 && opts.SkipRebalance) {
+		p.writeErrf(w, r, "%s: invalid deadline %v (skip-rebalance=%t)", msg.Action, opts.Deadline, opts.SkipRebalance)
+		return
+	}
 
 	nlog.Infof("%s: %s(%s) opts=%v", p, msg.Action, si.StringEx(), opts)
 
@@ -1184,11 +1345,41 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 			return
 		}
 		if rebID != "" {
+			if opts.Deadline > 0 {
+				p.rebDeadline(rebID, si, opts.Deadline.D())
+			}
 			writeXid(w, rebID)
 		}
 	}
 }
 
+// abort the rebalance that fails to evacuate data from the node in time;
+// upon abort, rmdModifier.postRm won't commit the transition, and the node
+// stays in its current (maintenance or decommissioning) state
+func (p *proxy) rebDeadline(rebID string, si *meta.Snode, deadline time.Duration) {
+	time.AfterFunc(deadline, func() {
+		nl := p.notifs.entry(rebID)
+		if nl == nil || nl.IsFinished() {
+			return
+		}
+		nlog.Errorf("%s: rebalance[%s] failed to evacuate data from %s within %v - aborting", p, rebID, si.StringEx(), deadline)
+
+		xargs := xact.ArgsMsg{ID: rebID, Kind: apc.ActRebalance, Force: true}
+		body := cos.MustMarshal(apc.ActMsg{Action: apc.ActXactStop, Name: cmn.ErrXactDeadlineAbort.Error(), Value: xargs})
+		args := allocBcArgs()
+		args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+		args.to = core.Targets
+		results := p.bcastGroup(args)
+		freeBcArgs(args)
+		for _, res := range results {
+			if res.err != nil {
+				nlog.Errorln(res.toErr())
+			}
+		}
+		freeBcastRes(results)
+	})
+}
+
 func (p *proxy) rmTarget(si *meta.Snode, msg *apc.ActMsg, reb bool) (rebID string, err error) {
 	var ctx *smapModifier
 	if ctx, err = p.mcastMaint(msg, si, reb, false /*maintPostReb*/); err != nil {
@@ -1606,8 +1797,14 @@ func (p *proxy) _stopMaintRMD(ctx *smapModifier, clone *smapX) {
 	if clone.CountActiveTs() < 2 {
 		return
 	}
+	isTarget := clone.GetTarget(ctx.sid) != nil
 	rmdCtx := &rmdModifier{
-		pre:     rmdInc,
+		pre: func(_ *rmdModifier, clone *rebMD) {
+			if isTarget {
+				clone.TargetIDs = []string{ctx.sid} // rejoining target (see Rebalance.WarmupBudget)
+			}
+			clone.inc()
+		},
 		smapCtx: ctx,
 		p:       p,
 		wait:    true,
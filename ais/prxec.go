@@ -6,11 +6,14 @@ package ais
 
 import (
 	"net/http"
+	"sort"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
 )
 
 func (p *proxy) ecHandler(w http.ResponseWriter, r *http.Request) {
@@ -51,3 +54,77 @@ func (p *proxy) onEC(bck *meta.Bck) error {
 	}
 	return p.ec.on(p, p.ec.timeout())
 }
+
+// EC slice placement and health of a given object:
+// broadcast to all targets and combine their local views (see ec.TargetStatus)
+func (p *proxy) objECStatus(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathEC.Join(ec.URLStatus, bck.Name, objName),
+		Query:  bck.AddToQuery(nil),
+	}
+	args.cresv = cresjGeneric[ec.TargetStatus]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var (
+		md       *ec.Metadata
+		statuses = make(map[string]*ec.TargetStatus, len(results))
+	)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		st := res.v.(*ec.TargetStatus)
+		statuses[res.si.ID()] = st
+		if st.MD != nil && (md == nil || st.MD.Generation > md.Generation) {
+			md = st.MD // the latest generation
+		}
+	}
+	freeBcastRes(results)
+	if md == nil {
+		p.writeErr(w, r, cos.NewErrNotFound(p, "EC metadata for "+bck.Cname(objName)), http.StatusNotFound)
+		return
+	}
+	p.writeJSON(w, r, ecStatus(md, statuses), apc.ActECStatus)
+}
+
+func ecStatus(md *ec.Metadata, statuses map[string]*ec.TargetStatus) *apc.ECStatus {
+	var (
+		numSlices int
+		mainOK    bool
+		status    = &apc.ECStatus{
+			Slices:     make([]apc.ECSliceStatus, 0, len(md.Daemons)),
+			Data:       md.Data,
+			Parity:     md.Parity,
+			Generation: md.Generation,
+			IsCopy:     md.IsCopy,
+		}
+	)
+	for tid, idx := range md.Daemons {
+		st := statuses[tid]
+		present := st != nil && st.Present && st.MD != nil &&
+			st.MD.Generation == md.Generation && st.MD.SliceID == int(idx)
+		status.Slices = append(status.Slices, apc.ECSliceStatus{Target: tid, Idx: int(idx), Present: present})
+		switch {
+		case !present:
+		case idx == 0:
+			mainOK = true // main or full replica
+		default:
+			numSlices++
+		}
+	}
+	sort.Slice(status.Slices, func(i, j int) bool {
+		a, b := status.Slices[i], status.Slices[j]
+		if a.Idx != b.Idx {
+			return a.Idx < b.Idx
+		}
+		return a.Target < b.Target
+	})
+	status.Reconstructable = mainOK || (!md.IsCopy && numSlices >= md.Data)
+	return status
+}
@@ -0,0 +1,77 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestECStatus(t *testing.T) {
+	const gen = 100
+	newMD := func(isCopy bool) *ec.Metadata {
+		md := &ec.Metadata{Generation: gen, Data: 2, Parity: 1, IsCopy: isCopy, Daemons: cos.MapStrUint16{"t0": 0}}
+		for i, tid := range []string{"t1", "t2", "t3"} {
+			md.Daemons[tid] = uint16(i + 1)
+			if isCopy {
+				md.Daemons[tid] = 0
+			}
+		}
+		return md
+	}
+	local := func(md *ec.Metadata, tid string, present bool) *ec.TargetStatus {
+		clone := md.Clone()
+		clone.SliceID = int(md.Daemons[tid])
+		return &ec.TargetStatus{MD: clone, Present: present}
+	}
+
+	tests := []struct {
+		name            string
+		isCopy          bool
+		present         map[string]bool
+		staleGen        string // target with an older generation
+		reconstructable bool
+		numPresent      int
+	}{
+		{"all present", false, map[string]bool{"t0": true, "t1": true, "t2": true, "t3": true}, "", true, 4},
+		{"no main, enough slices", false, map[string]bool{"t1": true, "t3": true}, "", true, 2},
+		{"no main, not enough slices", false, map[string]bool{"t2": true}, "", false, 1},
+		{"stale slice", false, map[string]bool{"t1": true, "t2": true}, "t2", false, 1},
+		{"replicated, one copy", true, map[string]bool{"t3": true}, "", true, 1},
+		{"replicated, none", true, map[string]bool{}, "", false, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			md := newMD(test.isCopy)
+			statuses := make(map[string]*ec.TargetStatus, len(md.Daemons))
+			for tid := range md.Daemons {
+				statuses[tid] = local(md, tid, test.present[tid])
+			}
+			if test.staleGen != "" {
+				statuses[test.staleGen].MD.Generation = gen - 1
+			}
+			status := ecStatus(md, statuses)
+
+			tassert.Fatalf(t, len(status.Slices) == len(md.Daemons), "expected %d slices, got %d", len(md.Daemons), len(status.Slices))
+			var numPresent int
+			for i, slice := range status.Slices {
+				if i > 0 {
+					prev := status.Slices[i-1]
+					tassert.Errorf(t, prev.Idx < slice.Idx || (prev.Idx == slice.Idx && prev.Target < slice.Target),
+						"not sorted: %+v", status.Slices)
+				}
+				if slice.Present {
+					numPresent++
+				}
+			}
+			tassert.Errorf(t, numPresent == test.numPresent, "expected %d present, got %d", test.numPresent, numPresent)
+			tassert.Errorf(t, status.Reconstructable == test.reconstructable, "expected reconstructable=%t",
+				test.reconstructable)
+		})
+	}
+}
@@ -421,7 +421,7 @@ func (p *proxy) logsETL(w http.ResponseWriter, r *http.Request, etlName string,
 }
 
 // +gen:endpoint GET /v1/etl/{etl-name}/health
-// Get health status of ETL job
+// Get K8s-level health of ETL pods: phase, restarts, and readiness (per target)
 func (p *proxy) healthETL(w http.ResponseWriter, r *http.Request) {
 	var (
 		results sliceResults
@@ -429,6 +429,8 @@ func (p *proxy) healthETL(w http.ResponseWriter, r *http.Request) {
 	)
 	args = allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
+	args.timeout = apc.DefaultTimeout
+	args.cresv = cresjGeneric[etl.HealthStatus]{}
 	results = p.bcastGroup(args)
 	defer freeBcastRes(results)
 	freeBcArgs(args)
@@ -439,12 +441,9 @@ func (p *proxy) healthETL(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, res.toErr(), res.status)
 			return
 		}
-		msg := etl.HealthStatus{
-			TargetID: res.si.ID(),
-			Status:   string(res.bytes),
-		}
-		healths = append(healths, &msg)
+		healths = append(healths, res.v.(*etl.HealthStatus))
 	}
+	sort.SliceStable(healths, func(i, j int) bool { return healths[i].TargetID < healths[j].TargetID })
 	p.writeJSON(w, r, healths, "health-etl")
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 
 	jsoniter "github.com/json-iterator/go"
@@ -33,8 +34,10 @@ type nopHB struct{}
 
 func (*nopHB) HeardFrom(string, int64) int64 { return 0 }
 func (*nopHB) TimedOut(string) bool          { return false }
+func (*nopHB) Failed(string) int64           { return 0 }
 func (*nopHB) reg(string)                    {}
 func (*nopHB) set(time.Duration) bool        { return false }
+func (*nopHB) status() stats.KaliveStatus    { return nil }
 
 var _ hbTracker = (*nopHB)(nil)
 
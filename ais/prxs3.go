@@ -252,6 +252,7 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, items []
 	}
 
 	smap := p.owner.smap.get()
+	objName = bck.NormObjName(objName)
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -532,6 +533,7 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 
 	objName := strings.Trim(parts[1], "/")
 	smap := p.owner.smap.get()
+	objName = bckSrc.NormObjName(objName)
 	tsi, err := smap.HrwName2T(bckSrc.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -566,6 +568,7 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	}
 
 	smap := p.owner.smap.get()
+	objName = bck.NormObjName(objName)
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -605,6 +608,7 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	}
 
 	smap := p.owner.smap.get()
+	objName = bck.NormObjName(objName)
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -693,6 +697,7 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	}
 
 	smap := p.owner.smap.get()
+	objName = bck.NormObjName(objName)
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
@@ -740,6 +745,7 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	}
 
 	smap := p.owner.smap.get()
+	objName = bck.NormObjName(objName)
 	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -810,7 +816,7 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
-	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+	if _, err := p.setBprops(msg, bck, nprops, false /*check backend*/); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 	}
 }
@@ -435,7 +435,7 @@ func bmodMirror(ctx *bmdModifier, clone *bucketMD) error {
 }
 
 // set-bucket-props: { confirm existence -- begin -- apply props -- metasync -- commit }
-func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (string /*xid*/, error) {
+func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops, checkBackend bool) (string /*xid*/, error) {
 	// 1. confirm existence
 	bprops, present := p.owner.bmd.get().Get(bck)
 	if !present {
@@ -479,6 +479,9 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 		c         = &txnCln{p: p}
 	)
 	c.init(&nmsg, bck, "" /*uuid*/, waitmsync)
+	if checkBackend {
+		c.req.Query.Set(apc.QparamCheckBackend, "true")
+	}
 	if err := c.begin(bck); err != nil {
 		return "", err
 	}
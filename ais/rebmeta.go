@@ -315,6 +315,10 @@ func (m *rmdModifier) postRm(nl nl.Listener) {
 		nlog.Errorf("Warning: %s (%s) got renewed (interrupted) - will not %s (%s)", xname, m.smapCtx.smap, warn, rmd)
 		return
 	}
+	if nlerr == cmn.ErrXactDeadlineAbort || nlerr.Error() == cmn.ErrXactDeadlineAbort.Error() {
+		nlog.Errorf("%s failed to evacuate data from %s before the deadline - will not %s", xname, sname, warn)
+		return
+	}
 	if m.smapCtx.msg.Action != apc.ActRmNodeUnsafe && m.smapCtx.msg.Action != apc.ActDecommissionNode {
 		nlog.Errorf("operation %q => %s (%s) failed - will not %s", m.smapCtx.msg.Action, xname, m.smapCtx.smap, warn)
 		return
@@ -6,15 +6,21 @@ package ais
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
+	"strconv"
+	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 )
@@ -257,3 +263,110 @@ func (bp *rlbackend) stats(ctx context.Context, bck *meta.Bck, count, latency st
 		cos.NamedVal64{Name: latency, Value: int64(total), VarLabs: vlabs},
 	)
 }
+
+//
+// per-bucket cap on in-flight cold GETs (rate_limit.max_cold_get_concurrency)
+// - queues (rather than rejects) for up to timeout.max_host_busy
+// - shares t.ratelim map (and housekeeping) with adaptive rate limiters
+//
+
+const coldGetVerb = "coldget"
+
+type coldSem struct {
+	ch       chan struct{} // counting semaphore
+	lastUsed ratomic.Int64 // mono time
+	mu       sync.Mutex    // vs housekeeping (see prune)
+	pruned   bool
+}
+
+var errColdGetBusy = errors.New("max cold-GET concurrency")
+
+// interface guard
+var _ cos.Rater = (*coldSem)(nil)
+
+// never pruned while in use
+func (s *coldSem) LastUsed() int64 {
+	if len(s.ch) > 0 {
+		return mono.NanoTime()
+	}
+	return s.lastUsed.Load()
+}
+
+// (housekeeping) once pruned, the semaphore is never used again
+func (s *coldSem) prune(rl *ratelim, k any) {
+	s.mu.Lock()
+	if len(s.ch) == 0 && time.Duration(rl.now-s.lastUsed.Load()) >= hk.PruneRateLimiters>>1 {
+		s.pruned = true
+		rl.CompareAndDelete(k, s)
+	}
+	s.mu.Unlock()
+}
+
+// (with the token acquired)
+func (s *coldSem) valid() bool {
+	s.mu.Lock()
+	ok := !s.pruned
+	s.mu.Unlock()
+	return ok
+}
+
+func (s *coldSem) release() {
+	s.lastUsed.Store(mono.NanoTime())
+	<-s.ch
+}
+
+// returns nil semaphore when not limited;
+// with wait=false, returns errColdGetBusy instead of queuing
+func (t *target) acquireColdGet(bck *meta.Bck, wait bool) (*coldSem, error) {
+	limit := bck.Props.RateLimit.MaxColdGetConcurrency
+	if limit <= 0 {
+		return nil, nil
+	}
+	var (
+		timer *time.Timer
+		uhash = bck.HashUname(coldGetVerb)
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	for {
+		v, ok := t.ratelim.Load(uhash)
+		if !ok {
+			v, _ = t.ratelim.LoadOrStore(uhash, &coldSem{ch: make(chan struct{}, limit)})
+		}
+		s := v.(*coldSem)
+		if cap(s.ch) != limit {
+			// reconfigured
+			t.ratelim.CompareAndSwap(uhash, v, &coldSem{ch: make(chan struct{}, limit)})
+			continue
+		}
+		s.lastUsed.Store(mono.NanoTime())
+
+		select {
+		case s.ch <- struct{}{}: // fast path
+		default:
+			if !wait {
+				return nil, errColdGetBusy
+			}
+			if timer == nil {
+				timer = time.NewTimer(cmn.GCO.Get().Timeout.MaxHostBusy.D())
+			}
+			// queue
+			t.statsT.Add(stats.RatelimColdGetQueued, 1)
+			select {
+			case s.ch <- struct{}{}:
+				t.statsT.Add(stats.RatelimColdGetQueued, -1)
+			case <-timer.C:
+				t.statsT.Add(stats.RatelimColdGetQueued, -1)
+				return nil, cmn.NewErrBusy("bucket", bck.Cname(""), errColdGetBusy.Error()+" "+strconv.Itoa(limit))
+			}
+		}
+		// pruned in the meantime (and possibly replaced) - retry
+		if s.valid() {
+			return s, nil
+		}
+		<-s.ch
+	}
+}
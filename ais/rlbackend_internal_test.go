@@ -8,14 +8,18 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
 
@@ -324,3 +328,103 @@ func TestRLBackendListObjectsCanceled(t *testing.T) {
 	tassert.Fatalf(t, ecode == 0, "expected code 0, got %d", ecode)
 	tassert.Fatalf(t, backend.calls == 1, "expected no retry call, got %d calls", backend.calls)
 }
+
+func TestColdGetConcurrency(t *testing.T) {
+	const limit = 2
+	bck := meta.NewBck("cold-get-sem", apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 0x91})
+	bck.Props.RateLimit.MaxColdGetConcurrency = limit
+	key := bck.HashUname(coldGetVerb)
+	defer mockTarget.ratelim.Delete(key)
+
+	config := cmn.GCO.BeginUpdate()
+	maxHostBusy := config.Timeout.MaxHostBusy
+	config.Timeout.MaxHostBusy = cos.Duration(50 * time.Millisecond)
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Timeout.MaxHostBusy = maxHostBusy
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	s1, err := mockTarget.acquireColdGet(bck, false)
+	tassert.CheckFatal(t, err)
+	s2, err := mockTarget.acquireColdGet(bck, false)
+	tassert.CheckFatal(t, err)
+
+	// no-wait: busy right away; wait: busy upon timeout
+	_, err = mockTarget.acquireColdGet(bck, false)
+	tassert.Errorf(t, err == errColdGetBusy, "expected %v, got %v", errColdGetBusy, err)
+	_, err = mockTarget.acquireColdGet(bck, true)
+	tassert.Errorf(t, cmn.IsErrBusy(err), "expected busy, got %v", err)
+
+	// queued, and then granted upon release
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s1.release()
+	}()
+	s3, err := mockTarget.acquireColdGet(bck, true)
+	tassert.CheckFatal(t, err)
+	s2.release()
+	s3.release()
+
+	// pruned when idle, never when in use
+	mockTarget.ratelim.housekeep(mono.NanoTime() + int64(hk.PruneRateLimiters))
+	tassert.Errorf(t, !s3.valid(), "expected idle semaphore pruned")
+	s4, err := mockTarget.acquireColdGet(bck, false)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, s4 != s3, "expected new semaphore")
+	mockTarget.ratelim.housekeep(mono.NanoTime() + int64(hk.PruneRateLimiters))
+	tassert.Errorf(t, s4.valid(), "semaphore in use must not be pruned")
+	s4.release()
+
+	// never exceeded - in presence of (aggressive) concurrent pruning
+	config = cmn.GCO.BeginUpdate()
+	config.Timeout.MaxHostBusy = cos.Duration(time.Minute)
+	cmn.GCO.CommitUpdate(config)
+	var (
+		wg       sync.WaitGroup
+		inflight atomic.Int32
+		peak     atomic.Int32
+		stop     = make(chan struct{})
+	)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				mockTarget.ratelim.housekeep(mono.NanoTime() + int64(hk.PruneRateLimiters))
+				time.Sleep(time.Microsecond)
+			}
+		}
+	}()
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				s, err := mockTarget.acquireColdGet(bck, true)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				n := inflight.Add(1)
+				for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+				}
+				time.Sleep(10 * time.Microsecond)
+				inflight.Add(-1)
+				s.release()
+			}
+		}()
+	}
+	wg.Wait()
+	close(stop)
+	tassert.Errorf(t, peak.Load() <= limit, "max cold-GET concurrency %d exceeded: %d", limit, peak.Load())
+
+	// reconfigured
+	bck.Props.RateLimit.MaxColdGetConcurrency = limit + 1
+	s, err := mockTarget.acquireColdGet(bck, false)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cap(s.ch) == limit+1, "expected reconfigured limit %d, got %d", limit+1, cap(s.ch))
+	s.release()
+}
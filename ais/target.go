@@ -36,6 +36,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/nl"
@@ -71,6 +72,8 @@ type (
 		fsprg    fsprungroup
 		txns     txns
 		ups      ups
+		sparse   sparseObjs
+		rqos     readQoS
 		htrun    // common w/ proxy
 		regstate regstate
 	}
@@ -334,6 +337,7 @@ func (t *target) init(config *cmn.Config) {
 	startedUp := ts.Init()    // reg common metrics (see also: "begin target metrics" below)
 	daemon.rg.add(ts)
 	t.statsT = ts
+	t.rqos.su = ts
 
 	k := newTalive(t, ts, startedUp)
 	daemon.rg.add(k)
@@ -439,6 +443,7 @@ func (t *target) Run() error {
 	}
 
 	t.ups.t = t
+	t.sparse.init(t)
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -509,6 +514,7 @@ func (t *target) Run() error {
 	mirror.Init()
 
 	xreg.RegWithHK()
+	hk.Reg(hknamePeriodicCleanup, t.periodicCleanup, periodicCleanupIdle)
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -994,6 +1000,17 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		return lom, err
 	}
 
+	// read admission (QoS); following an alias - admitted already
+	if dpq.aliasOf(r) == "" {
+		admitted, err := t.admitRead(r, bck, cmn.GCO.Get())
+		if err != nil {
+			return lom, err
+		}
+		if admitted {
+			defer t.rqos.release(&cmn.GCO.Get().ReadQoS)
+		}
+	}
+
 	// GET: regular | archive | range
 	goi := allocGOI()
 	{
@@ -1016,6 +1033,9 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 	}
+	if dpq.maxBps > 0 {
+		goi.w = newPacedWriter(w, dpq.maxBps)
+	}
 	if dpq.isArch() {
 		if goi.ranges.Range != "" {
 			details := fmt.Sprintf("range: %s, arch query: %s", goi.ranges.Range, goi.dpq._archstr())
@@ -1038,6 +1058,11 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 
 	// do
 	if ecode, err := goi.getObject(); err != nil {
+		if ea, ok := err.(*errAlias); ok {
+			core.FreeLOM(goi.lom)
+			freeGOI(goi)
+			return t.getAliased(w, r, dpq, bck, ea)
+		}
 		// stats
 		vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
 		switch {
@@ -1047,6 +1072,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		case goi.isIOErr || cos.IsIOError(err):
 			t.statsT.IncWith(stats.ErrGetCount, vlabs)
 			t.statsT.IncWith(stats.IOErrGetCount, vlabs)
+			t.statsT.AddRecentErr("get", bck.Bucket(), goi.lom.ObjName, err)
 
 			cnt := t.statsT.Get(stats.IOErrGetCount)
 			cmn.SparseWarn(cos.ModAIS, cnt, t, "GET local I/O error:", goi.lom.Cname(), "err:", err)
@@ -1054,10 +1080,12 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		case cos.IsNotExist(err, ecode):
 			if goi.lom.IsFeatureSet(feat.CountObjectNotFoundStats) {
 				t.statsT.IncWith(stats.ErrGetCount, vlabs)
+				t.statsT.AddRecentErr("get", bck.Bucket(), goi.lom.ObjName, err)
 			}
 
 		default:
 			t.statsT.IncWith(stats.ErrGetCount, vlabs)
+			t.statsT.AddRecentErr("get", bck.Bucket(), goi.lom.ObjName, err)
 		}
 
 		// handle right here, return nil
@@ -1099,8 +1127,18 @@ func (t *target) _erris(w http.ResponseWriter, r *http.Request, err error, code
 	}
 }
 
+// memory-pressure admission applies to client PUTs only - never to intra-cluster writes
+// (copy, transform, replication, rebalance, etc.) that carry apc.QparamOWT and/or t2t headers
+func oomRejectPut(config *cmn.Config, r *http.Request, dpq *dpq) bool {
+	if dpq.sys.owt != "" || r.Header.Get(apc.HdrT2TPutterID) != "" || r.Header.Get(apc.HdrSenderID) != "" {
+		return false
+	}
+	return config.Memsys.OOMReject(r.ContentLength)
+}
+
 // PUT /v1/objects/bucket-name/object-name; does:
 // 1) append object 2) append to archive 3) PUT 4) single object copy 5) multipart upload
+// 6) sparse (ranged) write into a pre-sized object
 func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRequest, lom *core.LOM) {
 	var (
 		config  = cmn.GCO.Get()
@@ -1120,6 +1158,15 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 	}
 
+	// memory-pressure admission (retriable)
+	if oomRejectPut(config, r, apireq.dpq) {
+		if flags := cos.NodeStateFlags(t.statsT.Get(cos.NodeAlerts)); flags.IsSet(cos.OOM) {
+			err := cmn.NewErrBusy("target", t.String(), "out of memory")
+			t.writeErr(w, r, err, http.StatusServiceUnavailable)
+			return
+		}
+	}
+
 	// init
 	if err := lom.InitBck(apireq.bck); err != nil {
 		if cmn.IsErrRemoteBckNotFound(err) {
@@ -1180,6 +1227,9 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		_, ecode, err = t.ups.putPart(&args)
 	case dpq.arch.path != "": // apc.QparamArchpath
+		if r.Header.Get(apc.HdrPutApndArchFlags) != "" {
+			t.gfnShard(lom) // append to a misplaced shard
+		}
 		dpq.arch.mime, err = archive.MimeFQN(t.smm, dpq.arch.mime, lom.FQN)
 		if err != nil {
 			break
@@ -1188,6 +1238,8 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		lom.Lock(true)
 		ecode, err = t.putApndArch(r, lom, started, dpq)
 		lom.Unlock(true)
+	case isSparseOp(apndTy):
+		ecode, err = t.sparse.do(r, lom, apndTy, dpq, config)
 	case apndTy != "":
 		a := &apndOI{
 			started: started,
@@ -1208,6 +1260,7 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 		t.statsT.IncWith(stats.ErrAppendCount, vlabs)
+		t.statsT.AddRecentErr("append", lom.Bucket(), lom.ObjName, err)
 	default:
 		ecode, err = t.putObject(w, r, dpq, lom, config)
 	}
@@ -1223,6 +1276,9 @@ func (t *target) putObject(w http.ResponseWriter, r *http.Request, dpq *dpq, lom
 	if !skipVC {
 		_ = lom.Load(false, false)
 	}
+	if lom.Bprops().CaseInsensitiveNames {
+		origName(r, lom)
+	}
 
 	poi := allocPOI()
 	{
@@ -1244,6 +1300,20 @@ func (t *target) putObject(w http.ResponseWriter, r *http.Request, dpq *dpq, lom
 	return ecode, err
 }
 
+// case-insensitive bucket: retain the original (as PUT) object name for display
+// (see cmn.OrigNameObjMD)
+func origName(r *http.Request, lom *core.LOM) {
+	items, err := cmn.ParseURL(r.URL.Path, apc.URLPathObjects.L, 2, false)
+	if err != nil {
+		return
+	}
+	if objName := items[1]; objName != lom.ObjName {
+		lom.SetCustomKey(cmn.OrigNameObjMD, objName)
+	} else {
+		lom.DelCustomKey(cmn.OrigNameObjMD)
+	}
+}
+
 // DELETE [ { action } ] /v1/objects/bucket-name/object-name
 func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	var msg actMsgExt
@@ -1284,6 +1354,18 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
+	if !evict && cos.IsParseBool(apireq.dpq.get(apc.QparamSoftDelete)) {
+		if ecode, err := t.trashObject(lom); err != nil {
+			if cos.IsNotExist(err) {
+				t.writeErrSilentf(w, r, http.StatusNotFound, "%s doesn't exist", lom.Cname())
+			} else {
+				t.writeErr(w, r, err, ecode)
+			}
+		}
+		core.FreeLOM(lom)
+		return
+	}
+
 	ecode, err := t.DeleteObject(lom, evict)
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
@@ -1327,7 +1409,36 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		} else {
 			vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 			t.statsT.IncWith(stats.ErrRenameCount, vlabs)
+			t.statsT.AddRecentErr("rename", lom.Bucket(), lom.ObjName, err)
+		}
+	case apc.ActMoveObject:
+		lom := &core.LOM{ObjName: apireq.items[1]}
+		if err = lom.InitBck(apireq.bck); err != nil {
+			break
 		}
+		err = t.objMvTo(lom, msg)
+	case apc.ActCreateAlias:
+		if r.Header.Get(apc.HdrSenderID) != "" {
+			// from the HRW target of the object being aliased (see t.createAlias)
+			alom := &core.LOM{ObjName: apireq.items[1]}
+			if err = alom.InitBck(apireq.bck); err != nil {
+				break
+			}
+			ecode, err = t.writeAlias(alom, msg.Name)
+			break
+		}
+		// NOTE: redirected to the HRW target of the object being aliased
+		lom := &core.LOM{ObjName: msg.Name}
+		if err = lom.InitBck(apireq.bck); err != nil {
+			break
+		}
+		ecode, err = t.createAlias(lom, apireq.items[1])
+	case apc.ActRestoreObject:
+		lom := &core.LOM{ObjName: apireq.items[1]}
+		if err = lom.InitBck(apireq.bck); err != nil {
+			break
+		}
+		err = t.restoreObject(lom)
 	case apc.ActBlobDl:
 		var (
 			xid     string
@@ -1394,6 +1505,8 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		})
 	case apc.ActCheckLock:
 		t._checkLocked(w, r, apireq.bck, apireq.items[1])
+	case apc.ActObjManifest:
+		t._objManifest(w, r, apireq.bck, apireq.items[1])
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -1432,6 +1545,69 @@ func (t *target) _checkLocked(w http.ResponseWriter, r *http.Request, bck *meta.
 	w.WriteHeader(ecode)
 }
 
+// return object's chunk layout (cmn.ChunkManifest);
+// monolithic objects are reported as a single (synthetic) chunk
+func (t *target) _objManifest(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsErrObjNought(err) {
+			t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+
+	out := cmn.ChunkManifest{Size: lom.Lsize()}
+	if !lom.IsChunked() {
+		chunk := cmn.ChunkInfo{Num: 1, Size: lom.Lsize()}
+		if cksum := lom.Checksum(); !cos.NoneC(cksum) {
+			chunk.Cksum = cksum.Clone()
+		}
+		out.Chunks = []cmn.ChunkInfo{chunk}
+		t.writeJSON(w, r, &out, apc.ActObjManifest)
+		return
+	}
+
+	ufest, err := core.NewUfest("", lom, true /*mustExist*/)
+	if err == nil {
+		err = ufest.LoadCompleted(lom)
+	}
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var (
+		offset int64
+		count  = ufest.Count()
+	)
+	out.Chunked, out.UploadID = true, ufest.ID()
+	out.Chunks = make([]cmn.ChunkInfo, 0, count)
+	for num := 1; num <= count; num++ {
+		c, err := ufest.GetChunk(num)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		out.Chunks = append(out.Chunks, cmn.ChunkInfo{
+			Cksum:  c.Cksum(),
+			ETag:   c.ETag,
+			Num:    num,
+			Offset: offset,
+			Size:   c.Size(),
+		})
+		offset += c.Size()
+	}
+	t.writeJSON(w, r, &out, apc.ActObjManifest)
+}
+
 // HEAD /v1/objects/<bucket-name>/<object-name>
 //
 // Deprecation notice:
@@ -1510,6 +1686,9 @@ func (t *target) objHead(r *http.Request, whdr http.Header, dpq *dpq, bck *meta.
 		if apc.IsFltNoProps(fltPresence) {
 			return 0, nil // early return: found locally, no props needed
 		}
+		if objName, ok := lom.AliasOf(); ok && fltPresence == 0 && dpq.aliasOf(r) == "" {
+			return t.headAliased(r, whdr, dpq, bck, lom.ObjName, objName, false /*v2*/)
+		}
 	case cmn.IsErrObjNought(err):
 		// object not found locally - try restore if requested
 		if fltPresence == apc.FltPresentCluster {
@@ -1730,11 +1909,41 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
+// Appending to a shard that is not present locally: this target is the shard's (current)
+// HRW owner, but the shard itself may still reside elsewhere - e.g., when the cluster map
+// has changed and rebalance is disabled, skipped, or not finished yet.
+// Locate the shard cluster-wide and pull it over (GFN) - otherwise, the subsequent
+// append would create a new shard containing only the appended file(s).
+func (t *target) gfnShard(lom *core.LOM) {
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil || !cos.IsNotExist(err) {
+		return
+	}
+	if !lom.Bck().IsAIS() {
+		return // remote shards are handled by the backend
+	}
+	smap := t.owner.smap.get()
+	tsi := t.headObjBcast(lom, smap)
+	if tsi == nil {
+		return // does not exist
+	}
+	if t.getFromNeighbor(lom, tsi) {
+		nlog.Infoln(t.String(), "append: gfn", lom.Cname(), "<=", tsi.StringEx())
+	}
+}
+
 func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	var isback bool
-	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
-	lom.Unlock(true)
+	if !evict {
+		// (intra-cluster HEADs - not holding the lock)
+		if err = t.errAliased(lom); err != nil {
+			code = http.StatusConflict
+		}
+	}
+	if err == nil {
+		lom.Lock(true)
+		code, err, isback = t.delobj(lom, evict)
+		lom.Unlock(true)
+	}
 
 	// special corner-case retry (quote):
 	// - googleapi: "Error 503: We encountered an internal error. Please try again."
@@ -1756,12 +1965,14 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	case cos.IsNotExist(err, code) || cmn.IsErrObjNought(err):
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
+			t.statsT.AddRecentErr("delete", lom.Bucket(), lom.ObjName, err)
 		}
 	default:
 		// not to confuse with `stats.RemoteDeletedDelCount` that counts against
 		// QparamLatestVer, 'versioning.validate_warm_get' and friends
 		t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
-		if !isback {
+		t.statsT.AddRecentErr("delete", lom.Bucket(), lom.ObjName, err)
+		if !isback && code != http.StatusConflict /*feat.ProtectAliasedObjects*/ {
 			t.statsT.IncWith(stats.IOErrDeleteCount, vlabs)
 		}
 	}
@@ -1921,6 +2132,73 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	return nil
 }
 
+// move obj => designated target, overriding HRW
+// - the entire (send, remove) sequence is done under wlock
+// - optionally, pin the object to its new location (cmn.PinnedObjMD)
+func (t *target) objMvTo(lom *core.LOM, msg *apc.ActMsg) error {
+	var args apc.MoveObjMsg
+	if err := cos.MorphMarshal(msg.Value, &args); err != nil {
+		return fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, msg.Action, msg.Value, err)
+	}
+	if lom.Bck().IsRemote() || lom.ECEnabled() {
+		return fmt.Errorf("%s: cannot move %s: not supported for remote and erasure-coded buckets", t.si, lom)
+	}
+	smap := t.owner.smap.get()
+	tsi := smap.GetTarget(args.DaemonID)
+	if tsi == nil {
+		return &errNodeNotFound{t.si, smap, msg.Action + " failure:", args.DaemonID}
+	}
+	if tsi.ID() == t.SID() {
+		return fmt.Errorf("%s: %s is already here, nothing to do", t.si, lom.Cname())
+	}
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	lh, err := lom.NewHandle(true /*loaded*/)
+	if err != nil {
+		return err
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.CopyFrom(lom, false /*skip cksum*/)
+	if args.Pin {
+		oa.SetCustomKey(cmn.PinnedObjMD, tsi.ID())
+	} else {
+		oa.DelCustomKey(cmn.PinnedObjMD)
+	}
+
+	coiParams := xs.AllocCOI()
+	{
+		coiParams.BckTo = lom.Bck()
+		coiParams.ObjnameTo = lom.ObjName
+		coiParams.Config = cmn.GCO.Get()
+		coiParams.OWT = cmn.OwtCopy
+	}
+	sargs := allocSnda()
+	{
+		sargs.objNameTo = lom.ObjName
+		sargs.bckTo = lom.Bck()
+		sargs.reader = lh
+		sargs.objAttrs = oa
+		sargs.tsi = tsi
+		sargs.owt = cmn.OwtCopy
+	}
+	coi := (*coi)(coiParams)
+	err = coi.put(t, sargs) // closes lh
+	freeSnda(sargs)
+	xs.FreeCOI(coiParams)
+	if err != nil {
+		return err
+	}
+
+	if err := lom.RemoveObj(); err != nil {
+		nlog.Warningf("%s: failed to delete moved object %s (new location %s): %v", t, lom, tsi.StringEx(), err)
+	}
+	return nil
+}
+
 // compare running the same via (generic) t.xstart
 func (t *target) blobdl(params *core.BlobParams, oa *cmn.ObjAttrs, whdr http.Header) (string, *xs.XactBlobDl, error) {
 	// cap
@@ -6,8 +6,11 @@ package integration_test
 
 import (
 	"archive/tar"
+	"bytes"
 	"fmt"
+	"io"
 	"math/rand/v2"
+	"net/http"
 	"net/url"
 	"path"
 	"path/filepath"
@@ -29,6 +32,8 @@ import (
 	"github.com/NVIDIA/aistore/tools/tlog"
 	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
 )
 
 //
@@ -239,6 +244,15 @@ func TestGetFromArch(t *testing.T) {
 							tassert.CheckFatal(t, err)
 						}
 					}
+					if !corrupted && !test.mime {
+						// same, via reader
+						r, size, err := api.GetArchFile(baseParams, m.bck, objname, randomNames[0], nil)
+						tassert.CheckFatal(t, err)
+						n, err := io.Copy(io.Discard, r)
+						r.Close()
+						tassert.CheckFatal(t, err)
+						tassert.Errorf(t, n == size, "%s/%s: read %d, expected %d", objname, randomNames[0], n, size)
+					}
 				})
 			}
 		}
@@ -672,3 +686,154 @@ func TestAppendToArch(t *testing.T) {
 		})
 	}
 }
+
+// append to shards after their HRW target has changed (and before rebalance has moved them)
+func TestAppendToArchCrossTarget(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
+	var (
+		m = ioContext{
+			t:   t,
+			bck: cmn.Bck{Name: trand.String(10), Provider: apc.AIS},
+		}
+		numShards  = 64
+		fileSize   = int64(cos.KiB)
+		shardFmt   = "shard-%03d.tar"
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	m.initAndSaveState(false /*cleanup*/)
+	m.expectTargets(2)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+
+	apnd := func(shard, archpath string, flags int64) {
+		reader, err := readers.New(&readers.Arg{Type: readers.Rand, Size: fileSize, CksumType: cos.ChecksumNone})
+		tassert.CheckFatal(t, err)
+		args := api.PutApndArchArgs{
+			PutArgs: api.PutArgs{
+				BaseParams: baseParams,
+				Bck:        m.bck,
+				ObjName:    shard,
+				Reader:     reader,
+				Size:       uint64(fileSize),
+			},
+			ArchPath: archpath,
+			Flags:    flags,
+		}
+		tassert.CheckFatal(t, api.PutApndArch(&args))
+	}
+
+	tlog.Logfln("PUT %d shards => %s", numShards, m.bck.Cname(""))
+	for i := range numShards {
+		apnd(fmt.Sprintf(shardFmt, i), "first.bin", 0 /*PUT*/)
+	}
+
+	// change HRW ownership without migrating the shards
+	target := m.startMaintenanceNoRebalance()
+	t.Cleanup(func() {
+		rebID := m.stopMaintenance(target)
+		tools.WaitForRebalanceByID(t, baseParams, rebID)
+	})
+
+	tlog.Logfln("APPEND to %d shards", numShards)
+	for i := range numShards {
+		apnd(fmt.Sprintf(shardFmt, i), "second.bin", apc.ArchAppendIfExist)
+	}
+
+	// expecting each shard to contain both files (and no duplicate shards)
+	lsmsg := &apc.LsoMsg{Prefix: "shard-"}
+	lsmsg.SetFlag(apc.LsArchDir)
+	lst, err := api.ListObjects(baseParams, m.bck, lsmsg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	var shards, entries int
+	for _, en := range lst.Entries {
+		if en.IsAnyFlagSet(apc.EntryInArch) {
+			entries++
+		} else {
+			shards++
+		}
+	}
+	tassert.Errorf(t, shards == numShards, "expected %d shards, have %d", numShards, shards)
+	tassert.Errorf(t, entries == 2*numShards, "expected %d archived files, have %d", 2*numShards, entries)
+}
+
+// table of contents (apc.ArchiveMsg.EmitTOC) whose HRW target differs from the shard's:
+// each shard must be finalized with its TOC already stored (see xs.emitTOC)
+func TestArchTOCCrossTarget(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			bck:      cmn.Bck{Name: trand.String(10), Provider: apc.AIS},
+			num:      100,
+			fileSize: cos.KiB,
+			prefix:   "toc/",
+		}
+		bckTo      = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		numShards  = 32
+		numInArch  = 4
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(2)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, bckTo, nil, true /*cleanup*/)
+	m.puts()
+
+	var (
+		shards = make([]string, 0, numShards)
+		cross  int
+	)
+	for i := range numShards {
+		archName := fmt.Sprintf("shard-%03d.tar", i)
+		shards = append(shards, archName)
+
+		shardT, err := m.smap.HrwName2T(bckTo.MakeUname(archName))
+		tassert.CheckFatal(t, err)
+		tocT, err := m.smap.HrwName2T(bckTo.MakeUname(archive.TOCName(archName)))
+		tassert.CheckFatal(t, err)
+		if shardT.ID() != tocT.ID() {
+			cross++
+		}
+
+		msg := cmn.ArchiveBckMsg{
+			ToBck:      bckTo,
+			ArchiveMsg: apc.ArchiveMsg{ArchName: archName, EmitTOC: true},
+		}
+		for j := range numInArch {
+			msg.ListRange.ObjNames = append(msg.ListRange.ObjNames, m.objNames[(i*numInArch+j)%m.num])
+		}
+		_, err = api.ArchiveMultiObj(baseParams, m.bck, &msg)
+		tassert.CheckFatal(t, err)
+	}
+	tlog.Logfln("%d shards, %d with table of contents stored on another target", numShards, cross)
+	tassert.Fatalf(t, cross > 0, "expected at least one cross-target table of contents")
+
+	flt := xact.ArgsMsg{Kind: apc.ActArchive, Bck: m.bck}
+	err := api.WaitForSnapsIdle(baseParams, &flt)
+	tassert.CheckFatal(t, err)
+
+	for _, archName := range shards {
+		var (
+			tocName = archive.TOCName(archName)
+			w       = bytes.NewBuffer(nil)
+			toc     archive.TOC
+		)
+		_, err := api.GetObject(baseParams, bckTo, tocName, &api.GetArgs{Writer: w})
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, jsoniter.Unmarshal(w.Bytes(), &toc))
+		tassert.Fatalf(t, toc.Archive == archName && len(toc.Entries) == numInArch,
+			"%s: unexpected table of contents %+v", tocName, toc)
+
+		// range-read each entry and compare with the source
+		for _, e := range toc.Entries {
+			src, dst := bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+			_, err := api.GetObject(baseParams, m.bck, e.Name, &api.GetArgs{Writer: src})
+			tassert.CheckFatal(t, err)
+			hdr := http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(e.Offset, e.Size)}}
+			_, err = api.GetObject(baseParams, bckTo, archName, &api.GetArgs{Writer: dst, Header: hdr})
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, bytes.Equal(src.Bytes(), dst.Bytes()), "%s[%s]: content mismatch at offset %d",
+				archName, e.Name, e.Offset)
+		}
+	}
+}
@@ -659,12 +659,103 @@ func TestSetInvalidBucketProps(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			if err := api.ValidateBucketProps(bp, bck, test.props); err == nil {
+				t.Error("expected validation error upon bad input")
+			}
 			_, err := api.SetBucketProps(bp, bck, test.props)
 			if err == nil {
 				t.Error("expected error when setting bad input")
 			}
 		})
 	}
+
+	// valid props: validate only, must not be applied
+	t.Run("validate only", func(t *testing.T) {
+		props := &cmn.BpropsToSet{Mirror: &cmn.MirrorConfToSet{Enabled: apc.Ptr(true), Copies: apc.Ptr[int64](2)}}
+		tassert.CheckFatal(t, api.ValidateBucketProps(bp, bck, props))
+		p, err := api.HeadBucket(bp, bck, true /*don't add*/)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, !p.Mirror.Enabled, "expecting mirroring to remain disabled")
+	})
+}
+
+func TestSetBucketPropsIfChanged(t *testing.T) {
+	var (
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+		bck      = cmn.Bck{Name: testBucketName, Provider: apc.AIS, Ns: genBucketNs()}
+		props    = &cmn.BpropsToSet{Versioning: &cmn.VersionConfToSet{Enabled: apc.Ptr(false)}}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	_, changed, err := api.SetBucketPropsIfChanged(bp, bck, props)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, changed, "expecting props to change")
+
+	bmd, err := api.GetBMD(bp)
+	tassert.CheckFatal(t, err)
+	for range 2 {
+		_, changed, err = api.SetBucketPropsIfChanged(bp, bck, props)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, !changed, "expecting no change when re-applying identical props")
+	}
+	bmdAfter, err := api.GetBMD(bp)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bmd.Version == bmdAfter.Version, "BMD version changed: v%d => v%d", bmd.Version, bmdAfter.Version)
+}
+
+func TestCaseInsensitiveNames(t *testing.T) {
+	var (
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+		bck      = cmn.Bck{Name: testBucketName, Provider: apc.AIS, Ns: genBucketNs()}
+		props    = &cmn.BpropsToSet{CaseInsensitiveNames: apc.Ptr(true)}
+		objNames = []string{"Docs/ReadMe.MD", "Docs/Guide.txt", "other/File.bin"}
+	)
+	tools.CreateBucket(t, proxyURL, bck, props, true /*cleanup*/)
+
+	for _, objName := range objNames {
+		_, err := api.PutObject(&api.PutArgs{
+			BaseParams: bp,
+			Bck:        bck,
+			ObjName:    objName,
+			Reader:     readers.NewBytes([]byte(objName)),
+		})
+		tassert.CheckFatal(t, err)
+	}
+
+	// lookup by any case
+	for _, objName := range objNames {
+		for _, name := range []string{objName, strings.ToUpper(objName), strings.ToLower(objName)} {
+			_, err := api.HeadObject(bp, bck, name, api.HeadArgs{})
+			tassert.Errorf(t, err == nil, "HEAD %q: %v", name, err)
+		}
+	}
+
+	// list: normalized names, mixed-case prefix, original names via custom props
+	for _, prefix := range []string{"docs/", "Docs/", "DOCS/"} {
+		msg := &apc.LsoMsg{Prefix: prefix}
+		msg.AddProps(apc.GetPropsName, apc.GetPropsCustom)
+		lst, err := api.ListObjects(bp, bck, msg, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(lst.Entries) == 2, "prefix %q: expected 2 objects, got %d", prefix, len(lst.Entries))
+		for _, en := range lst.Entries {
+			tassert.Errorf(t, en.Name == strings.ToLower(en.Name), "expected normalized name, got %q", en.Name)
+			tassert.Errorf(t, strings.Contains(en.Custom, cmn.OrigNameObjMD), "%q: expected %q in %q", en.Name, cmn.OrigNameObjMD, en.Custom)
+		}
+	}
+
+	// overwriting with different case: still one object
+	_, err := api.PutObject(&api.PutArgs{
+		BaseParams: bp,
+		Bck:        bck,
+		ObjName:    "DOCS/README.md",
+		Reader:     readers.NewBytes([]byte("overwrite")),
+	})
+	tassert.CheckFatal(t, err)
+	lst, err := api.ListObjects(bp, bck, nil, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == len(objNames), "expected %d objects, got %d", len(objNames), len(lst.Entries))
 }
 
 func TestBucketSingleProp(t *testing.T) {
@@ -2074,6 +2165,49 @@ func TestCopyBucketSync(t *testing.T) {
 		m.num-len(nam2del), len(lst.Entries))
 }
 
+// copied objects keep their per-object TTL (see api.PutArgs.TTL)
+func TestCopyBucketTTL(t *testing.T) {
+	var (
+		srcBck = cmn.Bck{Name: "cpyttl_src" + cos.GenTie(), Provider: apc.AIS}
+		dstBck = cmn.Bck{Name: "cpyttl_dst" + cos.GenTie(), Provider: apc.AIS}
+		bp     = tools.BaseAPIParams()
+		num    = 50
+	)
+	tools.CreateBucket(t, proxyURL, srcBck, nil, true /*cleanup*/)
+	t.Cleanup(func() { tools.DestroyBucket(t, proxyURL, dstBck) })
+
+	objNames := make([]string, 0, num)
+	for i := range num {
+		objName := fmt.Sprintf("ttl-%d", i)
+		_, err := api.PutObject(&api.PutArgs{
+			BaseParams: bp,
+			Bck:        srcBck,
+			ObjName:    objName,
+			Reader:     readers.NewBytes([]byte(objName)),
+			TTL:        time.Hour,
+		})
+		tassert.CheckFatal(t, err)
+		objNames = append(objNames, objName)
+	}
+
+	xid, err := api.CopyBucket(bp, srcBck, dstBck, &apc.TCBMsg{})
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck, Timeout: time.Minute}
+	_, err = api.WaitForXactionIC(bp, &args)
+	tassert.CheckFatal(t, err)
+
+	for _, objName := range objNames {
+		src, err := api.HeadObject(bp, srcBck, objName, api.HeadArgs{})
+		tassert.CheckFatal(t, err)
+		dst, err := api.HeadObject(bp, dstBck, objName, api.HeadArgs{})
+		tassert.CheckFatal(t, err)
+		exp, _ := src.GetCustomKey(cmn.ExpiresObjMD)
+		got, ok := dst.GetCustomKey(cmn.ExpiresObjMD)
+		tassert.Fatalf(t, exp != "", "%s: expected %q", srcBck.Cname(objName), cmn.ExpiresObjMD)
+		tassert.Errorf(t, ok && got == exp, "%s: expected %q=%s, got %q", dstBck.Cname(objName), cmn.ExpiresObjMD, exp, got)
+	}
+}
+
 func TestCopyBucketSimple(t *testing.T) {
 	var (
 		srcBck = cmn.Bck{Name: "cpybck_src" + cos.GenTie(), Provider: apc.AIS, Ns: genBucketNs()}
@@ -2117,6 +2251,7 @@ func TestCopyBucketSimple(t *testing.T) {
 	t.Run("Prepend", func(t *testing.T) { f(); testCopyBucketPrepend(t, srcBck, m) })
 	t.Run("Prefix", func(t *testing.T) { f(); testCopyBucketPrefix(t, srcBck, m, m.num/2) })
 	t.Run("Abort", func(t *testing.T) { f(); testCopyBucketAbort(t, srcBck, m, sleep) })
+	t.Run("AbortByFilter", func(t *testing.T) { f(); testCopyBucketAbortFlt(t, srcBck, m) })
 	t.Run("DryRun", func(t *testing.T) { f(); testCopyBucketDryRun(t, srcBck, m) })
 	t.Run("MultiWorker", func(t *testing.T) { f(); testCopyBucketMultiWorker(t, srcBck, m) })
 }
@@ -2248,6 +2383,33 @@ func testCopyBucketAbort(t *testing.T, srcBck cmn.Bck, m *ioContext, sleep time.
 	}
 }
 
+func testCopyBucketAbortFlt(t *testing.T, srcBck cmn.Bck, m *ioContext) {
+	proxyURL := tools.RandomProxyURL(t)
+	bp := tools.BaseAPIParams(proxyURL)
+	dstBck := cmn.Bck{Name: testBucketName + cos.GenTie(), Provider: apc.AIS}
+
+	xid, err := api.CopyBucket(bp, srcBck, dstBck, &apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Force: true}})
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() {
+		tools.DestroyBucket(t, m.proxyURL, dstBck)
+	})
+
+	_, err = api.AbortXactions(bp, api.XactFilter{})
+	tassert.Fatalf(t, err != nil, "expecting empty filter to be rejected")
+
+	tlog.Logfln("Aborting all x-%s on %s", apc.ActCopyBck, srcBck.String())
+	xids, err := api.AbortXactions(bp, api.XactFilter{Kind: apc.ActCopyBck, Bck: srcBck})
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(bp, &xact.ArgsMsg{ID: xid})
+	tassert.CheckFatal(t, err)
+	aborted, finished := _isAbortedOrFinished(xid, snaps)
+	tassert.Errorf(t, aborted || finished, "expecting copy-bucket %q to abort or finish", xid)
+	if aborted {
+		tassert.Errorf(t, slices.Contains(xids, xid), "expecting %q in the list of aborted %v", xid, xids)
+	}
+}
+
 func _isAbortedOrFinished(xid string, xs xact.MultiSnap) (aborted, finished bool) {
 	for _, snaps := range xs {
 		for _, xsnap := range snaps {
@@ -2837,6 +2999,75 @@ func testWarmValidation(t *testing.T, cksumType string, mirrored, eced bool) {
 	}
 }
 
+func TestBucketScrub(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{MinMountpaths: 2})
+	if docker.IsRunning() {
+		t.Skipf("skipping %s (docker is not supported)", t.Name())
+	}
+	const numCorrupted = 5
+	var (
+		m = ioContext{
+			t:        t,
+			num:      50,
+			fileSize: 4 * cos.KiB,
+		}
+		bp = tools.BaseAPIParams()
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	_, err := api.SetBucketProps(bp, m.bck, &cmn.BpropsToSet{
+		Mirror: &cmn.MirrorConfToSet{Enabled: apc.Ptr(true), Copies: apc.Ptr[int64](2)},
+	})
+	tassert.CheckFatal(t, err)
+
+	m.puts()
+	api.WaitForSnapsIdle(bp, &xact.ArgsMsg{Kind: apc.ActPutCopies, Bck: m.bck, Timeout: 10 * time.Second})
+	m.ensureNumCopies(bp, 2, false /*greaterOk*/)
+
+	initMountpaths(t, m.proxyURL)
+	for _, objName := range m.objNames[:numCorrupted] {
+		corruptSingleBitInFile(&m, objName, false /*eced*/)
+	}
+
+	scrub := func(repair bool) (corrupt, repaired int) {
+		xid, err := api.ScrubBucket(bp, m.bck, api.ScrubArgs{Repair: repair})
+		tassert.CheckFatal(t, err)
+		_, err = api.WaitForXactionIC(bp, &xact.ArgsMsg{ID: xid, Kind: apc.ActScrub, Bck: m.bck, Timeout: time.Minute})
+		tassert.CheckFatal(t, err)
+		snaps, err := api.QueryXactionSnaps(bp, &xact.ArgsMsg{ID: xid})
+		tassert.CheckFatal(t, err)
+		for _, tsnaps := range snaps {
+			for _, snap := range tsnaps {
+				tlog.Logfln("%s: %s", snap.ID, snap.CtlMsg)
+				for kv := range strings.SplitSeq(snap.CtlMsg, ", ") {
+					if v, ok := strings.CutPrefix(kv, "corrupt:"); ok {
+						n, _ := strconv.Atoi(v)
+						corrupt += n
+					} else if v, ok := strings.CutPrefix(kv, "repaired:"); ok {
+						n, _ := strconv.Atoi(v)
+						repaired += n
+					}
+				}
+			}
+		}
+		return corrupt, repaired
+	}
+
+	corrupt, _ := scrub(false)
+	tassert.Errorf(t, corrupt == numCorrupted, "detect: expected %d corrupted, got %d", numCorrupted, corrupt)
+
+	corrupt, repaired := scrub(true)
+	tassert.Errorf(t, corrupt == numCorrupted, "repair: expected %d corrupted, got %d", numCorrupted, corrupt)
+	tassert.Errorf(t, repaired == numCorrupted, "repair: expected %d repaired, got %d", numCorrupted, repaired)
+
+	corrupt, _ = scrub(false)
+	tassert.Errorf(t, corrupt == 0, "after repair: expected no corrupted objects, got %d", corrupt)
+	for _, objName := range m.objNames[:numCorrupted] {
+		_, err := api.GetObjectWithValidation(bp, m.bck, objName, nil)
+		tassert.CheckError(t, err)
+	}
+}
+
 func TestBucketListAndSummary(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
@@ -3020,6 +3251,113 @@ func TestBucketSummaryPollAndCallback(t *testing.T) {
 	checkBucketSummary(t, summaries, m.num)
 }
 
+func TestBucketApproxCount(t *testing.T) {
+	var (
+		m = &ioContext{
+			t:        t,
+			num:      100,
+			fileSize: cos.KiB,
+		}
+		bp = tools.BaseAPIParams()
+	)
+
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(1)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+
+	n, err := api.GetBucketApproxCount(bp, m.bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == 0, "new bucket: expected zero count, got %d", n)
+
+	m.puts()
+	n, err = api.GetBucketApproxCount(bp, m.bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == int64(m.num), "after PUT: expected %d, got %d", m.num, n)
+
+	// overwrite must not count twice
+	const numDel = 10
+	for _, objName := range m.objNames[:numDel] {
+		reader, err := readers.New(&readers.Arg{Type: readers.Rand, Size: cos.KiB, CksumType: cos.ChecksumNone})
+		tassert.CheckFatal(t, err)
+		_, err = api.PutObject(&api.PutArgs{BaseParams: bp, Bck: m.bck, ObjName: objName, Reader: reader})
+		tassert.CheckFatal(t, err)
+	}
+	n, err = api.GetBucketApproxCount(bp, m.bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == int64(m.num), "after overwrite: expected %d, got %d", m.num, n)
+
+	for _, objName := range m.objNames[:numDel] {
+		tassert.CheckFatal(t, api.DeleteObject(bp, m.bck, objName))
+	}
+	n, err = api.GetBucketApproxCount(bp, m.bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == int64(m.num-numDel), "after DELETE: expected %d, got %d", m.num-numDel, n)
+}
+
+func TestBucketObjectDistribution(t *testing.T) {
+	var (
+		m = &ioContext{
+			t:         t,
+			num:       200,
+			fileSize:  cos.KiB,
+			fixedSize: true,
+		}
+		bp = tools.BaseAPIParams()
+	)
+
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(1)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+
+	m.puts()
+	dist, err := api.GetObjectDistribution(bp, m.bck)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(dist) == m.smap.CountActiveTs(), "expected %d targets, got %d", m.smap.CountActiveTs(), len(dist))
+
+	var objs, size int64
+	for tid, ds := range dist {
+		tlog.Logfln("%s: %d objects, %s", tid, ds.Objs, cos.IEC(ds.Size, 0))
+		tassert.Errorf(t, ds.Misplaced == 0, "%s: unexpected misplaced objects: %d", tid, ds.Misplaced)
+		objs += ds.Objs
+		size += ds.Size
+	}
+	tassert.Errorf(t, objs == int64(m.num), "expected %d objects, got %d", m.num, objs)
+	tassert.Errorf(t, size == int64(m.num)*cos.KiB, "expected total size %d, got %d", int64(m.num)*cos.KiB, size)
+}
+
+// object distribution computed by the targets must match list-objects (by location)
+func TestBucketObjectDistributionVsList(t *testing.T) {
+	var (
+		m = &ioContext{
+			t:         t,
+			num:       500,
+			fileSize:  cos.KiB,
+			fixedSize: true,
+		}
+		bp = tools.BaseAPIParams()
+	)
+
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(2)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+
+	m.puts()
+	dist, err := api.GetObjectDistribution(bp, m.bck)
+	tassert.CheckFatal(t, err)
+	listed := m.listObjectDistribution(t)
+
+	for tid, cnt := range listed {
+		ds, ok := dist[tid]
+		tassert.Fatalf(t, ok, "target %s: listed %d objects, missing in distribution", tid, cnt)
+		tassert.Errorf(t, ds.Objs == cnt, "target %s: distribution %d vs listed %d objects", tid, ds.Objs, cnt)
+	}
+	for tid, ds := range dist {
+		if _, ok := listed[tid]; !ok {
+			tassert.Errorf(t, ds.Objs == 0, "target %s: distribution %d objects, none listed", tid, ds.Objs)
+		}
+	}
+}
+
 func TestBucketSummaryQueryBuckets(t *testing.T) {
 	const (
 		numFirst  = 37
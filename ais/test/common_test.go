@@ -57,6 +57,9 @@ const (
 
 const testMpath = "/tmp/ais/mountpath"
 
+// encryption key reference (see createEncodedBucket)
+const testEncKeyRef = "ais-test"
+
 var (
 	cliBck             cmn.Bck
 	cliIOCtxChunksConf *ioCtxChunksConf
@@ -211,19 +214,9 @@ func (m *ioContext) expectProxies(n int) {
 
 func (m *ioContext) checkObjectDistribution(t *testing.T) {
 	m.t.Helper()
-	var (
-		requiredCount     = int64(rebalanceObjectDistributionTestCoef * (float64(m.num) / float64(m.originalTargetCount)))
-		targetObjectCount = make(map[string]int64)
-	)
+	requiredCount := int64(rebalanceObjectDistributionTestCoef * (float64(m.num) / float64(m.originalTargetCount)))
 	tlog.Logfln("Checking if each target has a required number of object in bucket %s...", m.bck.String())
-	baseParams := tools.BaseAPIParams(m.proxyURL)
-	lst, err := api.ListObjects(baseParams, m.bck, &apc.LsoMsg{Props: apc.GetPropsLocation}, api.ListArgs{})
-	tassert.CheckFatal(t, err)
-	for _, obj := range lst.Entries {
-		tname, _ := core.ParseObjLoc(obj.Location)
-		tid := meta.N2ID(tname)
-		targetObjectCount[tid]++
-	}
+	targetObjectCount := m.listObjectDistribution(t)
 	if len(targetObjectCount) != m.originalTargetCount {
 		t.Fatalf("Rebalance error, %d/%d targets received no objects from bucket %s\n",
 			m.originalTargetCount-len(targetObjectCount), m.originalTargetCount, m.bck.String())
@@ -235,6 +228,22 @@ func (m *ioContext) checkObjectDistribution(t *testing.T) {
 	}
 }
 
+// number of objects per target ID, as per list-objects (compare with api.GetObjectDistribution)
+func (m *ioContext) listObjectDistribution(t *testing.T) map[string]int64 {
+	var (
+		targetObjectCount = make(map[string]int64)
+		baseParams        = tools.BaseAPIParams(m.proxyURL)
+	)
+	lst, err := api.ListObjects(baseParams, m.bck, &apc.LsoMsg{Props: apc.GetPropsLocation}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	for _, obj := range lst.Entries {
+		tname, _ := core.ParseObjLoc(obj.Location)
+		tid := meta.N2ID(tname)
+		targetObjectCount[tid]++
+	}
+	return targetObjectCount
+}
+
 func (m *ioContext) sizesToString() (s string) {
 	siz0, siz1 := int64(m.fileSizeRange[0]), int64(m.fileSizeRange[1])
 	switch {
@@ -1220,3 +1229,36 @@ func isErrNotFound(err error) bool {
 	herr := cmn.AsErrHTTP(err)
 	return herr != nil && herr.Status == http.StatusNotFound
 }
+
+// create bucket that stores objects compressed and, optionally, encrypted at rest (see core.LOM.IsEncoded);
+// encryption requires targets to have the `testEncKeyRef` key (e.g., AIS_ENCRYPTION_KEY_AIS_TEST) - skip otherwise
+func createEncodedBucket(t *testing.T, proxyURL string, bck cmn.Bck, encrypt bool) {
+	props := &cmn.BpropsToSet{
+		Compression: &cmn.CompressionConfToSet{Enabled: apc.Ptr(true), MinSize: apc.Ptr(cos.SizeIEC(cos.KiB))},
+	}
+	if encrypt {
+		props.Encryption = &cmn.EncryptionConfToSet{Enabled: apc.Ptr(true), KeyRef: apc.Ptr(testEncKeyRef)}
+	}
+	tools.CreateBucket(t, proxyURL, bck, props, true /*cleanup*/)
+	if !encrypt {
+		return
+	}
+	var (
+		baseParams = tools.BaseAPIParams(proxyURL)
+		objName    = "probe-" + trand.String(5)
+	)
+	_, err := api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objName, Reader: readers.NewBytes([]byte(objName))})
+	if err != nil {
+		t.Skipf("%s: encryption key %q is not available: %v", bck.Cname(""), testEncKeyRef, err)
+	}
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, objName))
+}
+
+// content that compresses well (compare w/ random content stored as is)
+func compressibleData(size int) []byte {
+	var b bytes.Buffer
+	for i := 0; b.Len() < size; i++ {
+		b.WriteString("line " + strconv.Itoa(i) + ": the quick brown fox jumps over the lazy dog\n")
+	}
+	return b.Bytes()[:size]
+}
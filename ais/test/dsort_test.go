@@ -1095,6 +1095,65 @@ func TestDsortCompressionDisk(t *testing.T) {
 	}
 }
 
+// input shards stored compressed (and encrypted - see createEncodedBucket): records must be read
+// from the decoded content, whether kept in memory or spilled to disk
+func TestDsortEncodedBucket(t *testing.T) {
+	runDsortTest(
+		t, dsortTestSpec{p: true, types: dsorterTypes},
+		func(dsorterType string, t *testing.T) {
+			cases := []struct {
+				maxMem  string
+				encrypt bool
+			}{
+				{"99%", false},
+				{"1KB", false}, // spill to disk
+				{"99%", true},
+				{"1KB", true},
+			}
+			for _, entry := range cases {
+				test := fmt.Sprintf("encrypt=%t/max-mem=%s", entry.encrypt, entry.maxMem)
+				t.Run(test, func(t *testing.T) {
+					var (
+						m = &ioContext{
+							t: t,
+						}
+						df = &dsortFramework{
+							m:           m,
+							dsorterType: dsorterType,
+							alg: &dsort.Algorithm{
+								Kind:           dsort.Content,
+								Ext:            ".loss",
+								ContentKeyType: shard.ContentKeyInt,
+							},
+							shardCnt:      100,
+							filesPerShard: 10,
+							maxMemUsage:   entry.maxMem,
+						}
+					)
+
+					m.initAndSaveState(true /*cleanup*/)
+					m.expectTargets(1)
+					createEncodedBucket(t, m.proxyURL, m.bck, entry.encrypt)
+
+					df.init()
+					df.createInputShards()
+
+					tlog.Logfln("starting dsort: %d/%d, %s", df.shardCnt, df.filesPerShard, test)
+					df.start()
+
+					aborted, err := tools.WaitForDsortToFinish(m.proxyURL, df.managerUUID)
+					tassert.CheckFatal(t, err)
+					tassert.Fatalf(t, !aborted, "%s was aborted", df.job())
+					tlog.Logfln("%s: finished", df.job())
+
+					df.checkMetrics(false /* expectAbort */)
+					df.checkOutputShards(5)
+				})
+			}
+		},
+	)
+}
+
 func TestDsortMemDisk(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
@@ -243,7 +243,7 @@ func waitForECFinishes(t *testing.T, totalCnt int, objSize, sliceSize int64, doE
 func randObjectSize(n, every int, o *ecOptions) (
 	totalCnt int, objSize, sliceSize int64, doEC bool) {
 	if o.objSize != 0 {
-		doEC = o.objSizeLimit != cmn.ObjSizeToAlwaysReplicate && o.objSize >= o.objSizeLimit
+		doEC = o.objSizeLimit != cmn.ObjSizeToAlwaysReplicate && o.objSize > o.objSizeLimit
 		objSize = o.objSize
 		if doEC {
 			totalCnt = 2 + (o.sliceTotal())*2
@@ -271,7 +271,7 @@ func randObjectSize(n, every int, o *ecOptions) (
 		objSize = int64(ecMinSmallSize + o.rnd.IntN(ecSmallDelta))
 		sliceSize = objSize
 	}
-	doEC = objSize >= o.objSizeLimit
+	doEC = objSize > o.objSizeLimit
 	if o.objSizeLimit == cmn.ObjSizeToAlwaysReplicate {
 		doEC = false
 		totalCnt = 2 + o.parityCnt*2
@@ -1036,6 +1036,124 @@ func TestECChecksum(t *testing.T) {
 	tassert.Fatalf(t, err != nil, "Object should not be restored when checksums are wrong")
 }
 
+func TestECObjectStatus(t *testing.T) {
+	if docker.IsRunning() {
+		t.Skipf("test %q requires direct access to mountpaths, doesn't work with docker", t.Name())
+	}
+	var (
+		proxyURL = tools.RandomProxyURL()
+		bck      = cmn.Bck{
+			Name:     testBucketName + "-ec-status",
+			Provider: apc.AIS,
+		}
+	)
+	o := &ecOptions{
+		minTargets:   4,
+		dataCnt:      1,
+		parityCnt:    1,
+		pattern:      "obj-status-%04d",
+		objSizeLimit: ecObjLimit,
+	}
+	o.init(t, proxyURL)
+	baseParams := tools.BaseAPIParams(proxyURL)
+	initMountpaths(t, proxyURL)
+
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	objName := fmt.Sprintf(o.pattern, 1)
+	objPath := ecTestDir + objName
+	foundParts, mainObjPath := createECFile(t, baseParams, bck, objName, o)
+
+	status, err := api.GetObjectECStatus(baseParams, bck, objPath)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(status.Slices) == 1+o.sliceTotal(), "expected %d locations, got %+v", 1+o.sliceTotal(), status.Slices)
+	for _, slice := range status.Slices {
+		tassert.Errorf(t, slice.Present, "expected slice %d at %s to be present", slice.Idx, slice.Target)
+	}
+	tassert.Errorf(t, status.Reconstructable, "expected %s to be reconstructable", objPath)
+
+	// remove main replica and all slices but one (the minimum for data=1)
+	tassert.CheckFatal(t, os.Remove(mainObjPath))
+	var removed bool
+	for fqn := range foundParts {
+		ct, err := core.NewCTFromFQN(fqn, nil)
+		tassert.CheckFatal(t, err)
+		if ct.ContentType() == fs.ECSliceCT && !removed {
+			tassert.CheckFatal(t, os.Remove(fqn))
+			removed = true
+		}
+	}
+	status, err = api.GetObjectECStatus(baseParams, bck, objPath)
+	tassert.CheckFatal(t, err)
+	var numMissing int
+	for _, slice := range status.Slices {
+		if !slice.Present {
+			numMissing++
+		}
+	}
+	tassert.Errorf(t, numMissing == 2, "expected 2 missing (main and slice), got %d: %+v", numMissing, status.Slices)
+	tassert.Errorf(t, status.Reconstructable, "expected %s to remain reconstructable from the remaining slice", objPath)
+
+	_, err = api.GetObjectECStatus(baseParams, bck, ecTestDir+"nonexistent")
+	tassert.Errorf(t, err != nil, "expected error for a non-existing object")
+}
+
+// Objects at or below EC.ObjSizeLimit must be replicated at PUT time, larger ones - encoded;
+// list-objects (apc.GetPropsECStatus) must report the same
+func TestECObjSizeLimit(t *testing.T) {
+	if docker.IsRunning() {
+		t.Skipf("test %q requires direct access to mountpaths, doesn't work with docker", t.Name())
+	}
+	var (
+		proxyURL = tools.RandomProxyURL()
+		bck      = cmn.Bck{
+			Name:     testBucketName + "-ec-limit",
+			Provider: apc.AIS,
+		}
+	)
+	o := &ecOptions{
+		minTargets:   4,
+		dataCnt:      1,
+		parityCnt:    1,
+		pattern:      "obj-limit-%04d",
+		objSizeLimit: ecObjLimit,
+	}
+	o.init(t, proxyURL)
+	baseParams := tools.BaseAPIParams(proxyURL)
+	initMountpaths(t, proxyURL)
+
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	expected := make(map[string]string, 3)
+	for i, objSize := range []int64{ecObjLimit - 1, ecObjLimit, ecObjLimit + 1} {
+		objPath := ecTestDir + fmt.Sprintf(o.pattern, i)
+		r, err := readers.New(&readers.Arg{Type: readers.Rand, Size: objSize, CksumType: cos.ChecksumNone})
+		tassert.CheckFatal(t, err)
+		_, err = api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objPath, Reader: r})
+		tassert.CheckFatal(t, err)
+
+		o.objSize = objSize
+		totalCnt, _, sliceSize, doEC := randObjectSize(i, 0, o)
+		foundParts, mainObjPath := waitForECFinishes(t, totalCnt, objSize, sliceSize, doEC, bck, objPath)
+		ecCheckSlices(t, foundParts, bck, objPath, objSize, sliceSize, totalCnt)
+		tassert.Errorf(t, mainObjPath != "", "Full copy of %s is not found", objPath)
+
+		expected[objPath] = apc.ECStatusReplicated
+		if doEC {
+			expected[objPath] = apc.ECStatusEncoded
+		}
+	}
+
+	msg := &apc.LsoMsg{Props: apc.GetPropsName + apc.LsPropsSepa + apc.GetPropsECStatus}
+	lst, err := api.ListObjects(baseParams, bck, msg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == len(expected), "expected %d objects, got %d", len(expected), len(lst.Entries))
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, en.ECStatus == expected[en.Name], "%s: expected EC status %q, got %q",
+			en.Name, expected[en.Name], en.ECStatus)
+	}
+}
+
 func TestECEnabledDisabledEnabled(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
@@ -1237,7 +1355,7 @@ func TestECDisableEnableDuringLoad(t *testing.T) {
 // Stress test to check that EC works as expected.
 //   - Changes bucket props to use EC
 //   - Generates `objCount` objects, size between `ecObjMinSize` and `ecObjMinSize`+ecObjMaxSize`
-//   - Objects smaller `ecObjLimit` must be copies, while others must be EC'ed
+//   - Objects up to `ecObjLimit` (inclusive) must be copies, while others must be EC'ed
 //   - PUTs objects to the bucket
 //   - filepath.Walk checks that the number of metafiles and slices are correct
 //   - The original object is deleted
@@ -1354,7 +1472,7 @@ func TestECStressManyBuckets(t *testing.T) {
 // ExtraStress test to check that EC works as expected
 //   - Changes bucket props to use EC
 //   - Generates `objCount` objects, size between `ecObjMinSize` and `ecObjMinSize`+ecObjMaxSize`
-//   - Objects smaller `ecObjLimit` must be copies, while others must be EC'ed
+//   - Objects up to `ecObjLimit` (inclusive) must be copies, while others must be EC'ed
 //   - PUTs ALL objects to the bucket stressing both EC and transport
 //   - filepath.Walk checks that the number of metafiles at the end is correct
 //   - No errors must occur
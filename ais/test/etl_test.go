@@ -807,6 +807,137 @@ func TestETLBucketDryRun(t *testing.T) {
 	checkETLStats(t, xid, m.num, uint64(m.num*int(m.fileSize)), false)
 }
 
+// cached results (apc.TCBMsg.CacheResults) with a websocket ETL: tagged results can't be direct-put,
+// and destinations owned by other targets must still receive the transformed objects
+func TestETLBucketCacheResults(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{RequiredDeployment: tools.ClusterTypeK8s, MinTargets: 2})
+	tetl.CheckNoRunningETLContainers(t, baseParams)
+
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+
+		bckFrom = cmn.Bck{Name: "etlcache-" + trand.String(5), Provider: apc.AIS}
+		bckTo   = cmn.Bck{Name: "etlcache-out-" + trand.String(5), Provider: apc.AIS}
+
+		m = ioContext{
+			t:         t,
+			num:       50,
+			fileSize:  512,
+			fixedSize: true,
+			bck:       bckFrom,
+		}
+	)
+
+	tools.CreateBucket(t, proxyURL, bckFrom, nil, true /*cleanup*/)
+	m.init(true /*cleanup*/)
+	m.puts()
+	t.Cleanup(func() { tools.DestroyBucket(t, proxyURL, bckTo) })
+
+	initMsg := tetl.InitSpec(t, baseParams, tetl.Echo, etl.WebSocket)
+	t.Cleanup(func() { tetl.StopAndDeleteETL(t, baseParams, initMsg.Name()) })
+
+	msg := &apc.TCBMsg{
+		Transform:    apc.Transform{Name: initMsg.Name()},
+		CopyBckMsg:   apc.CopyBckMsg{Force: true},
+		CacheResults: true,
+	}
+	for range 2 { // second run: all cached
+		xid, err := api.ETLBucket(baseParams, bckFrom, bckTo, msg)
+		tassert.CheckFatal(t, err)
+		args := xact.ArgsMsg{ID: xid, Timeout: time.Minute}
+		_, err = api.WaitForXactionIC(baseParams, &args)
+		tassert.CheckFatal(t, err)
+	}
+
+	for _, objName := range m.objNames {
+		props, err := api.HeadObject(baseParams, bckTo, objName, api.HeadArgs{})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, props.Size == int64(m.fileSize), "%s: expected size %d, got %d", objName, m.fileSize, props.Size)
+		_, ok := props.GetCustomKey(cmn.ETLSourceObjMD)
+		tassert.Errorf(t, ok, "%s: expected %q custom metadata", objName, cmn.ETLSourceObjMD)
+	}
+}
+
+// objects stored compressed (and encrypted - see createEncodedBucket) must reach the ETL container
+// as their original content, with or without passing FQN
+func TestETLEncodedBucket(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{RequiredDeployment: tools.ClusterTypeK8s})
+	tetl.CheckNoRunningETLContainers(t, baseParams)
+
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		tests      = []struct {
+			name    string
+			encrypt bool
+		}{
+			{"compressed", false},
+			{"encrypted", true},
+		}
+	)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				bckFrom = cmn.Bck{Name: "etlenc-" + trand.String(5), Provider: apc.AIS}
+				bckTo   = cmn.Bck{Name: "etlenc-out-" + trand.String(5), Provider: apc.AIS}
+				data    = make(map[string][]byte, 10)
+			)
+			createEncodedBucket(t, proxyURL, bckFrom, test.encrypt)
+			t.Cleanup(func() { tools.DestroyBucket(t, proxyURL, bckTo) })
+			for i := range 10 {
+				objName := fmt.Sprintf("obj-%d", i)
+				data[objName] = compressibleData(100*cos.KiB + i*1000)
+				_, err := api.PutObject(&api.PutArgs{
+					BaseParams: baseParams,
+					Bck:        bckFrom,
+					ObjName:    objName,
+					Reader:     readers.NewBytes(data[objName]),
+				})
+				tassert.CheckFatal(t, err)
+			}
+
+			for _, comm := range []string{etl.Hpush, etl.Hpull, etl.WebSocket} {
+				t.Run(comm, func(t *testing.T) {
+					initMsg := tetl.InitSpec(t, baseParams, tetl.Echo, comm)
+					t.Cleanup(func() { tetl.StopAndDeleteETL(t, baseParams, initMsg.Name()) })
+
+					// inline
+					for objName, exp := range data {
+						out := bytes.NewBuffer(nil)
+						_, err := api.GetObject(baseParams, bckFrom, objName, &api.GetArgs{
+							Writer: out,
+							Query:  url.Values{apc.QparamETLName: {initMsg.Name()}},
+						})
+						tassert.CheckFatal(t, err)
+						tassert.Errorf(t, bytes.Equal(out.Bytes(), exp), "%s: inline transform: content mismatch (size %d vs %d)",
+							bckFrom.Cname(objName), out.Len(), len(exp))
+					}
+
+					// offline
+					msg := &apc.TCBMsg{
+						Transform:  apc.Transform{Name: initMsg.Name()},
+						CopyBckMsg: apc.CopyBckMsg{Force: true},
+					}
+					xid, err := api.ETLBucket(baseParams, bckFrom, bckTo, msg)
+					tassert.CheckFatal(t, err)
+					args := xact.ArgsMsg{ID: xid, Timeout: time.Minute}
+					_, err = api.WaitForXactionIC(baseParams, &args)
+					tassert.CheckFatal(t, err)
+
+					for objName, exp := range data {
+						out := bytes.NewBuffer(nil)
+						_, err := api.GetObject(baseParams, bckTo, objName, &api.GetArgs{Writer: out})
+						tassert.CheckFatal(t, err)
+						tassert.Errorf(t, bytes.Equal(out.Bytes(), exp), "%s: offline transform: content mismatch (size %d vs %d)",
+							bckTo.Cname(objName), out.Len(), len(exp))
+					}
+				})
+			}
+		})
+	}
+}
+
 func TestETLInspectBucket(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{RequiredDeployment: tools.ClusterTypeK8s})
 	tetl.CheckNoRunningETLContainers(t, baseParams)
@@ -1008,10 +1139,11 @@ func TestETLHealth(t *testing.T) {
 		time.Sleep(10 * time.Second)
 	}
 
-	// TODO -- FIXME: see health handlers returning "OK" - revisit
 	for _, msg := range healths {
 		tassert.Errorf(t, msg.Status == "Running", "Expected pod at %s to be running, got %q",
 			meta.Tname(msg.TargetID), msg.Status)
+		tassert.Errorf(t, msg.Healthy(), "Expected pod at %s to be healthy, got %+v",
+			meta.Tname(msg.TargetID), msg)
 	}
 }
 
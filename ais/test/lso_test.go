@@ -5,7 +5,10 @@
 package integration_test
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand/v2"
 	"path"
 	"sort"
@@ -431,6 +434,208 @@ func TestLsoStartAfter(t *testing.T) {
 	})
 }
 
+func TestLsoSizeRange(t *testing.T) {
+	runProviderTests(t, func(t *testing.T, bck *meta.Bck) {
+		var (
+			baseParams = tools.BaseAPIParams()
+			m          = ioContext{
+				t:             t,
+				num:           100,
+				bck:           bck.Clone(),
+				fileSizeRange: [2]uint64{cos.KiB, 16 * cos.KiB},
+			}
+		)
+		if !bck.IsAIS() {
+			m.num = 20
+		}
+
+		m.init(true /*cleanup*/)
+		m.puts()
+		if m.bck.IsRemote() {
+			defer m.del()
+		}
+		lst, err := api.ListObjects(baseParams, m.bck, &apc.LsoMsg{Props: apc.GetPropsSize}, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+
+		sizes := make(map[string]int64, len(lst.Entries))
+		for _, en := range lst.Entries {
+			sizes[en.Name] = en.Size
+		}
+		tests := []struct {
+			name     string
+			min, max int64
+		}{
+			{"min", 8 * cos.KiB, 0},
+			{"max", 0, 4 * cos.KiB},
+			{"range", 4 * cos.KiB, 12 * cos.KiB},
+			{"exact", lst.Entries[0].Size, lst.Entries[0].Size},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var expected int
+				for _, size := range sizes {
+					if size >= test.min && (test.max == 0 || size <= test.max) {
+						expected++
+					}
+				}
+				msg := &apc.LsoMsg{PageSize: 10, Props: apc.GetPropsName, MinSize: cos.SizeIEC(test.min), MaxSize: cos.SizeIEC(test.max)}
+				msg.SetFlag(apc.LsNameOnly)
+				lst, err := api.ListObjects(baseParams, m.bck, msg, api.ListArgs{})
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, len(lst.Entries) == expected, "[%d, %d]: expected %d entries, got %d",
+					test.min, test.max, expected, len(lst.Entries))
+				for _, en := range lst.Entries {
+					tassert.Errorf(t, en.Size == sizes[en.Name], "%s: expected size %d, got %d",
+						en.Name, sizes[en.Name], en.Size)
+				}
+			})
+		}
+
+		// invalid range
+		msg := &apc.LsoMsg{MinSize: 2 * cos.KiB, MaxSize: cos.KiB}
+		_, err = api.ListObjects(baseParams, m.bck, msg, api.ListArgs{})
+		tassert.Errorf(t, err != nil, "expected error listing with min > max")
+	})
+}
+
+func TestExportListing(t *testing.T) {
+	var (
+		baseParams = tools.BaseAPIParams()
+		m          = ioContext{
+			t:             t,
+			num:           234,
+			fileSizeRange: [2]uint64{cos.KiB, 8 * cos.KiB},
+		}
+	)
+	m.init(true /*cleanup*/)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+
+	lst, err := api.ListObjects(baseParams, m.bck, &apc.LsoMsg{Props: apc.GetPropsSize}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	sizes := make(map[string]int64, len(lst.Entries))
+	for _, en := range lst.Entries {
+		sizes[en.Name] = en.Size
+	}
+
+	t.Run(api.ExportCSV, func(t *testing.T) {
+		var (
+			sb  strings.Builder
+			msg = &apc.LsoMsg{PageSize: 50, Props: apc.GetPropsSize + apc.LsPropsSepa + apc.GetPropsChecksum}
+		)
+		n, err := api.ExportListing(baseParams, m.bck, msg, &sb, api.ExportCSV)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, n == int64(m.num), "expected %d exported entries, got %d", m.num, n)
+
+		records, err := csv.NewReader(strings.NewReader(sb.String())).ReadAll()
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(records) == m.num+1, "expected %d rows, got %d", m.num+1, len(records))
+		header := strings.Join(records[0], ",")
+		tassert.Fatalf(t, header == "name,size,checksum", "unexpected header %q", header)
+		for _, rec := range records[1:] {
+			size, err := strconv.ParseInt(rec[1], 10, 64)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, size == sizes[rec[0]], "%s: expected size %d, got %d", rec[0], sizes[rec[0]], size)
+			tassert.Errorf(t, rec[2] != "", "%s: missing checksum", rec[0])
+		}
+	})
+
+	t.Run(api.ExportJSONL, func(t *testing.T) {
+		var (
+			sb  strings.Builder
+			msg = &apc.LsoMsg{PageSize: 50, Props: apc.GetPropsSize}
+		)
+		n, err := api.ExportListing(baseParams, m.bck, msg, &sb, api.ExportJSONL)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, n == int64(m.num), "expected %d exported entries, got %d", m.num, n)
+
+		lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+		tassert.Fatalf(t, len(lines) == m.num, "expected %d lines, got %d", m.num, len(lines))
+		for _, line := range lines {
+			var en cmn.LsoEnt
+			tassert.CheckFatal(t, json.Unmarshal([]byte(line), &en))
+			tassert.Errorf(t, en.Size == sizes[en.Name], "%s: expected size %d, got %d", en.Name, sizes[en.Name], en.Size)
+			tassert.Errorf(t, en.Checksum == "", "%s: unexpected checksum (not selected)", en.Name)
+		}
+	})
+
+	_, err = api.ExportListing(baseParams, m.bck, nil, io.Discard, "xml")
+	tassert.Errorf(t, err != nil, "expected error exporting in unsupported format")
+}
+
+func TestBucketMetaSnapshot(t *testing.T) {
+	var (
+		sb         strings.Builder
+		baseParams = tools.BaseAPIParams()
+		m          = ioContext{
+			t:             t,
+			num:           100,
+			prefix:        "snap/",
+			fileSizeRange: [2]uint64{cos.KiB, 4 * cos.KiB},
+		}
+		// non-standard keys get serialized in arbitrary order
+		custom = cos.StrKVs{"k1": "v1", "k2": "v2", "k3": "v3", "k4": "v4"}
+	)
+	m.init(true /*cleanup*/)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+	for _, nm := range m.objNames[:10] {
+		tassert.CheckFatal(t, api.SetObjectCustomProps(baseParams, m.bck, nm, custom, false /*set new*/))
+	}
+
+	// out of scope
+	outside := "other/obj"
+	reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: cos.KiB, CksumType: cos.ChecksumNone})
+	_, err := api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: m.bck, ObjName: outside, Reader: reader})
+	tassert.CheckFatal(t, err)
+
+	tassert.CheckFatal(t, api.ExportBucketMeta(baseParams, m.bck, m.prefix, &sb))
+	snap := sb.String()
+	tassert.Errorf(t, !strings.Contains(snap, outside), "prefix-scoped snapshot contains %q", outside)
+
+	for range 3 {
+		rep, err := api.VerifyBucketMeta(baseParams, m.bck, m.prefix, strings.NewReader(snap))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, rep.Matched == int64(m.num), "expected %d matched, got %d", m.num, rep.Matched)
+		tassert.Errorf(t, len(rep.Missing)+len(rep.Added)+len(rep.Changed) == 0, "expected no differences, got %+v", rep)
+	}
+
+	// entire bucket vs prefix-scoped snapshot: the out-of-scope object is "added"
+	rep, err := api.VerifyBucketMeta(baseParams, m.bck, "", strings.NewReader(snap))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(rep.Added) == 1 && rep.Added[0] == outside, "expected added [%s], got %v", outside, rep.Added)
+
+	// delete one, overwrite another, add a new one, change custom metadata of yet another
+	var (
+		deleted     = m.objNames[0]
+		overwritten = m.objNames[1]
+		customized  = m.objNames[2]
+		added       = m.objNames[0] + ".added"
+	)
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, m.bck, deleted))
+	for _, nm := range []string{overwritten, added} {
+		reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: 5 * cos.KiB, CksumType: cos.ChecksumNone})
+		_, err := api.PutObject(&api.PutArgs{
+			BaseParams: baseParams,
+			Bck:        m.bck,
+			ObjName:    nm,
+			Reader:     reader,
+		})
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, api.SetObjectCustomProps(baseParams, m.bck, customized, cos.StrKVs{"k1": "changed"}, false))
+
+	rep, err = api.VerifyBucketMeta(baseParams, m.bck, m.prefix, strings.NewReader(snap))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, rep.Matched == int64(m.num-3), "expected %d matched, got %d", m.num-3, rep.Matched)
+	tassert.Errorf(t, len(rep.Missing) == 1 && rep.Missing[0] == deleted, "expected missing [%s], got %v", deleted, rep.Missing)
+	tassert.Errorf(t, len(rep.Added) == 1 && rep.Added[0] == added, "expected added [%s], got %v", added, rep.Added)
+	tassert.Errorf(t, len(rep.Changed) == 2, "expected changed [%s %s], got %v", overwritten, customized, rep.Changed)
+
+	_, err = api.VerifyBucketMeta(baseParams, m.bck, "", strings.NewReader("{not json"))
+	tassert.Errorf(t, err != nil, "expected error verifying against invalid snapshot")
+}
+
 func TestLsoProps(t *testing.T) {
 	runProviderTests(t, func(t *testing.T, bck *meta.Bck) {
 		var (
@@ -5,6 +5,7 @@
 package integration_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"path/filepath"
@@ -21,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/tools/readers"
 	"github.com/NVIDIA/aistore/tools/tassert"
 	"github.com/NVIDIA/aistore/tools/tlog"
+	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/NVIDIA/aistore/xact"
 )
 
@@ -53,6 +55,80 @@ func TestMaintenanceOnOff(t *testing.T) {
 	tassert.Fatalf(t, err != nil, "Canceling maintenance must fail for 'normal' daemon")
 }
 
+func TestClusterReadOnly(t *testing.T) {
+	var (
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+		bck      = cmn.Bck{Name: "ro-" + trand.String(6), Provider: apc.AIS}
+		objName  = "obj"
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	r, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: cos.KiB, CksumType: cos.ChecksumNone})
+	_, err := api.PutObject(&api.PutArgs{BaseParams: bp, Bck: bck, ObjName: objName, Reader: r, Size: cos.KiB})
+	tassert.CheckFatal(t, err)
+
+	tassert.CheckFatal(t, api.SetClusterReadOnly(bp, true))
+	t.Cleanup(func() {
+		api.SetClusterReadOnly(bp, false)
+	})
+	smap := tools.GetClusterMap(t, proxyURL)
+	tassert.Fatalf(t, smap.ReadOnly, "expecting read-only cluster map %s", smap)
+
+	// reads work
+	_, err = api.GetObject(bp, bck, objName, nil)
+	tassert.CheckError(t, err)
+	_, err = api.ListObjects(bp, bck, nil, api.ListArgs{})
+	tassert.CheckError(t, err)
+
+	// writes don't
+	r, _ = readers.New(&readers.Arg{Type: readers.Rand, Size: cos.KiB, CksumType: cos.ChecksumNone})
+	_, err = api.PutObject(&api.PutArgs{BaseParams: bp, Bck: bck, ObjName: objName + "2", Reader: r, Size: cos.KiB})
+	tassert.Errorf(t, err != nil, "PUT must fail when cluster is read-only")
+	err = api.DeleteObject(bp, bck, objName)
+	tassert.Errorf(t, err != nil, "DELETE must fail when cluster is read-only")
+	err = api.DestroyBucket(bp, bck)
+	tassert.Errorf(t, err != nil, "destroy-bucket must fail when cluster is read-only")
+
+	tassert.CheckFatal(t, api.SetClusterReadOnly(bp, false))
+	err = api.DeleteObject(bp, bck, objName)
+	tassert.CheckError(t, err)
+}
+
+func TestMaintenanceWatchClusterMap(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{MinTargets: 3})
+	var (
+		proxyURL    = tools.RandomProxyURL(t)
+		bp          = tools.BaseAPIParams(proxyURL)
+		smap        = tools.GetClusterMap(t, proxyURL)
+		ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+	)
+	defer cancel()
+
+	ch, err := api.WatchClusterMap(ctx, bp)
+	tassert.CheckFatal(t, err)
+	first := <-ch
+	tassert.Fatalf(t, first.Version >= smap.Version, "expected current Smap, got %s (vs %s)", first, smap)
+
+	mntTarget, _ := smap.GetRandTarget()
+	msg := &apc.ActValRmNode{DaemonID: mntTarget.ID(), SkipRebalance: true}
+	_, err = tools.StartMaintenance(bp, msg)
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() {
+		api.StopMaintenance(bp, msg)
+		tools.WaitForClusterState(proxyURL, "target is back", smap.Version, smap.CountActivePs(), smap.CountTargets())
+	})
+
+	for next := range ch {
+		tassert.Fatalf(t, next.Version > first.Version, "expected newer Smap, got %s (vs %s)", next, first)
+		if next.InMaintOrDecomm(mntTarget.ID()) {
+			tlog.Logfln("%s: %s in maintenance", next, mntTarget.StringEx())
+			return
+		}
+		first = next
+	}
+	t.Fatalf("timed out waiting for %s to be in maintenance", mntTarget.StringEx())
+}
+
 func TestMaintenanceListObjects(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true, MinTargets: 3})
 
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -306,6 +307,103 @@ func TestAppendObject(t *testing.T) {
 	}
 }
 
+func TestSparseWriteObject(t *testing.T) {
+	const (
+		numRanges = 8
+		rangeSize = 4 * cos.KiB
+	)
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "sparse/obj"
+		content    = []byte(trand.String(numRanges * rangeSize))
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	err := api.CreateSizedObject(baseParams, bck, objName, int64(len(content)))
+	tassert.CheckFatal(t, err)
+
+	// all ranges but the first, in reverse order and concurrently
+	wg := &errgroup.Group{}
+	for i := numRanges - 1; i > 0; i-- {
+		off := i * rangeSize
+		wg.Go(func() error {
+			r := cos.NewByteReader(content[off : off+rangeSize])
+			return api.WriteObjectRange(baseParams, bck, objName, int64(off), rangeSize, r)
+		})
+	}
+	tassert.CheckFatal(t, wg.Wait())
+
+	err = api.FinalizeObject(baseParams, bck, objName)
+	tassert.Fatalf(t, err != nil, "expected finalize to fail with the first range missing")
+	tlog.Logfln("finalize (expected) failure: %v", err)
+
+	err = api.WriteObjectRange(baseParams, bck, objName, 0, rangeSize, cos.NewByteReader(content[:rangeSize]))
+	tassert.CheckFatal(t, err)
+	err = api.FinalizeObject(baseParams, bck, objName)
+	tassert.CheckFatal(t, err)
+
+	writer := bytes.NewBuffer(nil)
+	_, err = api.GetObjectWithValidation(baseParams, bck, objName, &api.GetArgs{Writer: writer})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(writer.Bytes(), content), "invalid object content (size %d, expected %d)",
+		writer.Len(), len(content))
+}
+
+func TestPutGeneratedObject(t *testing.T) {
+	const size = cos.MiB + 123
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		pattern    = []byte("0123456789abcdef!")
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	tests := []struct {
+		args  api.GenArgs
+		valid func([]byte) bool
+	}{
+		{
+			args:  api.GenArgs{Size: size},
+			valid: func(b []byte) bool { return bytes.Count(b, []byte{0}) == len(b) },
+		},
+		{
+			args: api.GenArgs{Size: size, Pattern: apc.GenRepeat, Bytes: pattern},
+			valid: func(b []byte) bool {
+				return bytes.Equal(b, bytes.Repeat(pattern, size/len(pattern)+1)[:size])
+			},
+		},
+		{
+			args:  api.GenArgs{Size: size, Pattern: apc.GenRandom},
+			valid: func(b []byte) bool { return bytes.Count(b, []byte{0}) < len(b)/128 },
+		},
+		{
+			args:  api.GenArgs{Size: 0, Pattern: apc.GenRandom},
+			valid: func([]byte) bool { return true },
+		},
+	}
+	for _, test := range tests {
+		objName := "gen/" + cos.Left(test.args.Pattern, apc.GenZeros) + "-" + strconv.FormatInt(test.args.Size, 10)
+		t.Run(objName, func(t *testing.T) {
+			_, err := api.PutGeneratedObject(baseParams, bck, objName, &test.args)
+			tassert.CheckFatal(t, err)
+
+			writer := bytes.NewBuffer(nil)
+			_, err = api.GetObjectWithValidation(baseParams, bck, objName, &api.GetArgs{Writer: writer})
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, writer.Len() == int(test.args.Size), "expected size %d, got %d", test.args.Size, writer.Len())
+			tassert.Errorf(t, test.valid(writer.Bytes()), "invalid %q content", objName)
+		})
+	}
+
+	_, err := api.PutGeneratedObject(baseParams, bck, "gen/invalid", &api.GenArgs{Size: 1, Pattern: apc.GenRepeat})
+	tassert.Errorf(t, err != nil, "expected error: repeating pattern without bytes")
+	_, err = api.PutGeneratedObject(baseParams, bck, "gen/invalid", &api.GenArgs{Size: 1, Pattern: "fibonacci"})
+	tassert.Errorf(t, err != nil, "expected error: unknown pattern")
+}
+
 func TestCopyObject(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
@@ -378,6 +476,155 @@ func TestCopyObject(t *testing.T) {
 	}
 }
 
+func TestProbeObjects(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: "probe-" + trand.String(5), Provider: apc.AIS}
+		data       = []byte("this is test data for probing objects")
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	oah, err := api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: "a/b", Reader: readers.NewBytes(data)})
+	tassert.CheckFatal(t, err)
+	attrs := oah.Attrs()
+	ty, val := attrs.Checksum().Get()
+
+	probes := []apc.ObjProbe{
+		{Name: "a/b", Size: int64(len(data)), CksumType: ty, CksumValue: val},
+		{Name: "a/b", Size: int64(len(data))},
+		{Name: "a/b", Size: int64(len(data)) + 1},
+		{Name: "a/b", Size: int64(len(data)), CksumType: ty, CksumValue: strings.Repeat("0", len(val))},
+		{Name: "nonexistent", Size: 1},
+	}
+	expected := []string{apc.ProbeMatch, apc.ProbeMatch, apc.ProbeDiffers, apc.ProbeDiffers, apc.ProbeNotFound}
+
+	out, err := api.ProbeObjects(baseParams, bck, probes)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(out) == len(expected), "expected %d results, got %v", len(expected), out)
+	for i := range expected {
+		tassert.Errorf(t, out[i] == expected[i], "probe #%d: expected %q, got %q", i, expected[i], out[i])
+	}
+}
+
+func TestObjectAlias(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: "alias-" + trand.String(5), Provider: apc.AIS}
+		v1, v2     = []byte("dataset version one"), []byte("dataset version two, a bit longer")
+		alias      = "dataset/latest"
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	for objName, data := range map[string][]byte{"dataset/v1": v1, "dataset/v2": v2} {
+		_, err := api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objName, Reader: readers.NewBytes(data)})
+		tassert.CheckFatal(t, err)
+	}
+
+	getAlias := func(expected []byte) {
+		var w bytes.Buffer
+		_, err := api.GetObject(baseParams, bck, alias, &api.GetArgs{Writer: &w})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(w.Bytes(), expected), "GET %s: expected %q, got %q", alias, expected, w.String())
+
+		op, err := api.HeadObject(baseParams, bck, alias, api.HeadArgs{})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, op.Size == int64(len(expected)), "HEAD %s: expected size %d, got %d", alias, len(expected), op.Size)
+	}
+
+	tassert.CheckFatal(t, api.CreateAlias(baseParams, bck, alias, "dataset/v1"))
+	getAlias(v1)
+
+	// re-point
+	tassert.CheckFatal(t, api.CreateAlias(baseParams, bck, alias, "dataset/v2"))
+	getAlias(v2)
+
+	// list
+	lst, err := api.ListObjects(baseParams, bck, &apc.LsoMsg{Props: apc.GetPropsName}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == 3, "expected 3 entries, got %d", len(lst.Entries))
+	for _, en := range lst.Entries {
+		isAlias := en.IsAnyFlagSet(apc.EntryIsAlias)
+		tassert.Errorf(t, isAlias == (en.Name == alias), "%s: unexpected alias flag %t", en.Name, isAlias)
+		if isAlias {
+			tassert.Errorf(t, strings.Contains(en.Custom, "dataset/v2"), "%s: expected aliased object in %q", en.Name, en.Custom)
+		}
+	}
+
+	// invalid
+	tassert.Errorf(t, api.CreateAlias(baseParams, bck, "dataset/v1", "dataset/v2") != nil, "expected failure to overwrite object with alias")
+	tassert.Errorf(t, api.CreateAlias(baseParams, bck, "dataset/other", alias) != nil, "expected failure to alias an alias")
+	tassert.Errorf(t, api.CreateAlias(baseParams, bck, "dataset/other", "nonexistent") != nil, "expected failure to alias nonexistent object")
+
+	// protected
+	_, err = api.SetBucketProps(baseParams, bck, &cmn.BpropsToSet{Features: apc.Ptr(feat.ProtectAliasedObjects)})
+	tassert.CheckFatal(t, err)
+	err = api.DeleteObject(baseParams, bck, "dataset/v2")
+	herr := cmn.AsErrHTTP(err)
+	tassert.Fatalf(t, herr != nil && herr.Status == http.StatusConflict, "expected deletion to fail with 409, got %v", err)
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, "dataset/v1")) // (stale back-reference)
+
+	// broken
+	_, err = api.SetBucketProps(baseParams, bck, &cmn.BpropsToSet{Features: apc.Ptr(feat.Flags(0))})
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, "dataset/v2"))
+	_, err = api.GetObject(baseParams, bck, alias, nil)
+	tassert.Fatalf(t, cmn.IsStatusNotFound(err), "expected 404, got %v", err)
+	tassert.Errorf(t, strings.Contains(err.Error(), "dataset/v2"), "expected error to name the aliased object, got %v", err)
+}
+
+// soft delete and restore; trashed objects survive space cleanup within space.trash_window
+// (for window expiry, see space/cleanup_test.go)
+func TestObjectSoftDelete(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: "trash-" + trand.String(5), Provider: apc.AIS}
+		objName    = "soft/deleted.bin"
+		data       = []byte("soft-deleted, to be restored")
+		soft       = &api.DeleteObjArgs{Soft: true}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	_, err := api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objName, Reader: readers.NewBytes(data)})
+	tassert.CheckFatal(t, err)
+
+	checkRestored := func() {
+		var w bytes.Buffer
+		_, err := api.GetObject(baseParams, bck, objName, &api.GetArgs{Writer: &w})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(w.Bytes(), data), "GET %s: expected %q, got %q", objName, data, w.String())
+	}
+
+	// soft delete: gone from HEAD and list
+	tassert.CheckFatal(t, api.DeleteObjectWithArgs(baseParams, bck, objName, soft))
+	_, err = api.HeadObject(baseParams, bck, objName, api.HeadArgs{})
+	tassert.Fatalf(t, cmn.IsStatusNotFound(err), "expected 404 after soft delete, got %v", err)
+	lst, err := api.ListObjects(baseParams, bck, nil, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == 0, "expected no listed objects after soft delete, got %d", len(lst.Entries))
+
+	// restore
+	tassert.CheckFatal(t, api.RestoreObject(baseParams, bck, objName))
+	checkRestored()
+	tassert.Errorf(t, api.RestoreObject(baseParams, bck, objName) != nil, "expected second restore to fail")
+
+	// soft delete again, run space cleanup, and restore (still within the window)
+	tassert.CheckFatal(t, api.DeleteObjectWithArgs(baseParams, bck, objName, soft))
+	xargs := xact.ArgsMsg{Kind: apc.ActStoreCleanup, Bck: bck}
+	xid, err := api.StartXaction(baseParams, &xargs, "")
+	tassert.CheckFatal(t, err)
+	xargs.ID, xargs.Timeout = xid, tools.RebalanceTimeout
+	_, err = api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+
+	tassert.CheckFatal(t, api.RestoreObject(baseParams, bck, objName))
+	checkRestored()
+
+	// hard delete: nothing to restore
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, objName))
+	err = api.RestoreObject(baseParams, bck, objName)
+	tassert.Errorf(t, cmn.IsStatusNotFound(err), "expected 404 restoring hard-deleted object, got %v", err)
+}
+
 func TestSameBucketName(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
@@ -2427,6 +2674,74 @@ func TestMultipartUploadAbort(t *testing.T) {
 	tlog.Logfln("multipart upload abort test completed successfully")
 }
 
+func TestMultipartListStuckUploads(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{
+			Name:     trand.String(10),
+			Provider: apc.AIS,
+		}
+		partData = []byte("stuck upload: part data")
+		numObjs  = 5
+		ids      = make(map[string]string, numObjs) // upload ID => object name
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	for i := range numObjs {
+		objName := fmt.Sprintf("stuck-%d", i)
+		uploadID, err := api.CreateMultipartUpload(baseParams, bck, objName)
+		tassert.CheckFatal(t, err)
+		err = api.UploadPart(&api.PutPartArgs{
+			PutArgs: api.PutArgs{
+				BaseParams: baseParams,
+				Bck:        bck,
+				ObjName:    objName,
+				Reader:     readers.NewBytes(partData),
+				Size:       uint64(len(partData)),
+			},
+			UploadID:   uploadID,
+			PartNumber: 1,
+		})
+		tassert.CheckFatal(t, err)
+		ids[uploadID] = objName
+	}
+
+	// too young
+	stuck, err := api.ListStuckUploads(baseParams, cmn.QueryBcks(bck), time.Hour)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(stuck) == 0, "expected no uploads older than 1h, got %d", len(stuck))
+
+	stuck, err = api.ListStuckUploads(baseParams, cmn.QueryBcks(bck), 0)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(stuck) == numObjs, "expected %d uploads, got %d", numObjs, len(stuck))
+	for _, u := range stuck {
+		tassert.Errorf(t, ids[u.UploadID] == u.ObjName, "unexpected upload %s[%s]", u.ObjName, u.UploadID)
+		tassert.Errorf(t, u.Bck.Equal(&bck), "expected bucket %s, got %s", bck.String(), u.Bck.String())
+		tassert.Errorf(t, u.Size == int64(len(partData)) && u.NumParts == 1, "%s: unexpected size %d or number of parts %d",
+			u.ObjName, u.Size, u.NumParts)
+		tassert.Errorf(t, u.TargetID != "", "%s: missing target ID", u.ObjName)
+	}
+
+	// all ais:// buckets
+	all, err := api.ListStuckUploads(baseParams, cmn.QueryBcks{Provider: apc.AIS}, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(all) >= numObjs, "expected at least %d uploads, got %d", numObjs, len(all))
+
+	err = api.AbortUploads(baseParams, stuck)
+	tassert.CheckFatal(t, err)
+
+	stuck, err = api.ListStuckUploads(baseParams, cmn.QueryBcks(bck), 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(stuck) == 0, "expected no uploads after abort, got %d", len(stuck))
+
+	// (no longer exist)
+	for uploadID, objName := range ids {
+		stuck = append(stuck, api.StuckUpload{Bck: bck, ObjName: objName, UploadID: uploadID})
+	}
+	tassert.CheckError(t, api.AbortUploads(baseParams, stuck))
+}
+
 func TestMultipartUploadAndCopyBucket(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
@@ -281,6 +281,15 @@ func TestObjHeadV2Selective(t *testing.T) {
 		tassert.Fatalf(t, opV2.Cksum.Val() == r.Cksum().Val(), "checksum value should be equal")
 	})
 
+	t.Run("SetGetCustom", func(t *testing.T) {
+		err := api.SetObjectCustom(baseParams, bck, objName, cos.StrKVs{"label": "cat"})
+		tassert.CheckFatal(t, err)
+		custom, err := api.GetObjectCustom(baseParams, bck, objName)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, custom["label"] == "cat", "expected label=cat, got %v", custom)
+		tassert.Errorf(t, custom["user-key"] == "user-value", "expected previously set user-key to remain, got %v", custom)
+	})
+
 	t.Run("LastModifiedUpdates", func(t *testing.T) {
 		// Create a new object for this test
 		testObjName := "test-last-modified-" + cos.GenUUID()
@@ -446,6 +455,26 @@ func TestObjHeadV2Selective(t *testing.T) {
 			"chunk count mismatch: expected %d, got %d", expectedChunkCount, opV2.Chunks.ChunkCount)
 		tassert.Fatalf(t, opV2.Chunks.MaxChunkSize > 0 && opV2.Chunks.MaxChunkSize <= chunkSize,
 			"max chunk size should be > 0 and <= %d, got %d", chunkSize, opV2.Chunks.MaxChunkSize)
+
+		// Validate chunk manifest
+		manifest, err := api.GetObjectManifest(baseParams, bck, chunkedObjName)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, manifest.Chunked && manifest.UploadID != "", "expecting chunked object with upload ID")
+		tassert.Fatalf(t, len(manifest.Chunks) == expectedChunkCount,
+			"manifest chunk count mismatch: expected %d, got %d", expectedChunkCount, len(manifest.Chunks))
+		var offset int64
+		for i, c := range manifest.Chunks {
+			tassert.Fatalf(t, c.Num == i+1 && c.Offset == offset, "chunk %d: unexpected num %d or offset %d", i+1, c.Num, c.Offset)
+			offset += c.Size
+		}
+		tassert.Fatalf(t, offset == largeObjSize, "sum of chunk sizes %d != %d", offset, largeObjSize)
+	})
+
+	t.Run("ManifestMonolithic", func(t *testing.T) {
+		manifest, err := api.GetObjectManifest(baseParams, bck, objName)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, !manifest.Chunked && len(manifest.Chunks) == 1, "expecting a single synthetic chunk")
+		tassert.Fatalf(t, manifest.Chunks[0].Size == manifest.Size, "size mismatch")
 	})
 
 	t.Run("V1vsV2Consistency", func(t *testing.T) {
@@ -28,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/tools/readers"
 	"github.com/NVIDIA/aistore/tools/tassert"
 	"github.com/NVIDIA/aistore/tools/tlog"
+	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/NVIDIA/aistore/xact"
 )
 
@@ -456,6 +457,14 @@ func TestGetClusterStats(t *testing.T) {
 				tlog.Logfln("%-30s %+v %+v", s, tcdf.Disks, tcdf.Capacity)
 			}
 		}
+
+		// typed per-disk stats
+		dstats, err := api.GetDiskStats(bp, tsi)
+		tassert.CheckFatal(t, err)
+		for disk, ds := range dstats {
+			tassert.Errorf(t, ds.Util >= 0 && ds.Util <= 100, "%s: %s util out of range: %d", tname, disk, ds.Util)
+			tassert.Errorf(t, ds.RIOPS >= 0 && ds.WIOPS >= 0 && ds.AvgQueue >= 0, "%s: %s invalid stats %+v", tname, disk, ds)
+		}
 	}
 }
 
@@ -636,6 +645,113 @@ func TestPrefetchList(t *testing.T) {
 	}
 }
 
+func TestSyncRemoteBucket(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			bck:      cliBck,
+			num:      100,
+			fileSize: cos.KiB,
+			prefix:   "sync-remote/obj-",
+		}
+		bck      = cliBck
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+	)
+
+	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true, RemoteBck: true, Bck: bck})
+
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(2)
+	m.puts()
+
+	// 1. evict half of the objects
+	evicted := m.objNames[:m.num/2]
+	xid, err := api.EvictMultiObj(bp, bck, &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: evicted}})
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActEvictObjects, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(bp, &args)
+	tassert.CheckFatal(t, err)
+
+	// 2. sync: expecting to prefetch (only) the evicted ones
+	tlog.Logfln("Synchronizing %s (prefix %q)", bck.Cname(""), m.prefix)
+	xid, err = api.SyncRemoteBucket(bp, bck, &apc.SyncRemoteMsg{Prefix: m.prefix, PrefetchNew: true, EvictDeleted: true})
+	tassert.CheckFatal(t, err)
+	args = xact.ArgsMsg{ID: xid, Kind: apc.ActSyncRemote, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(bp, &args)
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(bp, &xact.ArgsMsg{ID: xid})
+	tassert.CheckFatal(t, err)
+	locObjs, _, _ := snaps.ObjCounts(xid)
+	tassert.Errorf(t, locObjs == int64(len(evicted)), "expected %d prefetched, got %d", len(evicted), locObjs)
+
+	// 3. everything is in-cluster again
+	msg := &apc.LsoMsg{Prefix: m.prefix}
+	msg.SetFlag(apc.LsCached)
+	lst, err := api.ListObjects(bp, bck, msg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == m.num, "list-objects %s: expected %d cached, got %d", bck.String(), m.num, len(lst.Entries))
+}
+
+func TestRefreshBackendMeta(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			bck:      cliBck,
+			num:      100,
+			fileSize: cos.KiB,
+			prefix:   "refresh-meta/" + trand.String(6) + "-",
+		}
+		bck      = cliBck
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+		numEvict = m.num / 5
+		numDel   int
+	)
+
+	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true, RemoteBck: true, Bck: bck})
+
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(1)
+	m.puts()
+
+	// 1. evict some (=> New)
+	xid, err := api.EvictMultiObj(bp, bck, &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: m.objNames[:numEvict]}})
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActEvictObjects, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(bp, &args)
+	tassert.CheckFatal(t, err)
+
+	// 2. when available, use remote cluster to out-of-band delete some others (=> Removed)
+	if tools.RemoteCluster.UUID != "" {
+		numDel = m.num / 10
+		remoteBP := tools.BaseAPIParams(tools.RemoteCluster.URL)
+		for _, name := range m.objNames[numEvict : numEvict+numDel] {
+			tassert.CheckFatal(t, api.DeleteObject(remoteBP, bck, name))
+		}
+	}
+
+	// 3. refresh and check the counts
+	diff, err := api.RefreshBackendMeta(bp, bck, m.prefix, tools.RebalanceTimeout)
+	tassert.CheckFatal(t, err)
+	tlog.Logfln("%s: %+v", bck.Cname(m.prefix), *diff)
+
+	tassert.Errorf(t, diff.Remote == int64(m.num-numDel), "expected %d remote, got %d", m.num-numDel, diff.Remote)
+	tassert.Errorf(t, diff.New == int64(numEvict), "expected %d new, got %d", numEvict, diff.New)
+	tassert.Errorf(t, diff.Removed == int64(numDel), "expected %d removed, got %d", numDel, diff.Removed)
+	tassert.Errorf(t, diff.Changed == 0, "expected no changed, got %d", diff.Changed)
+	tassert.Errorf(t, diff.InSync == int64(m.num-numEvict-numDel), "expected %d in-sync, got %d", m.num-numEvict-numDel, diff.InSync)
+
+	// 4. removed ones are evicted
+	msg := &apc.LsoMsg{Prefix: m.prefix}
+	msg.SetFlag(apc.LsCached)
+	lst, err := api.ListObjects(bp, bck, msg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == m.num-numEvict-numDel, "list-objects %s: expected %d cached, got %d",
+		bck.String(), m.num-numEvict-numDel, len(lst.Entries))
+}
+
 func TestDeleteList(t *testing.T) {
 	runProviderTests(t, func(t *testing.T, bck *meta.Bck) {
 		var (
@@ -0,0 +1,431 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// object aliases (apc.ActCreateAlias):
+// - alias is a zero-size object with custom metadata (cmn.AliasObjMD) that names another
+//   object in the same bucket; ais:// buckets only (no remote backend, no erasure coding)
+// - the aliased object keeps the names of its aliases (cmn.AliasedByObjMD); these back-references
+//   may go stale (alias deleted, renamed, or re-pointed) and are therefore validated when used
+// - GET and HEAD follow the alias: locally when this target is the aliased object's HRW,
+//   via intra-cluster request otherwise; aliases of aliases are not permitted
+// - deleting (or soft-deleting) the aliased object breaks its aliases (404) unless feat.ProtectAliasedObjects
+// - apc.QparamAliasOf marks the request as following an alias; honored on intra-cluster requests only
+
+const maxAliasRefsSize = cos.KiB // (JSON-encoded cmn.AliasedByObjMD)
+
+// GET: goi.get => t.getObject to follow the alias
+type errAlias struct {
+	alias   string
+	objName string
+}
+
+func (e *errAlias) Error() string { return "alias " + e.alias + " => " + e.objName }
+
+func (t *target) errAliasBroken(aliasName string, lom *core.LOM) error {
+	return cos.NewErrNotFoundFmt(t, "alias %s is broken: aliased object %s not found", lom.Bck().Cname(aliasName), lom.Cname())
+}
+
+// is called by the HRW target of the object being aliased:
+// 1. validate the object and resolve its back-references (may entail intra-cluster HEADs - not holding the lock)
+// 2. add the back-reference (under the object's write lock)
+// 3. have the alias's HRW target check the name and write the alias (see writeAlias)
+func (t *target) createAlias(lom *core.LOM, aliasName string) (int, error) {
+	if lom.Bck().IsRemote() || lom.ECEnabled() {
+		return 0, fmt.Errorf("%s: cannot alias %s: not supported for remote and erasure-coded buckets", t.si, lom.Cname())
+	}
+	alom := core.AllocLOM(aliasName)
+	defer core.FreeLOM(alom)
+	if err := alom.InitBck(lom.Bck()); err != nil {
+		return 0, err
+	}
+	smap := t.owner.smap.get()
+	tsi, local, err := alom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return 0, err
+	}
+
+	// 1.
+	if ecode, err := t._aliasable(lom, aliasName, false /*locked*/); err != nil {
+		return ecode, err
+	}
+	var (
+		refs  = aliasRefs(lom)
+		stale []string
+	)
+	if !slices.Contains(refs, aliasName) && len(cos.MustMarshal(append(refs, aliasName))) > maxAliasRefsSize {
+		live := t.liveAliases(lom, slices.Clone(refs), smap)
+		for _, name := range refs {
+			if !slices.Contains(live, name) {
+				stale = append(stale, name)
+			}
+		}
+	}
+
+	// 2.
+	lom.Lock(true)
+	ecode, err := t._aliasable(lom, aliasName, true /*locked*/)
+	if err == nil {
+		ecode, err = _addAliasRef(lom, aliasName, stale)
+	}
+	lom.Unlock(true)
+	if err != nil {
+		return ecode, err
+	}
+
+	// 3. (failing here leaves behind a stale back-reference - see above)
+	if local {
+		return t.writeAlias(alom, lom.ObjName)
+	}
+	return t.writeAliasAt(alom, lom.ObjName, tsi, smap)
+}
+
+func (t *target) _aliasable(lom *core.LOM, aliasName string, locked bool) (int, error) {
+	if err := lom.Load(false /*cache it*/, locked); err != nil {
+		if cos.IsNotExist(err) {
+			return http.StatusNotFound, cos.NewErrNotFoundFmt(t, "cannot create alias %s: %s not found",
+				lom.Bck().Cname(aliasName), lom.Cname())
+		}
+		return 0, err
+	}
+	if lom.IsAlias() {
+		return http.StatusBadRequest, fmt.Errorf("cannot create alias %s: %s is itself an alias",
+			lom.Bck().Cname(aliasName), lom.Cname())
+	}
+	return 0, nil
+}
+
+// (under write lock)
+func _addAliasRef(lom *core.LOM, aliasName string, stale []string) (int, error) {
+	refs := aliasRefs(lom)
+	if slices.Contains(refs, aliasName) {
+		return 0, nil
+	}
+	if len(stale) > 0 {
+		refs = slices.DeleteFunc(refs, func(name string) bool { return slices.Contains(stale, name) })
+	}
+	b := cos.MustMarshal(append(refs, aliasName))
+	if len(b) > maxAliasRefsSize {
+		return http.StatusConflict, fmt.Errorf("cannot create alias %s: %s has too many aliases (%d)",
+			lom.Bck().Cname(aliasName), lom.Cname(), len(refs))
+	}
+	lom.SetCustomKey(cmn.AliasedByObjMD, string(b))
+	return 0, lom.Persist()
+}
+
+// is called by the alias's HRW target: check the name and write (or re-point) the alias -
+// zero-size object carrying cmn.AliasObjMD - under the same write lock, so that a concurrent
+// PUT of a regular object by the same name is never overwritten
+func (t *target) writeAlias(alom *core.LOM, objName string) (int, error) {
+	alom.Lock(true)
+	defer alom.Unlock(true)
+
+	switch err := alom.Load(false /*cache it*/, true /*locked*/); {
+	case err == nil:
+		// the name must belong to an existing alias (to re-point)
+		if !alom.IsAlias() {
+			return http.StatusConflict, fmt.Errorf("cannot create alias: %s already exists and is not an alias", alom.Cname())
+		}
+	case !cmn.IsErrObjNought(err):
+		return 0, err
+	}
+	alom.SetCustomMD(cos.StrKVs{cmn.AliasObjMD: objName})
+	alom.SetSize(0)
+	params := &core.PutParams{
+		Reader:  cos.NewByteReader(nil),
+		Atime:   time.Now(),
+		WorkTag: fs.WorkfilePut,
+		OWT:     cmn.OwtCopy,
+		Locked:  true,
+	}
+	return 0, t.PutObject(alom, params)
+}
+
+// ask the alias's HRW target to writeAlias (see target.httpobjpost)
+func (t *target) writeAliasAt(alom *core.LOM, objName string, tsi *meta.Snode, smap *smapX) (int, error) {
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Base:   tsi.URL(cmn.NetIntraControl),
+			Path:   apc.URLPathObjects.Join(alom.Bck().Name, alom.ObjName),
+			Query:  alom.Bck().NewQuery(),
+			Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActCreateAlias, Name: objName}),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	ecode, err := res.status, res.err
+	freeCargs(cargs)
+	freeCR(res)
+	return ecode, err
+}
+
+func aliasRefs(lom *core.LOM) (refs []string) {
+	s, ok := lom.GetCustomKey(cmn.AliasedByObjMD)
+	if !ok {
+		return nil
+	}
+	if err := jsoniter.UnmarshalFromString(s, &refs); err != nil {
+		nlog.Warningln(lom.Cname(), "invalid", cmn.AliasedByObjMD, s, "err:", err)
+		return nil
+	}
+	return refs
+}
+
+// filter out stale back-references (errors count as live)
+func (t *target) liveAliases(lom *core.LOM, refs []string, smap *smapX) []string {
+	live := refs[:0]
+	for _, aliasName := range refs {
+		if ok, err := t.refersTo(aliasName, lom, smap); ok || err != nil {
+			live = append(live, aliasName)
+		}
+	}
+	return live
+}
+
+// returns the name of the first (live) alias of the given object, if any (feat.ProtectAliasedObjects)
+func (t *target) aliasedBy(lom *core.LOM) string {
+	refs := aliasRefs(lom)
+	if len(refs) == 0 {
+		return ""
+	}
+	smap := t.owner.smap.get()
+	for _, aliasName := range refs {
+		ok, err := t.refersTo(aliasName, lom, smap)
+		if err != nil {
+			nlog.Warningln(t.String(), "failed to check alias", lom.Bck().Cname(aliasName), "err:", err)
+		}
+		if ok || err != nil {
+			return aliasName
+		}
+	}
+	return ""
+}
+
+// feat.ProtectAliasedObjects: neither (hard) delete nor soft delete (apc.QparamSoftDelete)
+// an object referenced by a live alias
+// - is called prior to taking the object's write lock (checking aliases entails intra-cluster HEADs)
+// - a non-existing object is the caller's to handle
+func (t *target) errAliased(lom *core.LOM) error {
+	if !lom.IsFeatureSet(feat.ProtectAliasedObjects) {
+		return nil
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return nil
+	}
+	if aliasName := t.aliasedBy(lom); aliasName != "" {
+		return fmt.Errorf("%s: cannot delete %s: referenced by alias %q (feature flag %q)",
+			t, lom.Cname(), aliasName, feat.ProtectAliasedObjects.Name())
+	}
+	return nil
+}
+
+// whether the named alias (still) refers to the given object
+func (t *target) refersTo(aliasName string, lom *core.LOM, smap *smapX) (bool, error) {
+	alom := core.AllocLOM(aliasName)
+	defer core.FreeLOM(alom)
+	if err := alom.InitBck(lom.Bck()); err != nil {
+		return false, err
+	}
+	tsi, local, err := alom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return false, err
+	}
+	var (
+		objName string
+		ok      bool
+	)
+	if local {
+		if err := alom.Load(true /*cache it*/, false /*locked*/); err != nil {
+			if cmn.IsErrObjNought(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		objName, ok = alom.AliasOf()
+	} else {
+		op, err := t.headt2t(alom, tsi, smap, []string{apc.GetPropsCustom})
+		if err != nil {
+			if cmn.IsErrObjNought(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		objName, ok = op.GetCustomKey(cmn.AliasObjMD)
+	}
+	return ok && objName == lom.ObjName, nil
+}
+
+//
+// GET and HEAD via alias
+//
+
+// apc.QparamAliasOf is an intra-cluster marker (set by the target following the alias);
+// when received from outside (client, S3, ETL) it is ignored - in particular,
+// it does not bypass read admission nor the following of aliases
+func (dpq *dpq) aliasOf(r *http.Request) string {
+	if dpq.aof != "" {
+		return dpq.aof
+	}
+	if r.Header.Get(apc.HdrSenderID) == "" {
+		return ""
+	}
+	return dpq.get(apc.QparamAliasOf)
+}
+
+func (goi *getOI) alias(objName string) (int, error) {
+	if aof := goi.dpq.aliasOf(goi.req); aof != "" {
+		return http.StatusConflict, fmt.Errorf("alias %s: %s is itself an alias (not following)",
+			goi.lom.Bck().Cname(aof), goi.lom.Cname())
+	}
+	return 0, &errAlias{alias: goi.lom.ObjName, objName: objName}
+}
+
+// (compare w/ t.getObject)
+func (t *target) getAliased(w http.ResponseWriter, r *http.Request, dpq *dpq, bck *meta.Bck, ea *errAlias) (*core.LOM, error) {
+	lom := core.AllocLOM(ea.objName)
+	if err := lom.InitBck(bck); err != nil {
+		return lom, err
+	}
+	smap := t.owner.smap.get()
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return lom, err
+	}
+	dpq.aof = ea.alias
+	if local {
+		return t.getObject(w, r, dpq, bck, lom)
+	}
+	return lom, t.getAliasedFrom(w, r, lom, ea.alias, tsi)
+}
+
+// stream the aliased object from its HRW target
+func (t *target) getAliasedFrom(w http.ResponseWriter, r *http.Request, lom *core.LOM, aliasName string, tsi *meta.Snode) error {
+	query := lom.Bck().NewQuery()
+	query.Set(apc.QparamAliasOf, aliasName)
+	hdr := make(http.Header, 2)
+	if rng := r.Header.Get(cos.HdrRange); rng != "" {
+		hdr.Set(cos.HdrRange, rng)
+	}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Path = apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName)
+		reqArgs.Query = query
+		reqArgs.Header = hdr
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return err
+	}
+	t.setIntraHdrs(req)
+
+	resp, err := g.client.data.Do(req)
+	cmn.HreqFree(req)
+	if err != nil {
+		return cmn.NewErrFailedTo(t, "GET via alias "+aliasName, lom.Cname(), err)
+	}
+	defer resp.Body.Close()
+
+	switch code := resp.StatusCode; {
+	case code == http.StatusNotFound:
+		return t.errAliasBroken(aliasName, lom)
+	case code >= http.StatusBadRequest:
+		msg := fmt.Sprintf("alias %s: failed to GET %s from %s: %s", lom.Bck().Cname(aliasName), lom.Cname(), tsi.StringEx(),
+			http.StatusText(code))
+		return &cmn.ErrHTTP{Message: msg, Status: code}
+	}
+
+	whdr := w.Header()
+	for k, v := range resp.Header {
+		whdr[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		// (ditto cmn.ErrGetTxBenign)
+		nlog.Warningln(t.String(), "GET via alias", lom.Bck().Cname(aliasName), "=>", lom.Cname(), "err:", err)
+	}
+	return nil
+}
+
+// (compare w/ t.objHead and t.objHeadV2)
+func (t *target) headAliased(r *http.Request, whdr http.Header, dpq *dpq, bck *meta.Bck, aliasName, objName string, v2 bool) (int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return 0, err
+	}
+	smap := t.owner.smap.get()
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return 0, err
+	}
+
+	var ecode int
+	if local {
+		dpq.aof = aliasName
+		if v2 {
+			ecode, err = t.objHeadV2(r, whdr, dpq, bck, lom)
+		} else {
+			ecode, err = t.objHead(r, whdr, dpq, bck, lom)
+		}
+	} else {
+		q := bck.NewQuery()
+		q.Set(apc.QparamAliasOf, aliasName)
+		if props := dpq.get(apc.QparamProps); props != "" {
+			q.Set(apc.QparamProps, props)
+		}
+		if dpq.latestVer {
+			q.Set(apc.QparamLatestVer, "true")
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = tsi
+			cargs.req = cmn.HreqArgs{
+				Method: http.MethodHead,
+				Base:   tsi.URL(cmn.NetIntraControl),
+				Path:   apc.URLPathObjects.Join(bck.Name, objName),
+				Query:  q,
+			}
+			cargs.timeout = cmn.Rom.CplaneOperation()
+		}
+		res := t.call(cargs, smap)
+		freeCargs(cargs)
+		ecode, err = res.status, res.err
+		if err == nil {
+			for k, v := range res.header {
+				whdr[k] = v
+			}
+		}
+		freeCR(res)
+	}
+	if err != nil && cos.IsNotExist(err, ecode) {
+		return http.StatusNotFound, t.errAliasBroken(aliasName, lom)
+	}
+	return ecode, err
+}
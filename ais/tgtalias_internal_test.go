@@ -0,0 +1,102 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// alias's HRW target: check-and-write under the alias's write lock
+func TestWriteAlias(t *testing.T) {
+	bck := meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+	tassert.CheckFatal(t, bck.Init(mockTarget.owner.bmd))
+
+	newLOM := func(name string) *core.LOM {
+		lom := core.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(bck))
+		return lom
+	}
+	load := func(lom *core.LOM) {
+		lom.UncacheUnless()
+		tassert.CheckFatal(t, lom.Load(false /*cache it*/, false /*locked*/))
+	}
+
+	// regular object
+	reg := newLOM("alias-regular.bin")
+	fh, err := cos.CreateFile(reg.FQN)
+	tassert.CheckFatal(t, err)
+	fh.Close()
+	reg.SetSize(0)
+	reg.SetAtimeUnix(time.Now().UnixNano())
+	reg.Lock(true)
+	err = reg.Persist()
+	reg.Unlock(true)
+	tassert.CheckFatal(t, err)
+
+	alom := newLOM("alias-new")
+	defer func() {
+		for _, lom := range []*core.LOM{reg, alom} {
+			lom.RemoveMain()
+			core.FreeLOM(lom)
+		}
+	}()
+
+	// never overwrite a regular object
+	ecode, err := mockTarget.writeAlias(reg, "obj-1")
+	tassert.Errorf(t, err != nil && ecode == http.StatusConflict, "expected 409, got (%d, %v)", ecode, err)
+	load(reg)
+	tassert.Errorf(t, !reg.IsAlias(), "regular object %s overwritten by alias", reg)
+
+	// create
+	_, err = mockTarget.writeAlias(alom, "obj-1")
+	tassert.CheckFatal(t, err)
+	load(alom)
+	objName, ok := alom.AliasOf()
+	tassert.Errorf(t, ok && objName == "obj-1", "expected alias of %q, got (%q, %t)", "obj-1", objName, ok)
+
+	// re-point
+	_, err = mockTarget.writeAlias(alom, "obj-2")
+	tassert.CheckFatal(t, err)
+	load(alom)
+	objName, ok = alom.AliasOf()
+	tassert.Errorf(t, ok && objName == "obj-2", "expected alias of %q, got (%q, %t)", "obj-2", objName, ok)
+}
+
+// back-references: resolved (stale) ones get pruned when adding a new one
+func TestAddAliasRef(t *testing.T) {
+	bck := meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+	tassert.CheckFatal(t, bck.Init(mockTarget.owner.bmd))
+	lom := core.AllocLOM("aliased.bin")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	fh, err := cos.CreateFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	fh.Close()
+	defer lom.RemoveMain()
+	lom.SetSize(0)
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	lom.SetCustomKey(cmn.AliasedByObjMD, string(cos.MustMarshal([]string{"a1", "a2", "a3"})))
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	_, err = _addAliasRef(lom, "a4", []string{"a2"})
+	tassert.CheckFatal(t, err)
+	refs := aliasRefs(lom)
+	tassert.Errorf(t, len(refs) == 3 && refs[0] == "a1" && refs[1] == "a3" && refs[2] == "a4", "unexpected %v", refs)
+
+	// idempotent
+	_, err = _addAliasRef(lom, "a1", nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(aliasRefs(lom)) == 3, "unexpected %v", aliasRefs(lom))
+}
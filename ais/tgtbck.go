@@ -6,6 +6,7 @@ package ais
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -13,11 +14,13 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -162,6 +165,85 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			t.bgetShardSumm(w, r, qbck, msg, dpq, phase)
 		}
 
+	case apc.ActApproxCount:
+		var bckName string
+		if len(apiItems) > 0 {
+			bckName = apiItems[0]
+		}
+		qbck, err := qbckFromDpq(bckName, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		bck, err := t._resolveQbck(w, r, qbck, true /*don't add remote*/)
+		if err != nil {
+			return
+		}
+		t.writeJSON(w, r, core.BcountGet(bck), msg.Action)
+
+	case apc.ActProbeObjects:
+		var bckName string
+		if len(apiItems) > 0 {
+			bckName = apiItems[0]
+		}
+		qbck, err := qbckFromDpq(bckName, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		bck, err := t._resolveQbck(w, r, qbck, true /*don't add remote*/)
+		if err != nil {
+			return
+		}
+		t.probeObjects(w, r, bck, msg)
+
+	case apc.ActObjDist:
+		var bckName string
+		if len(apiItems) > 0 {
+			bckName = apiItems[0]
+		}
+		qbck, err := qbckFromDpq(bckName, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		bck, err := t._resolveQbck(w, r, qbck, true /*don't add remote*/)
+		if err != nil {
+			return
+		}
+		ds, err := t.objDist(bck)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.writeJSON(w, r, ds, msg.Action)
+
+	case apc.ActListUploads:
+		var (
+			bckName   string
+			olderThan int64
+		)
+		if len(apiItems) > 0 {
+			bckName = apiItems[0]
+		}
+		qbck, err := qbckFromDpq(bckName, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := cos.MorphMarshal(msg.Value, &olderThan); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		if qbck.IsBucket() {
+			bck, err := t._resolveQbck(w, r, qbck, true /*don't add remote*/)
+			if err != nil {
+				return
+			}
+			qbck = (*cmn.QueryBcks)(bck.Bucket())
+		}
+		t.writeJSON(w, r, t.ups.list(qbck, time.Duration(olderThan)), msg.Action)
+
 	case apc.ActShowNBI:
 		var bckName string
 		if len(apiItems) > 0 {
@@ -179,6 +261,99 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	}
 }
 
+// apc.ActProbeObjects: statuses of the probes this target owns, "" for all others
+func (t *target) probeObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *actMsgExt) {
+	var probes []apc.ObjProbe
+	if err := cos.MorphMarshal(msg.Value, &probes); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	var (
+		smap = t.owner.smap.get()
+		out  = make([]string, len(probes))
+	)
+	for i := range probes {
+		probe := &probes[i]
+		lom := core.AllocLOM(probe.Name)
+		if err := lom.InitBck(bck); err != nil {
+			core.FreeLOM(lom)
+			t.writeErr(w, r, err)
+			return
+		}
+		if _, local, err := lom.HrwTarget(&smap.Smap); err == nil && local {
+			out[i] = _probe(lom, probe)
+		}
+		core.FreeLOM(lom)
+	}
+	t.writeJSON(w, r, out, msg.Action)
+}
+
+func _probe(lom *core.LOM, probe *apc.ObjProbe) string {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return apc.ProbeNotFound
+	}
+	if lom.Lsize() != probe.Size {
+		return apc.ProbeDiffers
+	}
+	if probe.CksumType == "" || lom.EqCksum(cos.NewCksum(probe.CksumType, probe.CksumValue)) {
+		return apc.ProbeMatch
+	}
+	return apc.ProbeDiffers
+}
+
+// apc.ActObjDist: walk all mountpaths in parallel and tally the bucket's local objects
+// (exact, as of the time of the walk - compare w/ core/bcount)
+func (t *target) objDist(bck *meta.Bck) (*apc.DistStats, error) {
+	var (
+		wg    sync.WaitGroup
+		avail = fs.GetAvail()
+		smap  = t.owner.smap.get()
+		dss   = make([]apc.DistStats, len(avail))
+		errs  = make([]error, len(avail))
+		i     int
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath, ds *apc.DistStats, perr *error) {
+			*perr = _objDist(mi, bck, &smap.Smap, ds)
+			wg.Done()
+		}(mi, &dss[i], &errs[i])
+		i++
+	}
+	wg.Wait()
+
+	out := &apc.DistStats{}
+	for i := range dss {
+		if errs[i] != nil && !cos.IsNotExist(errs[i]) {
+			return nil, errs[i]
+		}
+		out.Objs += dss[i].Objs
+		out.Size += dss[i].Size
+		out.Misplaced += dss[i].Misplaced
+	}
+	return out, nil
+}
+
+func _objDist(mi *fs.Mountpath, bck *meta.Bck, smap *meta.Smap, ds *apc.DistStats) error {
+	cb := func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		lom := core.AllocLOM("")
+		if lom.InitFQN(fqn, bck.Bucket()) == nil && lom.Load(false /*cache it*/, false /*locked*/) == nil && !lom.IsCopy() {
+			ds.Objs++
+			ds.Size += lom.Lsize()
+			if _, local, err := lom.HrwTarget(smap); err == nil && !local {
+				ds.Misplaced++
+			}
+		}
+		core.FreeLOM(lom)
+		return nil
+	}
+	opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjCT}, Callback: cb}
+	return fs.Walk(opts)
+}
+
 func (t *target) _resolveQbck(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, dontAddRemote bool) (*meta.Bck, error) {
 	bck := meta.CloneBck((*cmn.Bck)(qbck))
 	err := bck.Init(t.owner.bmd)
@@ -354,6 +529,27 @@ func (t *target) blist(qbck *cmn.QueryBcks, config *cmn.Config) (bcks cmn.Bcks,
 	return bcks, ecode, err
 }
 
+// apc.WhatBackendProbe: list buckets to exercise (and time) connectivity and credentials
+func (t *target) probeBackend(provider string) *apc.BackendProbeResult {
+	var (
+		config = cmn.GCO.Get()
+		qbck   = cmn.QueryBcks{Provider: provider}
+		res    = &apc.BackendProbeResult{Provider: provider}
+	)
+	if provider == apc.AIS {
+		qbck.Ns = cmn.NsAnyRemote
+	}
+	started := mono.NanoTime()
+	bcks, _, err := t.blist(&qbck, config)
+	res.Latency = mono.Since(started)
+	if err != nil {
+		res.Err = err.Error()
+	} else {
+		res.NumBcks = len(bcks)
+	}
+	return res
+}
+
 // returns `cmn.LsoRes` containing object names and (requested) props
 // control/scope - via `apc.LsoMsg`
 func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg, phase string) bool /*ok*/ {
@@ -654,6 +850,7 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		_ = xreg.RenewEvictDelete(xid, apc.ActEvictRemoteBck, bck, nil)
 
 		core.LcacheClearBcks(wg, bck)
+		core.BcountDel(bck)
 		err := fs.DestroyBucket(msg.Action, bck.Bucket(), bck.Props.BID)
 		if err != nil {
 			t.writeErr(w, r, err)
@@ -671,6 +868,10 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := evdMsg.MaxErrs.Validate(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		// NOTE: validate object names - each name individually
 		for _, name := range evdMsg.ObjNames {
 			if err := cos.ValidateOname(name); err != nil {
@@ -735,10 +936,28 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := prfMsg.MaxErrs.Validate(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		if ecode, err := t.runPrefetch(msg.UUID, apireq.bck, prfMsg); err != nil {
 			t.writeErr(w, r, err, ecode)
 			return
 		}
+	case apc.ActSyncRemote:
+		syncMsg := &apc.SyncRemoteMsg{}
+		if err = cos.MorphMarshal(msg.Value, syncMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := syncMsg.Validate(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if ecode, err := t.runSyncRemote(msg.UUID, apireq.bck, syncMsg); err != nil {
+			t.writeErr(w, r, err, ecode)
+			return
+		}
 	case apc.ActRechunk:
 		rechunkMsg := &apc.RechunkMsg{}
 		if err = cos.MorphMarshal(msg.Value, rechunkMsg); err != nil {
@@ -753,6 +972,20 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 		_, err = t.runIndexShard(msg.UUID, apireq.bck, sishMsg)
+	case apc.ActScrub:
+		scrubMsg := &apc.ScrubMsg{}
+		if err = cos.MorphMarshal(msg.Value, scrubMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		_, err = t.runScrub(msg.UUID, apireq.bck, scrubMsg)
+	case apc.ActVerifyChunks:
+		vcMsg := &apc.VerifyChunksMsg{}
+		if err = cos.MorphMarshal(msg.Value, vcMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		_, err = t.runVerifyChunks(msg.UUID, apireq.bck, vcMsg)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -803,6 +1036,24 @@ func (t *target) runIndexShard(xactID string, bck *meta.Bck, msg *apc.IndexShard
 	return xctn.ID(), nil
 }
 
+func (t *target) runScrub(xactID string, bck *meta.Bck, msg *apc.ScrubMsg) (xid string, err error) {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActScrub); err != nil {
+		return "", err
+	}
+	rns := xreg.RenewBckScrub(bck, xactID, msg)
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
 // handle apc.ActPrefetchObjects <-- via api.Prefetch* and api.StartX*
 func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchMsg) (int, error) {
 	cs := fs.Cap()
@@ -825,6 +1076,30 @@ func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchM
 	return 0, nil
 }
 
+// handle apc.ActSyncRemote <-- via api.SyncRemoteBucket
+func (t *target) runSyncRemote(xactID string, bck *meta.Bck, msg *apc.SyncRemoteMsg) (int, error) {
+	if msg.PrefetchNew || msg.UpdateChanged {
+		cs := fs.Cap()
+		if err := cs.Err(); err != nil {
+			return http.StatusInsufficientStorage, err
+		}
+	}
+	rns := xreg.RenewSyncRemote(xactID, bck, msg)
+	if rns.Err != nil {
+		return http.StatusBadRequest, rns.Err
+	}
+
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+
+	xact.GoRunW(xctn)
+	return 0, nil
+}
+
 // HEAD /v1/buckets/bucket-name
 // see related:
 // - t.httpbckpost() + apc.ActHeadBckWith
@@ -875,9 +1150,26 @@ func (t *target) _bckhead(w http.ResponseWriter, r *http.Request, apireq *apiReq
 		}
 	}
 
+	var timeout time.Duration
+	if s := apireq.dpq.get(apc.QparamBckHeadTimeout); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			t.writeErrf(w, r, "%s: invalid %s %q (expecting positive duration)", t, apc.QparamBckHeadTimeout, s)
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+		timeout = d
+	}
+
 	// + cloud
 	bp := t.Backend(bck)
 	bpropsKV, code, err := bp.HeadBucket(ctx, bck)
+	if err != nil && timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %v waiting for the backend: %w", timeout, err)
+		code = http.StatusGatewayTimeout
+	}
 	if err != nil {
 		if !inBMD {
 			if code == http.StatusNotFound {
@@ -905,3 +1197,21 @@ func (t *target) _bckhead(w http.ResponseWriter, r *http.Request, apireq *apiReq
 		hdr.Set(k, v)
 	}
 }
+
+func (t *target) runVerifyChunks(xactID string, bck *meta.Bck, msg *apc.VerifyChunksMsg) (xid string, err error) {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActVerifyChunks); err != nil {
+		return "", err
+	}
+	rns := xreg.RenewBckVerifyChunks(bck, xactID, msg)
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,8 +28,10 @@ import (
 	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
@@ -184,7 +187,8 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err)
 		}
 	case apc.ActRotateLogs:
-		nlog.Flush(nlog.ActRotate)
+		freed := stats.RotateLogs()
+		t.writeJSON(w, r, freed, msg.Action)
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		t.statsT.ResetStats(errorsOnly)
@@ -412,8 +416,8 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		httpdaeWhat = "httpdaeget-" + what
 	)
 	switch what {
-	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames:
+	case apc.WhatNodeConfig, apc.WhatNodeConfigDiff, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
+		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames, apc.WhatKeepalive, apc.WhatRecentErrors:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
@@ -471,6 +475,15 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			t.writeJSON(w, r, &tcdfExt, httpdaeWhat)
 		}
 
+	case apc.WhatDiskStats:
+		out := make(ios.AllDiskStats, fs.NumAvail())
+		fs.DiskStatsExt(out)
+		t.writeJSON(w, r, out, httpdaeWhat)
+
+	case apc.WhatBackendProbe:
+		res := t.probeBackend(query.Get(apc.QparamProvider))
+		t.writeJSON(w, r, res, httpdaeWhat)
+
 	case apc.WhatRemoteAIS:
 		var (
 			config  = cmn.GCO.Get()
@@ -608,6 +621,8 @@ func (t *target) handleMpathReq(w http.ResponseWriter, r *http.Request) {
 		t.rescanMpath(w, r, mpath)
 	case apc.ActMountpathFSHC:
 		t.fshcMpath(w, r, mpath)
+	case apc.ActMountpathLabel:
+		t.labelMpath(w, r, mpath)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
@@ -669,6 +684,23 @@ func (t *target) attachMpath(w http.ResponseWriter, r *http.Request, mpath strin
 	}
 }
 
+func (t *target) labelMpath(w http.ResponseWriter, r *http.Request, mpath string) {
+	q := r.URL.Query()
+	label := cos.MountpathLabel(q.Get(apc.QparamMpathLabel))
+	mi, err := t.fsprg.labelMpath(mpath, label)
+	if err != nil {
+		if cmn.IsErrMpathNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	if mi == nil {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func (t *target) _dontResilver(w http.ResponseWriter, r *http.Request) (dontResilver, ok bool) {
 	q := r.URL.Query()
 	dontResilver = cos.IsParseBool(q.Get(apc.QparamDontResilver))
@@ -921,6 +953,7 @@ func (t *target) _postBMD(newBMD *bucketMD, tag string, rmbcks []*meta.Bck) {
 	if len(rmbcks) > 0 {
 		wg := &sync.WaitGroup{}
 		core.LcacheClearBcks(wg, rmbcks...)
+		core.BcountDel(rmbcks...)
 
 		errV := fmt.Errorf("[post-bmd] %s %s: remove bucket%s", tag, newBMD, cos.Plural(len(rmbcks)))
 		xreg.AbortAllBuckets(errV, rmbcks...)
@@ -1049,6 +1082,9 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 	// 2. by RMD
 	xname := tag + nxid + "]"
 
+	// (re)joined target: warm up upon success (see Rebalance.WarmupBudget)
+	extArgs.Warmup = slices.Contains(newRMD.TargetIDs, t.SID())
+
 	debug.Assert(extArgs.Flags&xact.FlagRemoveMisplaced == 0,
 		"cleanup mode is user-initiated only; got it via RMD-driven action:", msg.Action)
 
@@ -1066,6 +1102,7 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 			s = " (to subsequently deactivate or remove _this_ target)"
 		}
 		nlog.Infoln(tname, "starting", msg.String(), "-triggered", xname, s, opts)
+		extArgs.Deadline = opts.Deadline.D()
 		// (##b)
 		go t.reb.Run(&smap.Smap, &extArgs)
 
@@ -47,6 +47,8 @@ func (t *target) httpecget(w http.ResponseWriter, r *http.Request) {
 	switch apireq.items[0] {
 	case ec.URLMeta:
 		t.sendECMetafile(w, r, apireq.bck, apireq.items[2])
+	case ec.URLStatus:
+		t.sendECStatus(w, r, apireq.bck, apireq.items[2])
 	default:
 		t.writeErrURL(w, r)
 	}
@@ -75,6 +77,22 @@ func (t *target) sendECMetafile(w http.ResponseWriter, r *http.Request, bck *met
 	w.Write(b)
 }
 
+// Returns local status of a CT (slice or replica); see also: p.objECStatus
+func (t *target) sendECStatus(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if err := bck.Init(t.owner.bmd); err != nil {
+		if !cmn.IsErrRemoteBckNotFound(err) { // is ais
+			t.writeErr(w, r, err, 0, Silent)
+			return
+		}
+	}
+	status, err := ec.ObjectStatus(bck, objName)
+	if err != nil {
+		t.writeErr(w, r, err, http.StatusInternalServerError, Silent)
+		return
+	}
+	t.writeJSON(w, r, status, apc.ActECStatus)
+}
+
 func (t *target) httpecpost(w http.ResponseWriter, r *http.Request) {
 	const (
 		hknameEC = apc.ActCloseEC + hk.NameSuffix
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -116,8 +117,15 @@ func (t *target) inlineETL(w http.ResponseWriter, r *http.Request, dpq *dpq, lom
 	var (
 		xetl     = comm.Xact()
 		pipeline apc.ETLPipeline
+		timeout  time.Duration
 		err      error
 	)
+	if s := dpq.get(apc.QparamETLTimeout); s != "" {
+		if timeout, err = time.ParseDuration(s); err != nil || timeout <= 0 {
+			t.writeErrf(w, r, "invalid %s=%q (expecting positive duration, e.g. \"30s\")", apc.QparamETLTimeout, s)
+			return
+		}
+	}
 	if etlPipeline := dpq.get(apc.QparamETLPipeline); etlPipeline != "" {
 		pipeline, err = etl.GetPipeline(strings.Split(etlPipeline, apc.ETLPipelineSeparator))
 		if err != nil {
@@ -129,6 +137,7 @@ func (t *target) inlineETL(w http.ResponseWriter, r *http.Request, dpq *dpq, lom
 		LatestVer:     dpq.latestVer,
 		TransformArgs: dpq.get(apc.QparamETLTransformArgs),
 		Pipeline:      pipeline,
+		Timeout:       timeout,
 	})
 
 	// error handling
@@ -143,6 +152,14 @@ func (t *target) inlineETL(w http.ResponseWriter, r *http.Request, dpq *dpq, lom
 			)
 			xetl.ObjsAdd(1, size)
 		}
+	case cmn.IsErrETLTimeout(err):
+		core.T.StatsUpdater().IncWith(stats.ErrETLTimeoutCount, xetl.Vlabs)
+		xetl.InlineObjErrs.Add(&etl.ObjErr{
+			ObjName: lom.Cname(),
+			Message: err.Error(),
+			Ecode:   ecode,
+		})
+		t.writeErr(w, r, err, ecode)
 	case cos.IsNotExist(err, ecode):
 		xetl.InlineObjErrs.Add(&etl.ObjErr{
 			ObjName: lom.Cname(),
@@ -179,7 +196,7 @@ func (t *target) healthETL(w http.ResponseWriter, r *http.Request, etlName strin
 		}
 		return
 	}
-	writeXid(w, health)
+	t.writeJSON(w, r, health, "health-etl")
 }
 
 // GET /v1/etl/<etl-name>/details
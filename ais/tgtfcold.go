@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"errors"
 	"io"
 
 	"github.com/NVIDIA/aistore/ais/s3"
@@ -88,8 +89,10 @@ func (goi *getOI) _cleanup(revert string, lmfh io.Closer, buf []byte, slab *mems
 }
 
 // NOTE:
-// Streaming cold GET feature (`feat.StreamingColdGET`) puts response header on the wire _prior_
-// to finalizing in-cluster object. Use it at your own risk.
+// Streaming cold GET feature (`feat.StreamingColdGET`, or per-request `apc.QparamWarmCache`)
+// puts response header on the wire _prior_ to finalizing in-cluster object. Use it at your own risk.
+// With `apc.QparamWarmCache` the local copy is best-effort: the client does not wait on local
+// writes and, if those fall behind, the object gets served but not stored (see coldTee).
 // (under wlock)
 func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 	var (
@@ -114,11 +117,30 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 
 	var (
 		written   int64
+		cksum     *cos.CksumHash
+		mw        io.Writer
 		buf, slab = t.gmm.AllocSize(_txsize(res.Size))
-		cksum     = cos.NewCksumHash(lom.CksumConf().Type)
-		mw        = cos.NewWriterMulti(goi.w, lmfh, cksum.H)
 		whdr      = goi.w.Header()
+		useExp    = lom.CksumConf().UseBackendCksum(res.ExpCksum)
 	)
+	var (
+		local io.Writer
+		tee   *coldTee
+	)
+	if useExp {
+		// compute backend's checksum type (only) to verify it - see CksumConf.PreferBackend
+		cksum = cos.NewCksumHash(res.ExpCksum.Type())
+	} else {
+		cksum = cos.NewCksumHash(lom.CksumConf().Type)
+	}
+	local = cos.NewWriterMulti(lmfh, cksum.H)
+	if goi.dpq.warmCache {
+		// client does not wait on local writes
+		tee = newColdTee(t.gmm, local, int64(len(buf)))
+		mw = cos.NewWriterMulti(goi.w, tee)
+	} else {
+		mw = cos.NewWriterMulti(goi.w, local)
+	}
 
 	// response header
 	whdr.Set(cos.HdrContentType, cos.ContentBinary)
@@ -130,7 +152,17 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 
 	written, err = cos.CopyBuffer(mw, res.R, buf)
 	cos.Close(res.R)
+	goi.rsize = written
 
+	if tee != nil {
+		errT := tee.wait()
+		if errT != nil && err == nil && written == res.Size {
+			// client served in full; no local copy
+			goi._cleanup(revert, lmfh, buf, slab, errT, "(warm-cache)")
+			goi.stats(written)
+			return nil
+		}
+	}
 	if err != nil {
 		goi._cleanup(revert, lmfh, buf, slab, err, "(rr/wl)")
 		return cmn.ErrGetTxBenign
@@ -164,6 +196,12 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 	// lom (main replica)
 	lom.SetSize(written)
 	cksum.Finalize()
+	if useExp && !cksum.Equal(res.ExpCksum) {
+		const act = "(cksum)"
+		errTx := newErrGetTxSevere(cos.NewErrDataCksum(res.ExpCksum, &cksum.Cksum, lom.Cname()), lom, act)
+		goi._cleanup(revert, lmfh, buf, slab, errTx, act)
+		return errTx
+	}
 	lom.SetCksum(&cksum.Cksum)
 	if lom.HasCopies() {
 		if err := lom.DelAllCopies(); err != nil {
@@ -177,7 +215,95 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 		return errTx
 	}
 
+	if !goi.verchanged {
+		core.BcountInc(lom.Bck()) // new object (written in place - see core/bcount)
+	}
 	slab.Free(buf)
 
 	return goi._fini(revert, res.Size, written)
 }
+
+//
+// coldTee: warm-cache (apc.QparamWarmCache) local writer
+//
+
+const coldTeeBufs = 8
+
+var errColdTeeBehind = errors.New("local write falling behind - not storing")
+
+// a bounded number of in-flight buffers between the client (the caller) and
+// local disk (the goroutine); when none is free the local copy gets dropped
+// instead of making the client wait
+type coldTee struct {
+	w      io.Writer // lmfh [+ checksum]
+	free   chan []byte
+	full   chan []byte
+	done   chan error
+	slabs  []*memsys.Slab
+	bufs   [][]byte
+	behind bool
+}
+
+// interface guard
+var _ io.Writer = (*coldTee)(nil)
+
+func newColdTee(mm *memsys.MMSA, w io.Writer, bufSize int64) *coldTee {
+	tee := &coldTee{
+		w:    w,
+		free: make(chan []byte, coldTeeBufs),
+		full: make(chan []byte, coldTeeBufs),
+		done: make(chan error, 1),
+	}
+	for range coldTeeBufs {
+		buf, slab := mm.AllocSize(bufSize)
+		tee.bufs = append(tee.bufs, buf)
+		tee.slabs = append(tee.slabs, slab)
+		tee.free <- buf
+	}
+	go tee.run()
+	return tee
+}
+
+func (tee *coldTee) run() {
+	var err error
+	for b := range tee.full {
+		if err == nil {
+			_, err = tee.w.Write(b)
+		}
+		tee.free <- b[:cap(b)]
+	}
+	tee.done <- err
+}
+
+// never fails and never blocks on disk
+func (tee *coldTee) Write(p []byte) (int, error) {
+	l := len(p)
+	for len(p) > 0 && !tee.behind {
+		select {
+		case b := <-tee.free:
+			n := copy(b, p)
+			tee.full <- b[:n]
+			p = p[n:]
+		default:
+			tee.behind = true
+			close(tee.full)
+		}
+	}
+	return l, nil
+}
+
+// waits for the local writer to finish; returns errColdTeeBehind if dropped
+func (tee *coldTee) wait() (err error) {
+	if !tee.behind {
+		close(tee.full)
+	}
+	err = <-tee.done
+	for i, slab := range tee.slabs {
+		slab.Free(tee.bufs[i])
+	}
+	tee.slabs, tee.bufs = nil, nil
+	if err == nil && tee.behind {
+		err = errColdTeeBehind
+	}
+	return err
+}
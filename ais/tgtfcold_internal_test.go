@@ -0,0 +1,159 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// local writer that blocks until released (or fails)
+type slowLocal struct {
+	buf     bytes.Buffer
+	release chan struct{}
+	err     error
+}
+
+func (w *slowLocal) Write(p []byte) (int, error) {
+	if w.release != nil {
+		<-w.release
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	return w.buf.Write(p)
+}
+
+func TestColdTee(t *testing.T) {
+	const bufSize = 4 * cos.KiB
+	var (
+		mm   = memsys.PageMM()
+		data = make([]byte, 64*bufSize)
+	)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	t.Run("keeps-up", func(t *testing.T) {
+		var (
+			local  slowLocal
+			client bytes.Buffer
+			tee    = newColdTee(mm, &local, bufSize)
+		)
+		// feed at the rate local can handle
+		for off := 0; off < len(data); off += bufSize {
+			_, err := cos.NewWriterMulti(&client, tee).Write(data[off : off+bufSize])
+			tassert.CheckFatal(t, err)
+			for len(tee.free) < coldTeeBufs {
+				time.Sleep(time.Millisecond)
+			}
+		}
+		tassert.CheckFatal(t, tee.wait())
+		tassert.Errorf(t, bytes.Equal(client.Bytes(), data), "client: content mismatch")
+		tassert.Errorf(t, bytes.Equal(local.buf.Bytes(), data), "local: content mismatch")
+	})
+
+	t.Run("behind", func(t *testing.T) {
+		var (
+			local  = slowLocal{release: make(chan struct{})}
+			client bytes.Buffer
+			tee    = newColdTee(mm, &local, bufSize)
+			done   = make(chan error, 1)
+		)
+		// client must not wait on (blocked) local writes
+		go func() {
+			_, err := io.CopyBuffer(cos.NewWriterMulti(&client, tee), bytes.NewReader(data), make([]byte, bufSize))
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			tassert.CheckFatal(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("client blocked on local write")
+		}
+		close(local.release)
+		err := tee.wait()
+		tassert.Fatalf(t, errors.Is(err, errColdTeeBehind), "expected %v, got %v", errColdTeeBehind, err)
+		tassert.Errorf(t, bytes.Equal(client.Bytes(), data), "client: content mismatch")
+		tassert.Errorf(t, local.buf.Len() < len(data), "expected partial local copy, got %d", local.buf.Len())
+	})
+
+	t.Run("local-error", func(t *testing.T) {
+		var (
+			local  = slowLocal{err: errors.New("disk failure")}
+			client bytes.Buffer
+			tee    = newColdTee(mm, &local, bufSize)
+		)
+		_, err := cos.NewWriterMulti(&client, tee).Write(data[:bufSize])
+		tassert.CheckFatal(t, err)
+		err = tee.wait()
+		tassert.Fatalf(t, err == local.err, "expected %v, got %v", local.err, err)
+		tassert.Errorf(t, bytes.Equal(client.Bytes(), data[:bufSize]), "client: content mismatch")
+	})
+}
+
+// streaming cold GET with checksum.prefer_backend: backend-provided checksum gets verified
+// (and the object stored) only when it matches the content
+func TestColdStreamPreferBackend(t *testing.T) {
+	data := bytes.Repeat([]byte("prefer-backend"), 4*cos.KiB)
+	good := cos.NewCksumHash(cos.ChecksumMD5)
+	good.H.Write(data)
+	good.Finalize()
+
+	tests := []struct {
+		name  string
+		cksum *cos.Cksum
+	}{
+		{"match", good.Clone()},
+		{"mismatch", cos.NewCksum(cos.ChecksumMD5, "00000000000000000000000000000000")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lom := core.AllocLOM("cold-stream-" + test.name)
+			defer core.FreeLOM(lom)
+			tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+			defer lom.RemoveMain()
+
+			cksumConf := &lom.Bprops().Cksum
+			saved := *cksumConf
+			defer func() { *cksumConf = saved }()
+			cksumConf.Type = cos.ChecksumOneXxh
+			cksumConf.PreferBackend = true
+
+			dpq := dpqAlloc()
+			defer dpqFree(dpq)
+			w := httptest.NewRecorder()
+			goi := &getOI{atime: time.Now().UnixNano(), t: mockTarget, lom: lom, w: w, dpq: dpq}
+			res := &core.GetReaderResult{R: io.NopCloser(bytes.NewReader(data)), Size: int64(len(data)), ExpCksum: test.cksum}
+
+			lom.Lock(true)
+			err := goi.coldStream(res) // (unlocks)
+			tassert.Errorf(t, bytes.Equal(w.Body.Bytes(), data), "client: content mismatch")
+
+			lom.UncacheDel()
+			errLoad := lom.Load(false, false)
+			if test.name == "match" {
+				tassert.CheckFatal(t, err)
+				tassert.CheckFatal(t, errLoad)
+				tassert.Errorf(t, lom.Checksum().Equal(good.Clone()), "expected stored %s, got %s", good.Clone(), lom.Checksum())
+				return
+			}
+			tassert.Fatalf(t, isErrGetTxSevere(err), "expected checksum mismatch error, got %v", err)
+			tassert.Errorf(t, errLoad != nil, "object with mismatching checksum must not be stored")
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/xoshiro256"
+)
+
+// PUT(object) with server-side generated content (apc.QparamGenSize et al.):
+// the request carries no body; the target generates the content of the requested size
+// and pattern and then proceeds with the regular PUT (including chunking, mirroring, EC,
+// and remote backend, if any)
+
+type genReader struct {
+	rnd     *rand.ChaCha8 // apc.GenRandom
+	pattern []byte        // apc.GenRepeat (nil: apc.GenZeros)
+	off     int64
+	size    int64
+}
+
+// interface guard
+var _ io.ReadCloser = (*genReader)(nil)
+
+func newGenReader(dpq *dpq) (*genReader, error) {
+	size, err := strconv.ParseInt(dpq.get(apc.QparamGenSize), 10, 64)
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("invalid %s %q", apc.QparamGenSize, dpq.get(apc.QparamGenSize))
+	}
+	gr := &genReader{size: size}
+	switch pattern := dpq.get(apc.QparamGenPattern); pattern {
+	case "", apc.GenZeros:
+	case apc.GenRepeat:
+		b := dpq.get(apc.QparamGenBytes)
+		if b == "" || len(b) > apc.MaxGenBytes {
+			return nil, fmt.Errorf("invalid %s: expecting [1, %d] bytes to repeat, got %d",
+				apc.QparamGenBytes, apc.MaxGenBytes, len(b))
+		}
+		gr.pattern = []byte(b)
+	case apc.GenRandom:
+		var seed [32]byte
+		now := uint64(mono.NanoTime())
+		for i := 0; i < len(seed); i += 8 {
+			binary.LittleEndian.PutUint64(seed[i:], xoshiro256.Hash(now+uint64(i)))
+		}
+		gr.rnd = rand.NewChaCha8(seed)
+	default:
+		return nil, fmt.Errorf("invalid %s %q (expecting one of: %q, %q, %q)",
+			apc.QparamGenPattern, pattern, apc.GenZeros, apc.GenRepeat, apc.GenRandom)
+	}
+	return gr, nil
+}
+
+func (gr *genReader) Read(b []byte) (int, error) {
+	left := gr.size - gr.off
+	if left <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(b)) > left {
+		b = b[:left]
+	}
+	switch {
+	case gr.rnd != nil:
+		_, _ = gr.rnd.Read(b)
+	case gr.pattern == nil:
+		clear(b)
+	default:
+		for i, j := 0, int(gr.off%int64(len(gr.pattern))); i < len(b); j = 0 {
+			i += copy(b[i:], gr.pattern[j:])
+		}
+	}
+	gr.off += int64(len(b))
+	return len(b), nil
+}
+
+func (*genReader) Close() error { return nil }
@@ -51,6 +51,9 @@ func (t *target) objHeadV2(r *http.Request, whdr http.Header, dpq *dpq, bck *met
 		if fltPresence == apc.FltExistsOutside {
 			return 0, fmt.Errorf(fmtOutside, lom.Cname(), fltPresence)
 		}
+		if objName, ok := lom.AliasOf(); ok && fltPresence == 0 && dpq.aliasOf(r) == "" {
+			return t.headAliased(r, whdr, dpq, bck, lom.ObjName, objName, true /*v2*/)
+		}
 	} else {
 		if !cmn.IsErrObjNought(err) {
 			return 0, err
@@ -204,6 +204,7 @@ func (t *target) HeadCold(lom *core.LOM, origReq *http.Request) (oa *cmn.ObjAttr
 	oa, ecode, err = bp.HeadObj(context.Background(), lom, origReq)
 	if err != nil {
 		t.statsT.IncWith(stats.ErrHeadCount, vlabs)
+		t.statsT.AddRecentErr("head", lom.Bucket(), lom.ObjName, err)
 	} else {
 		t.statsT.IncWith(bp.MetricName(stats.HeadCount), vlabs)
 		t.statsT.AddWith(
@@ -159,6 +159,42 @@ func (ups *ups) toSlice() (all []*core.Ufest) {
 	return
 }
 
+// apc.ActListUploads: incomplete uploads (tracked by this target) that are at least `olderThan` old
+func (ups *ups) list(qbck *cmn.QueryBcks, olderThan time.Duration) []apc.MptUploadInfo {
+	var (
+		now = time.Now()
+		out = make([]apc.MptUploadInfo, 0, iniCapUploads)
+	)
+	ups.RLock()
+	for id, up := range ups.m {
+		manifest := up.u
+		if manifest.Completed() || now.Sub(manifest.Created()) < olderThan {
+			continue
+		}
+		lom := manifest.Lom()
+		bck := *lom.Bucket()
+		if !qbck.Contains(&bck) {
+			continue
+		}
+		info := apc.MptUploadInfo{
+			Bucket:   bck.Name,
+			Provider: bck.Provider,
+			ObjName:  lom.ObjName,
+			UploadID: id,
+			Created:  manifest.Created().UnixNano(),
+		}
+		if !bck.Ns.IsGlobal() {
+			info.Ns = bck.Ns.Uname()
+		}
+		manifest.Lock()
+		info.Size, info.NumParts = manifest.Size(), manifest.Count()
+		manifest.Unlock()
+		out = append(out, info)
+	}
+	ups.RUnlock()
+	return out
+}
+
 func (ups *ups) del(id string) {
 	ups.Lock()
 	delete(ups.m, id)
@@ -267,6 +303,10 @@ func (ups *ups) start(r *http.Request, lom *core.LOM, skipBackend bool) (uploadI
 
 func (ups *ups) _start(r *http.Request, lom *core.LOM, skipBackend bool) (uploadID string, metadata map[string]string, err error) {
 	bck := lom.Bck()
+	if lom.Bprops().Encryption.Enabled {
+		// chunks are stored as is (see core/lencrypt.go)
+		return "", nil, cmn.NewErrUnsupp("store chunked (e.g., multipart-uploaded) objects in", bck.Cname("")+" with encryption enabled")
+	}
 	if bck.IsRemote() && !skipBackend {
 		// Extract metadata:
 		// - from HTTP request headers if available (normal upload path)
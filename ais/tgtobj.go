@@ -6,20 +6,24 @@ package ais
 
 import (
 	"archive/tar"
+	"compress/gzip"
 	"context"
 	"encoding"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -50,26 +54,29 @@ import (
 type (
 	putOI struct {
 		oreq        *http.Request
-		r           io.ReadCloser // content reader
-		xctn        core.Xact     // xaction that puts
-		t           *target       // this
-		lom         *core.LOM     // obj
-		cksumToUse  *cos.Cksum    // if available (not `none`), can be validated and will be stored
-		config      *cmn.Config   // (during this request)
-		resphdr     http.Header   // as implied
-		workFQN     string        // temp fqn to be renamed
-		atime       int64         // access time.Now()
-		ltime       int64         // mono.NanoTime, to measure latency
-		rltime      int64         // mono.NanoTime, to measure remote bucket latency
-		size        int64         // aka Content-Length
-		owt         cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
-		restful     bool          // being invoked via RESTful API
-		t2t         bool          // by another target
-		skipEC      bool          // do not erasure-encode when finalizing
-		skipVC      bool          // skip loading existing Version and skip comparing Checksums (skip VC)
-		skipBackend bool          // don't write to backend (e.g., cold-GET caching, rechunk)
-		locked      bool          // true if the LOM is already locked by the caller
-		remoteErr   bool          // to exclude `putRemote` errors when counting soft IO errors
+		r           io.ReadCloser  // content reader
+		xctn        core.Xact      // xaction that puts
+		t           *target        // this
+		lom         *core.LOM      // obj
+		cksumToUse  *cos.Cksum     // if available (not `none`), can be validated and will be stored
+		trailer     *cos.CksumHash // to compare with the checksum that arrives as HTTP trailer
+		config      *cmn.Config    // (during this request)
+		resphdr     http.Header    // as implied
+		workFQN     string         // temp fqn to be renamed
+		atime       int64          // access time.Now()
+		ltime       int64          // mono.NanoTime, to measure latency
+		rltime      int64          // mono.NanoTime, to measure remote bucket latency
+		size        int64          // aka Content-Length
+		expires     int64          // per-object TTL: expiration time (unix nano), if specified
+		owt         cmn.OWT        // object write transaction enum { OwtPut, ..., OwtGet* }
+		restful     bool           // being invoked via RESTful API
+		t2t         bool           // by another target
+		skipEC      bool           // do not erasure-encode when finalizing
+		skipVC      bool           // skip loading existing Version and skip comparing Checksums (skip VC)
+		skipBackend bool           // don't write to backend (e.g., cold-GET caching, rechunk)
+		rmttl       bool           // removing expiration time inherited from the previous version
+		locked      bool           // true if the LOM is already locked by the caller
+		remoteErr   bool           // to exclude `putRemote` errors when counting soft IO errors
 	}
 
 	getOI struct {
@@ -82,6 +89,7 @@ type (
 		ranges     byteRanges // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
 		atime      int64      // access time.Now()
 		ltime      int64      // mono.NanoTime, to measure latency
+		rsize      int64      // cold GET: size read from remote backend (vs. written to the client - m.b. range)
 		chunked    bool       // chunked transfer (en)coding: https://tools.ietf.org/html/rfc7230#page-36
 		unlocked   bool       // internal
 		verchanged bool       // version changed
@@ -117,6 +125,12 @@ type (
 
 	coi xs.CoiParams
 
+	// destination's custom metadata (core.LOM or cmn.ObjAttrs)
+	customMDer interface {
+		SetCustomKey(k, v string)
+		DelCustomKey(k string)
+	}
+
 	sendArgs struct {
 		reader    cos.ReadOpenCloser
 		dm        *bundle.DM
@@ -156,10 +170,22 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (_ int, err
 	if poi.cksumToUse, err = oah.FromHeader(r.Header); err != nil {
 		return 0, err
 	}
+	if err := poi.ttl(r.Header); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if _, ok := r.Trailer[apc.HdrObjCksumVal]; ok {
+		if err := poi.expectTrailer(r); err != nil {
+			return http.StatusBadRequest, err
+		}
+	}
 
 	if dpq.sys.owt != "" {
 		poi.owt.FromS(dpq.sys.owt)
 	}
+	if poi.owt == cmn.OwtPut {
+		// overwriting alias (if any) with a regular object
+		poi.lom.DelCustomKey(cmn.AliasObjMD)
+	}
 	if dpq.sys.uuid != "" {
 		// resolve cluster-wide xact "behind" this PUT (promote via a single target won't show up)
 		xctn, err := xreg.GetXact(dpq.sys.uuid)
@@ -176,9 +202,85 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (_ int, err
 			poi.size = size
 		}
 	}
+	if dpq.has(apc.QparamGenSize) {
+		gr, err := newGenReader(dpq)
+		if err != nil {
+			return http.StatusBadRequest, fmt.Errorf("%s: %v", poi.lom.Cname(), err)
+		}
+		poi.r, poi.size = gr, gr.size
+		poi.cksumToUse = nil
+	}
 	return poi.putObject()
 }
 
+// checksum value to arrive as HTTP trailer: compute while receiving
+// (see api.PutArgs.ExpectChecksumTrailer)
+func (poi *putOI) expectTrailer(r *http.Request) error {
+	ty := r.Header.Get(apc.HdrObjCksumType)
+	if ty == "" || ty == cos.ChecksumNone {
+		return fmt.Errorf("%s: checksum trailer requires %s", poi.lom.Cname(), apc.HdrObjCksumType)
+	}
+	if err := cos.ValidateCksumType(ty); err != nil {
+		return err
+	}
+	poi.trailer = cos.NewCksumHash(ty)
+	poi.r = struct {
+		io.Reader
+		io.Closer
+	}{io.TeeReader(r.Body, poi.trailer.H), r.Body}
+	return nil
+}
+
+func (poi *putOI) checkTrailer() error {
+	poi.trailer.Finalize()
+	val := poi.oreq.Trailer.Get(apc.HdrObjCksumVal)
+	if val == "" {
+		return fmt.Errorf("%s: missing %s trailer", poi.lom.Cname(), apc.HdrObjCksumVal)
+	}
+	expct := cos.NewCksum(poi.trailer.Ty(), val)
+	if !poi.trailer.Equal(expct) {
+		poi.t.statsT.IncWith(stats.ErrPutCksumCount, poi._vlabs(true /*detailed*/))
+		return cos.NewErrDataCksum(expct, &poi.trailer.Cksum, poi.lom.Cname())
+	}
+	return nil
+}
+
+// per-object TTL: store expiration time in the object's custom metadata, or
+// remove the one that may have been inherited from the previous version;
+// intra-cluster PUTs (copy, move, etc.) keep the sender's expiration, if any
+func (poi *putOI) ttl(hdr http.Header) error {
+	s := hdr.Get(apc.HdrObjTTL)
+	if s == "" {
+		if poi.t2t && _hasExpires(hdr) {
+			v, _ := poi.lom.GetCustomKey(cmn.ExpiresObjMD) // (set by oah.FromHeader)
+			poi.expires, _ = strconv.ParseInt(v, 10, 64)
+			return nil
+		}
+		if _, ok := poi.lom.GetCustomKey(cmn.ExpiresObjMD); ok {
+			poi.lom.DelCustomKey(cmn.ExpiresObjMD)
+			poi.rmttl = true
+		}
+		return nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("%s: invalid %s %q (expecting positive duration)", poi.lom.Cname(), apc.HdrObjTTL, s)
+	}
+	poi.expires = poi.atime + int64(d)
+	poi.lom.SetCustomKey(cmn.ExpiresObjMD, strconv.FormatInt(poi.expires, 10))
+	return nil
+}
+
+// whether the sender's custom metadata (see cmn.ObjAttrs.FromHeader) carries expiration time
+func _hasExpires(hdr http.Header) bool {
+	for _, kv := range hdr[apc.HdrObjCustomMD] {
+		if strings.HasPrefix(kv, cmn.ExpiresObjMD+"=") {
+			return true
+		}
+	}
+	return false
+}
+
 func (poi *putOI) chunk(chunkSize int64) (ecode int, err error) {
 	var (
 		lom      = poi.lom
@@ -248,6 +350,24 @@ func (poi *putOI) chunk(chunkSize int64) (ecode int, err error) {
 	return ecode, err
 }
 
+// bucket's `chunks.auto_size_limit`: transparently store new content as chunks
+// - applies to regular PUT, promote, and cold GET (including prefetch)
+// - does not apply to copies, archives, and rebalance - see coi._chunk and x-rechunk
+// - requires known size
+func (poi *putOI) autoChunk() bool {
+	if poi.size <= 0 || !poi.lom.Bprops().Chunks.AutoChunk(poi.size) {
+		return false
+	}
+	switch poi.owt {
+	case cmn.OwtPut, cmn.OwtPromote:
+		return true
+	case cmn.OwtGetTryLock, cmn.OwtGetLock, cmn.OwtGet, cmn.OwtGetPrefetchLock: // cold GET
+		return true
+	default:
+		return false
+	}
+}
+
 func (poi *putOI) putObject() (ecode int, err error) {
 	maxMonoSize := int64(poi.lom.Bprops().Chunks.MaxMonolithicSize)
 	// protect the bucket: if the object size exceeds the max monolithic size, MUST chunk
@@ -258,10 +378,16 @@ func (poi *putOI) putObject() (ecode int, err error) {
 		}
 		return poi.chunk(int64(poi.lom.Bprops().Chunks.ChunkSize))
 	}
+	if poi.autoChunk() {
+		if cmn.Rom.V(5, cos.ModAIS) {
+			nlog.Infoln("PUT", poi.lom.Cname(), "size", poi.size, "exceeds auto-chunking limit, PUT as chunks")
+		}
+		return poi.chunk(int64(poi.lom.Bprops().Chunks.ChunkSize))
+	}
 	poi.ltime = mono.NanoTime()
 
-	// if checksums match PUT is a no-op
-	if !poi.skipVC && !poi.skipBackend {
+	// if checksums match PUT is a no-op (unless (re)setting or clearing TTL)
+	if !poi.skipVC && !poi.skipBackend && poi.expires == 0 && !poi.rmttl {
 		if poi.lom.EqCksum(poi.cksumToUse) {
 			if cmn.Rom.V(4, cos.ModAIS) {
 				nlog.Infoln(poi.lom.String(), "has identical", poi.cksumToUse.String(), "- PUT is a no-op")
@@ -310,6 +436,7 @@ rerr:
 	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
 		vlabs := poi._vlabs(true /*detailed*/)
 		poi.t.statsT.IncWith(stats.ErrPutCount, vlabs)
+		poi.t.statsT.AddRecentErr("put", poi.lom.Bucket(), poi.lom.ObjName, err)
 
 		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !cos.IsErrRetriableConn(err) && !cos.IsErrMv(err) {
 			poi.t.statsT.IncWith(stats.IOErrPutCount, vlabs)
@@ -349,6 +476,10 @@ func (poi *putOI) stats() {
 		cos.NamedVal64{Name: stats.PutLatency, Value: delta, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.PutLatencyTotal, Value: delta, VarLabs: vlabs},
 	)
+	if tvlabs := tvlabs(bck, poi.oreq); tvlabs != nil {
+		poi.t.statsT.IncWith(stats.TagPutCount, tvlabs)
+		poi.t.statsT.AddWith(cos.NamedVal64{Name: stats.TagPutSize, Value: size, VarLabs: tvlabs})
+	}
 	if poi.rltime > 0 {
 		debug.Assert(bck.IsRemote())
 		bp := poi.t.Backend(bck)
@@ -456,6 +587,16 @@ func (poi *putOI) fini() (ecode int, err error) {
 		}
 	}
 
+	// compress and encrypt at rest (see bucket props: compression, encryption); outside the lock when possible
+	compressed, errc := lom.CompressWork(poi.workFQN)
+	if errc != nil {
+		nlog.Warningln(poi.loghdr(), "failed to compress, storing as is:", errc)
+	}
+	enc, err := lom.EncryptWork(poi.workFQN)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
 	// locking strategies: optimistic and otherwise
 	// (see GetCold() implementation and cmn.OWT enum)
 	switch poi.owt {
@@ -470,8 +611,13 @@ func (poi *putOI) fini() (ecode int, err error) {
 		defer lom.Unlock(true)
 	default:
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime) // expecting valid atime
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if poi.locked {
+			// (core.PutParams.Locked)
+			debug.Assertf(lom.IsLocked() == apc.LockWrite, "lom %s is not write-locked", lom.Cname())
+		} else {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+		}
 		lom.SetAtimeUnix(poi.atime)
 	}
 
@@ -494,6 +640,8 @@ func (poi *putOI) fini() (ecode int, err error) {
 	if err := lom.RenameFinalize(poi.workFQN); err != nil {
 		return 0, err
 	}
+	lom.SetCompressed(compressed)
+	lom.SetEncrypted(enc)
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
@@ -546,6 +694,7 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 			finalized bool           // to avoid computing the same checksum type twice
 		}{}
 		ckconf = poi.lom.CksumConf()
+		useExp = poi.coldGet() && ckconf.UseBackendCksum(poi.cksumToUse) // (compute once, verify, store)
 	)
 	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
 		return nil, nil, nil, err
@@ -562,7 +711,7 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
 		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
-	case !cos.NoneC(poi.cksumToUse) && !poi.validateCksum(ckconf):
+	case !cos.NoneC(poi.cksumToUse) && !poi.validateCksum(ckconf) && !useExp:
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
 		poi.lom.SetCksum(poi.cksumToUse)
@@ -571,9 +720,13 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	default:
 		writers := make([]io.Writer, 0, 3)
 		writers = append(writers, lmfh)
-		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
+		ty := ckconf.Type // according to the bucket, unless cold GET with a usable backend checksum
+		if useExp {
+			ty = poi.cksumToUse.Type()
+		}
+		cksums.store = cos.NewCksumHash(ty)
 		writers = append(writers, cksums.store.H)
-		if !poi.skipVC && !cos.NoneC(poi.cksumToUse) && poi.validateCksum(ckconf) {
+		if useExp || (!poi.skipVC && !cos.NoneC(poi.cksumToUse) && poi.validateCksum(ckconf)) {
 			cksums.expct = poi.cksumToUse
 			if poi.cksumToUse.Type() == cksums.store.Type() {
 				cksums.compt = cksums.store
@@ -599,6 +752,11 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 			return buf, slab, lmfh, err
 		}
 	}
+	if poi.trailer != nil {
+		if err = poi.checkTrailer(); err != nil {
+			return buf, slab, lmfh, err
+		}
+	}
 
 	// ok
 	if poi.lom.IsFeatureSet(feat.FsyncPUT) {
@@ -641,6 +799,15 @@ func (poi *putOI) _cleanup(buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 }
 
+func (poi *putOI) coldGet() bool {
+	switch poi.owt {
+	case cmn.OwtGetTryLock, cmn.OwtGetLock, cmn.OwtGet, cmn.OwtGetPrefetchLock:
+		return true
+	default:
+		return false
+	}
+}
+
 func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 	switch poi.owt {
 	case cmn.OwtRebalance, cmn.OwtCopy:
@@ -675,10 +842,16 @@ func (goi *getOI) get() (ecode int, err error) {
 	var (
 		uplock      *_uplock
 		cs          fs.CapStatus
+		sem         *coldSem // rate_limit.max_cold_get_concurrency
 		doubleCheck bool
 		retried     bool
 		cold        bool
 	)
+	defer func() {
+		if sem != nil {
+			sem.release()
+		}
+	}()
 do: // retry uplock or ec-recovery, the latter only once
 
 	err = goi.lom.Load(true /*cache it*/, true /*locked*/)
@@ -695,6 +868,8 @@ do: // retry uplock or ec-recovery, the latter only once
 		if cs.IsOOS() {
 			return http.StatusInsufficientStorage, cs.Err()
 		}
+	} else if objName, ok := goi.lom.AliasOf(); ok {
+		return goi.alias(objName) // (the caller to follow)
 	}
 
 	switch {
@@ -763,6 +938,21 @@ do: // retry uplock or ec-recovery, the latter only once
 			return http.StatusInsufficientStorage, cs.Err()
 		}
 
+		// rate_limit.max_cold_get_concurrency: queue, if need be, without holding the lock
+		if sem == nil {
+			var errS error
+			if sem, errS = goi.t.acquireColdGet(goi.lom.Bck(), false /*wait*/); errS != nil {
+				goi.lom.Unlock(false)
+				if sem, errS = goi.t.acquireColdGet(goi.lom.Bck(), true); errS != nil {
+					goi.unlocked = true
+					return http.StatusServiceUnavailable, errS
+				}
+				goi.lom.Lock(false)
+				cold = false
+				goto do // (may've been cold-GET-ed in the meantime)
+			}
+		}
+
 		// try upgrading rlock => wlock
 		if !goi.lom.UpgradeLock() {
 			if uplock == nil {
@@ -801,14 +991,23 @@ do: // retry uplock or ec-recovery, the latter only once
 
 		// regular path
 		ecode, err = goi.coldPut(&res)
+		if sem != nil {
+			sem.release()
+			sem = nil
+		}
 		if err != nil {
 			goi.unlocked = true
 			return ecode, err
 		}
+		goi.rsize = goi.lom.Lsize()
 	}
 
 	// read locally and stream back
 fin:
+	if sem != nil {
+		sem.release()
+		sem = nil
+	}
 	var fqn string
 	fqn, ecode, err = goi.txfini()
 	if err == nil {
@@ -846,13 +1045,14 @@ func (goi *getOI) expostfacto(fqn string) error {
 		}
 		return cmn.ErrGetTxBenign
 	}
+	lom.IncHeat(goi.atime)
 	lom.SetAtimeUnix(goi.atime)
 	lom.Recache()
 	return nil
 }
 
 func (goi *getOI) isStreamingColdGet() bool {
-	if !goi.lom.IsFeatureSet(feat.StreamingColdGET) {
+	if !goi.lom.IsFeatureSet(feat.StreamingColdGET) && !goi.dpq.warmCache {
 		return false
 	}
 
@@ -862,9 +1062,11 @@ func (goi *getOI) isStreamingColdGet() bool {
 		return false
 	case goi.ranges.Range != "":
 		return false
+	case goi.dpq.decodeCE:
+		return false
 	case goi.lom.ValidateColdGet():
 		return false
-	case goi.lom.Bprops().Chunks.AutoEnabled():
+	case goi.lom.Bprops().Chunks.AutoEnabled() || goi.lom.Bprops().Chunks.AutoSizeLimit > 0:
 		return false
 	}
 	return true
@@ -911,13 +1113,16 @@ func (goi *getOI) coldPut(res *core.GetReaderResult) (int, error) {
 
 // validateRecover first validates and tries to recover a corrupted object:
 //   - validate checksums
-//   - if corrupted and IsAIS or coldGET not permitted, try to recover from redundant
-//     replicas or EC slices
+//   - if corrupted and mirrored, read-repair from a healthy local replica
+//     (and rewrite corrupted replicas, if any)
+//   - otherwise (including failed read-repair), if IsAIS or coldGET not permitted, try to recover from EC slices
 //   - otherwise, rely on the remote backend for recovery (tradeoff; TODO: make it configurable)
 func (goi *getOI) validateRecover() (coldGet bool, ecode int, err error) {
 	var (
-		lom     = goi.lom
-		retried bool
+		lom        = goi.lom
+		repaired   bool // read-repair attempted
+		retried    bool
+		keepCopies bool // not all copies are known to be corrupted
 	)
 validate:
 	err = lom.ValidateMetaChecksum()
@@ -931,53 +1136,61 @@ validate:
 	if !cos.IsErrBadCksum(err) {
 		return false, ecode, err
 	}
+	nlog.Warningln(err)
+
+	// read-repair (n-way mirror)
+	if lom.HasCopies() && !repaired {
+		repaired = true
+		lom.RemoveMain()
+		goi.lom.Unlock(false)
+		n, errRepair := lom.RepairFromCopies()
+		goi.lom.Lock(false)
+		if n > 0 {
+			nlog.Warningf("%s: repaired corrupted %s from local replica (num repaired: %d)", goi.t, lom, n)
+			goi.t.statsT.IncWith(stats.GetRepairedCount, bvlabs(lom.Bck()))
+			goto validate
+		}
+		// not all copies are known to be corrupted (e.g., missing copy, io error) - keep them
+		// and proceed to recover via cold GET or EC
+		if !cos.IsErrBadCksum(errRepair) {
+			nlog.Warningf("%s: failed to read-repair %s, err: %v", goi.t, lom, errRepair)
+			keepCopies = true
+		}
+	}
+
 	if !lom.Bck().IsAIS() && !goi.lom.IsFeatureSet(feat.DisableColdGET) {
 		return true, ecode, err
 	}
-
-	nlog.Warningln(err)
-	redundant := lom.HasCopies() || lom.ECEnabled()
 	//
-	// return err if there's no redundancy OR already recovered once (and failed)
+	// return err if there's no (remaining) redundancy OR already recovered once (and failed)
 	//
-	if retried || !redundant {
+	if retried || !lom.ECEnabled() {
 		// TODO: mark `deleted` and postpone actual deletion
-		if erl := lom.RemoveObj(true /*force through rlock*/); erl != nil {
-			nlog.Warningf("%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
+		if !keepCopies {
+			if erl := lom.RemoveObj(true /*force through rlock*/); erl != nil {
+				nlog.Warningf("%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
+			}
 		}
 		return false, ecode, err
 	}
 	//
 	// try to recover from BAD CHECKSUM
 	//
+	retried = true
+	goi.lom.Unlock(false)
 	lom.RemoveMain()
-
-	if lom.HasCopies() {
-		retried = true
-		goi.lom.Unlock(false)
-		// lookup and restore the object from local replicas
-		restored := lom.RestoreToLocation()
-		goi.lom.Lock(false)
-		if restored {
-			nlog.Warningf("%s: recovered corrupted %s from local replica", goi.t, lom)
-			goto validate
-		}
-	}
-	if lom.ECEnabled() {
-		retried = true
-		goi.lom.Unlock(false)
-		lom.RemoveMain()
-		_, ecode, err = goi.restoreFromAny(true /*skipLomRestore*/)
-		goi.lom.Lock(false)
-		if err == nil {
-			nlog.Warningf("%s: recovered corrupted %s from EC slices", goi.t, lom)
-			goto validate
-		}
+	_, ecode, err = goi.restoreFromAny(true /*skipLomRestore*/)
+	goi.lom.Lock(false)
+	if err == nil {
+		nlog.Warningf("%s: recovered corrupted %s from EC slices", goi.t, lom)
+		goto validate
 	}
 
 	// TODO: ditto
-	if erl := lom.RemoveObj(true /*force through rlock*/); erl != nil {
-		nlog.Warningf("%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
+	if !keepCopies {
+		if erl := lom.RemoveObj(true /*force through rlock*/); erl != nil {
+			nlog.Warningf("%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
+		}
 	}
 	return false, ecode, err
 }
@@ -1040,7 +1253,7 @@ func (goi *getOI) restoreFromAny(skipLomRestore bool) (doubleCheck bool, ecode i
 	}
 gfn:
 	if gfnNode != nil {
-		if goi.getFromNeighbor(goi.lom, gfnNode) {
+		if goi.t.getFromNeighbor(goi.lom, gfnNode) {
 			return false, 0, nil
 		}
 	}
@@ -1073,7 +1286,7 @@ gfn:
 	return doubleCheck, http.StatusNotFound, err
 }
 
-func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
+func (t *target) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 	config := cmn.GCO.Get()
 	params := &core.GfnParams{
 		Lom:    lom,
@@ -1081,7 +1294,7 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 		Config: config,
 		Size:   lom.Lsize(true),
 	}
-	resp, err := goi.t.GetFromNeighbor(params) //nolint:bodyclose // closed by poi.put()
+	resp, err := t.GetFromNeighbor(params) //nolint:bodyclose // closed by poi.put()
 	if err != nil {
 		nlog.Warningln(err)
 		return false
@@ -1093,11 +1306,18 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 		debug.AssertNoErr(err)
 		nlog.Errorln("invalid obj attrs from neighbor:", err)
 	}
+	// pinned to the neighbor (apc.ActMoveObject) - not restoring
+	if tid, ok := oah.GetCustomKey(cmn.PinnedObjMD); ok && tid == tsi.ID() {
+		cos.Close(resp.Body)
+		oah.DelCustomKey(cmn.PinnedObjMD)
+		nlog.Infoln(t.String(), "gfn", lom.Cname(), "is pinned to", tsi.StringEx(), "- skipping")
+		return false
+	}
 	workFQN := lom.GenFQN(fs.WorkCT, fs.WorkfileRemote)
 
 	poi := allocPOI()
 	{
-		poi.t = goi.t
+		poi.t = t
 		poi.lom = lom
 		poi.config = config
 		poi.r = resp.Body
@@ -1113,11 +1333,11 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 	freePOI(poi)
 	if erp == nil {
 		if cmn.Rom.V(5, cos.ModAIS) {
-			nlog.Infoln(goi.t.String(), "gfn", goi.lom.String(), "<=", tsi.StringEx())
+			nlog.Infoln(t.String(), "gfn", lom.String(), "<=", tsi.StringEx())
 		}
 		return true
 	}
-	nlog.Errorf("%s: gfn-GET failed to PUT locally: %v(%d)", goi.t, erp, ecode)
+	nlog.Errorf("%s: gfn-GET failed to PUT locally: %v(%d)", t, erp, ecode)
 	return false
 }
 
@@ -1129,14 +1349,13 @@ func (goi *getOI) txfini() (fqn string, ecode int, err error) {
 		lom  = goi.lom
 	)
 	// open
-	if cmn.Rom.Features().IsSet(feat.LoadBalanceGET) && !goi.cold && !dpq.isGFN && !lom.IsChunked() {
-		// [feat] best-effort GET load balancing across mirrored copies
-		if lmfh, fqn = lom.OpenCopy(); lmfh == nil {
-			fqn = lom.FQN
-			lmfh, err = lom.Open()
-		}
-	} else {
-		fqn = lom.FQN
+	var mi *fs.Mountpath
+	if policy := goi.readPolicy(); policy != "" {
+		// best-effort GET load balancing across mirrored copies
+		lmfh, fqn, mi = lom.OpenCopy(policy)
+	}
+	if lmfh == nil {
+		fqn, mi = lom.FQN, lom.Mountpath()
 		lmfh, err = lom.Open()
 	}
 
@@ -1153,10 +1372,31 @@ func (goi *getOI) txfini() (fqn string, ecode int, err error) {
 		return fqn, ecode, err
 	}
 
+	// [read-ahead] prefetch the next chunks while transmitting the current one
+	var ufr *core.UfestReader
+	if dpq.readAhead > 0 {
+		if ufr, _ = lmfh.(*core.UfestReader); ufr != nil {
+			ufr.SetReadAhead(int(dpq.readAhead))
+		}
+	}
+
+	// per-copy (i.e., per-mountpath) read counts of mirrored objects
+	if lom.HasCopies() {
+		vlabs := map[string]string{stats.VlabMountpath: mi.String()}
+		goi.t.statsT.AddWith(cos.NamedVal64{Name: stats.GetCopyCount, Value: 1, VarLabs: vlabs})
+	}
+
 	whdr := goi.w.Header()
 
-	// transmit (range, arch, regular)
+	// transmit (decoded, range, arch, regular)
 	switch {
+	case dpq.decodeCE && goi.isGzipCE():
+		if goi.ranges.Range != "" || dpq.isArch() {
+			ecode = http.StatusBadRequest
+			err = cmn.NewErrUnsupp("decode content-encoding of", lom.Cname()+" when reading range or archived file")
+			break
+		}
+		err = goi._txdecode(fqn, lmfh, whdr)
 	case goi.ranges.Range != "":
 		debug.Assert(!dpq.isArch())
 		rsize := lom.Lsize()
@@ -1174,11 +1414,35 @@ func (goi *getOI) txfini() (fqn string, ecode int, err error) {
 	}
 
 	cos.Close(lmfh)
+	if ufr != nil {
+		if n := ufr.NumReadAhead(); n > 0 {
+			goi.t.statsT.AddWith(cos.NamedVal64{Name: stats.GetReadAheadCount, Value: n, VarLabs: bvlabs(lom.Bck())})
+		}
+	}
 	return fqn, ecode, err
 }
 
+// mirror.read_policy (and, for backward compatibility, feat.LoadBalanceGET);
+// returns empty when reading the primary copy
+func (goi *getOI) readPolicy() string {
+	lom := goi.lom
+	if goi.cold || goi.dpq.isGFN || lom.IsChunked() || !lom.HasCopies() {
+		return ""
+	}
+	switch policy := lom.MirrorConf().ReadPolicy; policy {
+	case cmn.ReadPolicyRoundRobin, cmn.ReadPolicyLeastBusy:
+		return policy
+	case "":
+		if cmn.Rom.Features().IsSet(feat.LoadBalanceGET) {
+			return cmn.ReadPolicyLeastBusy
+		}
+	}
+	return ""
+}
+
 const (
 	checksumRangeSizeThreshold = 4 * cos.MiB // see goi._txrng
+	maxCollisionSuffix         = 100         // see coi.renameSuffix
 )
 
 func (goi *getOI) _txrng(fqn string, lmfh cos.LomReader, whdr http.Header, hrng *htrange) (err error) {
@@ -1338,6 +1602,42 @@ func (goi *getOI) _txreg(fqn string, lmfh cos.LomReader, whdr http.Header) (err
 	return err
 }
 
+func (goi *getOI) isGzipCE() bool {
+	ce, ok := goi.lom.GetCustomKey(cmn.ContentEncodingObjMD)
+	return ok && (strings.EqualFold(ce, "gzip") || strings.EqualFold(ce, "x-gzip"))
+}
+
+// decompress gzip-encoded remote object on read (see apc.QparamDecodeContentEncoding)
+// - decoded size is not known upfront: no Content-Length (chunked transfer)
+// - stored checksum applies to the encoded bytes: not included
+func (goi *getOI) _txdecode(fqn string, lmfh cos.LomReader, whdr http.Header) error {
+	lom := goi.lom
+	gzr, err := gzip.NewReader(lmfh)
+	if err != nil {
+		return fmt.Errorf("%s: failed to decode gzip content: %w", lom.Cname(), err)
+	}
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	cmn.ToHeader(lom.ObjAttrs(), whdr, 0 /*size*/, cos.NoneCksum)
+
+	buf, slab := goi.t.gmm.AllocSize(memsys.MaxPageSlabSize)
+	written, err := cos.CopyBuffer(goi.w, gzr, buf)
+	slab.Free(buf)
+	gzr.Close()
+
+	if err == nil {
+		goi.stats(written)
+		return nil
+	}
+	if cos.IsErrRetriableConn(err) {
+		return cmn.ErrGetTxBenign
+	}
+	nlog.Errorln("GET", lom.Cname(), fqn, "decode content-encoding:", err)
+	if written > 0 {
+		return cmn.ErrGetTxBenign // (committed)
+	}
+	return fmt.Errorf("%s: failed to decode gzip content: %w", lom.Cname(), err)
+}
+
 // TODO: checksum
 func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) error {
 	var (
@@ -1436,7 +1736,7 @@ func (goi *getOI) sendfile(r io.Reader, fqn string, size int64, committed bool)
 
 // source must be monolithic file-backed (see assert)
 func (goi *getOI) canSendfile(lmfh cos.LomReader) bool {
-	if cmn.Rom.UseHTTPS() || goi.lom.IsChunked() {
+	if cmn.Rom.UseHTTPS() || goi.lom.IsChunked() || goi.lom.IsEncoded() {
 		return false
 	}
 
@@ -1505,11 +1805,19 @@ func (goi *getOI) stats(written int64) {
 		cos.NamedVal64{Name: stats.GetLatency, Value: delta, VarLabs: vlabs},      // see also: per-backend *LatencyTotal below
 		cos.NamedVal64{Name: stats.GetLatencyTotal, Value: delta, VarLabs: vlabs}, // ditto
 	)
+	if tvlabs := tvlabs(bck, goi.req); tvlabs != nil {
+		goi.t.statsT.IncWith(stats.TagGetCount, tvlabs)
+		goi.t.statsT.AddWith(cos.NamedVal64{Name: stats.TagGetSize, Value: written, VarLabs: tvlabs})
+	}
 
 	if !goi.rget {
 		debug.Assert(!goi.verchanged)
+		if bck.IsRemote() {
+			goi.t.statsT.AddWith(cos.NamedVal64{Name: stats.GetCacheSize, Value: written, VarLabs: vlabs})
+		}
 		return
 	}
+	goi.t.statsT.AddWith(cos.NamedVal64{Name: stats.GetBackendSize, Value: goi.rsize, VarLabs: vlabs})
 
 	// always provide non-empty vlabs for backend stats
 	cname := bck.Cname("")
@@ -1638,7 +1946,11 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 		workFQN = a.lom.GenFQN(fs.WorkCT, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			if a.lom.IsEncoded() {
+				a.hdl.partialCksum, err = a.decode(workFQN, buf)
+			} else {
+				_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			}
 			a.lom.Unlock(false)
 			if err != nil {
 				return "", err
@@ -1679,6 +1991,24 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 	return packedHdl, nil
 }
 
+// object stored compressed and/or encrypted: append to its original content (compare w/ cos.CopyFile above)
+func (a *apndOI) decode(workFQN string, buf []byte) (*cos.CksumHash, error) {
+	lh, err := a.lom.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(lh)
+	fh, err := a.lom.CreateWork(workFQN)
+	if err != nil {
+		return nil, err
+	}
+	_, cksum, err := cos.CopyAndChecksum(fh, lh, buf, a.lom.CksumType())
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	return cksum, err
+}
+
 func (a *apndOI) flush() (int, error) {
 	if a.hdl.workFQN == "" {
 		return 0, fmt.Errorf("failed to finalize append-file operation: empty source in the %+v handle", a.hdl)
@@ -1745,6 +2075,14 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 	if coi.ETLArgs == nil {
 		coi.ETLArgs = &core.ETLArgs{}
 	}
+	if coi.ArgsFrom != "" {
+		// per-object transform args (apc.TCBMsg.ArgsFrom)
+		if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil {
+			if targs, ok := lom.GetCustomKey(coi.ArgsFrom); ok {
+				coi.ETLArgs.TransformArgs = targs
+			}
+		}
+	}
 
 	if coi.DryRun {
 		return coi._dryRun(lom, coi.ObjnameTo, coi.ETLArgs)
@@ -1763,6 +2101,39 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 		return xs.CoiRes{Err: err}
 	}
 
+	// resuming (apc.TCBMsg.Resume) and/or destination name collision policy (apc.CopyBckMsg.Collision):
+	// at most one local load or HEAD(destination) to learn both presence and identity
+	var (
+		dstExists, dstEq bool
+		collision        = coi.Collision != "" && coi.Collision != apc.CollisionOverwrite
+	)
+	if coi.Resume || collision {
+		dstExists, dstEq = coi.dstStat(t, tsi, lom, coi.ObjnameTo)
+	}
+
+	// skip objects copied by the previous run (and unchanged since)
+	if coi.Resume && dstEq && !coi.LatestVer && !coi.Sync {
+		return xs.CoiRes{Resumed: true}
+	}
+
+	// an existing destination that is identical to the source (e.g., copied by a previous run)
+	// is not a collision
+	if collision && dstExists && !dstEq {
+		switch coi.Collision {
+		case apc.CollisionSkip:
+			return xs.CoiRes{Collided: true, Skipped: true}
+		case apc.CollisionFail:
+			return xs.CoiRes{Err: cos.NewErrAlreadyExists(t, coi.BckTo.Cname(coi.ObjnameTo)), Ecode: http.StatusConflict, Collided: true}
+		default:
+			debug.Assert(coi.Collision == apc.CollisionRenameSuffix, coi.Collision)
+			if tsi, err = coi.renameSuffix(t, smap, lom); err != nil {
+				return xs.CoiRes{Err: err, Collided: true}
+			}
+			uname = coi.BckTo.MakeUname(coi.ObjnameTo)
+			defer func() { res.Collided = true }()
+		}
+	}
+
 	// use escaped URL to simplify parsing on the ETL side)
 	daddr, err := url.Parse(cos.JoinPath(tsi.URL(cmn.NetIntraData), url.PathEscape(cos.UnsafeS(uname))))
 	if err != nil {
@@ -1776,13 +2147,26 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 		daddr.RawQuery = q.Encode()
 	}
 
+	// ETL result caching (apc.TCBMsg.CacheResults)
+	var etlTag string
+	if coi.CacheTag != "" {
+		if etlTag = coi.etlTag(lom); etlTag != "" && coi.isCached(t, tsi, etlTag) {
+			return xs.CoiRes{Cached: true}
+		}
+	}
+
 	if tsi.ID() != t.SID() {
-		if coi.ETLArgs.Pipeline == nil {
-			coi.ETLArgs.Pipeline = make(apc.ETLPipeline, 0, 1)
+		if etlTag == "" { // direct put from ETL can't be tagged
+			if coi.ETLArgs.Pipeline == nil {
+				coi.ETLArgs.Pipeline = make(apc.ETLPipeline, 0, 1)
+			}
+			coi.ETLArgs.Pipeline.Join(daddr.String()) // attach direct put destination target to the pipeline
 		}
-		coi.ETLArgs.Pipeline.Join(daddr.String()) // attach direct put destination target to the pipeline
 		var r cos.ReadOpenCloser
 		if coi.PutWOC != nil {
+			if etlTag != "" {
+				return coi._sendWOC(t, dm, lom, tsi, etlTag)
+			}
 			_, ecode, err := coi.PutWOC(lom, coi.LatestVer, coi.Sync, nil, coi.ETLArgs)
 			return xs.CoiRes{Err: err, Ecode: ecode}
 		} else if coi.GetROC != nil {
@@ -1793,6 +2177,22 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 			}
 			coi.OAH = resp.OAH
 			r = resp.R
+			bmd, ecode, err := coi.getBackendMD(t, lom)
+			if err != nil {
+				cos.Close(r)
+				return xs.CoiRes{Err: err, Ecode: ecode}
+			}
+			if mod := coi.modBackendMD(resp.OAH, bmd); etlTag != "" || mod {
+				oa := &cmn.ObjAttrs{}
+				oa.CopyFrom(resp.OAH, false /*skip cksum*/)
+				if etlTag != "" {
+					oa.SetCustomKey(cmn.ETLSourceObjMD, etlTag)
+				}
+				if mod {
+					coi.setBackendMD(oa, bmd)
+				}
+				coi.OAH = oa
+			}
 		}
 		return coi.send(t, dm, lom, r, tsi) // lom is the source of reader if no reader specified
 	}
@@ -1805,6 +2205,9 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 	if err := dst.InitBck(coi.BckTo); err != nil {
 		return xs.CoiRes{Err: err}
 	}
+	if etlTag != "" {
+		dst.SetCustomKey(cmn.ETLSourceObjMD, etlTag)
+	}
 	dstMaxMonoSize := dst.Bprops().Chunks.MaxMonolithicSize
 
 	switch {
@@ -1831,6 +2234,9 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 	case lom.Bprops().Chunks.MaxMonolithicSize != dstMaxMonoSize && lom.Lsize() > int64(dstMaxMonoSize):
 		// source and destination buckets have different chunks config => rechunk if the source exceeds the destination's limit
 		res = coi._chunk(t, lom, dst, int64(dst.Bprops().Chunks.ChunkSize))
+	case dst.Bprops().Encryption.Enabled && lom.Bprops().Encryption != dst.Bprops().Encryption:
+		// destination encrypts (with a different key) => read and re-write rather than copy stored bytes
+		res = coi._reencode(t, lom, dst)
 	default:
 		// fast path: destination is _this_ target
 		// (note coi.send(=> another target) above)
@@ -1843,6 +2249,147 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 	return res
 }
 
+var backendObjMD = [...]string{cmn.StorageClassObjMD, cmn.ACLObjMD}
+
+// backend-specific metadata (apc.TCBMsg.PreserveBackendMeta): captured from the remote
+// source when requested and supported by the backend; otherwise, dropped
+func (coi *coi) getBackendMD(t *target, lom *core.LOM) (cos.StrKVs, int, error) {
+	if !coi.BackendMeta || !lom.Bck().IsCloud() {
+		return nil, 0, nil
+	}
+	bp, ok := t.bps[lom.Bck().Provider].(backend.MDGetter)
+	if !ok {
+		return nil, 0, nil
+	}
+	return bp.GetObjBackendMD(context.Background(), lom)
+}
+
+func (coi *coi) modBackendMD(src cos.OAH, bmd cos.StrKVs) bool {
+	if coi.BackendMeta {
+		return len(bmd) > 0
+	}
+	for _, k := range backendObjMD {
+		if _, ok := src.GetCustomKey(k); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (coi *coi) setBackendMD(dst customMDer, bmd cos.StrKVs) {
+	if coi.BackendMeta {
+		for k, v := range bmd {
+			dst.SetCustomKey(k, v)
+		}
+		return
+	}
+	for _, k := range backendObjMD {
+		dst.DelCustomKey(k)
+	}
+}
+
+// ETL name(s) + source version and checksum; empty when the source has neither
+func (coi *coi) etlTag(lom *core.LOM) string {
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return ""
+	}
+	var (
+		ver   = lom.Version()
+		cksum = lom.Checksum()
+	)
+	if ver == "" && cos.NoneC(cksum) {
+		return ""
+	}
+	tag := coi.CacheTag + "@" + ver
+	if !cos.NoneC(cksum) {
+		tag += "," + cksum.Type() + ":" + cksum.Val()
+	}
+	if coi.ETLArgs.TransformArgs != "" {
+		tag += "," + coi.ETLArgs.TransformArgs // (per-object args; see apc.TCBMsg.ArgsFrom)
+	}
+	return tag
+}
+
+// whether the destination already holds the result tagged with the same `etlTag`
+func (coi *coi) isCached(t *target, tsi *meta.Snode, etlTag string) bool {
+	dst := core.AllocLOM(coi.ObjnameTo)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(coi.BckTo); err != nil {
+		return false
+	}
+	var (
+		val string
+		ok  bool
+	)
+	if tsi.ID() == t.SID() {
+		if err := dst.Load(true /*cache it*/, false /*locked*/); err != nil {
+			return false
+		}
+		val, ok = dst.GetCustomKey(cmn.ETLSourceObjMD)
+	} else {
+		op, err := t.HeadObjT2T(dst, tsi, apc.GetPropsCustom)
+		if err != nil || op == nil {
+			return false
+		}
+		val, ok = op.GetCustomKey(cmn.ETLSourceObjMD)
+	}
+	return ok && val == etlTag
+}
+
+// whether the destination object is present in the cluster (compare with isCached)
+// and, if it is, whether it has the same (size, version, checksum) as the source (compare with isNOP);
+// identity is established only for plain copies - transformed content differs by definition
+func (coi *coi) dstStat(t *target, tsi *meta.Snode, lom *core.LOM, objName string) (exists, eq bool) {
+	dst := core.AllocLOM(objName)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(coi.BckTo); err != nil {
+		return false, false
+	}
+	var oah cos.OAH
+	if tsi.ID() == t.SID() {
+		if err := dst.Load(true /*cache it*/, false /*locked*/); err != nil {
+			return false, false
+		}
+		oah = dst
+	} else {
+		op, err := t.HeadObjT2T(dst, tsi, apc.GetPropsSize, apc.GetPropsVersion, apc.GetPropsChecksum, apc.GetPropsCustom)
+		if err != nil || op == nil {
+			return false, false
+		}
+		oah = &op.ObjAttrs
+	}
+	if coi.OWT != cmn.OwtCopy {
+		return true, false
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return true, false
+	}
+	return true, lom.CheckEq(oah) == nil
+}
+
+// apc.CollisionRenameSuffix: pick the first "<base>_<N><ext>" destination name that is either
+// available or already holds an identical copy of the source (so that re-running the same
+// copy job does not keep producing new suffixes);
+// best-effort, in that concurrent writers may still race for the same name
+func (coi *coi) renameSuffix(t *target, smap *meta.Smap, lom *core.LOM) (*meta.Snode, error) {
+	var (
+		ext  = path.Ext(coi.ObjnameTo)
+		base = strings.TrimSuffix(coi.ObjnameTo, ext)
+	)
+	for i := 1; i <= maxCollisionSuffix; i++ {
+		objName := base + "_" + strconv.Itoa(i) + ext
+		tsi, err := smap.HrwName2T(coi.BckTo.MakeUname(objName))
+		if err != nil {
+			return nil, err
+		}
+		if exists, eq := coi.dstStat(t, tsi, lom, objName); !exists || eq {
+			coi.ObjnameTo = objName
+			return tsi, nil
+		}
+	}
+	return nil, cos.NewErrAlreadyExists(t, coi.BckTo.Cname(base+"_[1-"+strconv.Itoa(maxCollisionSuffix)+"]"+ext))
+}
+
 func (coi *coi) isNOP(lom, dst *core.LOM, dm *bundle.DM) bool {
 	if coi.LatestVer || coi.Sync {
 		return false
@@ -1909,6 +2456,34 @@ func (coi *coi) _writer(t *target, lom, dst *core.LOM, args *core.ETLArgs) (res
 	return res
 }
 
+// remote destination with ETL result caching: no direct put (that can't be tagged) -
+// transform into a local work file and send the result
+func (coi *coi) _sendWOC(t *target, dm *bundle.DM, lom *core.LOM, tsi *meta.Snode, etlTag string) xs.CoiRes {
+	workFQN := lom.GenFQN(fs.WorkCT, fs.WorkfileTransform)
+	lomWriter, err := lom.CreateWork(workFQN) // closed in the `coi.PutWOC` call
+	if err != nil {
+		return xs.CoiRes{Err: err}
+	}
+	size, ecode, err := coi.PutWOC(lom, coi.LatestVer, coi.Sync, lomWriter, coi.ETLArgs)
+	if err != nil {
+		cos.RemoveFile(workFQN)
+		return xs.CoiRes{Err: err, Ecode: ecode}
+	}
+	fh, err := os.Open(workFQN)
+	cos.RemoveFile(workFQN) // (remains readable via open handle until sent)
+	if err != nil {
+		return xs.CoiRes{Err: err}
+	}
+	oa := &cmn.ObjAttrs{Size: size, Atime: time.Now().UnixNano()}
+	oa.SetCustomKey(cmn.ETLSourceObjMD, etlTag)
+	coi.OAH = oa
+	res := coi.send(t, dm, lom, cos.NopOpener(fh), tsi)
+	if res.Err == nil {
+		res.Lsize = size
+	}
+	return res
+}
+
 // PUT lom => dst
 // NOTE: no assumpions are being made on whether the source lom is present in cluster.
 // (can be a "pure" metadata of a (non-existing) Cloud object; accordingly, GetROC must
@@ -1944,9 +2519,19 @@ func (coi *coi) _reader(t *target, dm *bundle.DM, lom, dst *core.LOM, args *core
 		}
 	}
 	if poi.owt == cmn.OwtCopy {
+		bmd, ecode, err := coi.getBackendMD(t, lom)
+		if err != nil {
+			cos.Close(resp.R)
+			return xs.CoiRes{Ecode: ecode, Err: err}
+		}
 		// preserve src metadata when copying (vs. transforming)
 		dst.CopyVersion(lom)
-		dst.SetCustomMD(lom.GetCustomMD())
+		if coi.modBackendMD(lom, bmd) {
+			dst.SetCustomMD(maps.Clone(lom.GetCustomMD()))
+			coi.setBackendMD(dst, bmd)
+		} else {
+			dst.SetCustomMD(lom.GetCustomMD())
+		}
 	}
 
 	ecode, err := poi.putObject()
@@ -2018,6 +2603,35 @@ func (coi *coi) _chunk(t *target, lom, dst *core.LOM, dstChunkSize int64) (res x
 	return res
 }
 
+// read plain content and PUT it (to be stored in the destination's format - see poi.fini)
+func (coi *coi) _reencode(t *target, lom, dst *core.LOM) (res xs.CoiRes) {
+	resp := lom.GetROC(coi.LatestVer, coi.Sync)
+	if resp.Err != nil {
+		return xs.CoiRes{Ecode: resp.Ecode, Err: resp.Err}
+	}
+	poi := allocPOI()
+	defer freePOI(poi)
+	{
+		poi.t = t
+		poi.lom = dst
+		poi.r = resp.R
+		poi.size = lom.Lsize()
+		poi.workFQN = dst.GenFQN(fs.WorkCT, fs.WorkfileEncrypt)
+		poi.atime = resp.OAH.AtimeUnix()
+		poi.cksumToUse = resp.OAH.Checksum()
+		poi.xctn = coi.Xact // on behalf of
+		poi.owt = coi.OWT
+		poi.config = coi.Config
+	}
+	ecode, err := poi.putObject()
+	if err != nil {
+		return xs.CoiRes{Ecode: ecode, Err: err}
+	}
+	res.Lsize = poi.lom.Lsize()
+
+	return res
+}
+
 // send object => designated target
 // * source is a LOM or a reader (that may be reading from remote)
 // * one of the two equivalent transmission mechanisms: PUT or transport Send
@@ -2155,7 +2769,7 @@ func (a *putA2I) do() (int, error) {
 	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
+	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() && !a.lom.IsEncoded() {
 		var (
 			err       error
 			fh        *os.File
@@ -2283,10 +2897,16 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		debug.AssertNoErr(err)
 		debug.Assertf(finfo.Size() == size, "%d != %d", finfo.Size(), size)
 	})
+	// encrypt at rest, if configured
+	enc, err := a.lom.EncryptWork(fqn)
+	if err != nil {
+		return err
+	}
 	// done
 	if err := a.lom.RenameFinalize(fqn); err != nil {
 		return err
 	}
+	a.lom.SetEncrypted(enc)
 	a.lom.SetSize(size)
 	a.lom.SetCksum(cksum)
 	a.lom.SetAtimeUnix(a.started)
@@ -2370,6 +2990,54 @@ func (u *_uplock) do(lom *core.LOM) error {
 	return nil
 }
 
+// GET: best-effort per-request transmit rate cap (see apc.QparamMaxBps)
+// - token bucket that holds up to (1/8 second) worth of bytes
+// - floored at minPacedBps, lest a tiny cap keep the object rlocked for hours
+// - does not implement io.ReaderFrom and therefore disables sendfile (see canSendfile)
+const (
+	minPacedBps   = 64 * cos.KiB
+	minPacedBurst = 4 * cos.KiB
+)
+
+type pacedWriter struct {
+	http.ResponseWriter
+	bps    int64
+	burst  int64
+	tokens int64
+	refill int64 // mono time
+}
+
+func newPacedWriter(w http.ResponseWriter, bps int64) *pacedWriter {
+	bps = max(bps, minPacedBps)
+	burst := max(bps>>3, minPacedBurst)
+	return &pacedWriter{ResponseWriter: w, bps: bps, burst: burst, tokens: burst, refill: mono.NanoTime()}
+}
+
+func (pw *pacedWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		now := mono.NanoTime()
+		if elapsed := now - pw.refill; elapsed > 0 {
+			add := float64(elapsed) * float64(pw.bps) / float64(time.Second)
+			pw.tokens = int64(min(float64(pw.tokens)+add, float64(pw.burst)))
+			pw.refill = now
+		}
+		want := min(int64(len(p)), pw.burst)
+		if pw.tokens < want {
+			time.Sleep(time.Duration(float64(want-pw.tokens) * float64(time.Second) / float64(pw.bps)))
+			continue
+		}
+		var m int
+		m, err = pw.ResponseWriter.Write(p[:want])
+		n += m
+		pw.tokens -= int64(m)
+		if err != nil {
+			return n, err
+		}
+		p = p[m:]
+	}
+	return n, nil
+}
+
 // TODO:
 // - CopyBuffer
 // - currently, only tar - add message pack (what else?)
@@ -0,0 +1,143 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// store `data` with (optional) content-encoding recorded as custom metadata
+// (as cold GET does - see cmn.ContentEncodingObjMD)
+func putEncoded(t *testing.T, objName string, data []byte, ce string) *core.LOM {
+	t.Helper()
+	lom := core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       mockTarget,
+		lom:     lom,
+		r:       io.NopCloser(bytes.NewReader(data)),
+		size:    int64(len(data)),
+		workFQN: path.Join(testMountpath, objName+".work"),
+		config:  cmn.GCO.Get(),
+	}
+	_, err := poi.putObject()
+	tassert.CheckFatal(t, err)
+
+	lom.Lock(true)
+	tassert.CheckFatal(t, lom.Load(false, true))
+	if ce != "" {
+		lom.SetCustomKey(cmn.ContentEncodingObjMD, ce)
+		tassert.CheckFatal(t, lom.Persist())
+	}
+	lom.Unlock(true)
+	t.Cleanup(func() {
+		lom.RemoveMain()
+		core.FreeLOM(lom)
+	})
+	return lom
+}
+
+// GET with (or without) apc.QparamDecodeContentEncoding and, optionally, range
+func getDecoded(t *testing.T, lom *core.LOM, query, rng string) (*httptest.ResponseRecorder, int, error) {
+	t.Helper()
+	dpq := dpqAlloc()
+	t.Cleanup(func() { dpqFree(dpq) })
+	tassert.CheckFatal(t, dpq.parse(query))
+
+	w := httptest.NewRecorder()
+	goi := &getOI{
+		atime:  time.Now().UnixNano(),
+		t:      mockTarget,
+		lom:    lom,
+		w:      w,
+		dpq:    dpq,
+		ranges: byteRanges{Range: rng, Size: lom.Lsize()},
+	}
+	lom.Lock(false)
+	_, ecode, err := goi.txfini()
+	lom.Unlock(false)
+	return w, ecode, err
+}
+
+func TestGetDecodeContentEncoding(t *testing.T) {
+	var (
+		plain  = []byte(strings.Repeat("the quick brown fox jumps over the lazy dog\n", 1000))
+		gz     bytes.Buffer
+		decode = apc.QparamDecodeContentEncoding + "=true"
+	)
+	zw := gzip.NewWriter(&gz)
+	_, err := zw.Write(plain)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, zw.Close())
+	encoded := gz.Bytes()
+
+	t.Run("decode", func(t *testing.T) {
+		lom := putEncoded(t, "decode-gzip", encoded, "gzip")
+		w, _, err := getDecoded(t, lom, decode, "")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(w.Body.Bytes(), plain), "expected decoded content (%d bytes), got %d bytes", len(plain), w.Body.Len())
+		// decoded size is not known upfront; stored checksum applies to the encoded bytes
+		tassert.Errorf(t, w.Header().Get(cos.HdrContentLength) == "", "unexpected Content-Length %q", w.Header().Get(cos.HdrContentLength))
+		tassert.Errorf(t, w.Header().Get(apc.HdrObjCksumVal) == "", "unexpected checksum %q", w.Header().Get(apc.HdrObjCksumVal))
+	})
+
+	t.Run("x-gzip", func(t *testing.T) {
+		lom := putEncoded(t, "decode-xgzip", encoded, "X-GZIP")
+		w, _, err := getDecoded(t, lom, decode, "")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(w.Body.Bytes(), plain), "expected decoded content")
+	})
+
+	t.Run("not-requested", func(t *testing.T) {
+		lom := putEncoded(t, "decode-no-query", encoded, "gzip")
+		w, _, err := getDecoded(t, lom, "", "")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(w.Body.Bytes(), encoded), "expected content as stored")
+	})
+
+	t.Run("not-encoded", func(t *testing.T) {
+		lom := putEncoded(t, "decode-plain", plain, "")
+		w, _, err := getDecoded(t, lom, decode, "")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(w.Body.Bytes(), plain), "expected content as stored")
+	})
+
+	t.Run("range", func(t *testing.T) {
+		lom := putEncoded(t, "decode-range", encoded, "gzip")
+		w, ecode, err := getDecoded(t, lom, decode, cos.HdrRangeValPrefix+"0-9")
+		tassert.Fatalf(t, err != nil && ecode == http.StatusBadRequest, "expected 400, got %v(%d)", err, ecode)
+		tassert.Errorf(t, w.Body.Len() == 0, "expected nothing written, got %d bytes", w.Body.Len())
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		lom := putEncoded(t, "decode-corrupted", plain, "gzip") // not gzip
+		w, _, err := getDecoded(t, lom, decode, "")
+		tassert.Fatalf(t, err != nil && err != cmn.ErrGetTxBenign, "expected decoding error, got %v", err)
+		tassert.Errorf(t, w.Body.Len() == 0, "expected nothing written, got %d bytes", w.Body.Len())
+
+		// truncated: fails midway, after having written (committed) some content
+		lom = putEncoded(t, "decode-truncated", encoded[:len(encoded)/2], "gzip")
+		w, _, err = getDecoded(t, lom, decode, "")
+		tassert.Fatalf(t, err == cmn.ErrGetTxBenign, "expected %v, got %v", cmn.ErrGetTxBenign, err)
+		tassert.Errorf(t, w.Body.Len() > 0 && bytes.HasPrefix(plain, w.Body.Bytes()),
+			"expected partial decoded content, got %d bytes", w.Body.Len())
+	})
+}
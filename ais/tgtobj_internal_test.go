@@ -5,10 +5,16 @@
 package ais
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -127,6 +133,68 @@ func TestPutObjectChunks(tst *testing.T) {
 	}
 }
 
+// chunks.auto_size_limit: PUT, promote, and cold GET only
+func TestPutAutoChunk(t *testing.T) {
+	lom := core.AllocLOM("test-auto-chunk-obj")
+	defer core.FreeLOM(lom)
+	err := lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})
+	tassert.CheckFatal(t, err)
+
+	chunks := &lom.Bprops().Chunks
+	saved := *chunks
+	defer func() { *chunks = saved }()
+	chunks.AutoSizeLimit = 512
+	chunks.ObjSizeLimit = 0 // (x-rechunk only)
+
+	tests := []struct {
+		owt  cmn.OWT
+		size int64
+		want bool
+	}{
+		{cmn.OwtPut, 1000, true},
+		{cmn.OwtPut, 512, true},
+		{cmn.OwtPut, 511, false},
+		{cmn.OwtPut, 0, false}, // unknown size
+		{cmn.OwtPromote, 1000, true},
+		{cmn.OwtGetTryLock, 1000, true},
+		{cmn.OwtGetLock, 1000, true},
+		{cmn.OwtGet, 1000, true},
+		{cmn.OwtGetPrefetchLock, 1000, true},
+		{cmn.OwtArchive, 1000, false},
+		{cmn.OwtTransform, 1000, false},
+		{cmn.OwtCopy, 1000, false},
+		{cmn.OwtChunks, 1000, false},
+		{cmn.OwtRebalance, 1000, false},
+	}
+	for _, tt := range tests {
+		poi := &putOI{lom: lom, owt: tt.owt, size: tt.size}
+		tassert.Errorf(t, poi.autoChunk() == tt.want, "%s, size %d: expected auto-chunk %t", tt.owt, tt.size, tt.want)
+	}
+
+	// end-to-end: regular PUT at or above the threshold is stored chunked, below - monolithic
+	chunks.ChunkSize = 200
+	for _, size := range []int64{1000, 100} {
+		reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: size, CksumType: cos.ChecksumNone})
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       mockTarget,
+			lom:     lom,
+			r:       reader,
+			oreq:    &http.Request{Header: make(http.Header)},
+			workFQN: path.Join(testMountpath, "test-auto-chunk-obj.work"),
+			config:  cmn.GCO.Get(),
+			owt:     cmn.OwtPut,
+			size:    size,
+		}
+		_, err := poi.putObject()
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, lom.Load(false, false))
+		tassert.Errorf(t, lom.IsChunked() == (size >= 512), "size %d: chunked=%t", size, lom.IsChunked())
+		tassert.Errorf(t, lom.Lsize() == size, "size mismatch: expected %d, got %d", size, lom.Lsize())
+		lom.RemoveMain()
+	}
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
@@ -296,3 +364,405 @@ func BenchmarkObjGetDiscard(b *testing.B) {
 		})
 	}
 }
+
+// name collision policy: an existing destination identical to the source is not a collision
+func TestCopyDstStat(t *testing.T) {
+	bck := meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+	tassert.CheckFatal(t, bck.Init(mockTarget.owner.bmd))
+	putObj := func(name, cksum string) *core.LOM {
+		lom := core.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(bck))
+		reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: 128, CksumType: cos.ChecksumNone})
+		fh, err := cos.CreateFile(lom.FQN)
+		tassert.CheckFatal(t, err)
+		_, err = io.Copy(fh, reader)
+		fh.Close()
+		tassert.CheckFatal(t, err)
+		lom.SetSize(128)
+		lom.SetCksum(cos.NewCksum(cos.ChecksumOneXxh, cksum))
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		lom.Lock(true)
+		err = lom.Persist()
+		lom.Unlock(true)
+		tassert.CheckFatal(t, err)
+		return lom
+	}
+	var (
+		src   = putObj("coll-src.bin", "0123456789abcdef")
+		same  = putObj("coll-same.bin", "0123456789abcdef")
+		diff  = putObj("coll-diff.bin", "fedcba9876543210")
+		same2 = putObj("coll-diff_2.bin", "0123456789abcdef")
+		tsi   = mockTarget.si
+		smap  = &meta.Smap{Tmap: meta.NodeMap{tsi.ID(): tsi}}
+	)
+	defer func() {
+		for _, lom := range []*core.LOM{src, same, diff, same2} {
+			lom.RemoveMain()
+			core.FreeLOM(lom)
+		}
+	}()
+
+	tests := []struct {
+		objName    string
+		owt        cmn.OWT
+		exists, eq bool
+	}{
+		{"coll-none.bin", cmn.OwtCopy, false, false},
+		{"coll-same.bin", cmn.OwtCopy, true, true},
+		{"coll-diff.bin", cmn.OwtCopy, true, false},
+		{"coll-same.bin", cmn.OwtTransform, true, false}, // (identity not established when transforming)
+	}
+	for _, tt := range tests {
+		c := &coi{OWT: tt.owt, BckTo: bck}
+		exists, eq := c.dstStat(mockTarget, tsi, src, tt.objName)
+		tassert.Errorf(t, exists == tt.exists && eq == tt.eq, "%s (%s): expected (exists=%t, eq=%t), got (%t, %t)",
+			tt.objName, tt.owt, tt.exists, tt.eq, exists, eq)
+	}
+
+	// rename-suffix: pick the first suffixed name that is either free or holds an identical copy
+	c := &coi{OWT: cmn.OwtCopy, BckTo: bck, ObjnameTo: "coll-diff.bin"}
+	_, err := c.renameSuffix(mockTarget, smap, src)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, c.ObjnameTo == "coll-diff_1.bin", "expected first available suffix, got %q", c.ObjnameTo)
+
+	// occupied "_1" (different content) => reuse "_2" that holds an identical copy
+	diff1 := putObj("coll-diff_1.bin", "fedcba9876543210")
+	defer func() { diff1.RemoveMain(); core.FreeLOM(diff1) }()
+	c.ObjnameTo = "coll-diff.bin"
+	_, err = c.renameSuffix(mockTarget, smap, src)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, c.ObjnameTo == "coll-diff_2.bin", "expected identical suffixed copy to be reused, got %q", c.ObjnameTo)
+}
+
+type fakeMDGetter struct {
+	core.Backend
+	md    cos.StrKVs
+	err   error
+	calls int
+}
+
+func (bp *fakeMDGetter) GetObjBackendMD(context.Context, *core.LOM) (cos.StrKVs, int, error) {
+	bp.calls++
+	return bp.md, 0, bp.err
+}
+
+// apc.TCBMsg.PreserveBackendMeta: remote (cloud) source => ais
+func TestCopyBackendMD(t *testing.T) {
+	srcBck := meta.NewBck("pbm-src", apc.AWS, cmn.NsGlobal)
+	bmd := mockTarget.owner.bmd.get().clone()
+	bmd.add(srcBck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+	mockTarget.owner.bmd.putPersist(bmd, nil)
+	tassert.CheckFatal(t, srcBck.Init(mockTarget.owner.bmd))
+	dstBck := meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+	tassert.CheckFatal(t, dstBck.Init(mockTarget.owner.bmd))
+
+	bp := &fakeMDGetter{md: cos.StrKVs{cmn.StorageClassObjMD: "STANDARD_IA", cmn.ACLObjMD: `READ:uri="all"`}}
+	saved := mockTarget.bps
+	mockTarget.bps = backends{apc.AWS: bp}
+	defer func() { mockTarget.bps = saved }()
+
+	const size = 128
+	src := core.AllocLOM("pbm.bin")
+	defer core.FreeLOM(src)
+	tassert.CheckFatal(t, src.InitBck(srcBck))
+
+	copyObj := func(preserve bool, srcMD cos.StrKVs) *core.LOM {
+		src.SetCustomMD(srcMD)
+		c := &coi{OWT: cmn.OwtCopy, BckTo: dstBck, ObjnameTo: "pbm-dst.bin", Config: cmn.GCO.Get()}
+		c.BackendMeta = preserve
+		c.GetROC = func(*core.LOM, bool, bool, *core.ETLArgs) core.ReadResp {
+			reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: size, CksumType: cos.ChecksumNone})
+			return core.ReadResp{R: reader, OAH: &cmn.ObjAttrs{Size: size, Atime: time.Now().UnixNano()}}
+		}
+		dst := core.AllocLOM(c.ObjnameTo)
+		tassert.CheckFatal(t, dst.InitBck(dstBck))
+		res := c._reader(mockTarget, nil, src, dst, &core.ETLArgs{})
+		tassert.CheckFatal(t, res.Err)
+		core.FreeLOM(dst)
+
+		dst = core.AllocLOM(c.ObjnameTo)
+		tassert.CheckFatal(t, dst.InitBck(dstBck))
+		tassert.CheckFatal(t, dst.Load(false, false))
+		return dst
+	}
+	userMD := cos.StrKVs{cmn.SourceObjMD: apc.AWS, "X-Amz-Meta-Color": "blue"}
+
+	// not requested: nothing captured, user metadata retained
+	dst := copyObj(false, userMD)
+	_, ok := dst.GetCustomKey(cmn.StorageClassObjMD)
+	tassert.Errorf(t, !ok, "unexpected %s", cmn.StorageClassObjMD)
+	v, _ := dst.GetCustomKey("X-Amz-Meta-Color")
+	tassert.Errorf(t, v == "blue", "user metadata: expected %q, got %q", "blue", v)
+	tassert.Errorf(t, bp.calls == 0, "expected no backend requests, got %d", bp.calls)
+	dst.RemoveMain()
+	core.FreeLOM(dst)
+
+	// requested: captured
+	dst = copyObj(true, userMD)
+	for k, expected := range bp.md {
+		v, _ := dst.GetCustomKey(k)
+		tassert.Errorf(t, v == expected, "%s: expected %q, got %q", k, expected, v)
+	}
+	v, _ = dst.GetCustomKey("X-Amz-Meta-Color")
+	tassert.Errorf(t, v == "blue", "user metadata: expected %q, got %q", "blue", v)
+	tassert.Errorf(t, bp.calls == 1, "expected a single backend request, got %d", bp.calls)
+	_, ok = src.GetCustomKey(cmn.ACLObjMD)
+	tassert.Errorf(t, !ok, "source metadata must not be modified")
+	dst.RemoveMain()
+	core.FreeLOM(dst)
+
+	// not requested: previously captured metadata gets dropped
+	dst = copyObj(false, cos.StrKVs{cmn.SourceObjMD: apc.AWS, cmn.StorageClassObjMD: "GLACIER", cmn.ACLObjMD: `READ:uri="all"`})
+	for _, k := range backendObjMD {
+		_, ok := dst.GetCustomKey(k)
+		tassert.Errorf(t, !ok, "unexpected %s", k)
+	}
+	dst.RemoveMain()
+	core.FreeLOM(dst)
+
+	// failing to capture fails the copy
+	bp.err = errors.New("access denied")
+	c := &coi{OWT: cmn.OwtCopy, BckTo: dstBck, ObjnameTo: "pbm-dst.bin", Config: cmn.GCO.Get()}
+	c.BackendMeta = true
+	c.GetROC = func(*core.LOM, bool, bool, *core.ETLArgs) core.ReadResp {
+		reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: size, CksumType: cos.ChecksumNone})
+		return core.ReadResp{R: reader, OAH: &cmn.ObjAttrs{Size: size}}
+	}
+	dst = core.AllocLOM(c.ObjnameTo)
+	defer core.FreeLOM(dst)
+	tassert.CheckFatal(t, dst.InitBck(dstBck))
+	res := c._reader(mockTarget, nil, src, dst, &core.ETLArgs{})
+	tassert.Errorf(t, res.Err != nil, "expected failure to capture backend metadata")
+	tassert.Errorf(t, cos.Stat(dst.FQN) != nil, "expected no destination object")
+}
+
+// memsys.oom_reject_put: client PUTs only
+func TestOOMRejectPut(t *testing.T) {
+	config := &cmn.Config{}
+	config.Memsys.OOMRejectPut = true
+	config.Memsys.OOMPutSize = cos.SizeIEC(cos.MiB)
+
+	tests := []struct {
+		name   string
+		owt    string // apc.QparamOWT
+		hdr    string // intra-cluster header
+		size   int64
+		reject bool
+	}{
+		{"client, large", "", "", 2 * cos.MiB, true},
+		{"client, unknown size", "", "", -1, true},
+		{"client, small", "", "", cos.KiB, false},
+		{"copy", cmn.OwtCopy.ToS(), "", 2 * cos.MiB, false},
+		{"transform", cmn.OwtTransform.ToS(), "", -1, false},
+		{"t2t putter", "", apc.HdrT2TPutterID, 2 * cos.MiB, false},
+		{"intra-cluster sender", "", apc.HdrSenderID, 2 * cos.MiB, false},
+	}
+	for _, tt := range tests {
+		q := url.Values{}
+		if tt.owt != "" {
+			q.Set(apc.QparamOWT, tt.owt)
+		}
+		dpq := dpqAlloc()
+		tassert.CheckFatal(t, dpq.parse(q.Encode()))
+		r := &http.Request{Header: make(http.Header), ContentLength: tt.size}
+		if tt.hdr != "" {
+			r.Header.Set(tt.hdr, "t1")
+		}
+		tassert.Errorf(t, oomRejectPut(config, r, dpq) == tt.reject, "%s: expected reject=%t", tt.name, tt.reject)
+		dpqFree(dpq)
+	}
+
+	config.Memsys.OOMRejectPut = false
+	dpq := dpqAlloc()
+	r := &http.Request{Header: make(http.Header), ContentLength: -1}
+	tassert.Errorf(t, !oomRejectPut(config, r, dpq), "disabled: expected no reject")
+	dpqFree(dpq)
+}
+
+func TestPacedWriter(t *testing.T) {
+	const (
+		bps  = cos.MiB
+		size = 512 * cos.KiB
+	)
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	rec := httptest.NewRecorder()
+	pw := newPacedWriter(rec, bps)
+	started := time.Now()
+	n, err := io.CopyBuffer(pw, bytes.NewReader(data), make([]byte, 32*cos.KiB))
+	elapsed := time.Since(started)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == size && bytes.Equal(rec.Body.Bytes(), data), "content mismatch (written %d)", n)
+
+	// the initial burst (1/8s worth) goes out at once, the rest at the configured rate
+	expected := time.Duration(float64(size-bps>>3) / bps * float64(time.Second))
+	tassert.Errorf(t, elapsed >= expected*9/10, "too fast: %v (expected at least %v)", elapsed, expected)
+	tassert.Errorf(t, elapsed < expected*3, "too slow: %v (expected about %v)", elapsed, expected)
+
+	// floor
+	pw = newPacedWriter(httptest.NewRecorder(), 1)
+	tassert.Errorf(t, pw.bps == minPacedBps, "expected rate floored at %d, got %d", minPacedBps, pw.bps)
+}
+
+// same-content PUT without TTL is not a no-op if the previous version had one
+func TestPutClearTTL(t *testing.T) {
+	const objName = "test-ttl-obj"
+	data := bytes.Repeat([]byte("ttl"), cos.KiB)
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+	defer lom.RemoveMain()
+
+	// (no-op PUT requires checksums)
+	cksumConf := &lom.Bprops().Cksum
+	saved := *cksumConf
+	defer func() { *cksumConf = saved }()
+	cksumConf.Type = cos.ChecksumOneXxh
+
+	// t2t: intra-cluster PUT (e.g., copy or move) carrying the sender's custom metadata, if any
+	put := func(ttl string, t2t bool, senderExpires string) {
+		hdr := make(http.Header)
+		if ttl != "" {
+			hdr.Set(apc.HdrObjTTL, ttl)
+		}
+		if senderExpires != "" {
+			hdr.Add(apc.HdrObjCustomMD, cmn.ExpiresObjMD+"="+senderExpires)
+		}
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       mockTarget,
+			lom:     lom,
+			r:       io.NopCloser(bytes.NewReader(data)),
+			size:    int64(len(data)),
+			workFQN: path.Join(testMountpath, objName+".work"),
+			config:  cmn.GCO.Get(),
+			t2t:     t2t,
+		}
+		if lom.Load(false, false) == nil {
+			poi.cksumToUse = lom.Checksum().Clone() // identical content
+		}
+		_, err := lom.ObjAttrs().FromHeader(hdr)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, poi.ttl(hdr))
+		_, err = poi.putObject()
+		tassert.CheckFatal(t, err)
+	}
+	expires := func() string {
+		lom.UncacheDel()
+		tassert.CheckFatal(t, lom.Load(false, false))
+		v, _ := lom.GetCustomKey(cmn.ExpiresObjMD)
+		return v
+	}
+
+	put("1h", false, "")
+	tassert.Fatalf(t, expires() != "", "expected %q in custom metadata", cmn.ExpiresObjMD)
+	put("", false, "")
+	tassert.Fatalf(t, expires() == "", "expected %q removed", cmn.ExpiresObjMD)
+
+	// copy or move keeps the sender's expiration
+	senderExpires := strconv.FormatInt(time.Now().Add(time.Hour).UnixNano(), 10)
+	put("", true, senderExpires)
+	tassert.Fatalf(t, expires() == senderExpires, "expected %q=%s, got %q", cmn.ExpiresObjMD, senderExpires, expires())
+	put("", true, "")
+	tassert.Fatalf(t, expires() == "", "expected %q removed (sender has none)", cmn.ExpiresObjMD)
+}
+
+// GFN must not restore an object that is pinned to the neighbor (apc.ActMoveObject)
+func TestGfnPinned(t *testing.T) {
+	const objName = "test-gfn-obj"
+	data := bytes.Repeat([]byte("gfn"), cos.KiB)
+
+	saved := g.client.data
+	g.client.data = &http.Client{}
+	defer func() { g.client.data = saved }()
+
+	// (GFN timeout)
+	config := cmn.GCO.BeginUpdate()
+	savedTout := config.Client.Timeout
+	config.Client.Timeout = cos.Duration(10 * time.Second)
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Client.Timeout = savedTout
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	tsi := &meta.Snode{DaeID: "neighbor", DaeType: apc.Target}
+	for _, pinned := range []bool{true, false} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tassert.Errorf(t, r.URL.Query().Get(apc.QparamIsGFNRequest) == "true", "expecting GFN request")
+			oa := &cmn.ObjAttrs{Size: int64(len(data)), Atime: time.Now().UnixNano()}
+			if pinned {
+				oa.SetCustomKey(cmn.PinnedObjMD, tsi.ID())
+			}
+			cmn.ToHeader(oa, w.Header(), oa.Size)
+			w.Write(data)
+		}))
+		tsi.DataNet.URL = srv.URL
+
+		lom := core.AllocLOM(objName)
+		tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+
+		ok := mockTarget.getFromNeighbor(lom, tsi)
+		srv.Close()
+		tassert.Errorf(t, ok == !pinned, "pinned=%t: expected gfn %t, got %t", pinned, !pinned, ok)
+
+		err := lom.Load(false, false)
+		if pinned {
+			tassert.Errorf(t, cos.IsNotExist(err), "pinned object must not be restored locally (err %v)", err)
+		} else {
+			tassert.CheckError(t, err)
+			tassert.Errorf(t, lom.Lsize() == int64(len(data)), "expected size %d, got %d", len(data), lom.Lsize())
+			lom.RemoveMain()
+		}
+		core.FreeLOM(lom)
+	}
+}
+
+// ETL result caching, remote destination: transformed size and tag
+func TestSendWOC(t *testing.T) {
+	const etlTag = "md5@v1"
+	data := bytes.Repeat([]byte("woc"), cos.KiB)
+
+	saved := g.client.data
+	g.client.data = &http.Client{}
+	defer func() { g.client.data = saved }()
+
+	var (
+		rsize int64
+		rtag  string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		oa := &cmn.ObjAttrs{}
+		_, err := oa.FromHeader(r.Header)
+		tassert.CheckError(t, err)
+		rtag, _ = oa.GetCustomKey(cmn.ETLSourceObjMD)
+		rsize, err = io.Copy(io.Discard, r.Body)
+		tassert.CheckError(t, err)
+	}))
+	defer srv.Close()
+	tsi := &meta.Snode{DaeID: "dst", DaeType: apc.Target}
+	tsi.DataNet.URL = srv.URL
+
+	lom := core.AllocLOM("test-woc-obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+
+	bck := meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+	config := *cmn.GCO.Get()
+	config.Timeout.SendFile = cos.Duration(10 * time.Second)
+	c := &coi{OWT: cmn.OwtTransform, BckTo: bck, ObjnameTo: "test-woc-dst", Config: &config}
+	c.PutWOC = func(_ *core.LOM, _, _ bool, woc io.WriteCloser, _ *core.ETLArgs) (int64, int, error) {
+		n, err := woc.Write(data)
+		woc.Close()
+		return int64(n), 0, err
+	}
+	res := c._sendWOC(mockTarget, nil /*dm*/, lom, tsi, etlTag)
+	tassert.CheckFatal(t, res.Err)
+	tassert.Errorf(t, res.Lsize == int64(len(data)), "expected result size %d, got %d", len(data), res.Lsize)
+	tassert.Errorf(t, rsize == int64(len(data)), "expected to send %d bytes, sent %d", len(data), rsize)
+	tassert.Errorf(t, rtag == etlTag, "expected %s=%q, got %q", cmn.ETLSourceObjMD, etlTag, rtag)
+}
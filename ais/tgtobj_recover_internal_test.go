@@ -0,0 +1,167 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const recoverObjSize = 4 * cos.KiB
+
+// mirrored (n-way) object with corrupted main replica; returns the copies
+func newCorrupted(t *testing.T, bck *meta.Bck, objName string, n int) (*core.LOM, []string) {
+	t.Helper()
+	lom := core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, make([]byte, recoverObjSize), cos.PermRWR))
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	lom.SetSize(recoverObjSize)
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	tassert.CheckFatal(t, lom.Persist())
+	_, err := lom.ComputeSetCksum(true /*locked*/)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, lom.Persist())
+
+	var copies []string
+	buf := make([]byte, recoverObjSize)
+	for _, mi := range fs.GetAvail() {
+		if mi.Path == lom.Mountpath().Path || len(copies) == n-1 {
+			continue
+		}
+		tassert.CheckFatal(t, lom.Copy(mi, buf))
+		copies = append(copies, mi.MakePathFQN(lom.Bucket(), fs.ObjCT, objName))
+	}
+	tassert.CheckFatal(t, lom.Persist())
+	tassert.Fatalf(t, lom.NumCopies() == n, "expected %d copies, got %d", n, lom.NumCopies())
+
+	corrupt(t, lom.FQN)
+	return lom, copies
+}
+
+// same size, different content
+func corrupt(t *testing.T, fqn string) {
+	t.Helper()
+	data := make([]byte, recoverObjSize)
+	data[0] = 0xff
+	tassert.CheckFatal(t, os.WriteFile(fqn, data, cos.PermRWR))
+}
+
+func TestValidateRecover(t *testing.T) {
+	// two more mountpaths (copies)
+	for range 2 {
+		mpath := filepath.Join(t.TempDir(), "mpath")
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.AddTestMpath(mpath, mockTarget.SID())
+		tassert.CheckFatal(t, err)
+		t.Cleanup(func() { fs.Remove(mpath) })
+	}
+
+	var (
+		bck       = meta.NewBck("recover-bck", apc.AIS, cmn.NsGlobal)
+		remoteBck = meta.NewBck("recover-remote-bck", apc.AWS, cmn.NsGlobal)
+		bmd       = mockTarget.owner.bmd.get().clone()
+	)
+	bmd.add(bck, &cmn.Bprops{
+		Cksum:  cmn.CksumConf{Type: cos.ChecksumOneXxh},
+		Mirror: cmn.MirrorConf{Enabled: true, Copies: 3},
+	})
+	bmd.add(remoteBck, &cmn.Bprops{
+		Cksum:  cmn.CksumConf{Type: cos.ChecksumOneXxh},
+		Mirror: cmn.MirrorConf{Enabled: true, Copies: 2},
+	})
+	mockTarget.owner.bmd.putPersist(bmd, nil)
+	for _, b := range []*meta.Bck{bck, remoteBck} {
+		tassert.CheckFatal(t, b.Init(mockTarget.owner.bmd))
+		fs.CreateBucket(b.Bucket(), false /*nilbmd*/)
+	}
+
+	run := func(lom *core.LOM) (bool, int, error) {
+		goi := &getOI{t: mockTarget}
+		goi.lom = core.AllocLOM(lom.ObjName)
+		defer core.FreeLOM(goi.lom)
+		tassert.CheckFatal(t, goi.lom.InitBck(lom.Bck()))
+		goi.lom.UncacheUnless()
+		goi.lom.Lock(false)
+		defer goi.lom.Unlock(false)
+		tassert.CheckFatal(t, goi.lom.Load(false /*cache it*/, true /*locked*/))
+		return goi.validateRecover()
+	}
+	validate := func(lom *core.LOM) (int, error) {
+		coldGet, ecode, err := run(lom)
+		tassert.Errorf(t, !coldGet, "ais bucket: unexpected cold GET")
+		return ecode, err
+	}
+
+	t.Run("good-copy", func(t *testing.T) {
+		lom, copies := newCorrupted(t, bck, "good-copy", 3)
+		defer core.FreeLOM(lom)
+		corrupt(t, copies[0])
+
+		_, err := validate(lom)
+		tassert.CheckFatal(t, err)
+		// (the corrupted copy gets rewritten only when visited prior to the healthy one)
+		for _, fqn := range []string{lom.FQN, copies[1]} {
+			clone := lom.CloneTo(fqn)
+			tassert.CheckFatal(t, clone.InitFQN(fqn, lom.Bucket()))
+			tassert.CheckFatal(t, clone.Load(false, false))
+			tassert.Errorf(t, clone.ValidateContentChecksum(false) == nil, "%s: expected healthy", fqn)
+			core.FreeLOM(clone)
+		}
+	})
+
+	t.Run("all-bad", func(t *testing.T) {
+		lom, copies := newCorrupted(t, bck, "all-bad", 3)
+		defer core.FreeLOM(lom)
+		for _, fqn := range copies {
+			corrupt(t, fqn)
+		}
+
+		_, err := validate(lom)
+		tassert.Fatalf(t, cos.IsErrBadCksum(err), "expected bad checksum, got %v", err)
+		for _, fqn := range append(copies, lom.FQN) {
+			tassert.Errorf(t, cos.Stat(fqn) != nil, "%s: expected corrupted replica removed", fqn)
+		}
+	})
+
+	t.Run("io-error", func(t *testing.T) {
+		lom, copies := newCorrupted(t, bck, "io-error", 3)
+		defer core.FreeLOM(lom)
+		corrupt(t, copies[0])
+		// unreadable
+		tassert.CheckFatal(t, os.Remove(copies[1]))
+		tassert.CheckFatal(t, cos.CreateDir(copies[1]))
+
+		// no EC: nothing to recover from
+		_, err := validate(lom)
+		tassert.Fatalf(t, err != nil, "expected failure to recover")
+		tassert.Errorf(t, cos.Stat(copies[0]) == nil, "%s: expected kept", copies[0])
+		tassert.Errorf(t, cos.Stat(copies[1]) == nil, "%s: expected kept", copies[1])
+		tassert.CheckFatal(t, os.RemoveAll(copies[1]))
+	})
+
+	// failed read-repair must not prevent cold GET
+	t.Run("remote-missing-copy", func(t *testing.T) {
+		lom, copies := newCorrupted(t, remoteBck, "missing-copy", 2)
+		defer core.FreeLOM(lom)
+		tassert.CheckFatal(t, os.Remove(copies[0]))
+
+		coldGet, _, err := run(lom)
+		tassert.Errorf(t, err != nil, "expected (bad checksum) error")
+		tassert.Errorf(t, coldGet, "remote bucket: expected cold GET")
+	})
+}
@@ -0,0 +1,149 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const trailerObjSize = 64 * cos.KiB
+
+// sets the specified trailer value (or none) upon EOF
+type fakeTrailer struct {
+	io.ReadCloser
+	hdr http.Header
+	val string
+}
+
+func (ft *fakeTrailer) Read(p []byte) (n int, err error) {
+	n, err = ft.ReadCloser.Read(p)
+	if err == io.EOF && ft.val != "" {
+		ft.hdr.Set(apc.HdrObjCksumVal, ft.val)
+	}
+	return n, err
+}
+
+func wrapFakeTrailer(req *http.Request, val string) {
+	ft := &fakeTrailer{ReadCloser: req.Body, hdr: http.Header{apc.HdrObjCksumVal: nil}, val: val}
+	req.Body, req.Trailer = ft, ft.hdr
+	req.ContentLength = -1
+}
+
+// target: PUT(object) handler; proxy: redirects
+func newTrailerServers(t *testing.T) (proxy, target *httptest.Server) {
+	target = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		items := strings.Split(strings.TrimPrefix(r.URL.Path, apc.URLPathObjects.S+"/"), "/")
+		lom := core.AllocLOM(items[1])
+		defer core.FreeLOM(lom)
+		if err := lom.InitBck(&meta.Bck{Name: items[0], Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		dpq := dpqAlloc()
+		defer dpqFree(dpq)
+		if err := dpq.parse(r.URL.RawQuery); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		poi := &putOI{atime: time.Now().UnixNano(), t: mockTarget, lom: lom, config: cmn.GCO.Get()}
+		if ecode, err := poi.do(w.Header(), r, dpq); err != nil {
+			http.Error(w, err.Error(), cos.NonZero(ecode, http.StatusInternalServerError))
+		}
+	}))
+	proxy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL+r.URL.RequestURI(), http.StatusTemporaryRedirect)
+	}))
+	t.Cleanup(proxy.Close)
+	t.Cleanup(target.Close)
+	return proxy, target
+}
+
+func TestPutChecksumTrailer(t *testing.T) {
+	proxy, target := newTrailerServers(t)
+	bck := cmn.Bck{Name: testBucket, Provider: apc.AIS}
+
+	exists := func(objName string) bool {
+		lom := core.AllocLOM(objName)
+		defer core.FreeLOM(lom)
+		tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+		return lom.Load(false, false) == nil
+	}
+	cleanup := func(objName string) {
+		lom := core.AllocLOM(objName)
+		defer core.FreeLOM(lom)
+		if lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}) == nil {
+			lom.RemoveMain()
+		}
+	}
+
+	// good: api.PutObject, with and without proxy => target redirect
+	for _, url := range []string{target.URL, proxy.URL} {
+		objName := "trailer-good"
+		reader, err := readers.New(&readers.Arg{Type: readers.Rand, Size: trailerObjSize, CksumType: cos.ChecksumOneXxh})
+		tassert.CheckFatal(t, err)
+		_, err = api.PutObject(&api.PutArgs{
+			BaseParams:            api.BaseParams{Client: &http.Client{}, URL: url},
+			Bck:                   bck,
+			ObjName:               objName,
+			Reader:                reader,
+			Cksum:                 cos.NewCksum(cos.ChecksumOneXxh, ""),
+			ExpectChecksumTrailer: true,
+		})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, exists(objName), "%s: expected %q stored", url, objName)
+		cleanup(objName)
+	}
+
+	// bad and missing: raw PUT (the trailer re-wrapped upon redirect)
+	tests := []struct {
+		name string
+		val  string
+	}{
+		{"trailer-bad", "0123456789abcdef"},
+		{"trailer-missing", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, url := range []string{target.URL, proxy.URL} {
+				reader, err := readers.New(&readers.Arg{Type: readers.Rand, Size: trailerObjSize, CksumType: cos.ChecksumNone})
+				tassert.CheckFatal(t, err)
+				req, err := http.NewRequest(http.MethodPut, url+apc.URLPathObjects.Join(testBucket, tt.name), reader)
+				tassert.CheckFatal(t, err)
+				req.Header.Set(apc.HdrObjCksumType, cos.ChecksumOneXxh)
+				req.GetBody = func() (io.ReadCloser, error) { return reader.Open() }
+				wrapFakeTrailer(req, tt.val)
+
+				client := &http.Client{
+					CheckRedirect: func(req *http.Request, _ []*http.Request) error {
+						wrapFakeTrailer(req, tt.val)
+						return nil
+					},
+				}
+				resp, err := client.Do(req)
+				tassert.CheckFatal(t, err)
+				cos.DrainReader(resp.Body)
+				resp.Body.Close()
+
+				tassert.Errorf(t, resp.StatusCode >= http.StatusBadRequest, "%s: expected failure, got %d", url, resp.StatusCode)
+				tassert.Errorf(t, !exists(tt.name), "%s: %q must not be stored", url, tt.name)
+				cleanup(tt.name)
+			}
+		})
+	}
+}
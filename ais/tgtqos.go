@@ -0,0 +1,202 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+//
+// read admission with priority (QoS) classes (config.read_qos, bucket rate_limit.qos_class)
+// - up to read_qos.max_reads concurrent GETs per target; the rest queue by class
+// - freed slots go to the queued classes in proportion to their weights (stride scheduling):
+//   the class with the smallest pass wins; ties go to the higher priority
+// - a class that was idle does not accumulate credit (its pass catches up with vtime)
+// - queued GETs time out after timeout.max_host_busy (503)
+//
+
+const (
+	qosHigh = iota
+	qosNormal
+	qosLow
+	qosNumClasses
+)
+
+const qosStride = 1 << 20 // per-class stride = qosStride / weight
+
+var (
+	qosQueued = [qosNumClasses]string{stats.QoSHighQueued, stats.QoSNormalQueued, stats.QoSLowQueued}
+	qosWait   = [qosNumClasses]string{stats.QoSHighWait, stats.QoSNormalWait, stats.QoSLowWait}
+)
+
+type (
+	qosWaiter struct {
+		ch      chan struct{}
+		granted bool // under readQoS lock
+	}
+	qosQueue struct {
+		waiters []*qosWaiter
+		pass    int64
+	}
+	readQoS struct {
+		su       cos.StatsUpdater
+		queues   [qosNumClasses]qosQueue
+		vtime    int64 // pass of the most recently served class
+		inflight int
+		mu       sync.Mutex
+	}
+)
+
+// GET: bucket's class unless overridden by the request
+func qosClass(r *http.Request, bck *meta.Bck) (int, error) {
+	cls := r.Header.Get(apc.HdrQoSClass)
+	if cls == "" {
+		cls = bck.Props.RateLimit.QoSClass
+	}
+	switch cls {
+	case apc.QoSHigh:
+		return qosHigh, nil
+	case "", apc.QoSNormal:
+		return qosNormal, nil
+	case apc.QoSLow:
+		return qosLow, nil
+	default:
+		return 0, cmn.NewErrUnsupp("read with QoS class", cls)
+	}
+}
+
+func qosWeight(conf *cmn.ReadQoSConf, cls int) int64 {
+	var w int
+	switch cls {
+	case qosHigh:
+		w = conf.WeightHigh
+	case qosNormal:
+		w = conf.WeightNormal
+	default:
+		w = conf.WeightLow
+	}
+	return int64(max(w, 1))
+}
+
+// returns true when admitted (in which case the caller must release)
+func (t *target) admitRead(r *http.Request, bck *meta.Bck, config *cmn.Config) (bool, error) {
+	if config.ReadQoS.MaxReads <= 0 {
+		return false, nil
+	}
+	cls, err := qosClass(r, bck)
+	if err != nil {
+		return false, err
+	}
+	if err := t.rqos.acquire(cls, &config.ReadQoS, config.Timeout.MaxHostBusy.D()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (q *readQoS) acquire(cls int, conf *cmn.ReadQoSConf, timeout time.Duration) error {
+	q.mu.Lock()
+
+	// fast path: a free slot and no one waiting
+	if q.inflight < conf.MaxReads && q.numQueued() == 0 {
+		q.inflight++
+		q._wait(cls, 0)
+		q.mu.Unlock()
+		return nil
+	}
+
+	// queue
+	var (
+		qq      = &q.queues[cls]
+		w       = &qosWaiter{ch: make(chan struct{})}
+		started = mono.NanoTime()
+	)
+	if len(qq.waiters) == 0 {
+		qq.pass = max(qq.pass, q.vtime)
+	}
+	qq.waiters = append(qq.waiters, w)
+	q.su.Add(qosQueued[cls], 1)
+	q._grant(conf) // (in case max_reads has been increased)
+	q.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	select {
+	case <-w.ch:
+	case <-timer.C:
+	}
+	timer.Stop()
+
+	q.mu.Lock()
+	q.su.Add(qosQueued[cls], -1)
+	if !w.granted {
+		for i, wi := range qq.waiters {
+			if wi == w {
+				qq.waiters = append(qq.waiters[:i], qq.waiters[i+1:]...)
+				break
+			}
+		}
+		q.mu.Unlock()
+		return cmn.NewErrBusy("target", "read admission", "read_qos.max_reads "+strconv.Itoa(conf.MaxReads))
+	}
+	q._wait(cls, mono.Since(started))
+	q.mu.Unlock()
+	return nil
+}
+
+func (q *readQoS) release(conf *cmn.ReadQoSConf) {
+	q.mu.Lock()
+	q.inflight--
+	q._grant(conf)
+	q.mu.Unlock()
+}
+
+// hand freed slots to the queued; max_reads == 0 (disabled at runtime) admits everyone
+func (q *readQoS) _grant(conf *cmn.ReadQoSConf) {
+	for conf.MaxReads <= 0 || q.inflight < conf.MaxReads {
+		cls := -1
+		for i := range q.queues {
+			if len(q.queues[i].waiters) > 0 && (cls < 0 || q.queues[i].pass < q.queues[cls].pass) {
+				cls = i
+			}
+		}
+		if cls < 0 {
+			return
+		}
+		qq := &q.queues[cls]
+		w := qq.waiters[0]
+		qq.waiters[0] = nil
+		qq.waiters = qq.waiters[1:]
+		q.vtime = qq.pass
+		qq.pass += qosStride / qosWeight(conf, cls)
+
+		w.granted = true
+		close(w.ch)
+		q.inflight++
+	}
+}
+
+func (q *readQoS) numQueued() (n int) {
+	for i := range q.queues {
+		n += len(q.queues[i].waiters)
+	}
+	return n
+}
+
+// (gauge: set via delta)
+func (q *readQoS) _wait(cls int, d time.Duration) {
+	name := qosWait[cls]
+	if delta := int64(d) - q.su.Get(name); delta != 0 {
+		q.su.Add(name, delta)
+	}
+}
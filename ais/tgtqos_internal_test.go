@@ -0,0 +1,129 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newTestQoSConf(maxReads int) *cmn.ReadQoSConf {
+	conf := &cmn.ReadQoSConf{MaxReads: maxReads}
+	if err := conf.Validate(); err != nil {
+		panic(err)
+	}
+	return conf
+}
+
+func (q *readQoS) waitQueued(n int) {
+	for {
+		q.mu.Lock()
+		m := q.numQueued()
+		q.mu.Unlock()
+		if m == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestReadQoSWeighted(t *testing.T) {
+	var (
+		q       = &readQoS{su: mock.NewStatsTracker()}
+		conf    = newTestQoSConf(1)
+		granted = make(chan int, 16)
+	)
+	tassert.CheckFatal(t, q.acquire(qosNormal, conf, time.Minute))
+
+	// batch reads queue first, interactive ones follow
+	enqueue := func(cls, num int) {
+		for range num {
+			n := q.numQueuedLocked()
+			go func() {
+				if err := q.acquire(cls, conf, time.Minute); err != nil {
+					t.Error(err)
+				}
+				granted <- cls
+			}()
+			q.waitQueued(n + 1)
+		}
+	}
+	enqueue(qosLow, 4)
+	enqueue(qosHigh, 8)
+
+	// weights 8:1 - the first nine freed slots: eight high, one low
+	var numHigh, numLow int
+	for range 12 {
+		q.release(conf)
+		switch <-granted {
+		case qosHigh:
+			numHigh++
+		case qosLow:
+			numLow++
+		}
+		if numHigh+numLow == 9 {
+			tassert.Errorf(t, numHigh == 8 && numLow == 1, "expected 8 high and 1 low, got %d and %d", numHigh, numLow)
+		}
+	}
+	tassert.Errorf(t, numHigh == 8 && numLow == 4, "expected all admitted, got %d high and %d low", numHigh, numLow)
+	tassert.Errorf(t, q.inflight == 1, "expected one in-flight read, got %d", q.inflight)
+}
+
+func TestReadQoSTimeout(t *testing.T) {
+	var (
+		q    = &readQoS{su: mock.NewStatsTracker()}
+		conf = newTestQoSConf(1)
+	)
+	tassert.CheckFatal(t, q.acquire(qosHigh, conf, time.Minute))
+
+	err := q.acquire(qosHigh, conf, 10*time.Millisecond)
+	tassert.Fatalf(t, cmn.IsErrBusy(err), "expected busy error, got %v", err)
+	tassert.Errorf(t, q.numQueuedLocked() == 0, "expected empty queue")
+
+	// runtime-disabled (max_reads = 0): queued reads get admitted
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.release(newTestQoSConf(0))
+	}()
+	tassert.CheckFatal(t, q.acquire(qosLow, conf, time.Minute))
+}
+
+func TestReadQoSClass(t *testing.T) {
+	var (
+		bck = meta.NewBck("qos", apc.AIS, cmn.NsGlobal, &cmn.Bprops{RateLimit: cmn.RateLimitConf{QoSClass: apc.QoSLow}})
+		r   = &http.Request{Header: http.Header{}}
+	)
+	cls, err := qosClass(r, bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cls == qosLow, "expected bucket's class, got %d", cls)
+
+	r.Header.Set(apc.HdrQoSClass, apc.QoSHigh)
+	cls, err = qosClass(r, bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cls == qosHigh, "expected request's class, got %d", cls)
+
+	bck.Props.RateLimit.QoSClass = ""
+	r.Header.Del(apc.HdrQoSClass)
+	cls, err = qosClass(r, bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cls == qosNormal, "expected default class, got %d", cls)
+
+	r.Header.Set(apc.HdrQoSClass, "urgent")
+	_, err = qosClass(r, bck)
+	tassert.Errorf(t, err != nil, "expected error for invalid class")
+}
+
+func (q *readQoS) numQueuedLocked() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.numQueued()
+}
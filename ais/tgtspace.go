@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/space"
@@ -32,6 +33,12 @@ const (
 	minAutoDetectInterval = 10 * time.Minute
 )
 
+// periodic space cleanup (see space.cleanup_interval)
+const (
+	hknamePeriodicCleanup = "space-cleanup" + hk.NameSuffix
+	periodicCleanupIdle   = minAutoDetectInterval // re-check config when disabled or not ready
+)
+
 var (
 	lastTrigOOS atomic.Int64
 )
@@ -92,6 +99,23 @@ func (t *target) OOS(csRefreshed *fs.CapStatus, config *cmn.Config, tcdf *fs.Tcd
 	return cs
 }
 
+// housekeeping callback: runs space cleanup every space.cleanup_interval (when configured)
+// to enforce per-object TTL and trash window irrespective of used capacity
+func (t *target) periodicCleanup(int64) time.Duration {
+	ival := cmn.GCO.Get().Space.CleanupInterval.D()
+	if ival == 0 {
+		return periodicCleanupIdle
+	}
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return min(ival, periodicCleanupIdle)
+	}
+	go func() {
+		var xargs xact.ArgsMsg // no bucket, no xid
+		t.runSpaceCleanup(&xargs, nil /*wg*/)
+	}()
+	return ival
+}
+
 func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.Bck) {
 	var (
 		ctlmsg  string
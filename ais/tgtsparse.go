@@ -0,0 +1,311 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Sparse (ranged) writes into a pre-sized object:
+// - apc.CreateSizedOp: create sparse workfile of a given size
+// - apc.WriteRangeOp:  write a range at a given offset (concurrently and in any order)
+// - apc.FinalizeOp:    once fully covered, checksum the content and promote the workfile
+//
+// All three are APPEND requests (see apc.QparamAppendType) redirected to the object's HRW target.
+// The state, including coverage, is kept in memory and is keyed by uname; it is not persisted
+// and does not survive target restart (or cluster map change that moves the object elsewhere).
+// Sized objects that are neither written nor finalized for sparseIdleTimeout are discarded
+// (see housekeep).
+
+const (
+	iniCapSparse      = 8
+	sparseIdleTimeout = time.Hour
+	hknameSparse      = "sparse-objs" + hk.NameSuffix
+)
+
+type (
+	sparseRange struct {
+		off, end int64 // [off, end)
+	}
+	sparseObj struct {
+		workFQN  string
+		ranges   []sparseRange // written so far: sorted and merged
+		size     int64
+		atime    int64 // last create or write (mono time)
+		inflight int   // writes in progress
+		done     bool  // finalized or discarded: no more writes
+		mu       sync.Mutex
+	}
+	sparseObjs struct {
+		t *target
+		m map[string]*sparseObj
+		sync.Mutex
+	}
+)
+
+func (sps *sparseObjs) init(t *target) {
+	sps.t = t
+	hk.Reg(hknameSparse, sps.housekeep, hk.DelOldIval)
+}
+
+func isSparseOp(op string) bool {
+	return op == apc.CreateSizedOp || op == apc.WriteRangeOp || op == apc.FinalizeOp
+}
+
+func (sps *sparseObjs) do(r *http.Request, lom *core.LOM, op string, dpq *dpq, config *cmn.Config) (int, error) {
+	switch op {
+	case apc.CreateSizedOp:
+		size, err := strconv.ParseInt(dpq.get(apc.QparamSparseSize), 10, 64)
+		if err != nil || size < 0 {
+			return http.StatusBadRequest, fmt.Errorf("%s: invalid size %q (%s)", lom, dpq.get(apc.QparamSparseSize), apc.QparamSparseSize)
+		}
+		return sps.create(lom, size)
+	case apc.WriteRangeOp:
+		off, err := strconv.ParseInt(dpq.get(apc.QparamSparseOffset), 10, 64)
+		if err != nil || off < 0 {
+			return http.StatusBadRequest, fmt.Errorf("%s: invalid offset %q (%s)", lom, dpq.get(apc.QparamSparseOffset), apc.QparamSparseOffset)
+		}
+		return sps.write(lom, off, r.Body, r.ContentLength)
+	default:
+		return sps.finalize(lom, config)
+	}
+}
+
+func (sps *sparseObjs) create(lom *core.LOM, size int64) (int, error) {
+	workFQN := lom.GenFQN(fs.WorkCT, fs.WorkfileSparse)
+	fh, err := lom.CreateWork(workFQN)
+	if err != nil {
+		return 0, err
+	}
+	cos.Close(fh)
+	if err := os.Truncate(workFQN, size); err != nil {
+		sps.rmwork(workFQN)
+		return 0, err
+	}
+
+	sp := &sparseObj{workFQN: workFQN, size: size, atime: mono.NanoTime()}
+	sps.Lock()
+	if sps.m == nil {
+		sps.m = make(map[string]*sparseObj, iniCapSparse)
+	}
+	prev := sps.m[lom.Uname()]
+	sps.m[lom.Uname()] = sp
+	sps.Unlock()
+
+	if prev != nil {
+		nlog.Warningln(sps.t.String(), "re-creating sized object", lom.Cname(), "- discarding previously written ranges")
+		prev.mu.Lock()
+		prev.done = true
+		prev.mu.Unlock()
+		sps.rmwork(prev.workFQN)
+	}
+	return 0, nil
+}
+
+func (sps *sparseObjs) write(lom *core.LOM, off int64, r io.Reader, size int64) (int, error) {
+	sp := sps.get(lom)
+	if sp == nil {
+		return http.StatusNotFound, fmt.Errorf("%s: sized object not found (expecting %q first)", lom, apc.CreateSizedOp)
+	}
+	if off > sp.size || (size > 0 && off+size > sp.size) {
+		return http.StatusRequestedRangeNotSatisfiable,
+			fmt.Errorf("%s: range [%d, %d) is out of bounds (size %d)", lom, off, off+size, sp.size)
+	}
+
+	// register in-flight write (finalize won't proceed while there are any)
+	sp.mu.Lock()
+	if sp.done {
+		sp.mu.Unlock()
+		return http.StatusConflict, fmt.Errorf("%s: sized object concurrently re-created or finalized", lom)
+	}
+	sp.inflight++
+	sp.atime = mono.NanoTime()
+	sp.mu.Unlock()
+
+	n, err := sps._write(lom, sp, off, r)
+
+	sp.mu.Lock()
+	sp.inflight--
+	if err == nil {
+		sp.add(off, off+n)
+	}
+	sp.mu.Unlock()
+	return 0, err
+}
+
+func (sps *sparseObjs) _write(lom *core.LOM, sp *sparseObj, off int64, r io.Reader) (int64, error) {
+	fh, err := os.OpenFile(sp.workFQN, os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return 0, err
+	}
+	buf, slab := sps.t.gmm.Alloc()
+	n, err := cos.CopyBuffer(io.NewOffsetWriter(fh, off), io.LimitReader(r, sp.size-off), buf)
+	// when size is unknown (chunked encoding), probe one extra byte to detect overflow
+	if err == nil {
+		if k, _ := io.ReadFull(r, buf[:1]); k > 0 {
+			err = fmt.Errorf("%s: range at offset %d exceeds the size %d", lom, off, sp.size)
+		}
+	}
+	slab.Free(buf)
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	return n, err
+}
+
+// must be called upon completion of all writes; refuses (409) while any are still in flight
+func (sps *sparseObjs) finalize(lom *core.LOM, config *cmn.Config) (int, error) {
+	sp := sps.get(lom)
+	if sp == nil {
+		return http.StatusNotFound, fmt.Errorf("%s: sized object not found (expecting %q first)", lom, apc.CreateSizedOp)
+	}
+	sp.mu.Lock()
+	if n := sp.inflight; n > 0 {
+		sp.mu.Unlock()
+		return http.StatusConflict, fmt.Errorf("%s: cannot finalize - %d range write(s) in progress", lom, n)
+	}
+	off, end, hasGap := sp.gap()
+	if !hasGap {
+		sp.done = true // no more writes
+	}
+	sp.mu.Unlock()
+	if hasGap {
+		return http.StatusConflict, fmt.Errorf("%s: cannot finalize - range [%d, %d) not written", lom, off, end)
+	}
+
+	sps.Lock()
+	if sps.m[lom.Uname()] != sp {
+		sps.Unlock()
+		return http.StatusConflict, fmt.Errorf("%s: sized object concurrently re-created or finalized", lom)
+	}
+	delete(sps.m, lom.Uname())
+	sps.Unlock()
+
+	// checksum over the completed content
+	var cksum *cos.Cksum
+	if ty := lom.CksumType(); ty != cos.ChecksumNone {
+		fh, err := os.Open(sp.workFQN)
+		if err != nil {
+			sps.rmwork(sp.workFQN)
+			return 0, err
+		}
+		_, cksumHash, err := cos.ChecksumReader(fh, ty)
+		cos.Close(fh)
+		if err != nil {
+			sps.rmwork(sp.workFQN)
+			return 0, err
+		}
+		cksum = cksumHash.Clone()
+	}
+
+	params := core.PromoteParams{
+		Bck:    lom.Bck(),
+		Cksum:  cksum,
+		Config: config,
+		PromoteArgs: apc.PromoteArgs{
+			SrcFQN:       sp.workFQN,
+			ObjName:      lom.ObjName,
+			OverwriteDst: true,
+			DeleteSrc:    true,
+		},
+	}
+	ecode, err := sps.t.Promote(&params)
+	if err != nil {
+		sps.rmwork(sp.workFQN) // no longer tracked (see above)
+	}
+	return ecode, err
+}
+
+func (sps *sparseObjs) get(lom *core.LOM) (sp *sparseObj) {
+	sps.Lock()
+	sp = sps.m[lom.Uname()]
+	sps.Unlock()
+	return sp
+}
+
+// discard sized objects that have been idle (no writes, no finalize) for too long
+func (sps *sparseObjs) housekeep(int64) time.Duration {
+	var (
+		stale []*sparseObj
+		now   = mono.NanoTime()
+	)
+	sps.Lock()
+	for uname, sp := range sps.m {
+		sp.mu.Lock()
+		if sp.inflight == 0 && time.Duration(now-sp.atime) > sparseIdleTimeout {
+			sp.done = true
+			delete(sps.m, uname)
+			stale = append(stale, sp)
+		}
+		sp.mu.Unlock()
+	}
+	sps.Unlock()
+
+	for _, sp := range stale {
+		nlog.Warningln(sps.t.String(), "discarding idle sized object", sp.workFQN, "- not finalized in", sparseIdleTimeout)
+		sps.rmwork(sp.workFQN)
+	}
+	return hk.DelOldIval
+}
+
+func (sps *sparseObjs) rmwork(workFQN string) {
+	if err := cos.RemoveFile(workFQN); err != nil {
+		nlog.Errorln(sps.t.String(), "failed to remove", workFQN, "err:", err)
+	}
+}
+
+///////////////
+// sparseObj //
+///////////////
+
+// add [off, end) merging with adjacent and overlapping ranges
+// (under sp.mu)
+func (sp *sparseObj) add(off, end int64) {
+	if off >= end {
+		return
+	}
+	rs := sp.ranges
+	i := sort.Search(len(rs), func(i int) bool { return rs[i].end >= off })
+	j := i
+	for ; j < len(rs) && rs[j].off <= end; j++ {
+		off, end = min(off, rs[j].off), max(end, rs[j].end)
+	}
+	sp.ranges = slices.Replace(rs, i, j, sparseRange{off, end})
+}
+
+// first unwritten range, if any
+func (sp *sparseObj) gap() (off, end int64, ok bool) {
+	rs := sp.ranges
+	switch {
+	case len(rs) == 0:
+		return 0, sp.size, sp.size > 0
+	case rs[0].off > 0:
+		return 0, rs[0].off, true
+	case rs[0].end < sp.size:
+		end = sp.size
+		if len(rs) > 1 {
+			end = rs[1].off
+		}
+		return rs[0].end, end, true
+	}
+	return 0, 0, false
+}
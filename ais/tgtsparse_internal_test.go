@@ -0,0 +1,154 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSparseCoverage(t *testing.T) {
+	sp := &sparseObj{size: 100}
+	off, end, ok := sp.gap()
+	tassert.Fatalf(t, ok && off == 0 && end == 100, "expected gap [0, 100), got [%d, %d) %t", off, end, ok)
+
+	sp.add(50, 60)
+	sp.add(10, 20)
+	sp.add(20, 30) // adjacent
+	sp.add(55, 70) // overlapping
+	sp.add(5, 5)   // empty
+	tassert.Fatalf(t, len(sp.ranges) == 2, "expected 2 ranges, got %+v", sp.ranges)
+
+	off, end, ok = sp.gap()
+	tassert.Errorf(t, ok && off == 0 && end == 10, "expected gap [0, 10), got [%d, %d) %t", off, end, ok)
+
+	sp.add(0, 10)
+	off, end, ok = sp.gap()
+	tassert.Errorf(t, ok && off == 30 && end == 50, "expected gap [30, 50), got [%d, %d) %t", off, end, ok)
+
+	sp.add(25, 55) // bridges the two
+	tassert.Fatalf(t, len(sp.ranges) == 1, "expected 1 range, got %+v", sp.ranges)
+	off, end, ok = sp.gap()
+	tassert.Errorf(t, ok && off == 70 && end == 100, "expected gap [70, 100), got [%d, %d) %t", off, end, ok)
+
+	sp.add(70, 100)
+	_, _, ok = sp.gap()
+	tassert.Errorf(t, !ok, "expected full coverage, got %+v", sp.ranges)
+
+	// zero-size object is always fully covered
+	_, _, ok = (&sparseObj{}).gap()
+	tassert.Errorf(t, !ok, "zero-size: expected no gaps")
+}
+
+func TestSparseInflightAndIdle(t *testing.T) {
+	var (
+		sps    = &sparseObjs{t: mockTarget}
+		config = cmn.GCO.Get()
+		lom    = core.AllocLOM("sparse-obj")
+	)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+
+	_, err := sps.create(lom, 10)
+	tassert.CheckFatal(t, err)
+	sp := sps.get(lom)
+	tassert.Fatalf(t, sp != nil, "expected sized object")
+
+	// finalize must not proceed while a write is in flight (even if fully covered)
+	sp.mu.Lock()
+	sp.add(0, 10)
+	sp.inflight++
+	sp.mu.Unlock()
+	ecode, err := sps.finalize(lom, config)
+	tassert.Errorf(t, err != nil && ecode == http.StatusConflict, "expected 409 while in flight, got %d: %v", ecode, err)
+	tassert.Errorf(t, sps.get(lom) == sp, "expected sized object to remain")
+
+	// nor is an idle object with in-flight writes discarded
+	sp.mu.Lock()
+	sp.atime = mono.NanoTime() - int64(2*sparseIdleTimeout)
+	sp.mu.Unlock()
+	sps.housekeep(0)
+	tassert.Errorf(t, sps.get(lom) == sp, "expected busy sized object to remain")
+
+	// idle and not busy: discarded, and subsequent writes are rejected
+	sp.mu.Lock()
+	sp.inflight--
+	sp.mu.Unlock()
+	sps.housekeep(0)
+	tassert.Errorf(t, sps.get(lom) == nil, "expected idle sized object to be discarded")
+	tassert.Errorf(t, cos.Stat(sp.workFQN) != nil, "expected %s to be removed", sp.workFQN)
+
+	ecode, err = sps.write(lom, 0, strings.NewReader("0123456789"), 10)
+	tassert.Errorf(t, err != nil && ecode == http.StatusNotFound, "expected 404 after discard, got %d: %v", ecode, err)
+	ecode, err = sps.finalize(lom, config)
+	tassert.Errorf(t, err != nil && ecode == http.StatusNotFound, "expected 404 after discard, got %d: %v", ecode, err)
+}
+
+// unknown size (chunked encoding): overflow is rejected and never reaches the workfile
+func TestSparseOverflow(t *testing.T) {
+	sps := &sparseObjs{t: mockTarget}
+	lom := core.AllocLOM("sparse-overflow")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+
+	_, err := sps.create(lom, 10)
+	tassert.CheckFatal(t, err)
+	sp := sps.get(lom)
+	defer sps.rmwork(sp.workFQN)
+
+	_, err = sps.write(lom, 4, strings.NewReader("0123456789"), -1 /*unknown*/)
+	tassert.Errorf(t, err != nil, "expected overflow error")
+	finfo, err := os.Stat(sp.workFQN)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, finfo.Size() == 10, "expected workfile size 10, got %d", finfo.Size())
+	_, _, ok := sp.gap()
+	tassert.Errorf(t, ok, "overflowing range must not be counted as written")
+
+	// exact fit
+	_, err = sps.write(lom, 4, strings.NewReader("456789"), -1)
+	tassert.CheckFatal(t, err)
+}
+
+// failed promotion (here: bucket destroyed in the meantime) must not leak the workfile
+func TestSparsePromoteFailure(t *testing.T) {
+	var (
+		sps    = &sparseObjs{t: mockTarget}
+		config = cmn.GCO.Get()
+		bck    = meta.NewBck("sparse-promote", apc.AIS, cmn.NsGlobal)
+		lom    = core.AllocLOM("sparse-obj")
+	)
+	defer core.FreeLOM(lom)
+	bmd := mockTarget.owner.bmd.get().clone()
+	bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+	mockTarget.owner.bmd.putPersist(bmd, nil)
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+
+	_, err := sps.create(lom, 10)
+	tassert.CheckFatal(t, err)
+	_, err = sps.write(lom, 0, strings.NewReader("0123456789"), 10)
+	tassert.CheckFatal(t, err)
+	sp := sps.get(lom)
+
+	bmd = mockTarget.owner.bmd.get().clone()
+	bmd.del(bck)
+	mockTarget.owner.bmd.putPersist(bmd, nil)
+
+	_, err = sps.finalize(lom, config)
+	tassert.Fatalf(t, err != nil, "expected promotion to fail")
+	tassert.Errorf(t, sps.get(lom) == nil, "expected sized object to be removed")
+	tassert.Errorf(t, cos.Stat(sp.workFQN) != nil, "expected %s to be removed", sp.workFQN)
+}
@@ -0,0 +1,65 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// soft delete (apc.QparamSoftDelete) and restore (apc.ActRestoreObject):
+// - ais:// buckets only (no remote backend, no erasure coding)
+// - the object is moved to its bucket's trash (fs.TrashCT) on the same mountpath
+// - space cleanup removes trashed objects permanently once `space.trash_window` expires
+// - restore must execute on the same target and mountpath - i.e., may fail
+//   if the cluster map or mountpaths have changed in the meantime
+
+func (t *target) _trashable(lom *core.LOM) error {
+	if lom.Bck().IsRemote() || lom.ECEnabled() {
+		return fmt.Errorf("%s: cannot soft-delete (or restore) %s: not supported for remote and erasure-coded buckets",
+			t.si, lom.Cname())
+	}
+	return nil
+}
+
+func (t *target) trashObject(lom *core.LOM) (int, error) {
+	if err := t._trashable(lom); err != nil {
+		return 0, err
+	}
+	var ecode int
+	// (ditto hard delete)
+	err := t.errAliased(lom)
+	if err != nil {
+		ecode = http.StatusConflict
+	} else {
+		lom.Lock(true)
+		if err = lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+			err = lom.MoveToTrash()
+		}
+		lom.Unlock(true)
+	}
+
+	vlabs := bvlabs(lom.Bck())
+	if err != nil {
+		t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
+		t.statsT.AddRecentErr("delete", lom.Bucket(), lom.ObjName, err)
+		return ecode, err
+	}
+	t.statsT.IncWith(stats.DeleteCount, vlabs)
+	return 0, nil
+}
+
+func (t *target) restoreObject(lom *core.LOM) error {
+	if err := t._trashable(lom); err != nil {
+		return err
+	}
+	lom.Lock(true)
+	err := lom.RestoreFromTrash()
+	lom.Unlock(true)
+	return err
+}
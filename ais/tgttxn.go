@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -383,6 +384,11 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 		if nprops, err = t.validateNprops(c.bck, c.msg); err != nil {
 			return "", err
 		}
+		if cos.IsParseBool(c.query.Get(apc.QparamCheckBackend)) {
+			if err = t.checkBackend(c.bck, nprops); err != nil {
+				return "", err
+			}
+		}
 		nlp := newBckNLP(c.bck)
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return "", cmn.NewErrBusy("bucket", c.bck.Cname(""))
@@ -473,6 +479,25 @@ func (t *target) validateNprops(bck *meta.Bck, msg *actMsgExt) (nprops *cmn.Bpro
 	return
 }
 
+// access remote bucket using new props (e.g., custom S3 endpoint);
+// no-op for ais:// buckets, including those with remote backends (validated by the proxy)
+func (t *target) checkBackend(bck *meta.Bck, nprops *cmn.Bprops) error {
+	nbck := meta.CloneBck(bck.Bucket())
+	nbck.Props = nprops
+	if !nbck.IsRemote() || nbck.Backend() != nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cmn.Rom.MaxKeepalive())
+	defer cancel()
+	if _, _, err := t.Backend(nbck).HeadBucket(ctx, nbck); err != nil {
+		if ep := nprops.Extra.AWS.Endpoint; ep != "" {
+			return fmt.Errorf("%s: failed to access %s via endpoint %q: %w", t, bck.Cname(""), ep, err)
+		}
+		return fmt.Errorf("%s: failed to access %s with the new props: %w", t, bck.Cname(""), err)
+	}
+	return nil
+}
+
 //
 // renameBucket
 //
@@ -171,16 +171,50 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		err := cos.Ternary(msg.Name == cmn.ErrXactICNotifAbort.Error(), cmn.ErrXactICNotifAbort, cmn.ErrXactUserAbort)
+		err := cmn.ErrXactUserAbort
+		switch msg.Name {
+		case cmn.ErrXactICNotifAbort.Error():
+			err = cmn.ErrXactICNotifAbort
+		case cmn.ErrXactDeadlineAbort.Error():
+			err = cmn.ErrXactDeadlineAbort
+		}
 		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
 		xreg.DoAbort(&flt, err)
+	case apc.ActXactPause, apc.ActXactResume:
+		if ecode, err := t.xpause(xargs.ID, msg.Action); err != nil {
+			t.writeErr(w, r, err, ecode, Silent)
+		}
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
 }
 
+func (t *target) xpause(xid, action string) (int, error) {
+	if err := xact.CheckValidUUID(xid); err != nil {
+		return 0, err
+	}
+	xctn, err := xreg.GetXact(xid)
+	if err != nil {
+		return 0, err
+	}
+	if xctn == nil || xctn.IsDone() {
+		return http.StatusNotFound, cmn.NewErrXactNotFoundError("[" + xid + "]")
+	}
+	pz, ok := xctn.(xact.Pausable)
+	if !ok {
+		return http.StatusNotImplemented, cmn.NewErrUnsupp(action, xctn.Name())
+	}
+	if action == apc.ActXactPause {
+		pz.Pause()
+	} else {
+		pz.Resume()
+	}
+	nlog.Infoln(t.String(), action, xctn.Name())
+	return 0, nil
+}
+
 func (t *target) xget(w http.ResponseWriter, r *http.Request, what, uuid string) {
-	if what != apc.WhatXactStats {
+	if what != apc.WhatXactStats && what != apc.WhatXactObjErrs {
 		t.writeErrf(w, r, fmtUnknownQue, what)
 		return
 	}
@@ -190,7 +224,11 @@ func (t *target) xget(w http.ResponseWriter, r *http.Request, what, uuid string)
 		return
 	}
 	if xctn != nil {
-		t.writeJSON(w, r, xctn.Snap(), what)
+		if what == apc.WhatXactObjErrs {
+			t.writeJSON(w, r, xctn.ErrObjs(), what)
+		} else {
+			t.writeJSON(w, r, xctn.Snap(), what)
+		}
 		return
 	}
 	err = cmn.NewErrXactNotFoundError("[" + uuid + "]")
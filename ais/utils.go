@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -560,6 +561,18 @@ func bvlabs(bck *meta.Bck) map[string]string {
 	return stats.EmptyBckVlabs
 }
 
+// (bucket, tag) labels when the request carries apc.HdrRequestTag; nil otherwise
+func tvlabs(bck *meta.Bck, r *http.Request) map[string]string {
+	if r == nil || !cmn.Rom.Features().IsSet(feat.EnableRequestTagMetrics) {
+		return nil
+	}
+	tag := r.Header.Get(apc.HdrRequestTag)
+	if tag == "" {
+		return nil
+	}
+	return stats.ReqTagVlabs(bck.Cname(""), tag)
+}
+
 func xvlabs(bck *meta.Bck) map[string]string {
 	if cmn.Rom.Features().IsSet(feat.EnableDetailedPromMetrics) {
 		return map[string]string{stats.VlabBucket: bck.Cname(""), stats.VlabXkind: ""}
@@ -88,6 +88,10 @@ const (
 	ClusterAccessRO = AceListBuckets | AceShowCluster
 	ClusterAccessRW = ClusterAccessRO | AceCreateBucket | AceDestroyBucket | AceMoveBucket
 
+	// all ACEs that modify data or metadata (denied when the cluster is read-only)
+	AccessMutate = AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE | AcePromote | AceObjUpdate |
+		AcePATCH | AceBckSetACL | AceCreateBucket | AceDestroyBucket | AceMoveBucket
+
 	AccessNone = AccessAttrs(0)
 )
 
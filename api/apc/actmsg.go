@@ -6,6 +6,7 @@ package apc
 
 import (
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 
@@ -20,12 +21,16 @@ const (
 	ActSetBprops   = "set-bprops"
 	ActResetBprops = "reset-bprops"
 
-	ActSummaryBck = "summary-bck"
+	ActSummaryBck   = "summary-bck"
+	ActApproxCount  = "approx-count"  // approximate number of objects (incrementally maintained; see api.GetBucketApproxCount)
+	ActProbeObjects = "probe-objects" // compare given names, sizes, and checksums with in-cluster objects (see ObjProbe)
+	ActObjDist      = "obj-dist"      // per-target object counts and sizes (see api.GetObjectDistribution)
 
 	ActECEncode  = "ec-encode" // erasure code a bucket
 	ActECGet     = "ec-get"    // read erasure coded objects
 	ActECPut     = "ec-put"    // erasure code objects
 	ActECRespond = "ec-resp"   // respond to other targets' EC requests
+	ActECStatus  = "ec-status" // EC slice placement and health of a given object
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
@@ -44,11 +49,17 @@ const (
 	ActIndexShard   = "index-shard"
 	ActSummaryShard = "summary-shard"
 
+	ActScrub = "scrub" // validate checksums of present objects (and optionally repair)
+
+	ActVerifyChunks = "verify-chunks" // consistency check of chunked objects (chunks vs manifests)
+
 	ActRebalance = "rebalance"
 	ActMoveBck   = "move-bck"
 
 	ActResilver = "resilver"
 
+	ActWarmup = "warmup" // post-rebalance warm-up of a (re)joined target (see Rebalance.WarmupBudget)
+
 	ActElection = "election"
 
 	ActLRU          = "lru"
@@ -65,6 +76,7 @@ const (
 	ActMptUpload   = "mpt-upload"   // create a new multipart upload
 	ActMptComplete = "mpt-complete" // complete a multipart upload
 	ActMptAbort    = "mpt-abort"    // abort a multipart upload
+	ActListUploads = "list-uploads" // list incomplete multipart uploads (see api.ListStuckUploads)
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
@@ -77,6 +89,8 @@ const (
 
 	ActClearLcache = "clear-lcache"
 
+	ActClusterReadOnly = "read-only" // cluster-wide read-only (maintenance) mode on/off; value: bool
+
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
 	// multi-object (via `ListRange`)
@@ -87,6 +101,8 @@ const (
 	ActPrefetchObjects = "prefetch-listrange"
 	ActArchive         = "archive" // see ArchiveMsg
 
+	ActSyncRemote = "sync-remote" // see SyncRemoteMsg
+
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
 
@@ -113,7 +129,12 @@ const (
 	ActRegGlobalXaction  = "reg-global-xaction"
 
 	// advanced usage
-	ActCheckLock = "check-lock"
+	ActCheckLock   = "check-lock"
+	ActObjManifest = "obj-manifest" // chunk manifest (layout) of a given object
+	ActMoveObject  = "move-obj"     // relocate object to a given target, overriding HRW (see MoveObjMsg)
+	ActCreateAlias = "create-alias" // metadata-only object that GET and HEAD follow to another object in the same bucket
+
+	ActRestoreObject = "restore-obj" // restore soft-deleted object (see QparamSoftDelete)
 
 	// api/ml.go; x-moss
 	ActGetBatch = "get-batch"
@@ -133,10 +154,13 @@ const (
 
 	ActMountpathRescan = "rescan-mp"
 	ActMountpathFSHC   = "fshc-mp"
+	ActMountpathLabel  = "label-mp"
 
 	// Actions on xactions
-	ActXactStop  = Stop
-	ActXactStart = Start
+	ActXactStop   = Stop
+	ActXactStart  = Start
+	ActXactPause  = "pause"  // (xactions that support it; see xact.Pausable)
+	ActXactResume = "resume" // ditto
 )
 
 // intra-cluster actions (internal use)
@@ -170,11 +194,12 @@ type (
 		Name   string `json:"name"`   // action-specific info of any kind (not necessarily "name")
 	}
 	ActValRmNode struct {
-		DaemonID          string `json:"sid"`
-		SkipRebalance     bool   `json:"skip_rebalance"`
-		RmUserData        bool   `json:"rm_user_data"`        // decommission-only
-		KeepInitialConfig bool   `json:"keep_initial_config"` // ditto (to be able to restart a node from scratch)
-		NoShutdown        bool   `json:"no_shutdown"`
+		DaemonID          string       `json:"sid"`
+		Deadline          cos.Duration `json:"deadline,omitempty"` // abort data-evacuating rebalance if not done in time (0: no deadline)
+		SkipRebalance     bool         `json:"skip_rebalance"`
+		RmUserData        bool         `json:"rm_user_data"`        // decommission-only
+		KeepInitialConfig bool         `json:"keep_initial_config"` // ditto (to be able to restart a node from scratch)
+		NoShutdown        bool         `json:"no_shutdown"`
 	}
 )
 
@@ -190,11 +215,13 @@ type (
 //   - WaitingDD - waiting for resilvering completion to be detached or disabled (moved to `Disabled`)
 //   - Disabled  - list of disabled mountpaths, the mountpaths that generated
 //     IO errors followed by (FSHC) health check, etc.
+//   - Labels    - all (available and disabled) labeled mountpaths grouped by label
 type (
 	MountpathList struct {
-		Available []string `json:"available"`
-		WaitingDD []string `json:"waiting_dd"`
-		Disabled  []string `json:"disabled"`
+		Available []string            `json:"available"`
+		WaitingDD []string            `json:"waiting_dd"`
+		Disabled  []string            `json:"disabled"`
+		Labels    map[string][]string `json:"labels,omitempty"` // label => mountpaths
 	}
 )
 
@@ -221,6 +248,14 @@ type (
 	}
 )
 
+// backend connectivity (see WhatBackendProbe)
+type BackendProbeResult struct {
+	Provider string        `json:"provider"`
+	Err      string        `json:"err,omitempty"` // empty when the target can reach the backend
+	Latency  time.Duration `json:"latency"`       // list-buckets roundtrip
+	NumBcks  int           `json:"num_bcks"`      // number of buckets the configured credentials can see
+}
+
 ////////////
 // ActMsg //
 ////////////
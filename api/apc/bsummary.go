@@ -50,6 +50,14 @@ type (
 		UsedPct      uint64 `json:"used_pct"`
 		IsBckPresent bool   `json:"is_present"` // in BMD
 	}
+
+	// per-target distribution of a bucket's in-cluster objects (see ActObjDist);
+	// counts primary copies only (i.e., excluding mirrored replicas)
+	DistStats struct {
+		Objs      int64 `json:"objs,string"`      // number of objects
+		Size      int64 `json:"size,string"`      // total size (bytes)
+		Misplaced int64 `json:"misplaced,string"` // objects that HRW-map to other targets (e.g., pending rebalance)
+	}
 )
 
 func (msg *BsummCtrlMsg) Str(cname string, sb *cos.SB) {
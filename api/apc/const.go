@@ -30,6 +30,9 @@ const (
 	EnvReadHeaderTimeout = "AIS_READ_HEADER_TIMEOUT"
 )
 
+// long-poll GET(cluster map): server-side wait (see QparamSmapNewer)
+const SmapLongPoll = 30 * time.Second
+
 // ulimits
 const (
 	UlimitProxy  = 16384
@@ -0,0 +1,27 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+type (
+	// ECStatus describes placement and health of an erasure-coded object
+	// (see ActECStatus and api.GetObjectECStatus).
+	ECStatus struct {
+		Slices     []ECSliceStatus `json:"slices"`
+		Data       int             `json:"data_slices"`
+		Parity     int             `json:"parity_slices"`
+		Generation int64           `json:"generation"` // when the object was erasure coded (mono-time)
+		IsCopy     bool            `json:"is_copy"`    // replicated (small object) rather than sliced
+		// whether the object can be (currently) restored from what's present
+		Reconstructable bool `json:"reconstructable"`
+	}
+	// ECSliceStatus is a single (slice or replica) location.
+	ECSliceStatus struct {
+		Target string `json:"target"` // node ID of the owning target
+		// 0: main (full) replica; [1, data]: data slices; [data+1, data+parity]: parity slices.
+		// Replicated objects have all indices set to 0.
+		Idx     int  `json:"idx"`
+		Present bool `json:"present"` // metadata and the slice (replica) itself are present, same generation
+	}
+)
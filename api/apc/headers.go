@@ -33,6 +33,9 @@ const (
 	HdrBlobWorkers     = aisPrefix + "Blob-Workers"      // optional: num concurrent downloading readers (see also: xs/nwp.go, "media type", load.Advice)
 	HdrBlobReadTimeout = aisPrefix + "Blob-Read-Timeout" // per-attempt timeout for backend range read; zero selects default
 
+	// GET: read priority class (QoSHigh, et al.) - overrides bucket's rate_limit.qos_class
+	HdrQoSClass = aisPrefix + "QoS-Class"
+
 	// Bucket props headers
 	HdrBucketProps      = aisPrefix + "Bucket-Props"       // => cmn.Bprops
 	HdrBucketSumm       = aisPrefix + "Bucket-Summ"        // => cmn.BsummResult (see also: QparamFltPresence)
@@ -63,6 +66,10 @@ const (
 	HdrObjAtime     = aisPrefix + "Atime"          // Object access time.
 	HdrObjCustomMD  = aisPrefix + "Custom-Md"      // Object custom metadata.
 	HdrObjVersion   = aisPrefix + "Version"        // Object version/generation - ais or cloud.
+	HdrObjTTL       = aisPrefix + "Ttl"            // Object time-to-live (PUT), e.g. "72h" - see cmn.ExpiresObjMD.
+
+	// GET and PUT: usage attribution (e.g. "team-x") - see feat.EnableRequestTagMetrics
+	HdrRequestTag = aisPrefix + "Request-Tag"
 
 	// Append object header
 	HdrAppendHandle = aisPrefix + "Append-Handle"
@@ -5,6 +5,7 @@
 package apc
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -109,6 +110,10 @@ const (
 	MaxPageSizeOCI   = 1000
 
 	MaxPageSizeGlobal = MaxPageSizeAIS // NOTE: maximum across all providers
+
+	// auto-reduced (effective) page size when listing expensive props, namely: custom metadata
+	// (GetPropsCustom); the page size used is returned via cmn.LsoRes.PageSize
+	MaxPageSizeCustom = 1000
 )
 
 // cmn/objlist_utils
@@ -117,7 +122,7 @@ const (
 	LsoStatusMask = (1 << statusBits) - 1
 )
 
-// NOTE: approaching uint16 limit - bits 9,14,15 remaining
+// NOTE: approaching uint16 limit - bits 9,15 remaining
 const (
 	// location _status_
 	LocOK = iota
@@ -136,6 +141,7 @@ const (
 	EntryHeadFail   = 1 << (statusBits + 7)
 	// added v4.0
 	EntryIsChunked = 1 << (statusBits + 8) // see NOTE above
+	EntryIsAlias   = 1 << (statusBits + 9) // ditto; see ActCreateAlias
 )
 
 // LsoMsg and HEAD(object) enum
@@ -157,10 +163,28 @@ const (
 	// 4.2
 	GetPropsLastModified = "last-modified"
 	GetPropsETag         = "etag"
+	// 5.0
+	GetPropsHeat = "heat" // decaying access count (list-objects only)
+	// number of chunks of a chunked object, from its manifest (list-objects only; absent when not chunked)
+	// - loads manifest of each chunked object; never included by default
+	GetPropsNumChunks = "num-chunks"
+	// EC-enabled buckets: whether a given object is replicated or erasure coded (list-objects only;
+	// requires reading EC metadata of each listed object; never included by default); see also ECStatus* values below
+	GetPropsECStatus = "ec-status"
+
+	// list-objects only, with LsNoRecursion: for each virtual directory, recursive count and total size
+	// of the (in-cluster) objects beneath it - expensive, walks entire subtrees; never included by default
+	GetPropsDirSummary = "dir-summary"
 )
 
 const GetPropsNameSize = GetPropsName + LsPropsSepa + GetPropsSize
 
+// GetPropsECStatus values
+const (
+	ECStatusReplicated = "replicated" // object size <= EC.ObjSizeLimit: (P+1) full copies
+	ECStatusEncoded    = "encoded"    // (D) data and (P) parity slices
+)
+
 // NOTE: update when changing any of the above :NOTE
 var (
 	// TODO [v4.5]: remove V1 props and HeadObject() API and impl. - superseded by V2
@@ -170,7 +194,7 @@ var (
 
 	GetPropsDefaultAIS = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime}
 	GetPropsAll        = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime,
-		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation}
+		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation, GetPropsHeat}
 
 	// GetPropsAllV2 extends GetPropsAll with fields exclusive to ObjectPropsV2.
 	// Note: GetPropsCached ("cached") and GetPropsStatus ("status") are intentionally
@@ -236,6 +260,17 @@ type (
 		// Maximum entries returned in a single page. `0` selects the
 		// server-side default.
 		PageSize int64 `json:"pagesize"` // +gen:optional
+		// Return only objects of size (in bytes) within [MinSize, MaxSize]
+		// inclusive; zero means no bound. Applied by targets during
+		// iteration; implies the `size` property. Virtual directories are
+		// not filtered. For an exact size, set both to the same value.
+		MinSize cos.SizeIEC `json:"min_size,omitempty"` // +gen:optional
+		MaxSize cos.SizeIEC `json:"max_size,omitempty"` // +gen:optional
+		// Return only objects whose custom metadata matches this expression,
+		// e.g. `custom.status == 'ready'` (see MetaFilter for the supported
+		// syntax). Applied by targets during iteration; implies the `custom`
+		// property. Virtual directories are not filtered.
+		MetaFilter string `json:"meta_filter,omitempty"` // +gen:optional
 	}
 )
 
@@ -298,6 +333,59 @@ func (lsmsg *LsoMsg) NormalizeNameSizeDflt() {
 	case GetPropsNameSize:
 		lsmsg.SetFlag(LsNameSize)
 	}
+	lsmsg.NormalizeSizeRange()
+	lsmsg.NormalizeMetaFilter()
+}
+
+// filtering by size (see MinSize, MaxSize) requires size
+func (lsmsg *LsoMsg) NormalizeSizeRange() {
+	if !lsmsg.HasSizeRange() {
+		return
+	}
+	if lsmsg.IsFlagSet(LsNameOnly) {
+		lsmsg.ClearFlag(LsNameOnly)
+		lsmsg.SetFlag(LsNameSize)
+	}
+	if !lsmsg.WantProp(GetPropsSize) {
+		lsmsg.AddProps(GetPropsSize)
+	}
+}
+
+func (lsmsg *LsoMsg) HasSizeRange() bool { return lsmsg.MinSize > 0 || lsmsg.MaxSize > 0 }
+
+func (lsmsg *LsoMsg) ValidateSizeRange() error {
+	if lsmsg.MinSize < 0 || lsmsg.MaxSize < 0 {
+		return fmt.Errorf("invalid size range: negative min (%d) or max (%d)", lsmsg.MinSize, lsmsg.MaxSize)
+	}
+	if lsmsg.MaxSize > 0 && lsmsg.MinSize > lsmsg.MaxSize {
+		return fmt.Errorf("invalid size range: min %d > max %d", lsmsg.MinSize, lsmsg.MaxSize)
+	}
+	return nil
+}
+
+// filtering by custom metadata (see MetaFilter) requires custom
+func (lsmsg *LsoMsg) NormalizeMetaFilter() {
+	if lsmsg.MetaFilter == "" {
+		return
+	}
+	lsmsg.ClearFlag(LsNameOnly | LsNameSize)
+	lsmsg.AddProps(GetPropsCustom)
+}
+
+// returns nil when there's no filter
+func (lsmsg *LsoMsg) ParseMetaFilter() (*MetaFilter, error) {
+	if lsmsg.MetaFilter == "" {
+		return nil, nil
+	}
+	return ParseMetaFilter(lsmsg.MetaFilter)
+}
+
+// whether the size is within [MinSize, MaxSize] (see HasSizeRange)
+func (lsmsg *LsoMsg) InSizeRange(size int64) bool {
+	if size < int64(lsmsg.MinSize) {
+		return false
+	}
+	return lsmsg.MaxSize == 0 || size <= int64(lsmsg.MaxSize)
 }
 
 func (lsmsg *LsoMsg) PropsSet() (s cos.StrSet) {
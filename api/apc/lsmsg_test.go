@@ -0,0 +1,54 @@
+// Package apc_test: tests for API control messages and constants.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestLsoMsgSizeRange(t *testing.T) {
+	tests := []struct {
+		min, max cos.SizeIEC
+	}{
+		{0, 0},
+		{cos.KiB, 0},
+		{0, 4 * cos.MiB},
+		{1500, 1500}, // not a whole number of KiB
+		{cos.GiB + 1, 2 * cos.GiB},
+	}
+	for _, tt := range tests {
+		msg := apc.LsoMsg{MinSize: tt.min, MaxSize: tt.max}
+		b, err := jsoniter.Marshal(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out apc.LsoMsg
+		if err := jsoniter.Unmarshal(b, &out); err != nil {
+			t.Fatalf("%s: %v", b, err)
+		}
+		if out.MinSize != tt.min || out.MaxSize != tt.max {
+			t.Errorf("%s: expected [%d, %d], got [%d, %d]", b, tt.min, tt.max, out.MinSize, out.MaxSize)
+		}
+		if err := out.ValidateSizeRange(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	msg := apc.LsoMsg{MinSize: 1500, MaxSize: 1500}
+	for size, in := range map[int64]bool{1499: false, 1500: true, 1501: false} {
+		if msg.InSizeRange(size) != in {
+			t.Errorf("size %d: expected in-range=%t", size, in)
+		}
+	}
+	msg = apc.LsoMsg{MinSize: 2 * cos.KiB, MaxSize: cos.KiB}
+	if msg.ValidateSizeRange() == nil {
+		t.Error("expected error upon min > max")
+	}
+}
@@ -0,0 +1,143 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Server-side filtering of listed objects by custom metadata (see LsoMsg.MetaFilter).
+// The expression language is a small JMESPath-like subset:
+//
+//	custom.KEY == 'VALUE'              - equality
+//	starts_with(custom.KEY, 'PREFIX')  - prefix
+//	custom.KEY                         - existence
+//
+// Predicates can be combined with `&&` (logical AND), e.g.:
+//
+//	custom.status == 'ready' && starts_with(custom.owner, 'team-') && custom.reviewed
+//
+// Values are single- or double-quoted. Keys are not quoted and cannot contain
+// whitespace or any of the following: `,()=!&`.
+
+const mfKeyPrefix = "custom."
+
+const (
+	mfExists = iota
+	mfEq
+	mfPrefix
+)
+
+type (
+	MetaFilter struct {
+		preds []metaPred
+	}
+	metaPred struct {
+		key string
+		val string
+		op  int
+	}
+)
+
+func ParseMetaFilter(expr string) (*MetaFilter, error) {
+	var (
+		mf = &MetaFilter{}
+		s  = strings.TrimSpace(expr)
+	)
+	for {
+		pred, rest, err := parsePred(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid meta-filter %q: %v", expr, err)
+		}
+		mf.preds = append(mf.preds, pred)
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			return mf, nil
+		}
+		after, ok := strings.CutPrefix(rest, "&&")
+		if !ok {
+			return nil, fmt.Errorf("invalid meta-filter %q: expecting '&&', got %q", expr, rest)
+		}
+		s = strings.TrimSpace(after)
+	}
+}
+
+// all predicates must hold
+func (mf *MetaFilter) Match(md map[string]string) bool {
+	for i := range mf.preds {
+		p := &mf.preds[i]
+		v, ok := md[p.key]
+		switch {
+		case !ok:
+			return false
+		case p.op == mfEq && v != p.val:
+			return false
+		case p.op == mfPrefix && !strings.HasPrefix(v, p.val):
+			return false
+		}
+	}
+	return true
+}
+
+func parsePred(s string) (p metaPred, rest string, err error) {
+	if after, ok := strings.CutPrefix(s, "starts_with("); ok {
+		p.op = mfPrefix
+		if p.key, rest, err = parseMfKey(strings.TrimSpace(after)); err != nil {
+			return p, "", err
+		}
+		rest = strings.TrimSpace(rest)
+		if rest == "" || rest[0] != ',' {
+			return p, "", errors.New("expecting ','")
+		}
+		if p.val, rest, err = parseMfVal(strings.TrimSpace(rest[1:])); err != nil {
+			return p, "", err
+		}
+		rest = strings.TrimSpace(rest)
+		if rest == "" || rest[0] != ')' {
+			return p, "", errors.New("expecting ')'")
+		}
+		return p, rest[1:], nil
+	}
+
+	if p.key, rest, err = parseMfKey(s); err != nil {
+		return p, "", err
+	}
+	rest = strings.TrimSpace(rest)
+	if after, ok := strings.CutPrefix(rest, "=="); ok {
+		p.op = mfEq
+		p.val, rest, err = parseMfVal(strings.TrimSpace(after))
+		return p, rest, err
+	}
+	p.op = mfExists
+	return p, rest, nil
+}
+
+func parseMfKey(s string) (key, rest string, _ error) {
+	after, ok := strings.CutPrefix(s, mfKeyPrefix)
+	if !ok {
+		return "", "", fmt.Errorf("expecting %q, got %q", mfKeyPrefix+"KEY", s)
+	}
+	i := strings.IndexAny(after, " \t,()=!&")
+	if i < 0 {
+		i = len(after)
+	}
+	if i == 0 {
+		return "", "", errors.New("empty key")
+	}
+	return after[:i], after[i:], nil
+}
+
+func parseMfVal(s string) (val, rest string, _ error) {
+	if s == "" || (s[0] != '\'' && s[0] != '"') {
+		return "", "", fmt.Errorf("expecting quoted value, got %q", s)
+	}
+	j := strings.IndexByte(s[1:], s[0])
+	if j < 0 {
+		return "", "", errors.New("unterminated quoted value")
+	}
+	return s[1 : j+1], s[j+2:], nil
+}
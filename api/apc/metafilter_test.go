@@ -0,0 +1,59 @@
+// Package apc_test: tests for API control messages and constants.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestMetaFilter(t *testing.T) {
+	md := map[string]string{"status": "ready", "owner": "team-a", "note": "a && b"}
+	tests := []struct {
+		expr    string
+		want    bool
+		wantErr bool
+	}{
+		{"custom.status", true, false},
+		{"custom.missing", false, false},
+		{"custom.status == 'ready'", true, false},
+		{`custom.status=="ready"`, true, false},
+		{"custom.status == 'pending'", false, false},
+		{"starts_with(custom.owner, 'team-')", true, false},
+		{"starts_with( custom.owner ,'dev-' )", false, false},
+		{"custom.note == 'a && b'", true, false},
+		{"custom.status == 'ready' && starts_with(custom.owner, 'team') && custom.note", true, false},
+		{"custom.status == 'ready' && custom.missing", false, false},
+
+		{"", false, true},
+		{"status == 'ready'", false, true},
+		{"custom.status == ready", false, true},
+		{"custom.status == 'ready", false, true},
+		{"custom.status != 'ready'", false, true},
+		{"custom.status 'ready'", false, true},
+		{"starts_with(custom.owner 'team')", false, true},
+		{"starts_with(custom.owner, 'team'", false, true},
+		{"custom. == 'x'", false, true},
+		{"custom.status &&", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			mf, err := apc.ParseMetaFilter(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := mf.Match(md); got != tt.want {
+				t.Errorf("match: got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,18 @@ type (
 		PartNumber int    `json:"part-number"`
 	}
 	MptCompletedParts []MptCompletedPart
+
+	// incomplete multipart upload (see ActListUploads)
+	MptUploadInfo struct {
+		Bucket   string `json:"bucket"`
+		Provider string `json:"provider"`
+		Ns       string `json:"namespace,omitempty"` // (cmn.Ns.Uname)
+		ObjName  string `json:"objname"`
+		UploadID string `json:"upload_id"`
+		Created  int64  `json:"created,string"` // unix nanoseconds
+		Size     int64  `json:"size,string"`    // uploaded so far
+		NumParts int    `json:"num_parts"`
+	}
 )
 
 func (m MptCompletedParts) Len() int {
@@ -5,8 +5,12 @@
 package apc
 
 import (
+	"errors"
 	"fmt"
+	"path"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
@@ -43,9 +47,24 @@ type (
 		ContinueOnError bool `json:"coer,omitempty"` // +gen:optional
 		// Do not recurse into nested virtual subdirectories.
 		NonRecurs bool `json:"non-recurs,omitempty"` // +gen:optional
+		MaxErrs
 	}
 )
 
+// MaxErrs, when set, bounds per-object failures that multi-object operations
+// otherwise tolerate (e.g., with `ContinueOnError`): the operation aborts once
+// either threshold is exceeded.
+type MaxErrs struct {
+	// Abort after more than this number of per-object failures (0: unlimited).
+	MaxErrors int `json:"max-errors,omitempty"` // +gen:optional
+	// Abort when failures exceed this percentage of all processed objects,
+	// in the range (0, 100]; evaluated only after the first MaxErrsMinVisited objects.
+	MaxErrorPct float64 `json:"max-error-pct,omitempty"` // +gen:optional
+}
+
+// MaxErrs.MaxErrorPct: the minimum number of processed objects to evaluate percentage
+const MaxErrsMinVisited = 100
+
 // [NOTE]
 // - empty `ListRange{}` implies operating on an entire bucket ("all objects in the source bucket")
 // - in re `LatestVer`, see related: `QparamLatestVer`, 'versioning.validate_warm_get'
@@ -104,6 +123,7 @@ type PrefetchMsg struct {
 	LatestVer bool `json:"latest-ver"` // +gen:optional
 	// Do not recurse into nested virtual subdirectories.
 	NonRecurs bool `json:"non-recurs,omitempty"` // +gen:optional
+	MaxErrs
 }
 
 // +ctlmsg
@@ -148,6 +168,69 @@ func (*PrefetchMsg) delim(sb *cos.SB) {
 	}
 }
 
+// SyncRemoteMsg parameterizes one-shot reconciliation of in-cluster content
+// with its remote bucket. At least one of the three actions must be enabled:
+//   - `prefetch-new`: cold-GET remote objects that are not present in-cluster
+//   - `update-changed`: re-fetch in-cluster objects whose remote version changed
+//   - `evict-deleted`: evict in-cluster objects that no longer exist remotely
+type SyncRemoteMsg struct {
+	// Restrict synchronization to object names that start with this prefix.
+	Prefix string `json:"prefix,omitempty"` // +gen:optional
+	// Number of concurrent workers:
+	//   - `0`: Auto-computed.
+	//   - `-1`: No additional workers.
+	//   - `>0`: Exact worker count.
+	NumWorkers int `json:"num-workers,omitempty"` // +gen:optional
+	// Cold-GET remote objects that are not present in-cluster.
+	PrefetchNew bool `json:"prefetch-new,omitempty"` // +gen:optional
+	// Evict in-cluster objects that were deleted remotely.
+	EvictDeleted bool `json:"evict-deleted,omitempty"` // +gen:optional
+	// Re-fetch in-cluster objects whose remote version differs.
+	UpdateChanged bool `json:"update-changed,omitempty"` // +gen:optional
+	MaxErrs
+}
+
+func (msg *SyncRemoteMsg) Validate() error {
+	if !msg.PrefetchNew && !msg.EvictDeleted && !msg.UpdateChanged {
+		return errors.New("sync-remote: nothing to do (expecting at least one of: prefetch-new, update-changed, evict-deleted)")
+	}
+	return msg.MaxErrs.Validate()
+}
+
+// +ctlmsg
+func (msg *SyncRemoteMsg) Str() string {
+	var sb cos.SB
+	sb.Init(80)
+	if msg.Prefix != "" {
+		sb.WriteString("prefix:")
+		sb.WriteString(msg.Prefix)
+	}
+	if msg.PrefetchNew {
+		msg.delim(&sb)
+		sb.WriteString("prefetch-new")
+	}
+	if msg.UpdateChanged {
+		msg.delim(&sb)
+		sb.WriteString("update-changed")
+	}
+	if msg.EvictDeleted {
+		msg.delim(&sb)
+		sb.WriteString("evict-deleted")
+	}
+	if msg.NumWorkers > 0 {
+		msg.delim(&sb)
+		sb.WriteString("workers:")
+		sb.WriteString(strconv.Itoa(msg.NumWorkers))
+	}
+	return sb.String()
+}
+
+func (*SyncRemoteMsg) delim(sb *cos.SB) {
+	if sb.Len() > 0 {
+		sb.WriteString(", ")
+	}
+}
+
 // ArchiveMsg parameterizes archiving multiple objects into a single
 // archive ("shard") object - one of `.tar`, `.tgz` / `.tar.gz`, `.zip`,
 // or `.tar.lz4`. Source objects are selected via ListRange. See
@@ -172,9 +255,94 @@ type ArchiveMsg struct {
 	InclSrcBname bool `json:"isbn"` // +gen:optional
 	// Append to the destination archive if it already exists.
 	AppendIfExists bool `json:"aate"` // +gen:optional
+	// What to do when the destination archive already exists (and is not
+	// appended to): `overwrite` (default) or `fail`.
+	Collision string `json:"collision,omitempty"` // +gen:optional
 	// Soft-error semantics for per-entry retrieval or processing
 	// failures. Support varies by job.
 	ContinueOnError bool `json:"coer"` // +gen:optional
 	// Do not archive contents of nested virtual subdirectories.
 	NonRecurs bool `json:"non-recurs,omitempty"` // +gen:optional
+	// WebDataset key: regular expression that extracts the sample key
+	// from each source object name (first capture group, if present,
+	// otherwise the entire match). The archived entry is then named
+	// key + extension, e.g. `img/001.jpg` => `001.jpg`. Takes precedence
+	// over `bnonly`. Two source objects that map to the same entry name
+	// fail the job.
+	WdsKey string `json:"wds-key,omitempty"` // +gen:optional
+	// Alongside the archive, write a sidecar object named
+	// `<archname>.toc.json` that lists archived entries with their
+	// offsets and sizes (see cmn/archive.TOC). TAR only; not supported
+	// with `aate`.
+	EmitTOC bool `json:"emit-toc,omitempty"` // +gen:optional
+	MaxErrs
+}
+
+// CompileWdsKey returns (nil, nil) when WdsKey is not set
+func (msg *ArchiveMsg) CompileWdsKey() (*regexp.Regexp, error) {
+	if msg.WdsKey == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(msg.WdsKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wds-key %q: %v", msg.WdsKey, err)
+	}
+	return re, nil
+}
+
+// WdsName returns WebDataset-compatible name: key (extracted via `re`) followed
+// by the extension of the object's base name - everything starting from the first
+// period, so that `a/001.seg.png` and `b/001.cls` share the same `001` stem
+func WdsName(re *regexp.Regexp, objName string) (string, error) {
+	m := re.FindStringSubmatch(objName)
+	if m == nil {
+		return "", fmt.Errorf("wds-key %q does not match %q", re.String(), objName)
+	}
+	key := m[0]
+	if len(m) > 1 && m[1] != "" {
+		key = m[1]
+	}
+	var (
+		base = path.Base(objName)
+		ext  string
+	)
+	if i := strings.IndexByte(base, '.'); i > 0 {
+		ext = base[i:]
+	}
+	key = strings.TrimSuffix(key, ext)
+	if key == "" || strings.ContainsRune(key, '.') {
+		return "", fmt.Errorf("wds-key %q: invalid key %q extracted from %q (expecting non-empty, no periods)",
+			re.String(), key, objName)
+	}
+	return key + ext, nil
+}
+
+/////////////
+// MaxErrs //
+/////////////
+
+func (m *MaxErrs) IsSet() bool { return m.MaxErrors > 0 || m.MaxErrorPct > 0 }
+
+func (m *MaxErrs) Validate() error {
+	if m.MaxErrors < 0 {
+		return fmt.Errorf("invalid max-errors %d (expecting non-negative)", m.MaxErrors)
+	}
+	if m.MaxErrorPct < 0 || m.MaxErrorPct > 100 {
+		return fmt.Errorf("invalid max-error-pct %.2f (expecting [0, 100] range)", m.MaxErrorPct)
+	}
+	return nil
+}
+
+// returns non-nil error when the number of failures `nerr` out of `nvisited`
+// (processed objects, including failed) exceeds either threshold
+func (m *MaxErrs) Check(nerr, nvisited int64) error {
+	if m.MaxErrors > 0 && nerr > int64(m.MaxErrors) {
+		return fmt.Errorf("number of errors (%d) exceeds max-errors %d", nerr, m.MaxErrors)
+	}
+	if m.MaxErrorPct > 0 && nvisited >= MaxErrsMinVisited {
+		if pct := float64(nerr) * 100 / float64(nvisited); pct > m.MaxErrorPct {
+			return fmt.Errorf("errors (%d out of %d, %.2f%%) exceed max-error-pct %.2f%%", nerr, nvisited, pct, m.MaxErrorPct)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,60 @@
+// Package apc_test: tests for API control messages and constants.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// TestWdsName covers WebDataset key extraction: capture group vs. entire match,
+// multi-part extensions, and negative (no match, empty or dotted key) cases.
+func TestWdsName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wdsKey  string
+		objName string
+		want    string
+		wantErr bool
+	}{
+		{"capture-group", `/(\d+)\.`, "img/001.jpg", "001.jpg", false},
+		{"capture-group-other-dir", `/(\d+)\.`, "lbl/001.txt", "001.txt", false},
+		{"entire-match", `[^/]+$`, "img/001.jpg", "001.jpg", false},
+		{"multi-part-ext", `/([^/.]+)\.`, "seg/001.seg.png", "001.seg.png", false},
+		{"dir-as-key", `^([^/]+)/`, "sample01/img.jpg", "sample01.jpg", false},
+		{"no-ext", `(\d+)$`, "raw/007", "007", false},
+		{"no-match", `(\d+)`, "img/abc.jpg", "", true},
+		{"dotted-key", `^(.+)$`, "img/a.b/001.jpg", "", true},
+		{"empty-key", `^()`, "img/001.jpg", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := apc.ArchiveMsg{WdsKey: tt.wdsKey}
+			re, err := msg.CompileWdsKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := apc.WdsName(re, tt.objName)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("%s: expected error, got %q", tt.objName, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.objName, err)
+			}
+			if got != tt.want {
+				t.Fatalf("%s: expected %q, got %q", tt.objName, tt.want, got)
+			}
+		})
+	}
+
+	msg := apc.ArchiveMsg{WdsKey: "(["}
+	if _, err := msg.CompileWdsKey(); err == nil {
+		t.Fatal("expected invalid regex error")
+	}
+}
@@ -0,0 +1,16 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActMoveObject: relocate object's primary copy to a given target, overriding HRW
+// (advanced usage: reproducing placement-specific issues, affinity experiments)
+//
+// The resulting object is "misplaced" - global rebalance would normally move it
+// back to its HRW target, and space cleanup may remove it once the HRW target
+// has an identical copy. Pin prevents both (see cmn.PinnedObjMD).
+type MoveObjMsg struct {
+	DaemonID string `json:"tid"`           // destination target ID
+	Pin      bool   `json:"pin,omitempty"` // keep the object on the destination target
+}
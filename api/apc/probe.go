@@ -0,0 +1,24 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActProbeObjects: compare (name, size, checksum) tuples with in-cluster objects in a single
+// round-trip - the primitive for rsync-style one-way sync into a bucket (see api.ProbeObjects)
+//
+// The checksum, if specified, must be of the bucket's type (see bucket props: checksum.type);
+// otherwise, the two cannot be compared and the object is reported as ProbeDiffers.
+type ObjProbe struct {
+	Name       string `json:"name"`
+	CksumType  string `json:"cksum_type,omitempty"`  // optional: when empty, compare sizes only
+	CksumValue string `json:"cksum_value,omitempty"` // ditto
+	Size       int64  `json:"size"`
+}
+
+// ObjProbe result (one per probe, in the same order)
+const (
+	ProbeMatch    = "match"   // in-cluster object with the same size and checksum
+	ProbeDiffers  = "differs" // object exists but is a different version (size and/or checksum)
+	ProbeNotFound = "none"    // no such object in the cluster
+)
@@ -0,0 +1,21 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// read priority (QoS) classes:
+// - bucket-configurable via `rate_limit.qos_class`
+// - per-request override via `HdrQoSClass`
+// - see cmn.ReadQoSConf and docs/rate_limit.md
+const (
+	QoSHigh   = "high"   // latency-sensitive (e.g., interactive serving)
+	QoSNormal = "normal" // default
+	QoSLow    = "low"    // throughput-oriented (e.g., batch training)
+)
+
+var SupportedQoS = [...]string{QoSHigh, QoSNormal, QoSLow}
+
+func IsValidQoSClass(c string) bool {
+	return c == "" || c == QoSHigh || c == QoSNormal || c == QoSLow
+}
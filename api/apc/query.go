@@ -24,7 +24,8 @@ const (
 	QparamETLPipeline      = "etl_pipeline"
 	QparamETLTransformArgs = "etl_args"
 	QparamETLFQN           = "etl_fqn"
-	QparamETLSecret        = "etl_secret" // secret generated during ETL init to validate directly target access from trusted ETL
+	QparamETLTimeout       = "etl_timeout" // per-request transform timeout (e.g. "30s"); overrides ETL's obj_timeout
+	QparamETLSecret        = "etl_secret"  // secret generated during ETL init to validate directly target access from trusted ETL
 
 	QparamRegex      = "regex"       // dsort: list regex
 	QparamOnlyActive = "only_active" // dsort: list only active
@@ -48,6 +49,10 @@ const (
 	// This query parameter can be used to override the default behavior.
 	QparamDontAddRemote = "dont_add_remote_bck_md" // Skip auto-registering the remote bucket in cluster metadata (by default, remote buckets are registered on first access).
 
+	// Bound the time to HEAD remote bucket (via api.HeadBucketWithArgs); when exceeded,
+	// the request fails with http.StatusGatewayTimeout
+	QparamBckHeadTimeout = "bhead_timeout" // Max time (Go duration, e.g. "5s") to wait for the remote backend to respond to HEAD(bucket).
+
 	// Add remote bucket to BMD _unconditionally_ and without executing HEAD request
 	// (to check access and load the bucket's properties)
 	// NOTE: usage is limited to setting up bucket properties with alternative
@@ -61,6 +66,21 @@ const (
 	// When evicting, keep remote bucket in BMD (i.e., evict data only)
 	QparamKeepRemote = "keep_bck_md" // Keep bucket metadata when evicting remote bucket data
 
+	// When deleting an object, move it to trash rather than remove it permanently;
+	// can be restored (via apc.ActRestoreObject) within the configured `space.trash_window`
+	QparamSoftDelete = "soft" // Soft-delete object (ais:// buckets only)
+
+	// When setting bucket props, have each target access the remote bucket using the new props
+	// (e.g., custom S3 endpoint and/or profile) prior to committing the change
+	QparamCheckBackend = "check_backend" // Verify remote bucket accessibility with the new props before committing
+
+	// When setting bucket props: validate (including target-count-dependent checks) but do not apply
+	QparamValidateOnly = "validate_only"
+
+	// When setting bucket props: do nothing (and respond with http.StatusNoContent)
+	// if the resulting props are identical to the current ones (see cmn.Bprops.Equal)
+	QparamIfChanged = "if_changed"
+
 	// (api.GetBucketInfo)
 	// NOTE: non-empty value indicates api.GetBucketInfo; "true" value further requires "with remote obj-s"
 	QparamBinfoWithOrWithoutRemote = "bsumm_remote" // Request bucket info (any non-empty value); set to "true" to also include remote (out-of-cluster) objects in the summary.
@@ -75,6 +95,15 @@ const (
 	QparamAppendType   = "append_type"   // Type of append operation (append, flush)
 	QparamAppendHandle = "append_handle" // Handle for ongoing append operations
 
+	// sparse (ranged) writes into a pre-sized object - see CreateSizedOp et al. below
+	QparamSparseSize   = "sparse_size"   // total size of the object (CreateSizedOp)
+	QparamSparseOffset = "sparse_offset" // offset of the range being written (WriteRangeOp)
+
+	// PUT(object) with server-side generated content (no request body) - see GenZeros et al. below
+	QparamGenSize    = "gen_size"    // size of the object to generate
+	QparamGenPattern = "gen_pattern" // content pattern (GenZeros, GenRepeat, GenRandom)
+	QparamGenBytes   = "gen_bytes"   // bytes to repeat (GenRepeat)
+
 	// HTTP bucket support.
 	QparamOrigURL = "original_url" // Original URL for HTTP bucket objects
 
@@ -83,6 +112,9 @@ const (
 	QparamLogOff  = "offset"
 	QparamAllLogs = "all"
 
+	// Max number of entries to return (e.g., WhatRecentErrors)
+	QparamLimit = "limit"
+
 	// The following 4 (four) QparamArch* parameters are all intended for usage with sharded datasets,
 	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, and/or .tar.lz4) formatted objects.
 	//
@@ -135,6 +167,22 @@ const (
 	// - implies remote backend
 	QparamLatestVer = "latest-ver" // Get latest version of objects from remote backend
 
+	// cold GET: transmit remote content to the client while (tee) persisting it in-cluster,
+	// rather than the default store-then-read; same as `feat.StreamingColdGET` but per request
+	QparamWarmCache = "warm-cache"
+
+	// GET chunked object: have the target prefetch the next N (up to 255) chunks into page cache
+	// while the current one is being transmitted (sequential reads of large objects)
+	QparamReadAhead = "readahead"
+
+	// GET remote object stored with `Content-Encoding: gzip` (see cmn.ContentEncodingObjMD):
+	// decompress on read and return the decoded content (size unknown upfront - chunked transfer)
+	QparamDecodeContentEncoding = "decode-content-encoding"
+
+	// GET: best-effort cap on the target's transmit rate for this request (bytes per second);
+	// floored at 64KiB/s
+	QparamMaxBps = "max-bps"
+
 	// in addition to the latest-ver (above), also entails removing remotely
 	// deleted objects
 	QparamSync = "synchronize"
@@ -193,6 +241,20 @@ func IsFltNoProps(v int) bool {
 const (
 	AppendOp = "append"
 	FlushOp  = "flush"
+
+	// sparse writes: create pre-sized object, fill it with (disjoint) ranges in any order, finalize
+	CreateSizedOp = "create-sized"
+	WriteRangeOp  = "write-range"
+	FinalizeOp    = "finalize"
+)
+
+// QparamGenPattern enum.
+const (
+	GenZeros  = "zeros"  // zero-fill (default)
+	GenRepeat = "repeat" // repeating QparamGenBytes
+	GenRandom = "random" // pseudo-random
+
+	MaxGenBytes = 1024 // max length of the repeating sequence
 )
 
 // health
@@ -215,6 +277,7 @@ const (
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return `cos.NodeStateInfo` including cluster metadata versions and state flags
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamAliasOf          = "aof" // GET and HEAD: name of the alias that is being followed (not to follow again)
 
 	// combines QparamClusterInfo and QparamAskPrimary: the responder must be primary and return NodeStateInfo
 	QparamPrimaryCii = QparamClusterInfo + "-" + QparamAskPrimary
@@ -238,6 +301,10 @@ const (
 	// Notification target's node ID (usually, the node that initiates the operation).
 	QparamNotifyMe = "nft"
 
+	// long-poll GET(cluster map): respond when Smap version becomes greater than the specified one
+	// (or upon server-side timeout - whichever comes first); see api.WatchClusterMap
+	QparamSmapNewer = "smap-newer"
+
 	// added in v4.1
 	QparamSmapVer = "vpams"
 	QparamNonce   = "x"
@@ -258,17 +325,24 @@ const (
 	WhatBMD  = "bmd"
 
 	// config
-	WhatNodeConfig    = "config"         // query specific node for (cluster config + overrides, local config)
-	WhatClusterConfig = "cluster_config" // as the name implies; identical (compressed, checksummed, versioned) copy on each node
+	WhatNodeConfig     = "config"         // query specific node for (cluster config + overrides, local config)
+	WhatClusterConfig  = "cluster_config" // as the name implies; identical (compressed, checksummed, versioned) copy on each node
+	WhatNodeConfigDiff = "config_diff"    // cluster config settings that differ from built-in defaults, excluding auth, backend, and ext (see cmn.ConfigDiff)
 
 	// configured backends
-	WhatBackends = "backends"
+	WhatBackends     = "backends"
+	WhatBackendProbe = "backend_probe" // each target: list buckets via the given provider (QparamProvider) - see BackendProbeResult
 
 	// stats and status
 	WhatNodeStats          = "node_stats"  // redundant
 	WhatNodeStatsAndStatus = "node_status" // current
 
-	WhatDiskRWUtilCap = "disk" // read/write stats, disk utilization, capacity
+	WhatDiskRWUtilCap = "disk"       // read/write stats, disk utilization, capacity
+	WhatDiskStats     = "disk_stats" // typed per-disk I/O stats: throughput, IOPS, utilization, queue size
+
+	WhatKeepalive = "keepalive" // per-peer keep-alive status: last success and consecutive failures
+
+	WhatRecentErrors = "recent_errors" // last (up to QparamLimit) error events: time, op, bucket, object, message
 
 	WhatMetricNames = "metrics"
 
@@ -288,6 +362,7 @@ const (
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatXactObjErrs     = "xobjerrs"    // per-object errors: xaction by uuid (see api.GetXactionErrors)
 
 	// internal
 	WhatSnode    = "snode"
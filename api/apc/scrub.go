@@ -0,0 +1,26 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ScrubMsg is the control message for ActScrub xaction that validates
+// checksums of all present (in-cluster) objects in a bucket.
+type ScrubMsg struct {
+	// Scrub only objects whose name starts with this prefix. Empty
+	// applies to all objects in the bucket.
+	Prefix string `json:"prefix"` // +gen:optional
+	// Repair corrupted objects: re-fetch from the remote backend (remote
+	// buckets) or restore from local replicas or EC slices.
+	// Otherwise, corrupted objects are only counted and reported.
+	Repair bool `json:"repair"` // +gen:optional
+}
+
+// VerifyChunksMsg is the control message for ActVerifyChunks xaction that checks
+// chunked objects in a bucket: chunks referenced by completed manifests (presence,
+// size, and checksum), orphan chunks, and partial manifests. Read-only.
+type VerifyChunksMsg struct {
+	// Check only objects whose name starts with this prefix. Empty
+	// applies to all objects in the bucket.
+	Prefix string `json:"prefix"` // +gen:optional
+}
@@ -5,6 +5,7 @@
 package apc
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -16,6 +17,14 @@ import (
 
 // TODO: `ContinueOnError` not implemented for the most part
 
+// destination object name collision policy (see CopyBckMsg.Collision and ArchiveMsg.Collision)
+const (
+	CollisionOverwrite    = "overwrite"     // default: last write wins
+	CollisionSkip         = "skip"          // keep existing destination, skip source
+	CollisionFail         = "fail"          // per-object error (or abort, unless ContinueOnError)
+	CollisionRenameSuffix = "rename-suffix" // write under the name with numeric suffix, e.g. "a/b_1.jpg"
+)
+
 type (
 	// CopyBckMsg is the shared knobs subset used by offline
 	// bucket-to-bucket and multi-object copy/transform.
@@ -40,6 +49,9 @@ type (
 		Sync bool `json:"synchronize"` // +gen:optional
 		// Do not recurse into nested virtual subdirectories.
 		NonRecurs bool `json:"non-recurs,omitempty"` // +gen:optional
+		// What to do when the destination object already exists:
+		// `overwrite` (default), `skip`, `fail`, or `rename-suffix`.
+		Collision string `json:"collision,omitempty"` // +gen:optional
 	}
 
 	// Transform selects an ETL transformation (or pipeline) to apply
@@ -77,6 +89,42 @@ type (
 		// Soft-error semantics for per-object retrieval or processing
 		// failures. Support varies by job.
 		ContinueOnError bool `json:"coer,omitempty"` // +gen:optional
+		MaxErrs
+
+		// ETL only, ais:// destinations only: skip re-transforming source objects
+		// whose destination already holds a result produced by the same ETL
+		// (or pipeline) from the same source version and checksum.
+		// The result is tagged with custom metadata (see cmn.ETLSourceObjMD).
+		CacheResults bool `json:"cache-results,omitempty"` // +gen:optional
+
+		// ETL only: name of the source object's custom metadata key whose value
+		// (if present) is passed to the transformer as per-object `etl_args`
+		// (e.g., key "resize" with value "crop=256").
+		ArgsFrom string `json:"args-from,omitempty"` // +gen:optional
+
+		// ETL only: fail (or, with ContinueOnError, count as error) source objects
+		// whose extension is not present in the Ext map - rather than transforming
+		// them under unchanged names. Ignored when Ext is empty.
+		ExtStrict bool `json:"ext-strict,omitempty"` // +gen:optional
+
+		// Copy only: capture backend-specific metadata of remote source objects
+		// (storage class and ACL - see cmn.StorageClassObjMD and cmn.ACLObjMD) as custom
+		// metadata of the destination objects, to be restored upon a later
+		// copy back to the same backend. User metadata is retained regardless.
+		// Costs additional per-object request(s) to the backend.
+		// See docs/batch.md for the fields supported by each backend.
+		PreserveBackendMeta bool `json:"preserve-backend-meta,omitempty"` // +gen:optional
+
+		// Bucket-to-bucket copy only: journal the names of copied objects (on each
+		// target, per mountpath) so that, if interrupted, the copy can be resumed
+		// (see Resume). Journals are removed upon successful completion.
+		Checkpoint bool `json:"checkpoint,omitempty"` // +gen:optional
+
+		// Bucket-to-bucket copy only: resume a previously interrupted (aborted)
+		// checkpointed copy of the same source => destination (and prefix). Objects
+		// recorded as completed by the previous run are skipped, provided the destination
+		// holds the same (size, version, checksum). Implies Checkpoint. See docs/batch.md.
+		Resume bool `json:"resume,omitempty"` // +gen:optional
 	}
 
 	// TCOMsg is the multi-object copy & transform payload. Source
@@ -109,16 +157,44 @@ func (msg *TCBMsg) ToName(name string) string {
 	return name
 }
 
+// Returns false if the name's extension (or lack thereof) is not in the Ext map;
+// always true when there's no map (see ExtStrict).
+func (msg *TCBMsg) ExtMapped(name string) bool {
+	if len(msg.Ext) == 0 {
+		return true
+	}
+	idx := strings.LastIndexByte(name, '.')
+	if idx < 0 {
+		return false
+	}
+	_, ok := msg.Ext[name[idx+1:]]
+	return ok
+}
+
 ////////////////
 // CopyBckMsg //
 ////////////////
 
+func ValidateCollision(policy string) error {
+	switch policy {
+	case "", CollisionOverwrite, CollisionSkip, CollisionFail, CollisionRenameSuffix:
+		return nil
+	default:
+		return fmt.Errorf("invalid collision policy %q (expecting one of: %s, %s, %s, %s)", policy,
+			CollisionOverwrite, CollisionSkip, CollisionFail, CollisionRenameSuffix)
+	}
+}
+
 func (msg *CopyBckMsg) Str(sb *cos.SB, fromCname, toCname, tag string) {
 	sb.WriteString(tag)
 	sb.WriteString(fromCname)
 	sb.WriteString("=>")
 	sb.WriteString(toCname)
 
+	if msg.Collision != "" && msg.Collision != CollisionOverwrite {
+		sb.WriteString(", collision:")
+		sb.WriteString(msg.Collision)
+	}
 	if msg.LatestVer || msg.Sync || msg.NonRecurs {
 		sb.WriteString(", flags:")
 		first := true
@@ -0,0 +1,40 @@
+// Package apc_test: tests for API control messages and constants.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestExtMapped(t *testing.T) {
+	msg := apc.TCBMsg{Ext: map[string]string{"jpg": "txt", "png": ".txt"}}
+	tests := []struct {
+		objName string
+		mapped  bool
+		toName  string
+	}{
+		{"img/001.jpg", true, "img/001.txt"},
+		{"img/001.png", true, "img/001.txt"},
+		{"img/001.jpeg", false, "img/001.jpeg"},
+		{"img/001", false, "img/001"},
+		{"img/001.tar.gz", false, "img/001.tar.gz"},
+	}
+	for _, tt := range tests {
+		if got := msg.ExtMapped(tt.objName); got != tt.mapped {
+			t.Errorf("%s: expected mapped=%t, got %t", tt.objName, tt.mapped, got)
+		}
+		if got := msg.ToName(tt.objName); got != tt.toName {
+			t.Errorf("%s: expected %q, got %q", tt.objName, tt.toName, got)
+		}
+	}
+
+	// no mapping - nothing to enforce
+	var empty apc.TCBMsg
+	if !empty.ExtMapped("img/001.jpeg") {
+		t.Error("expected any name to be mapped when Ext is empty")
+	}
+}
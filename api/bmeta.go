@@ -0,0 +1,204 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket metadata snapshot: object names, sizes, checksums, versions, custom metadata,
+// and chunk manifests (of chunked objects) - without object bodies.
+// Snapshot format: JSON Lines, one BckMetaEntry per in-cluster object, in listing order.
+// Optional prefix scopes both export and verification to the objects with matching names.
+// Usage: metadata backup independent of (possibly remote) data; audit of unexpected changes.
+
+type (
+	BckMetaEntry struct {
+		Chunks   *cmn.ChunkManifest `json:"chunks,omitempty"` // chunked objects only
+		Name     string             `json:"name"`
+		Checksum string             `json:"checksum,omitempty"`
+		Version  string             `json:"version,omitempty"`
+		Custom   string             `json:"custom-md,omitempty"`
+		Size     int64              `json:"size,string"`
+	}
+
+	// VerifyBucketMeta result
+	DiffReport struct {
+		Missing []string `json:"missing,omitempty"` // in the snapshot but not in the bucket
+		Added   []string `json:"added,omitempty"`   // in the bucket but not in the snapshot
+		Changed []string `json:"changed,omitempty"` // present in both, with different metadata
+		Matched int64    `json:"matched"`
+	}
+
+	// (lsoExporter)
+	bmetaExporter struct {
+		enc *json.Encoder
+		bp  BaseParams
+		bck cmn.Bck
+	}
+	bmetaVerifier struct {
+		snap   map[string]*BckMetaEntry
+		rep    *DiffReport
+		bp     BaseParams
+		bck    cmn.Bck
+		prefix string
+	}
+)
+
+// ExportBucketMeta streams metadata snapshot of in-cluster objects in `bck` to `w`;
+// empty prefix: all objects.
+// See also: VerifyBucketMeta
+func ExportBucketMeta(bp BaseParams, bck cmn.Bck, prefix string, w io.Writer) error {
+	exp := &bmetaExporter{enc: json.NewEncoder(w), bp: bp, bck: bck}
+	_, err := bmetaList(bp, bck, prefix, exp)
+	return err
+}
+
+// VerifyBucketMeta compares the current state of `bck` with the snapshot (previously
+// produced by ExportBucketMeta) and reports the differences, if any;
+// non-empty prefix limits the comparison to the matching objects on both sides.
+// NOTE: the snapshot is loaded in memory in its entirety.
+func VerifyBucketMeta(bp BaseParams, bck cmn.Bck, prefix string, r io.Reader) (*DiffReport, error) {
+	ver := &bmetaVerifier{snap: make(map[string]*BckMetaEntry, 1024), rep: &DiffReport{}, bp: bp, bck: bck, prefix: prefix}
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for num := 0; ; {
+		en := &BckMetaEntry{}
+		if err := dec.Decode(en); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("invalid bucket metadata snapshot (entry #%d): %w", num+1, err)
+		}
+		num++
+		if strings.HasPrefix(en.Name, prefix) {
+			ver.snap[en.Name] = en
+		}
+	}
+	if _, err := bmetaList(bp, bck, prefix, ver); err != nil {
+		return nil, err
+	}
+	rep := ver.rep
+	for name := range ver.snap {
+		rep.Missing = append(rep.Missing, name)
+	}
+	sort.Strings(rep.Missing)
+	return rep, nil
+}
+
+func bmetaList(bp BaseParams, bck cmn.Bck, prefix string, exp lsoExporter) (int64, error) {
+	lsmsg := &apc.LsoMsg{Prefix: prefix, Flags: apc.LsCached}
+	lsmsg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsVersion,
+		apc.GetPropsCustom, apc.GetPropsChunked)
+
+	q := qalloc()
+	reqParams := lsoReq(bp, bck, &ListArgs{}, q)
+	n, err := exportPages(reqParams, lsmsg, exp)
+
+	freeMbuf(reqParams.buf)
+	FreeRp(reqParams)
+	qfree(q)
+	return n, err
+}
+
+func newBckMetaEntry(bp BaseParams, bck cmn.Bck, en *cmn.LsoEnt) (*BckMetaEntry, error) {
+	out := &BckMetaEntry{
+		Name:     en.Name,
+		Checksum: en.Checksum,
+		Version:  en.Version,
+		Custom:   en.Custom,
+		Size:     en.Size,
+	}
+	if en.IsAnyFlagSet(apc.EntryIsChunked) {
+		manifest, err := GetObjectManifest(bp, bck, en.Name)
+		if err != nil {
+			return nil, err
+		}
+		out.Chunks = manifest
+	}
+	return out, nil
+}
+
+func (en *BckMetaEntry) equal(other *BckMetaEntry) bool {
+	if en.Size != other.Size || en.Checksum != other.Checksum || en.Version != other.Version {
+		return false
+	}
+	if en.Custom != other.Custom && !customEq(en.Custom, other.Custom) {
+		return false
+	}
+	a, b := en.Chunks, other.Chunks
+	switch {
+	case a == nil && b == nil:
+		return true
+	case a == nil || b == nil:
+		return false
+	case a.UploadID != b.UploadID || a.Size != b.Size || len(a.Chunks) != len(b.Chunks):
+		return false
+	}
+	for i := range a.Chunks {
+		ca, cb := &a.Chunks[i], &b.Chunks[i]
+		if ca.Num != cb.Num || ca.Offset != cb.Offset || ca.Size != cb.Size || !ca.Cksum.Equal(cb.Cksum) {
+			return false
+		}
+	}
+	return true
+}
+
+// custom metadata (see cmn.CustomMD2S) serializes non-standard keys in arbitrary order
+func customEq(a, b string) bool {
+	ma, mb := make(cos.StrKVs, 4), make(cos.StrKVs, 4)
+	cmn.S2CustomMD(ma, a, "")
+	cmn.S2CustomMD(mb, b, "")
+	return maps.Equal(ma, mb)
+}
+
+///////////////////
+// bmetaExporter //
+///////////////////
+
+func (e *bmetaExporter) write(en *cmn.LsoEnt) error {
+	out, err := newBckMetaEntry(e.bp, e.bck, en)
+	if err != nil {
+		return err
+	}
+	return e.enc.Encode(out)
+}
+
+func (*bmetaExporter) flush() error { return nil }
+
+///////////////////
+// bmetaVerifier //
+///////////////////
+
+func (v *bmetaVerifier) write(en *cmn.LsoEnt) error {
+	prev, ok := v.snap[en.Name]
+	if !ok {
+		v.rep.Added = append(v.rep.Added, en.Name)
+		return nil
+	}
+	delete(v.snap, en.Name)
+
+	curr, err := newBckMetaEntry(v.bp, v.bck, en)
+	if err != nil {
+		return err
+	}
+	if curr.equal(prev) {
+		v.rep.Matched++
+	} else {
+		v.rep.Changed = append(v.rep.Changed, en.Name)
+	}
+	return nil
+}
+
+func (*bmetaVerifier) flush() error { return nil }
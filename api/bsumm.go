@@ -315,3 +315,51 @@ func _invalidStatus(status int) error {
 		Status:  status,
 	}
 }
+
+// GetBucketApproxCount returns the approximate number of in-cluster objects in a given bucket:
+// a sum of per-target counters that are maintained incrementally (upon PUT, DELETE, etc.)
+// - cheap and near-instant: no scanning and no listing
+// - approximate: may drift (e.g., after a crash) until reconciled by the next full (no prefix) bucket summary
+// - for exact numbers, see GetBucketSummary
+func GetBucketApproxCount(bp BaseParams, bck cmn.Bck) (int64, error) {
+	q := qalloc()
+	bck.SetQuery(q)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActApproxCount})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	var n int64
+	_, err := reqParams.DoReqAny(&n)
+	FreeRp(reqParams)
+	qfree(q)
+	return n, err
+}
+
+// GetObjectDistribution returns per-target (by target ID) number and total size of the bucket's
+// in-cluster objects, along with the number of objects that HRW-map to other targets
+// - computed by the targets (each walking its own mountpaths in parallel): no listing
+// - exact, e.g. to check whether a bucket is evenly distributed after rebalance
+// - compare with GetBucketApproxCount (cheaper and approximate) and GetBucketSummary
+func GetObjectDistribution(bp BaseParams, bck cmn.Bck) (map[string]apc.DistStats, error) {
+	q := qalloc()
+	bck.SetQuery(q)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActObjDist})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	var out map[string]apc.DistStats
+	_, err := reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
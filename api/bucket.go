@@ -9,10 +9,14 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -41,6 +45,59 @@ func SetBucketProps(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (string,
 	return patchBprops(bp, bck, jbody)
 }
 
+// Same as above, with each target first accessing the remote bucket using the new props
+// (e.g., custom S3 endpoint, profile, or credentials); fails (without changing anything)
+// if the bucket is unreachable or access is denied.
+func SetBucketPropsCheck(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (string, error) {
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
+	q := qalloc()
+	bp.Method = http.MethodPatch
+	bck.SetQuery(q)
+	q.Set(apc.QparamCheckBackend, "true")
+	return doBckAct(bp, bck, jbody, q)
+}
+
+// ValidateBucketProps performs the same validation as SetBucketProps (including checks
+// that depend on the current number of targets, e.g. erasure coding) without applying
+// the props; returns validation error or warning, if any.
+// Warnings (soft errors) are not returned when `props.Force` is set - same as SetBucketProps.
+// Has no side effects: remote (and backend) buckets are not added to cluster metadata;
+// backend bucket, if specified, is validated by name only.
+func ValidateBucketProps(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) error {
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
+	q := qalloc()
+	bp.Method = http.MethodPatch
+	bck.SetQuery(q)
+	q.Set(apc.QparamValidateOnly, "true")
+	_, err := doBckAct(bp, bck, jbody, q)
+	return err
+}
+
+// SetBucketPropsIfChanged is the same as SetBucketProps except it is a no-op when the resulting
+// props would be identical to the current ones (see cmn.Bprops.Equal) - in which case cluster
+// metadata is not updated and `changed` is false (e.g., IaC reconcile loops re-applying the same props).
+func SetBucketPropsIfChanged(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (xid string, changed bool, err error) {
+	var (
+		status int
+		q      = qalloc()
+	)
+	bp.Method = http.MethodPatch
+	bck.SetQuery(q)
+	q.Set(apc.QparamIfChanged, "true")
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	status, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	qfree(q)
+	return xid, err == nil && status != http.StatusNoContent, err
+}
+
 // Reset bucket properties to the global configuration.
 func ResetBucketProps(bp BaseParams, bck cmn.Bck) (string, error) {
 	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActResetBprops})
@@ -54,6 +111,12 @@ func patchBprops(bp BaseParams, bck cmn.Bck, jbody []byte) (string, error) {
 	return doBckAct(bp, bck, jbody, q)
 }
 
+// HeadBckArgs parameterizes HeadBucketWithArgs.
+type HeadBckArgs struct {
+	Timeout       time.Duration // when non-zero, bounds HEAD(remote bucket) - see apc.QparamBckHeadTimeout
+	DontAddRemote bool          // see HeadBucket
+}
+
 // HEAD(bucket): apc.HdrBucketProps => cmn.Bprops{} and apc.HdrBucketInfo => BucketInfo{}
 //
 // Converts the string type fields returned from the HEAD request to their
@@ -67,15 +130,30 @@ func patchBprops(bp BaseParams, bck cmn.Bck, jbody []byte) (string, error) {
 // Use `dontAddRemote` to override the default behavior: as the name implies, setting
 // `dontAddRemote = true` prevents AIS from adding remote bucket to the cluster's metadata.
 func HeadBucket(bp BaseParams, bck cmn.Bck, dontAddRemote bool) (p *cmn.Bprops, err error) {
+	return HeadBucketWithArgs(bp, bck, &HeadBckArgs{DontAddRemote: dontAddRemote})
+}
+
+// HeadBucketWithArgs is HeadBucket with additional (optional) controls - in particular,
+// HeadBckArgs.Timeout to fail fast when a remote bucket (that is not yet in the cluster's BMD)
+// does not respond in time. In the latter case, the returned error is *cmn.ErrHTTP
+// with http.StatusGatewayTimeout (see also: IsErrBckHeadTimeout).
+// Nil args is the same as HeadBucket with `dontAddRemote = false`.
+func HeadBucketWithArgs(bp BaseParams, bck cmn.Bck, args *HeadBckArgs) (p *cmn.Bprops, err error) {
 	var (
 		hdr    http.Header
 		path   = apc.URLPathBuckets.Join(bck.Name)
 		q      = qalloc()
 		status int
 	)
-	if dontAddRemote {
+	if args == nil {
+		args = &HeadBckArgs{}
+	}
+	if args.DontAddRemote {
 		q.Set(apc.QparamDontAddRemote, "true")
 	}
+	if args.Timeout > 0 {
+		q.Set(apc.QparamBckHeadTimeout, args.Timeout.String())
+	}
 	q = bck.AddToQuery(q)
 
 	bp.Method = http.MethodHead
@@ -97,6 +175,11 @@ func HeadBucket(bp BaseParams, bck cmn.Bck, dontAddRemote bool) (p *cmn.Bprops,
 	return p, err
 }
 
+func IsErrBckHeadTimeout(err error) bool {
+	herr, ok := err.(*cmn.ErrHTTP)
+	return ok && herr.Status == http.StatusGatewayTimeout
+}
+
 // fill-in herr message (HEAD response will never contain one)
 func hdr2msg(bck cmn.Bck, status int, err error) error {
 	herr, ok := err.(*cmn.ErrHTTP)
@@ -119,8 +202,11 @@ func hdr2msg(bck cmn.Bck, status int, err error) error {
 	}
 	// common
 	herr.Message = "http error code '" + http.StatusText(status) + "'"
-	if status == http.StatusGone {
+	switch status {
+	case http.StatusGone:
 		herr.Message += " (removed from the backend)"
+	case http.StatusGatewayTimeout:
+		herr.Message += " (timed out waiting for the backend)"
 	}
 	herr.Message += ", bucket "
 	if bck.IsQuery() {
@@ -163,6 +249,24 @@ func CreateBucket(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadRe
 	return err
 }
 
+// Same as above, for an ais:// bucket with remote backend (`props.BackendBck`):
+// upon successful creation, start prefetching the entire backend bucket
+// (cmn.BpropsToSet.PrefetchOnAttach) and return the prefetch job ID.
+// The job can be long-running - use the returned xid to wait, monitor, or abort.
+func CreateBucketPrefetch(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (string, error) {
+	if err := bck.Validate(); err != nil {
+		return "", err
+	}
+	if props == nil || props.BackendBck == nil {
+		return "", fmt.Errorf("cannot prefetch-on-attach %s: backend bucket not specified", bck.Cname(""))
+	}
+	cprops := *props // (not modifying the caller's)
+	cprops.PrefetchOnAttach = true
+	bp.Method = http.MethodPost
+	q := qalloc()
+	return doBckAct(bp, bck, cos.MustMarshal(apc.ActMsg{Action: apc.ActCreateBck, Value: &cprops}), bck.AddToQuery(q))
+}
+
 // Destroy an ais:// bucket and remove all its content. Return an error if the operation fails.
 func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
 	q := qalloc()
@@ -184,6 +288,60 @@ func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
 	return err
 }
 
+// DestroyBuckets and DestroyBucketsMatching: max number of concurrent destroy requests
+const destroyBcksWorkers = 4
+
+// per-bucket result of DestroyBuckets and DestroyBucketsMatching
+type DestroyBckResult struct {
+	Err error
+	Bck cmn.Bck
+}
+
+// Destroy multiple ais:// buckets (see DestroyBucket), concurrently.
+// Not atomic: some buckets may get destroyed while others fail.
+// Returns per-bucket results in the order of `bcks`.
+func DestroyBuckets(bp BaseParams, bcks []cmn.Bck) []DestroyBckResult {
+	var (
+		wg  sync.WaitGroup
+		res = make([]DestroyBckResult, len(bcks))
+		ch  = make(chan int, len(bcks))
+	)
+	for i := range bcks {
+		res[i].Bck = bcks[i]
+		ch <- i
+	}
+	close(ch)
+	for range min(destroyBcksWorkers, len(bcks)) {
+		wg.Go(func() {
+			for i := range ch {
+				res[i].Err = DestroyBucket(bp, res[i].Bck)
+			}
+		})
+	}
+	wg.Wait()
+	return res
+}
+
+// Destroy all present ais:// buckets that match the query (provider, namespace)
+// and whose names start with the specified prefix.
+// As a safety measure, requires either a non-empty prefix or explicit confirmation.
+func DestroyBucketsMatching(bp BaseParams, qbck cmn.QueryBcks, prefix string, confirm bool) ([]DestroyBckResult, error) {
+	if prefix == "" && !confirm {
+		return nil, fmt.Errorf("destroying all buckets matching %q requires either non-empty prefix or confirmation", qbck.String())
+	}
+	bcks, err := ListBuckets(bp, qbck, apc.FltPresent)
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]cmn.Bck, 0, len(bcks))
+	for i := range bcks {
+		if bcks[i].IsAIS() && strings.HasPrefix(bcks[i].Name, prefix) {
+			selected = append(selected, bcks[i])
+		}
+	}
+	return DestroyBuckets(bp, selected), nil
+}
+
 // Copy all or selected content of `bckFrom` to the destination `bckTo`.
 //
 //   - AIS will create `bckTo` on the fly, but only if it's an AIS bucket; for 3rd-party
@@ -261,6 +419,63 @@ func RenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (string, error) {
 	return doBckAct(bp, bckFrom, jbody, q)
 }
 
+// RenameBucketViaCopy "renames" buckets that cannot be renamed natively (e.g., cloud
+// buckets): copies all of bckFrom (including remote objects not present in the cluster)
+// to bckTo, waits for the copy to finish, and then removes the source:
+//   - ais:// source is destroyed
+//   - remote source gets all its objects deleted (in the cluster and in the remote
+//     backend), and is then evicted
+//
+// WARNING: the operation is NOT atomic. The source and destination coexist while copying,
+// and a failure in the middle may leave the source partially deleted (with its content
+// in bckTo). Use RenameBucket for ais:// buckets.
+//
+// Aborted copy always leaves the source intact. When the copy finishes with (non-fatal)
+// errors, the source is removed only if `force` is set.
+// Returns copy-bucket xaction ID along with error, if any.
+func RenameBucketViaCopy(bp BaseParams, bckFrom, bckTo cmn.Bck, force bool) (string, error) {
+	if err := bckTo.Validate(); err != nil {
+		return "", err
+	}
+	if bckFrom.Equal(&bckTo) {
+		return "", fmt.Errorf("cannot rename bucket %q onto itself", bckFrom.Cname(""))
+	}
+	var flt []int
+	if bckFrom.IsRemote() {
+		flt = []int{apc.FltExists}
+	}
+	xid, err := CopyBucket(bp, bckFrom, bckTo, &apc.TCBMsg{}, flt...)
+	if err != nil {
+		return xid, err
+	}
+
+	// wait for copy (by ID: copying remote bucket may run as x-tco)
+	args := &xact.ArgsMsg{ID: xid, Timeout: -1 /*long*/}
+	status, err := WaitForXactionIC(bp, args)
+	if err != nil {
+		return xid, err
+	}
+	if status.AbortedX {
+		return xid, fmt.Errorf("%s aborted (source %q remains intact): %s", args, bckFrom.Cname(""), status.ErrMsg)
+	}
+	if status.ErrMsg != "" && !force {
+		return xid, fmt.Errorf("%s finished with errors (source %q remains intact): %s", args, bckFrom.Cname(""), status.ErrMsg)
+	}
+
+	// remove source
+	if !bckFrom.IsRemote() {
+		return xid, DestroyBucket(bp, bckFrom)
+	}
+	dxid, err := DeleteMultiObj(bp, bckFrom, &apc.EvdMsg{} /*all objects*/)
+	if err != nil {
+		return xid, err
+	}
+	if err := WaitForXaction(bp, &xact.ArgsMsg{ID: dxid, Kind: apc.ActDeleteObjects, Timeout: -1}); err != nil {
+		return xid, err
+	}
+	return xid, EvictRemoteBucket(bp, bckFrom, false /*keepMD*/)
+}
+
 // Evict an entire remote bucket from AIS cluster, where:
 // - keepMD: evict objects but keep bucket metadata
 func EvictRemoteBucket(bp BaseParams, bck cmn.Bck, keepMD bool) error {
@@ -302,6 +517,39 @@ func IndexBucketShards(bp BaseParams, bck cmn.Bck, msg *apc.IndexShardMsg) (stri
 	return doBckAct(bp, bck, jbody, q)
 }
 
+// ScrubArgs parameterizes ScrubBucket (see apc.ScrubMsg).
+type ScrubArgs struct {
+	Prefix string // only scrub objects whose name begins with Prefix
+	Repair bool   // re-fetch (remote) or restore (mirror, EC) corrupted objects
+}
+
+// ScrubBucket starts an xaction that walks all present (in-cluster) objects in bck
+// and validates their stored checksums against content. Corrupted objects are
+// reported via xaction snapshot (CtlMsg) and, with args.Repair, recovered.
+// Returns xaction ID if successful, or an error otherwise.
+func ScrubBucket(bp BaseParams, bck cmn.Bck, args ScrubArgs) (string, error) {
+	q := qalloc()
+	bck.SetQuery(q)
+	bp.Method = http.MethodPost
+	msg := &apc.ScrubMsg{Prefix: args.Prefix, Repair: args.Repair}
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActScrub, Value: msg})
+	return doBckAct(bp, bck, jbody, q)
+}
+
+// VerifyChunks starts an xaction that checks chunked objects in bck (optionally, only
+// those whose names begin with prefix): every chunk referenced by a completed manifest
+// must exist with the right size and checksum. Also counts orphan chunks and partial
+// manifests; the counts (ok, missing_chunk, orphan, partial) are reported via xaction
+// snapshot (CtlMsg). Read-only: nothing gets removed or repaired.
+// Returns xaction ID if successful, or an error otherwise.
+func VerifyChunks(bp BaseParams, bck cmn.Bck, prefix string) (string, error) {
+	q := qalloc()
+	bck.SetQuery(q)
+	bp.Method = http.MethodPost
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActVerifyChunks, Value: &apc.VerifyChunksMsg{Prefix: prefix}})
+	return doBckAct(bp, bck, jbody, q)
+}
+
 // Start an eXtended Action (xaction) to bring a given bucket to a
 // certain redundancy level (num copies).
 // Return xaction ID if successful, or an error otherwise.
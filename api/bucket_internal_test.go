@@ -0,0 +1,296 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// HEAD(bucket) server: records the query and responds with the given status
+func newBheadServer(t *testing.T, status int, query *url.Values) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.Query()
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		props := cmn.Bprops{Provider: apc.AWS, BID: 1}
+		w.Header().Set(apc.HdrBucketProps, cos.MustMarshalToString(&props))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHeadBucketWithArgs(t *testing.T) {
+	bck := cmn.Bck{Name: "bhead", Provider: apc.AWS}
+
+	t.Run("nil-args", func(t *testing.T) {
+		var query url.Values
+		srv := newBheadServer(t, http.StatusOK, &query)
+		bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+		p, err := HeadBucketWithArgs(bp, bck, nil)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, p.Provider == apc.AWS, "expected %q, got %q", apc.AWS, p.Provider)
+		tassert.Errorf(t, !query.Has(apc.QparamBckHeadTimeout), "unexpected %s", apc.QparamBckHeadTimeout)
+		tassert.Errorf(t, !query.Has(apc.QparamDontAddRemote), "unexpected %s", apc.QparamDontAddRemote)
+	})
+
+	t.Run("args", func(t *testing.T) {
+		var query url.Values
+		srv := newBheadServer(t, http.StatusOK, &query)
+		bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+		_, err := HeadBucketWithArgs(bp, bck, &HeadBckArgs{Timeout: 5 * time.Second, DontAddRemote: true})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, query.Get(apc.QparamBckHeadTimeout) == "5s", "expected %s=5s, got %q",
+			apc.QparamBckHeadTimeout, query.Get(apc.QparamBckHeadTimeout))
+		tassert.Errorf(t, query.Get(apc.QparamDontAddRemote) == "true", "expected %s", apc.QparamDontAddRemote)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		var query url.Values
+		srv := newBheadServer(t, http.StatusGatewayTimeout, &query)
+		bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+		_, err := HeadBucketWithArgs(bp, bck, &HeadBckArgs{Timeout: time.Second})
+		tassert.Fatalf(t, IsErrBckHeadTimeout(err), "expected timeout error, got %v", err)
+
+		srv = newBheadServer(t, http.StatusNotFound, &query)
+		bp = BaseParams{Client: &http.Client{}, URL: srv.URL}
+		_, err = HeadBucketWithArgs(bp, bck, nil)
+		tassert.Fatalf(t, err != nil && !IsErrBckHeadTimeout(err), "expected (non-timeout) error, got %v", err)
+	})
+}
+
+// cluster that copies and removes buckets: records the requested actions and responds
+// with the given copy-bucket status
+type rnServer struct {
+	status nl.Status // copy-bucket (final) status
+	xids   map[string]string
+	acts   []string
+	flt    string // copy-bucket presence filter
+	mu     sync.Mutex
+}
+
+func newRnServer(t *testing.T, status nl.Status) (*rnServer, *httptest.Server) {
+	rs := &rnServer{status: status, xids: make(map[string]string, 2)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+		if r.Method == http.MethodGet { // xaction status
+			var msg xact.QueryMsg
+			tassert.CheckError(t, jsoniter.NewDecoder(r.Body).Decode(&msg))
+			st := nl.Status{UUID: msg.ID, Kind: msg.Kind, EndTimeX: time.Now().UnixNano()}
+			if rs.xids[apc.ActCopyBck] == msg.ID {
+				st.AbortedX, st.ErrMsg = rs.status.AbortedX, rs.status.ErrMsg
+			}
+			w.Write(cos.MustMarshal(&st))
+			return
+		}
+		var msg apc.ActMsg
+		tassert.CheckError(t, jsoniter.NewDecoder(r.Body).Decode(&msg))
+		bname := strings.TrimPrefix(r.URL.Path, apc.URLPathBuckets.S+"/")
+		rs.acts = append(rs.acts, msg.Action+" "+bname)
+		switch msg.Action {
+		case apc.ActCopyBck, apc.ActDeleteObjects:
+			if msg.Action == apc.ActCopyBck {
+				rs.flt = r.URL.Query().Get(apc.QparamFltPresence)
+			}
+			xid := "x" + cos.CryptoRandS(cos.LenShortID)
+			rs.xids[msg.Action] = xid
+			w.Write([]byte(xid))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return rs, srv
+}
+
+func TestRenameBucketViaCopy(t *testing.T) {
+	var (
+		aisFrom = cmn.Bck{Name: "rn-src", Provider: apc.AIS}
+		rmtFrom = cmn.Bck{Name: "rn-src", Provider: apc.AWS}
+		bckTo   = cmn.Bck{Name: "rn-dst", Provider: apc.AIS}
+	)
+	tests := []struct {
+		name   string
+		from   cmn.Bck
+		status nl.Status
+		force  bool
+		fail   bool
+		acts   []string
+	}{
+		{
+			name: "ais", from: aisFrom,
+			acts: []string{apc.ActCopyBck + " rn-src", apc.ActDestroyBck + " rn-src"},
+		},
+		{
+			name: "remote", from: rmtFrom,
+			acts: []string{apc.ActCopyBck + " rn-src", apc.ActDeleteObjects + " rn-src", apc.ActEvictRemoteBck + " rn-src"},
+		},
+		{
+			name: "aborted", from: rmtFrom, status: nl.Status{AbortedX: true, ErrMsg: "aborted"}, force: true, fail: true,
+			acts: []string{apc.ActCopyBck + " rn-src"},
+		},
+		{
+			name: "errors", from: aisFrom, status: nl.Status{ErrMsg: "failed to copy"}, fail: true,
+			acts: []string{apc.ActCopyBck + " rn-src"},
+		},
+		{
+			name: "errors-force", from: aisFrom, status: nl.Status{ErrMsg: "failed to copy"}, force: true,
+			acts: []string{apc.ActCopyBck + " rn-src", apc.ActDestroyBck + " rn-src"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, srv := newRnServer(t, tt.status)
+			bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+			xid, err := RenameBucketViaCopy(bp, tt.from, bckTo, tt.force)
+			if tt.fail {
+				tassert.Fatalf(t, err != nil, "expected error")
+			} else {
+				tassert.CheckFatal(t, err)
+			}
+			tassert.Errorf(t, xid == rs.xids[apc.ActCopyBck], "expected xid %q, got %q", rs.xids[apc.ActCopyBck], xid)
+			tassert.Fatalf(t, strings.Join(rs.acts, ", ") == strings.Join(tt.acts, ", "),
+				"expected actions %v, got %v", tt.acts, rs.acts)
+			if tt.from.IsRemote() {
+				// must copy remote objects that are not present in the cluster
+				tassert.Errorf(t, rs.flt == strconv.Itoa(apc.FltExists), "expected %s=%d, got %q",
+					apc.QparamFltPresence, apc.FltExists, rs.flt)
+			}
+		})
+	}
+
+	// validation: nothing requested
+	rs, srv := newRnServer(t, nl.Status{})
+	bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+	_, err := RenameBucketViaCopy(bp, aisFrom, aisFrom, true)
+	tassert.Errorf(t, err != nil, "expected error renaming bucket onto itself")
+	tassert.Errorf(t, len(rs.acts) == 0, "unexpected actions %v", rs.acts)
+}
+
+func TestCreateBucketPrefetch(t *testing.T) {
+	const xid = "pfx-xid-1"
+	var (
+		bck   = cmn.Bck{Name: "cache", Provider: apc.AIS}
+		props cmn.BpropsToSet
+		qbck  url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := apc.ActMsg{Value: &props}
+		tassert.CheckError(t, jsoniter.NewDecoder(r.Body).Decode(&msg))
+		tassert.Errorf(t, msg.Action == apc.ActCreateBck, "expected %q, got %q", apc.ActCreateBck, msg.Action)
+		qbck = r.URL.Query()
+		w.Write([]byte(xid))
+	}))
+	defer srv.Close()
+	bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+
+	in := &cmn.BpropsToSet{BackendBck: &cmn.BackendBckToSet{Name: apc.Ptr("origin"), Provider: apc.Ptr(apc.AWS)}}
+	id, err := CreateBucketPrefetch(bp, bck, in)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, id == xid, "expected xid %q, got %q", xid, id)
+	tassert.Errorf(t, props.PrefetchOnAttach, "expected prefetch_on_attach in the request")
+	tassert.Errorf(t, props.BackendBck != nil && *props.BackendBck.Name == "origin", "expected backend bucket in the request")
+	tassert.Errorf(t, qbck.Get(apc.QparamProvider) == apc.AIS, "expected provider %q, got %q", apc.AIS, qbck.Get(apc.QparamProvider))
+	tassert.Errorf(t, !in.PrefetchOnAttach, "caller's props must not be modified")
+
+	// backend bucket is required
+	_, err = CreateBucketPrefetch(bp, bck, &cmn.BpropsToSet{})
+	tassert.Errorf(t, err != nil, "expected error upon missing backend bucket")
+	_, err = CreateBucketPrefetch(bp, bck, nil)
+	tassert.Errorf(t, err != nil, "expected error upon nil props")
+}
+
+func TestDestroyBuckets(t *testing.T) {
+	var (
+		listed = cmn.Bcks{
+			{Name: "data-1", Provider: apc.AIS},
+			{Name: "data-2", Provider: apc.AIS},
+			{Name: "data-fail", Provider: apc.AIS},
+			{Name: "data-3", Provider: apc.AWS}, // not ais://
+			{Name: "database", Provider: apc.AIS},
+			{Name: "other", Provider: apc.AIS},
+		}
+		destroyed []string
+		listCnt   int
+		mu        sync.Mutex
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg apc.ActMsg
+		tassert.CheckError(t, jsoniter.NewDecoder(r.Body).Decode(&msg))
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodGet {
+			tassert.Errorf(t, msg.Action == apc.ActList, "expected %q, got %q", apc.ActList, msg.Action)
+			listCnt++
+			w.Write(cos.MustMarshal(listed))
+			return
+		}
+		tassert.Errorf(t, r.Method == http.MethodDelete && msg.Action == apc.ActDestroyBck, "unexpected %s %q", r.Method, msg.Action)
+		bname := strings.TrimPrefix(r.URL.Path, apc.URLPathBuckets.S+"/")
+		if strings.HasSuffix(bname, "-fail") {
+			http.Error(w, "failed to destroy "+bname, http.StatusInternalServerError)
+			return
+		}
+		destroyed = append(destroyed, bname)
+	}))
+	defer srv.Close()
+	bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+	qbck := cmn.QueryBcks{Provider: apc.AIS}
+
+	// guard: neither prefix nor confirmation
+	_, err := DestroyBucketsMatching(bp, qbck, "", false)
+	tassert.Errorf(t, err != nil, "expected error upon empty prefix without confirmation")
+	tassert.Errorf(t, listCnt == 0 && len(destroyed) == 0, "unexpected requests: %d list, destroyed %v", listCnt, destroyed)
+
+	// prefix: only matching ais:// buckets; per-bucket results
+	res, err := DestroyBucketsMatching(bp, qbck, "data-", false)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(res) == 3, "expected 3 results, got %d", len(res))
+	for i, exp := range []string{"data-1", "data-2", "data-fail"} {
+		tassert.Errorf(t, res[i].Bck.Name == exp, "result #%d: expected %q, got %q", i, exp, res[i].Bck.Name)
+		if exp == "data-fail" {
+			tassert.Errorf(t, res[i].Err != nil, "%s: expected error", exp)
+		} else {
+			tassert.Errorf(t, res[i].Err == nil, "%s: unexpected error %v", exp, res[i].Err)
+		}
+	}
+	slices.Sort(destroyed)
+	tassert.Errorf(t, slices.Equal(destroyed, []string{"data-1", "data-2"}), "expected data-1 and data-2 destroyed, got %v", destroyed)
+
+	// confirmed: all ais:// buckets
+	destroyed = destroyed[:0]
+	res, err = DestroyBucketsMatching(bp, qbck, "", true)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(res) == 5, "expected 5 results, got %d", len(res))
+	slices.Sort(destroyed)
+	tassert.Errorf(t, slices.Equal(destroyed, []string{"data-1", "data-2", "database", "other"}),
+		"expected all ais:// (but failed) destroyed, got %v", destroyed)
+
+	// explicit list: results in the order of the list
+	destroyed = destroyed[:0]
+	bcks := []cmn.Bck{{Name: "x-fail", Provider: apc.AIS}, {Name: "x", Provider: apc.AIS}}
+	res = DestroyBuckets(bp, bcks)
+	tassert.Fatalf(t, len(res) == 2, "expected 2 results, got %d", len(res))
+	tassert.Errorf(t, res[0].Bck.Name == "x-fail" && res[0].Err != nil, "expected x-fail to fail, got %+v", res[0])
+	tassert.Errorf(t, res[1].Bck.Name == "x" && res[1].Err == nil, "expected x destroyed, got %+v", res[1])
+	tassert.Errorf(t, slices.Equal(destroyed, []string{"x"}), "expected x destroyed, got %v", destroyed)
+}
@@ -1,14 +1,16 @@
 // Package api provides native Go-based API/SDK over HTTP(S).
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -86,6 +88,81 @@ func GetClusterMap(bp BaseParams) (smap *meta.Smap, err error) {
 	return smap, err
 }
 
+// WatchClusterMap streams cluster maps as they change (nodes joining and leaving,
+// maintenance, primary change, etc.):
+//   - the first value is the current cluster map
+//   - subsequently, each new Smap version (long-poll via apc.QparamSmapNewer)
+//   - intermediate versions may be skipped when changes are coming in rapid succession
+//   - transient errors are retried; the channel gets closed when `ctx` is done
+func WatchClusterMap(ctx context.Context, bp BaseParams) (<-chan *meta.Smap, error) {
+	smap, err := GetClusterMap(bp)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *meta.Smap, 1)
+	ch <- smap
+
+	// the client must outlast the server-side wait
+	if c := bp.Client; c != nil && c.Timeout > 0 && c.Timeout < apc.SmapLongPoll+smapWatchSlack {
+		cl := *c
+		cl.Timeout = apc.SmapLongPoll + smapWatchSlack
+		bp.Client = &cl
+	}
+	go watchSmap(ctx, bp, smap.Version, ch)
+	return ch, nil
+}
+
+const (
+	smapWatchRetry = 2 * time.Second
+	smapWatchSlack = 10 * time.Second
+)
+
+func watchSmap(ctx context.Context, bp BaseParams, ver int64, ch chan *meta.Smap) {
+	defer close(ch)
+	for {
+		smap, err := getSmapNewer(ctx, bp, ver)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(smapWatchRetry):
+			}
+			continue
+		case smap.Version <= ver:
+			continue // long-poll timed out
+		}
+		ver = smap.Version
+		select {
+		case ch <- smap:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func getSmapNewer(ctx context.Context, bp BaseParams, ver int64) (smap *meta.Smap, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatSmap)
+	q.Set(apc.QparamSmapNewer, strconv.FormatInt(ver, 10))
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDae.S
+		reqParams.Query = q
+		reqParams.ctx = ctx
+	}
+	_, err = reqParams.DoReqAny(&smap)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return smap, err
+}
+
 // GetNodeClusterMap retrieves cluster map from the specified node.
 func GetNodeClusterMap(bp BaseParams, sid string) (smap *meta.Smap, err error) {
 	q := qalloc()
@@ -220,6 +297,29 @@ func GetConfiguredBackends(bp BaseParams) (out []string, err error) {
 	return out, err
 }
 
+// TestBackend has each target list buckets via the given (configured) provider
+// and returns the results keyed by target ID - see apc.BackendProbeResult
+// - to troubleshoot credentials and connectivity
+// - failure to reach the backend is reported per target (BackendProbeResult.Err), not as an error
+func TestBackend(bp BaseParams, provider string) (out map[string]*apc.BackendProbeResult, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatBackendProbe)
+	q.Set(apc.QparamProvider, provider)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&out)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
+
 // JoinCluster add a node to a cluster.
 func JoinCluster(bp BaseParams, nodeInfo *meta.Snode, flags cos.BitFlags) (rebID, sid string, err error) {
 	bp.Method = http.MethodPost
@@ -346,6 +446,14 @@ func ClearLcache(bp BaseParams, tid string) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActClearLcache, Name: tid})
 }
 
+// SetClusterReadOnly puts the entire cluster in (or takes it out of) read-only mode:
+// reads and lists keep working, while all mutations - PUT, DELETE, rename, bucket
+// create/destroy, et al. - fail with cmn.ErrClusterReadOnly.
+// The current state is reported via GetClusterMap (see meta.Smap.ReadOnly).
+func SetClusterReadOnly(bp BaseParams, readOnly bool) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActClusterReadOnly, Value: readOnly})
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
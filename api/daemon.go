@@ -68,6 +68,19 @@ func GetDaemonConfig(bp BaseParams, node *meta.Snode) (config *cmn.Config, err e
 	return
 }
 
+// Returns only the cluster config settings that differ from built-in defaults:
+// dotted key (e.g. "versioning.enabled") => {current, default}
+// Not included:
+// - local (per-node) config
+// - `auth`, `backend`, and `ext` sections (may carry secrets; no meaningful built-in defaults)
+// - identity and versioning fields: uuid, lastupdate_time, config_version
+// (see cmn.ClusterConfig.DiffDefaults)
+func GetDaemonConfigDiff(bp BaseParams, node *meta.Snode) (diff cmn.ConfigDiff, err error) {
+	diff = make(cmn.ConfigDiff, 16)
+	err = getNodeReverse(bp, node, apc.WhatNodeConfigDiff, &diff)
+	return
+}
+
 // Returns metric names and kinds: (name, kind) pairs
 func GetMetricNames(bp BaseParams, node *meta.Snode) (kvs cos.StrKVs, err error) {
 	kvs = make(cos.StrKVs, 32)
@@ -103,6 +116,15 @@ func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, label ..
 	return _actMpath(bp, node, mountpath, apc.ActMountpathAttach, q)
 }
 
+// SetMountpathLabel (re)labels an existing (available or disabled) mountpath;
+// empty label removes the existing one, if any.
+// The label is persisted and survives restarts; see also GetMountpaths (MountpathList.Labels)
+func SetMountpathLabel(bp BaseParams, node *meta.Snode, mountpath string, label cos.MountpathLabel) error {
+	q := url.Values{apc.QparamMpathLabel: []string{string(label)}}
+	bp.Method = http.MethodPost
+	return _actMpath(bp, node, mountpath, apc.ActMountpathLabel, q)
+}
+
 func EnableMountpath(bp BaseParams, node *meta.Snode, mountpath string) error {
 	bp.Method = http.MethodPost
 	return _actMpath(bp, node, mountpath, apc.ActMountpathEnable, nil)
@@ -184,8 +206,24 @@ func ResetDaemonConfig(bp BaseParams, nodeID string) error {
 	return _putDaemon(bp, nodeID, apc.ActMsg{Action: apc.ActResetConfig})
 }
 
-func RotateLogs(bp BaseParams, nodeID string) error {
-	return _putDaemon(bp, nodeID, apc.ActMsg{Action: apc.ActRotateLogs})
+// RotateLogs rotates the node's logs and immediately (i.e., without waiting for the periodic
+// check) removes the oldest ones in excess of the configured `log.max_total`;
+// returns the number of bytes freed
+func RotateLogs(bp BaseParams, nodeID string) (freed int64, err error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActRotateLogs})
+		reqParams.Header = http.Header{
+			apc.HdrNodeID:      []string{nodeID},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+	}
+	_, err = reqParams.DoReqAny(&freed)
+	FreeRp(reqParams)
+	return freed, err
 }
 
 func _putDaemon(bp BaseParams, nodeID string, msg apc.ActMsg) error {
@@ -57,6 +57,13 @@ const (
 	AisK8sPublicDNSMode        = "AIS_PUBLIC_DNS_MODE"
 	AisK8sEnableExternalAccess = "ENABLE_EXTERNAL_ACCESS"
 
+	// encryption at rest (see cmn.EncryptionConf and docs/encryption.md): each target looks up
+	// the bucket's encryption key by its reference (name), first in the secrets directory
+	// (one file per key), and then in the environment: AIS_ENCRYPTION_KEY_<NAME>,
+	// with the name in upper case and '-' replaced with '_'
+	AisEncryptionKeysDir   = "AIS_ENCRYPTION_KEYS_DIR"
+	AisEncryptionKeyPrefix = "AIS_ENCRYPTION_KEY_"
+
 	// AisK8sPublicDNSMode values
 	PubNetDNSModeIP   string = "IP"
 	PubNetDNSModeNode string = "Node"
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -155,6 +156,10 @@ func ETLMetrics(params BaseParams, etlName string) (healths etl.CPUMemByTarget,
 	return
 }
 
+// ETLHealth returns, for each target, the K8s-level state of the respective ETL pod:
+// phase, container restart count, and the last readiness-probe result.
+// Use it to detect a flapping (e.g., CrashLoopBackOff) transformer that
+// ETLList still reports as "Running" at the AIS stage level.
 func ETLHealth(params BaseParams, etlName string) (healths etl.HealthByTarget, err error) {
 	params.Method = http.MethodGet
 	path := apc.URLPathETL.Join(etlName, apc.ETLHealth)
@@ -201,7 +206,31 @@ func etlPostAction(bp BaseParams, etlName, action string) (err error) {
 }
 
 func ETLObject(bp BaseParams, etl *ETL, bck cmn.Bck, objName string, w io.Writer) (oah ObjAttrs, err error) {
+	return etlObject(bp, etl, bck, objName, w, 0)
+}
+
+// ETLObjectWithTimeout is ETLObject that bounds the time the target waits for the transformer
+// (overriding ETL's `obj_timeout` for this request only).
+// When exceeded, returns *cmn.ErrETLTimeout (see cmn.IsErrETLTimeout) carrying ETL name and object.
+// Note: applies to hpush communication type (hpull redirects, and websocket uses long-lived sessions).
+func ETLObjectWithTimeout(bp BaseParams, etl *ETL, bck cmn.Bck, objName string, w io.Writer, timeout time.Duration) (ObjAttrs, error) {
+	if timeout <= 0 {
+		return ObjAttrs{}, fmt.Errorf("invalid ETL timeout %v (expecting positive duration)", timeout)
+	}
+	oah, err := etlObject(bp, etl, bck, objName, w, timeout)
+	if err != nil {
+		if herr := cmn.AsErrHTTP(err); herr != nil && herr.TypeCode == "ErrETLTimeout" {
+			err = cmn.NewErrETLTimeout(etl.ETLName, bck.Cname(objName), timeout)
+		}
+	}
+	return oah, err
+}
+
+func etlObject(bp BaseParams, etl *ETL, bck cmn.Bck, objName string, w io.Writer, timeout time.Duration) (oah ObjAttrs, err error) {
 	query := url.Values{apc.QparamETLName: []string{etl.ETLName}}
+	if timeout > 0 {
+		query.Set(apc.QparamETLTimeout, timeout.String())
+	}
 	if etl.TransformArgs != nil {
 		targs, err := cos.ConvertToString(etl.TransformArgs)
 		if err != nil {
@@ -6,9 +6,14 @@ package api
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -22,6 +27,12 @@ const (
 	maxListPageRetries = 3
 )
 
+// ExportListing formats
+const (
+	ExportCSV   = "csv"
+	ExportJSONL = "jsonl"
+)
+
 type (
 	LsoCounter struct {
 		callback  LsoCB
@@ -32,6 +43,21 @@ type (
 	}
 	LsoCB func(*LsoCounter)
 
+	// ExportListing
+	lsoExporter interface {
+		write(en *cmn.LsoEnt) error
+		flush() error
+	}
+	lsoCSV struct {
+		w    *csv.Writer
+		cols []string
+		row  []string
+	}
+	lsoJSONL struct {
+		enc   *json.Encoder
+		props cos.StrSet
+	}
+
 	// additional and optional list-objects args (compare with: GetArgs, PutArgs)
 	ListArgs struct {
 		Context   context.Context // optional; defaults to context.Background()
@@ -181,6 +207,7 @@ func lso(reqParams *ReqParams, lsmsg *apc.LsoMsg, args ListArgs) (lst *cmn.LsoRe
 			lst.Entries = append(lst.Entries, page.Entries...)
 			lst.ContinuationToken = page.ContinuationToken
 			lst.Flags |= page.Flags
+			lst.PageSize = page.PageSize
 			debug.Assert(lst.UUID == page.UUID, lst.UUID, page.UUID)
 		}
 		if ctx != nil && ctx.mustCall() {
@@ -247,6 +274,142 @@ func ListObjectsPage(bp BaseParams, bck cmn.Bck, lsmsg *apc.LsoMsg, args ListArg
 	return page, nil
 }
 
+// ExportListing lists the bucket page by page and streams each listed entry to `w`
+// in the specified format without materializing the entire result set in memory:
+//   - ExportCSV:   header row (`name` followed by the selected props), one row per entry
+//   - ExportJSONL: one JSON-encoded `cmn.LsoEnt` per line (with selected props only)
+//
+// Selected props are `lsmsg.Props` (e.g., apc.GetPropsSize, apc.GetPropsChecksum) -
+// same as in the regular listing; when unspecified, defaulting to apc.GetPropsMinimal.
+// Returns the number of exported entries.
+// See also: `api.ListObjects`
+func ExportListing(bp BaseParams, bck cmn.Bck, lsmsg *apc.LsoMsg, w io.Writer, format string) (int64, error) {
+	if lsmsg == nil {
+		lsmsg = &apc.LsoMsg{}
+	} else {
+		lsmsg = lsmsg.Clone() // (paging below must not modify the caller's message)
+	}
+	props := lsmsg.Props
+	if props == "" {
+		props = strings.Join(apc.GetPropsMinimal, apc.LsPropsSepa)
+	}
+	var (
+		exp  lsoExporter
+		pset = exportProps(props)
+		cols = exportCols(pset)
+	)
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		exp = &lsoCSV{w: cw, cols: cols}
+		if err := cw.Write(cols); err != nil {
+			return 0, err
+		}
+	case ExportJSONL:
+		exp = &lsoJSONL{enc: json.NewEncoder(w), props: pset}
+	default:
+		return 0, fmt.Errorf("invalid export format %q (expecting %q or %q)", format, ExportCSV, ExportJSONL)
+	}
+
+	q := qalloc()
+	reqParams := lsoReq(bp, bck, &ListArgs{}, q)
+	lsmsg.UUID, lsmsg.ContinuationToken = "", "" // new
+	n, err := exportPages(reqParams, lsmsg, exp)
+
+	freeMbuf(reqParams.buf)
+	FreeRp(reqParams)
+	qfree(q)
+	return n, err
+}
+
+func exportPages(reqParams *ReqParams, lsmsg *apc.LsoMsg, exp lsoExporter) (n int64, _ error) {
+	for {
+		actMsg := apc.ActMsg{Action: apc.ActList, Value: lsmsg}
+		reqParams.Body = cos.MustMarshal(actMsg)
+		page, err := lsoPage(reqParams)
+		if err != nil {
+			return n, err
+		}
+		for _, en := range page.Entries {
+			if err := exp.write(en); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if err := exp.flush(); err != nil {
+			return n, err
+		}
+		if page.ContinuationToken == "" { // listed all pages
+			return n, nil
+		}
+		lsmsg.UUID = page.UUID
+		lsmsg.ContinuationToken = page.ContinuationToken
+	}
+}
+
+// split comma-separated props into whole (trimmed) tokens
+// (substring matching would, e.g., mistake "ec" for "checksum")
+func exportProps(props string) cos.StrSet {
+	pset := make(cos.StrSet, 8)
+	for prop := range strings.SplitSeq(props, apc.LsPropsSepa) {
+		if prop = strings.TrimSpace(prop); prop != "" {
+			pset.Add(prop)
+		}
+	}
+	return pset
+}
+
+// CSV columns: name followed by selected (and exportable) props, in this order
+func exportCols(pset cos.StrSet) []string {
+	cols := make([]string, 0, 8)
+	cols = append(cols, apc.GetPropsName)
+	for _, prop := range []string{apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsVersion,
+		apc.GetPropsCached, apc.GetPropsCopies, apc.GetPropsLocation, apc.GetPropsCustom} {
+		if pset.Contains(prop) {
+			cols = append(cols, prop)
+		}
+	}
+	return cols
+}
+
+func (e *lsoCSV) write(en *cmn.LsoEnt) error {
+	row := e.row[:0]
+	for _, col := range e.cols {
+		var v string
+		switch col {
+		case apc.GetPropsName:
+			v = en.Name
+		case apc.GetPropsSize:
+			v = strconv.FormatInt(en.Size, 10)
+		case apc.GetPropsChecksum:
+			v = en.Checksum
+		case apc.GetPropsAtime:
+			v = en.Atime
+		case apc.GetPropsVersion:
+			v = en.Version
+		case apc.GetPropsCached:
+			v = strconv.FormatBool(en.IsPresent())
+		case apc.GetPropsCopies:
+			v = strconv.Itoa(int(en.Copies))
+		case apc.GetPropsLocation:
+			v = en.Location
+		case apc.GetPropsCustom:
+			v = en.Custom
+		}
+		row = append(row, v)
+	}
+	e.row = row
+	return e.w.Write(row)
+}
+
+func (e *lsoCSV) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *lsoJSONL) write(en *cmn.LsoEnt) error { return e.enc.Encode(en.CopyWithProps(e.props)) }
+func (*lsoJSONL) flush() error                 { return nil }
+
 ////////////////
 // LsoCounter //
 ////////////////
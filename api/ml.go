@@ -118,6 +118,31 @@ func GetBatchStream(bp BaseParams, bck cmn.Bck, req *apc.MossReq) (io.ReadCloser
 	return body, wresp.Header, nil
 }
 
+// GetObjectsAsArchive streams the named objects from a given bucket as a single archive
+// written to `w` on the fly - ephemeral, nothing is persisted in the cluster
+// (compare with ArchiveMultiObj that creates a shard).
+// - `format`: output format, e.g. ".tar" (default when empty), ".tgz", ".tar.lz4", ".zip";
+// - files in the resulting archive are named <ObjName>;
+// - .zip is not streamable and is therefore finalized (server-side) prior to being written to `w`;
+// - a convenience wrapper over GetBatch - see the latter for details.
+func GetObjectsAsArchive(bp BaseParams, bck cmn.Bck, names []string, w io.Writer, format string) error {
+	if len(names) == 0 {
+		return errors.New("GetObjectsAsArchive: empty list of object names")
+	}
+	req := &apc.MossReq{
+		OutputFormat: format,
+		In:           make([]apc.MossIn, len(names)),
+		OnlyObjName:  true,
+	}
+	for i, name := range names {
+		req.In[i].ObjName = name
+	}
+	of := strings.ToLower(format)
+	req.StreamingGet = of != zipext && !strings.Contains(of, zipext[1:])
+	_, err := GetBatch(bp, bck, req, w)
+	return err
+}
+
 //
 // misc. helpers
 //
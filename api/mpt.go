@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -21,6 +22,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 )
 
+// AbortUploads: max number of concurrent abort requests
+const abortUploadsWorkers = 4
+
 // Default values for multipart download
 const (
 	defaultMptDownloadWorkers   = 16
@@ -30,6 +34,18 @@ const (
 )
 
 type (
+	// incomplete multipart upload (see ListStuckUploads)
+	StuckUpload struct {
+		Created  time.Time     `json:"created"`
+		Bck      cmn.Bck       `json:"bck"`
+		ObjName  string        `json:"objname"`
+		UploadID string        `json:"upload_id"`
+		TargetID string        `json:"tid"` // target that tracks the upload
+		Age      time.Duration `json:"age"` // at the time of listing
+		Size     int64         `json:"size"`
+		NumParts int           `json:"num_parts"`
+	}
+
 	PutPartArgs struct {
 		UploadID   string // QparamMptUploadID
 		PutArgs           // regular PUT args
@@ -219,6 +235,86 @@ func AbortMultipartUpload(bp BaseParams, bck cmn.Bck, objName, uploadID string)
 	return err
 }
 
+// ListStuckUploads returns incomplete multipart uploads that are at least `olderThan` old, cluster-wide:
+//   - `qbck` is either a bucket or a bucket query (e.g., all ais:// buckets); the latter requires
+//     cluster-level access
+//   - lists the uploads the targets are currently tracking; uploads orphaned by a target restart
+//     are reclaimed by space cleanup (see config: space.dont_cleanup_time)
+//   - sorted by age, oldest first
+//
+// See also: AbortUploads
+func ListStuckUploads(bp BaseParams, qbck cmn.QueryBcks, olderThan time.Duration) ([]StuckUpload, error) {
+	q := qalloc()
+	qbck.SetQuery(q)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(qbck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActListUploads, Value: int64(olderThan)})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	var all map[string][]apc.MptUploadInfo
+	_, err := reqParams.DoReqAny(&all)
+	FreeRp(reqParams)
+	qfree(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now = time.Now()
+		out = make([]StuckUpload, 0, len(all))
+	)
+	for tid, infos := range all {
+		for i := range infos {
+			info := &infos[i]
+			created := time.Unix(0, info.Created)
+			out = append(out, StuckUpload{
+				Bck:      cmn.Bck{Name: info.Bucket, Provider: info.Provider, Ns: cmn.ParseNsUname(info.Ns)},
+				ObjName:  info.ObjName,
+				UploadID: info.UploadID,
+				TargetID: tid,
+				Created:  created,
+				Age:      now.Sub(created),
+				Size:     info.Size,
+				NumParts: info.NumParts,
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Created.Before(out[j].Created) })
+	return out, nil
+}
+
+// AbortUploads aborts the given multipart uploads (see AbortMultipartUpload), concurrently.
+// Uploads that no longer exist (e.g., completed or aborted in the meantime) are skipped.
+// Not atomic: returns all errors (joined), if any.
+func AbortUploads(bp BaseParams, uploads []StuckUpload) error {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(uploads))
+		ch   = make(chan int, len(uploads))
+	)
+	for i := range uploads {
+		ch <- i
+	}
+	close(ch)
+	for range min(abortUploadsWorkers, len(uploads)) {
+		wg.Go(func() {
+			for i := range ch {
+				u := &uploads[i]
+				err := AbortMultipartUpload(bp, u.Bck, u.ObjName, u.UploadID)
+				if err != nil && !cmn.IsStatusNotFound(err) {
+					errs[i] = fmt.Errorf("%s[%s]: %w", u.Bck.Cname(u.ObjName), u.UploadID, err)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
 // MultipartDownload performs concurrent range-based download of an object.
 // It spawns multiple goroutines to download different byte ranges in parallel.
 //
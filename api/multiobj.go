@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
 )
 
 //
@@ -95,3 +97,111 @@ func Prefetch(bp BaseParams, bck cmn.Bck, msg *apc.PrefetchMsg) (string, error)
 	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActPrefetchObjects, Value: msg})
 	return doBckAct(bp, bck, jbody, q)
 }
+
+// SyncRemoteBucket reconciles in-cluster content of a remote bucket with the bucket itself:
+// prefetches new objects, re-fetches objects with changed remote versions, and/or evicts
+// objects deleted remotely - as selected by the respective `msg` flags.
+// Returns xaction ID; per-action counts are reported via the xaction's control message
+// (see api.QueryXactionSnaps).
+func SyncRemoteBucket(bp BaseParams, bck cmn.Bck, msg *apc.SyncRemoteMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := qalloc()
+	bck.SetQuery(q)
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActSyncRemote, Value: msg})
+	return doBckAct(bp, bck, jbody, q)
+}
+
+// BackendMetaDiff: in-cluster vs remote backend, as computed by RefreshBackendMeta
+type BackendMetaDiff struct {
+	Remote  int64 // remote objects (under prefix)
+	New     int64 // remote objects that are not present in-cluster
+	Changed int64 // in-cluster objects with a different remote version
+	Removed int64 // in-cluster objects deleted remotely (and evicted)
+	InSync  int64 // in-cluster objects that match their remote counterparts
+}
+
+// RefreshBackendMeta re-lists the remote bucket (optionally, only objects with the given prefix)
+// and reconciles it with the in-cluster content without reading any object data:
+//   - compares in-cluster and remote metadata (see apc.LsDiff);
+//   - evicts in-cluster copies of the objects that were deleted remotely (out-of-band),
+//     so that subsequent 'cached' listings (apc.LsCached) remain accurate;
+//   - returns the diff counts.
+//
+// Eviction is done page by page, as the listing progresses; `timeout` bounds the wait
+// for each page's eviction (see xact.ArgsMsg.Timeout).
+//
+// Objects with a changed remote version are counted but not touched - use SyncRemoteBucket
+// or Prefetch with `LatestVer` to re-fetch them.
+func RefreshBackendMeta(bp BaseParams, bck cmn.Bck, prefix string, timeout time.Duration) (*BackendMetaDiff, error) {
+	if !bck.IsRemote() {
+		return nil, fmt.Errorf("cannot refresh backend metadata of a non-remote bucket %s", bck.Cname(""))
+	}
+	var (
+		diff    = &BackendMetaDiff{}
+		removed []string
+		lsmsg   = &apc.LsoMsg{Prefix: prefix, Flags: apc.LsDiff}
+	)
+	lsmsg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsVersion, apc.GetPropsCached, apc.GetPropsStatus)
+	for {
+		page, err := ListObjectsPage(bp, bck, lsmsg, ListArgs{})
+		if err != nil {
+			return diff, err
+		}
+		removed = removed[:0]
+		for _, en := range page.Entries {
+			switch {
+			case en.IsAnyFlagSet(apc.EntryVerRemoved):
+				diff.Removed++
+				removed = append(removed, en.Name)
+				continue
+			case !en.IsPresent():
+				diff.New++
+			case en.IsAnyFlagSet(apc.EntryVerChanged):
+				diff.Changed++
+			default:
+				diff.InSync++
+			}
+			diff.Remote++
+		}
+		if len(removed) > 0 {
+			if err := evictWait(bp, bck, removed, timeout); err != nil {
+				return diff, err
+			}
+		}
+		if lsmsg.ContinuationToken == "" {
+			break
+		}
+	}
+	return diff, nil
+}
+
+func evictWait(bp BaseParams, bck cmn.Bck, objNames []string, timeout time.Duration) error {
+	xid, err := EvictMultiObj(bp, bck, &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: objNames}})
+	if err != nil {
+		return err
+	}
+	return WaitForXaction(bp, &xact.ArgsMsg{ID: xid, Kind: apc.ActEvictObjects, Timeout: timeout})
+}
+
+// ProbeObjects compares (name, size, optional checksum) tuples with the in-cluster objects
+// of a given bucket in a single round-trip, instead of HEAD-ing objects one by one.
+// Returns one status per probe, in the same order: apc.ProbeMatch, apc.ProbeDiffers, or apc.ProbeNotFound.
+// - only in-cluster objects are considered (remote buckets are not accessed)
+// - when specified, checksums must be of the bucket's checksum type
+func ProbeObjects(bp BaseParams, bck cmn.Bck, probes []apc.ObjProbe) (out []string, err error) {
+	q := qalloc()
+	bck.SetQuery(q)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActProbeObjects, Value: probes})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
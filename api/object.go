@@ -5,9 +5,12 @@
 package api
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"runtime"
@@ -31,6 +34,8 @@ const (
 	// From https://cloud.google.com/storage/quotas#objects
 	// * "There is an update limit on each object of once per second..."
 	httpRetryRateSleep = 1500 * time.Millisecond
+
+	maxRedirects = 10 // same as net/http default
 )
 
 // GET(object)
@@ -54,13 +59,49 @@ type (
 		// - TODO: add `apc.QparamValidateCksum`
 		Query url.Values
 
-		// The field is used to facilitate a) range read, and b) blob download
+		// The field is used to facilitate a) range read, b) blob download, and c) read priority
 		// E.g. range:
 		// * Header.Set(cos.HdrRange, fmt.Sprintf("bytes=%d-%d", fromOffset, toOffset))
 		//   For range formatting, see https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 		// E.g. blob download:
 		// * Header.Set(apc.HdrBlobDownload, "true")
+		// E.g. read priority (see cmn.ReadQoSConf):
+		// * Header.Set(apc.HdrQoSClass, apc.QoSHigh)
 		Header http.Header
+
+		// Cold GET only: stream remote object to the client while, at the same time, persisting
+		// it in the cluster (warming the cache for subsequent readers); as opposed to the default
+		// behavior whereby the client waits until the object is stored (see apc.QparamWarmCache);
+		// best-effort: when local writes fall behind, the object is served but not stored
+		WarmCache bool
+
+		// GetObjectReader only: max number of times the returned reader resumes (at the offset
+		// already delivered) upon mid-stream read failure - e.g., when a disk fails while
+		// reading one of the object's copies, the retried GET will be served from another
+		// (mirrored or EC-reconstructed) copy.
+		// Zero (default) disables.
+		// Not applicable to range reads, archived files, and inline transformations.
+		ReadRetries int
+
+		// Chunked objects only: have the target prefetch the next (up to) ReadAhead chunks
+		// into page cache while transmitting the current one - a throughput optimization for
+		// sequential (streaming) reads of large objects (see apc.QparamReadAhead).
+		// Zero (default) disables; the maximum is 255.
+		ReadAhead int
+
+		// Remote objects stored with `Content-Encoding: gzip` only: have the target decompress
+		// on read and return the decoded content (see apc.QparamDecodeContentEncoding).
+		// Since the decoded size is not known upfront, the response carries neither size
+		// nor checksum; not applicable to range reads and archived files.
+		// Default (false) returns the stored (encoded) bytes as is.
+		DecodeContentEncoding bool
+
+		// Cap the target's transmit rate for this GET, in bytes per second (see apc.QparamMaxBps) -
+		// for background and batch readers that should not starve interactive traffic.
+		// Best-effort and per-target: the target paces its writes, and each (redirected)
+		// request is paced independently. Disables sendfile. Zero (default) means no cap;
+		// nonzero values below 64KiB/s are rounded up to 64KiB/s.
+		MaxBytesPerSec int64
 	}
 
 	// `ObjAttrs` represents object attributes and can be further used to retrieve
@@ -72,6 +113,22 @@ type (
 		wrespHeader http.Header
 		n           int64
 	}
+
+	// GetObjectReader: resumes reading at the current offset upon mid-stream failure
+	resumeReader struct {
+		rc      io.ReadCloser
+		ctx     context.Context
+		bck     cmn.Bck
+		query   url.Values
+		hdr     http.Header
+		bp      BaseParams
+		objName string
+		err     error  // sticky: failed to resume
+		version string // to make sure we keep reading the same object
+		off     int64  // delivered so far
+		size    int64
+		retries int
+	}
 )
 
 // PUT(object)
@@ -82,16 +139,38 @@ type (
 //     skip loading existing object's metadata in order to
 //     compare its Checksum and update its existing Version (if exists);
 //     can be used to reduce PUT latency when massively writing new content (or simply don't care)
+//   - TTL (optional): per-object time-to-live; once `PUT time + TTL` passes, the object
+//     is considered expired and gets removed by space cleanup (see apc.HdrObjTTL)
+//   - ExpectChecksumTrailer: for streaming sources that cannot be checksummed upfront;
+//     requires Cksum type (the value, if any, is ignored); the checksum gets computed
+//     while sending and is delivered as HTTP trailer (apc.HdrObjCksumVal) - chunked
+//     transfer encoding, Size is ignored - for the target to compute-and-compare
 type (
 	PutArgs struct {
-		Reader     cos.ReadOpenCloser
-		Cksum      *cos.Cksum
-		Header     http.Header
-		BaseParams BaseParams
-		Bck        cmn.Bck
-		ObjName    string
-		Size       uint64
-		SkipVC     bool
+		Reader                cos.ReadOpenCloser
+		Cksum                 *cos.Cksum
+		Header                http.Header
+		BaseParams            BaseParams
+		Bck                   cmn.Bck
+		ObjName               string
+		Size                  uint64
+		TTL                   time.Duration
+		SkipVC                bool
+		ExpectChecksumTrailer bool
+	}
+
+	// PUT(object) with content generated by the target (see PutGeneratedObject)
+	GenArgs struct {
+		Pattern string // apc.GenZeros (default), apc.GenRepeat, or apc.GenRandom
+		Bytes   []byte // bytes to repeat (apc.GenRepeat only); max length: apc.MaxGenBytes
+		Size    int64
+	}
+
+	// computes checksum while reading (the body) and sets it as HTTP trailer upon EOF
+	cksumTrailer struct {
+		io.ReadCloser
+		hash *cos.CksumHash
+		hdr  http.Header
 	}
 )
 
@@ -166,6 +245,24 @@ func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 		w = args.Writer
 	}
 	q, hdr = args.Query, args.Header
+	if args.WarmCache || args.ReadAhead > 0 || args.DecodeContentEncoding || args.MaxBytesPerSec > 0 {
+		q = maps.Clone(q) // (do not modify caller's query)
+		if q == nil {
+			q = make(url.Values, 4)
+		}
+		if args.WarmCache {
+			q.Set(apc.QparamWarmCache, "true")
+		}
+		if args.ReadAhead > 0 {
+			q.Set(apc.QparamReadAhead, strconv.Itoa(args.ReadAhead))
+		}
+		if args.DecodeContentEncoding {
+			q.Set(apc.QparamDecodeContentEncoding, "true")
+		}
+		if args.MaxBytesPerSec > 0 {
+			q.Set(apc.QparamMaxBps, strconv.FormatInt(args.MaxBytesPerSec, 10))
+		}
+	}
 	return
 }
 
@@ -267,6 +364,9 @@ func GetObjectWithValidation(bp BaseParams, bck cmn.Bck, objName string, args *G
 
 // Returns reader of the requested object. It does not read body
 // bytes, nor validates a checksum. Caller is responsible for closing the reader.
+// With GetArgs.ReadRetries > 0, a truncated stream gets resumed at the offset delivered so far;
+// when it cannot be resumed, the reader fails with *cmn.ErrPartialContent carrying the number
+// of bytes delivered - the caller may then continue with a ranged GET from that offset.
 func GetObjectReader(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs) (r io.ReadCloser, size int64, err error) {
 	_, q, hdr := args.ret()
 	q = bck.AddToQuery(q)
@@ -278,11 +378,145 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs)
 		reqParams.Query = q
 		reqParams.Header = hdr
 	}
-	r, size, err = reqParams.doReader()
+	if args != nil && args.ReadRetries > 0 {
+		r, size, err = reqParams.doResumable(bck, objName, args)
+	} else {
+		r, size, err = reqParams.doReader()
+	}
 	FreeRp(reqParams)
 	return
 }
 
+// GetObjectReader: retry-with-resume
+func (reqParams *ReqParams) doResumable(bck cmn.Bck, objName string, args *GetArgs) (io.ReadCloser, int64, error) {
+	if args.Header.Get(cos.HdrRange) != "" {
+		return reqParams.doReader()
+	}
+	q := reqParams.Query
+	if q.Get(apc.QparamArchpath) != "" || q.Get(apc.QparamArchregx) != "" || q.Get(apc.QparamETLName) != "" {
+		return reqParams.doReader()
+	}
+
+	resp, err := reqParams.do()
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := reqParams.checkResp(resp); err != nil {
+		resp.Body.Close()
+		return nil, 0, err
+	}
+	if resp.ContentLength < 0 {
+		return resp.Body, resp.ContentLength, nil // (unknown size - cannot resume)
+	}
+	rr := &resumeReader{
+		rc:      resp.Body,
+		ctx:     reqParams.ctx,
+		bp:      reqParams.BaseParams,
+		bck:     bck,
+		objName: objName,
+		query:   maps.Clone(q),
+		hdr:     reqParams.Header,
+		version: resp.Header.Get(apc.HdrObjVersion),
+		size:    resp.ContentLength,
+		retries: args.ReadRetries,
+	}
+	return rr, rr.size, nil
+}
+
+func (rr *resumeReader) Read(p []byte) (n int, err error) {
+	if rr.err != nil {
+		return 0, rr.err
+	}
+	for {
+		n, err = rr.rc.Read(p)
+		rr.off += int64(n)
+		if err == nil || err == io.EOF || rr.off >= rr.size {
+			return n, err
+		}
+		if rr.retries <= 0 {
+			rr.err = cmn.NewErrPartialContent(err, rr.off, rr.size)
+			return n, rr.err
+		}
+		rr.retries--
+		if errR := rr.resume(); errR != nil {
+			err = fmt.Errorf("%w (failed to resume reading %s at offset %d: %v)", err, rr.bck.Cname(rr.objName), rr.off, errR)
+			rr.err = cmn.NewErrPartialContent(err, rr.off, rr.size)
+			return n, rr.err
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+func (rr *resumeReader) Close() error { return rr.rc.Close() }
+
+// GET the remaining range [off, size)
+func (rr *resumeReader) resume() error {
+	rr.rc.Close()
+
+	hdr := rr.hdr.Clone()
+	if hdr == nil {
+		hdr = make(http.Header, 1)
+	}
+	hdr.Set(cos.HdrRange, cmn.MakeRangeHdr(rr.off, rr.size-rr.off))
+
+	bp := rr.bp
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(rr.bck.Name, rr.objName)
+		reqParams.Query = rr.query
+		reqParams.Header = hdr
+		reqParams.ctx = rr.ctx
+	}
+	resp, err := reqParams.do()
+	if err == nil {
+		if err = reqParams.checkResp(resp); err != nil {
+			resp.Body.Close()
+		}
+	}
+	FreeRp(reqParams)
+	if err != nil {
+		return err
+	}
+	if v := resp.Header.Get(apc.HdrObjVersion); v != rr.version {
+		resp.Body.Close()
+		return fmt.Errorf("object version changed (%q vs %q)", rr.version, v)
+	}
+	if resp.StatusCode != http.StatusPartialContent || resp.ContentLength != rr.size-rr.off {
+		resp.Body.Close()
+		return fmt.Errorf("unexpected range response: status %d, content-length %d", resp.StatusCode, resp.ContentLength)
+	}
+	rr.rc = resp.Body
+	return nil
+}
+
+// Returns reader of a single file (`archPath`) stored in the named shard, along with its size.
+// The entry gets extracted target-side (without transferring the entire shard):
+// zip and (indexed) tar shards are read at the entry's offset, other formats are
+// sequentially scanned up to the entry.
+// Optional `args` may carry `apc.QparamArchmime` (in `args.Query`) when the shard's
+// name lacks a recognizable extension; `args.Writer` is ignored.
+// Caller is responsible for closing the reader.
+func GetArchFile(bp BaseParams, bck cmn.Bck, shardName, archPath string, args *GetArgs) (io.ReadCloser, int64, error) {
+	var (
+		nargs GetArgs
+		q     url.Values
+	)
+	if args != nil {
+		nargs.Header = args.Header
+		q = maps.Clone(args.Query) // (do not modify caller's query)
+	}
+	if q == nil {
+		q = make(url.Values, 2)
+	}
+	q.Set(apc.QparamArchpath, archPath)
+	nargs.Query = q
+	return GetObjectReader(bp, bck, shardName, &nargs)
+}
+
 // PUT(object) ============================================================================================
 //
 // Uses the specified reader (`args.Reader`) to write a new object (or a new version of the object).
@@ -299,8 +533,17 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	// Go http doesn't automatically set this for files, so to handle redirect we do it here.
 	req.GetBody = args.getBody
 
-	// compute client-side checksum
-	if cksum := args.Cksum; cksum != nil {
+	if args.ExpectChecksumTrailer {
+		ty, err := args.trailerTy()
+		if err != nil {
+			return nil, cmn.NewErrCreateHreq(err)
+		}
+		req.Header.Set(apc.HdrObjCksumType, ty)
+		ct := newCksumTrailer(req.Body, ty)
+		req.Body, req.Trailer = ct, ct.hdr
+		req.ContentLength = -1 // (trailers require chunked encoding)
+	} else if cksum := args.Cksum; cksum != nil {
+		// compute client-side checksum
 		var (
 			typ = cksum.Ty()
 			val = cksum.Val()
@@ -327,18 +570,47 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 		}
 	}
 
-	if args.Size != 0 {
+	if args.Size != 0 && !args.ExpectChecksumTrailer {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	if args.TTL > 0 {
+		req.Header.Set(apc.HdrObjTTL, args.TTL.String())
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
 
+func (args *PutArgs) trailerTy() (string, error) {
+	if args.Cksum == nil || args.Cksum.Ty() == "" || args.Cksum.Ty() == cos.ChecksumNone {
+		return "", errors.New("checksum trailer: checksum type must be specified")
+	}
+	return args.Cksum.Ty(), cos.ValidateCksumType(args.Cksum.Ty())
+}
+
+// redirected request (proxy => target) does not carry the original's trailer - re-wrapping
+func (args *PutArgs) trailerClient() *http.Client {
+	client := *args.BaseParams.Client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		ct := newCksumTrailer(req.Body, args.Cksum.Ty())
+		req.Body, req.Trailer = ct, ct.hdr
+		req.ContentLength = -1
+		return nil
+	}
+	return &client
+}
+
 func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 	var (
-		resp *http.Response
-		q    = qalloc()
+		resp   *http.Response
+		q      = qalloc()
+		client = args.BaseParams.Client
 	)
+	if args.ExpectChecksumTrailer {
+		client = args.trailerClient()
+	}
 	args.Bck.SetQuery(q)
 	if args.SkipVC {
 		q.Set(apc.QparamSkipVC, "true")
@@ -352,7 +624,7 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 		reqArgs.BodyR = args.Reader
 		reqArgs.Header = args.Header
 	}
-	resp, err = DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
+	resp, err = DoWithRetry(client, args.put, reqArgs) //nolint:bodyclose // is closed inside
 	cmn.FreeHra(reqArgs)
 	qfree(q)
 	if err == nil {
@@ -361,6 +633,28 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 	return
 }
 
+//////////////////
+// cksumTrailer //
+//////////////////
+
+func newCksumTrailer(r io.ReadCloser, ty string) *cksumTrailer {
+	return &cksumTrailer{
+		ReadCloser: r,
+		hash:       cos.NewCksumHash(ty),
+		hdr:        http.Header{apc.HdrObjCksumVal: nil}, // (value to be set upon EOF)
+	}
+}
+
+func (ct *cksumTrailer) Read(p []byte) (n int, err error) {
+	n, err = ct.ReadCloser.Read(p)
+	ct.hash.H.Write(p[:n])
+	if err == io.EOF {
+		ct.hash.Finalize()
+		ct.hdr.Set(apc.HdrObjCksumVal, ct.hash.Val())
+	}
+	return n, err
+}
+
 func copyOrTransformObject(bp BaseParams, args *CopyArgs, etl *ETL) error {
 	var (
 		q         = qalloc()
@@ -585,9 +879,38 @@ func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, objName string, custom cos
 	return err
 }
 
+// SetObjectCustom adds (or updates) application metadata (e.g., labels, provenance) of an
+// existing in-cluster object without re-uploading it; other custom keys remain intact.
+// NOTE: in-cluster metadata only - not propagated to remote backends.
+func SetObjectCustom(bp BaseParams, bck cmn.Bck, objName string, kvs cos.StrKVs) error {
+	return SetObjectCustomProps(bp, bck, objName, kvs, false /*set new*/)
+}
+
+// GetObjectCustom returns object's custom metadata - user-defined keys along with
+// system-maintained ones, if any (e.g., cmn.ETag, cmn.VersionObjMD, cmn.SourceObjMD).
+func GetObjectCustom(bp BaseParams, bck cmn.Bck, objName string) (cos.StrKVs, error) {
+	op, err := HeadObjectV2(bp, bck, objName, apc.GetPropsCustom, HeadArgs{FltPresence: apc.FltPresent})
+	if err != nil {
+		return nil, err
+	}
+	return op.CustomMD, nil
+}
+
 // DELETE(object) ======================================================================================
 
+type DeleteObjArgs struct {
+	Soft bool // move to trash rather than delete permanently (see RestoreObject)
+}
+
 func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	return DeleteObjectWithArgs(bp, bck, objName, &DeleteObjArgs{})
+}
+
+// Soft delete (DeleteObjArgs.Soft):
+//   - ais:// buckets only (no remote backend, no erasure coding), non-chunked objects only
+//   - soft-deleted object can be restored via RestoreObject within the configured `space.trash_window`;
+//     after that, space cleanup removes it permanently
+func DeleteObjectWithArgs(bp BaseParams, bck cmn.Bck, objName string, args *DeleteObjArgs) error {
 	q := qalloc()
 	bp.Method = http.MethodDelete
 	reqParams := AllocRp()
@@ -595,6 +918,31 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
 		bck.SetQuery(q)
+		if args.Soft {
+			q.Set(apc.QparamSoftDelete, "true")
+		}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
+// RestoreObject restores previously soft-deleted object (see DeleteObjectWithArgs).
+// Must run on the same target and mountpath that soft-deleted the object - i.e.,
+// may fail with "not found" if cluster membership or mountpaths have changed in the meantime.
+func RestoreObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActRestoreObject})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
 		reqParams.Query = q
 	}
 	err := reqParams.DoRequest()
@@ -756,6 +1104,97 @@ func FlushObject(args *FlushArgs) error {
 	return err
 }
 
+// Sparse write (object) ========================================================================
+// Builds an object out of byte ranges written in any order, possibly concurrently:
+// - `api.CreateSizedObject` pre-creates an object of a given size
+// - `api.WriteObjectRange` writes a range at a given offset (ranges are expected to be disjoint)
+// - `api.FinalizeObject` computes the checksum and makes the object visible
+// Unlike append, which is sequential, ranges can be filled in random order. The target tracks
+// written ranges and rejects finalize if any part of the object remains unwritten.
+// NOTE:
+// - object becomes visible (to clients) and accessible only _after_ the call to `api.FinalizeObject`
+// - in-progress state is in-memory and does not survive target restart
+// - finalize is rejected (409) while any range writes are still in progress
+// - sized objects left idle (neither written nor finalized) for an hour are discarded
+
+func CreateSizedObject(bp BaseParams, bck cmn.Bck, objName string, size int64) error {
+	q := qalloc()
+	q.Set(apc.QparamAppendType, apc.CreateSizedOp)
+	q.Set(apc.QparamSparseSize, strconv.FormatInt(size, 10))
+	return sparseOp(bp, bck, objName, q)
+}
+
+func WriteObjectRange(bp BaseParams, bck cmn.Bck, objName string, offset, size int64, r cos.ReadOpenCloser) error {
+	q := qalloc()
+	q.Set(apc.QparamAppendType, apc.WriteRangeOp)
+	q.Set(apc.QparamSparseOffset, strconv.FormatInt(offset, 10))
+	q = bck.AddToQuery(q)
+
+	args := &AppendArgs{Reader: r, BaseParams: bp, Bck: bck, Object: objName, Size: size}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = bp.URL
+		reqArgs.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqArgs.Query = q
+		reqArgs.BodyR = r
+	}
+	_, err := DoWithRetry(bp.Client, args._append, reqArgs) //nolint:bodyclose // it's closed inside
+	cmn.FreeHra(reqArgs)
+	qfree(q)
+	return err
+}
+
+func FinalizeObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	q := qalloc()
+	q.Set(apc.QparamAppendType, apc.FinalizeOp)
+	return sparseOp(bp, bck, objName, q)
+}
+
+func sparseOp(bp BaseParams, bck cmn.Bck, objName string, q url.Values) error {
+	q = bck.AddToQuery(q)
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
+// PutGeneratedObject creates (or overwrites) an object with content that the target generates
+// locally - zero-filled, repeating bytes, or random - without uploading any data.
+// Intended usage: provisioning large-scale test and benchmark datasets.
+func PutGeneratedObject(bp BaseParams, bck cmn.Bck, objName string, args *GenArgs) (oah ObjAttrs, err error) {
+	if args.Size < 0 {
+		return oah, fmt.Errorf("invalid size %d", args.Size)
+	}
+	q := qalloc()
+	q.Set(apc.QparamGenSize, strconv.FormatInt(args.Size, 10))
+	if args.Pattern != "" {
+		q.Set(apc.QparamGenPattern, args.Pattern)
+	}
+	if len(args.Bytes) > 0 {
+		q.Set(apc.QparamGenBytes, string(args.Bytes))
+	}
+	q = bck.AddToQuery(q)
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	oah.wrespHeader, _, err = reqParams.doReqHdr()
+	FreeRp(reqParams)
+	qfree(q)
+	return oah, err
+}
+
 // Rename(object) ==============================================================================
 // renames object name from `oldName` to `newName`. Works only within a given specified bucket.
 
@@ -778,6 +1217,61 @@ func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	return err
 }
 
+// MoveObject relocates object's primary copy to the specified target, overriding HRW.
+// Advanced usage - intended for reproducing placement-specific issues and affinity experiments:
+//   - the moved object is "misplaced" - global rebalance would move it back to its HRW target
+//     unless `pin` is true (in which case neither rebalance nor space cleanup will relocate it)
+//   - the source is the HRW target; moving an object that is already misplaced is not supported
+//   - not supported for remote and erasure-coded buckets
+func MoveObject(bp BaseParams, bck cmn.Bck, objName, targetID string, pin bool) error {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{
+			Action: apc.ActMoveObject,
+			Value:  &apc.MoveObjMsg{DaemonID: targetID, Pin: pin},
+		})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
+// CreateAlias creates (or re-points) `aliasName` - a metadata-only object that refers to
+// `objName` in the same bucket. GET and HEAD of the alias transparently return the aliased object;
+// list-objects reports aliases with apc.EntryIsAlias and cmn.AliasObjMD custom property.
+//   - ais:// buckets only (no remote backend, no erasure coding)
+//   - the aliased object must exist and must not be an alias itself
+//   - an existing (regular) object named `aliasName` is never overwritten (409)
+//   - deleting the aliased object breaks the alias (GET returns 404) unless the bucket
+//     has "Protect-Aliased-Objects" feature flag (in which case the deletion fails with 409)
+func CreateAlias(bp BaseParams, bck cmn.Bck, aliasName, objName string) error {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, aliasName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActCreateAlias, Name: objName})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
 // Promote =========================================================================================
 // promote POSIX files and/or directories to (become) in-cluster objects.
 
@@ -848,6 +1342,62 @@ func CheckObjectLock(bp BaseParams, bck cmn.Bck, objName string) (int, error) {
 	}
 }
 
+// Returns object's chunk layout: ordered chunks with their offsets, sizes, and checksums,
+// and the upload ID. For monolithic (non-chunked) objects, the returned manifest
+// contains a single chunk and `Chunked == false`.
+func GetObjectManifest(bp BaseParams, bck cmn.Bck, objName string) (*cmn.ChunkManifest, error) {
+	var (
+		q      = qalloc()
+		actMsg = apc.ActMsg{Action: apc.ActObjManifest}
+		out    = &cmn.ChunkManifest{}
+	)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(actMsg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	_, err := reqParams.DoReqAny(out)
+	FreeRp(reqParams)
+	qfree(q)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetObjectECStatus returns erasure-coded object's slice (and replica) placement:
+// for each slice index, the owning target and whether the slice is present,
+// plus whether the object can be currently reconstructed.
+func GetObjectECStatus(bp BaseParams, bck cmn.Bck, objName string) (*apc.ECStatus, error) {
+	var (
+		q      = qalloc()
+		actMsg = apc.ActMsg{Action: apc.ActECStatus}
+		out    = &apc.ECStatus{}
+	)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(actMsg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	_, err := reqParams.DoReqAny(out)
+	FreeRp(reqParams)
+	qfree(q)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 //
 // misc. helpers
 //
@@ -0,0 +1,135 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const resumeObjSize = 64 * cos.KiB
+
+// serves the object (or its range) but breaks the connection midway the first `fails` times;
+// `midway` (if non-nil) gets called prior to breaking
+func newFlakyServer(t *testing.T, data []byte, fails int32, midway func()) (*httptest.Server, *atomic.Int32) {
+	var cnt atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := cnt.Add(1)
+		off, length, status := int64(0), int64(len(data)), http.StatusOK
+		if hrng := r.Header.Get(cos.HdrRange); hrng != "" {
+			var end int64
+			if _, err := fmt.Sscanf(hrng, cos.HdrRangeValPrefix+"%d-%d", &off, &end); err != nil {
+				http.Error(w, "bad range", http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			length, status = end+1-off, http.StatusPartialContent
+		}
+		w.Header().Set(apc.HdrObjVersion, "1")
+		w.Header().Set(cos.HdrContentLength, strconv.FormatInt(length, 10))
+		w.WriteHeader(status)
+		if n > fails {
+			w.Write(data[off : off+length])
+			return
+		}
+		w.Write(data[off : off+length/2])
+		w.(http.Flusher).Flush()
+		if midway != nil {
+			midway()
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &cnt
+}
+
+func TestGetObjectReaderResume(t *testing.T) {
+	data := make([]byte, resumeObjSize)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	bck := cmn.Bck{Name: "bck", Provider: apc.AIS}
+
+	t.Run("resume", func(t *testing.T) {
+		srv, cnt := newFlakyServer(t, data, 2, nil)
+		bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+		r, size, err := GetObjectReader(bp, bck, "obj", &GetArgs{ReadRetries: 2})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, size == resumeObjSize, "expected size %d, got %d", resumeObjSize, size)
+		got, err := io.ReadAll(r)
+		r.Close()
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(got, data), "content mismatch (read %d bytes)", len(got))
+		tassert.Errorf(t, cnt.Load() == 3, "expected 3 requests, got %d", cnt.Load())
+	})
+
+	t.Run("default-no-retries", func(t *testing.T) {
+		srv, cnt := newFlakyServer(t, data, 1, nil)
+		bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+		r, _, err := GetObjectReader(bp, bck, "obj", nil)
+		tassert.CheckFatal(t, err)
+		_, err = io.ReadAll(r)
+		r.Close()
+		tassert.Fatalf(t, err != nil, "expected mid-stream failure")
+		tassert.Errorf(t, cnt.Load() == 1, "expected a single request, got %d", cnt.Load())
+	})
+
+	t.Run("retries-exhausted", func(t *testing.T) {
+		srv, _ := newFlakyServer(t, data, 2, nil)
+		bp := BaseParams{Client: &http.Client{}, URL: srv.URL}
+		r, _, err := GetObjectReader(bp, bck, "obj", &GetArgs{ReadRetries: 1})
+		tassert.CheckFatal(t, err)
+		got, err := io.ReadAll(r)
+		r.Close()
+		var errP *cmn.ErrPartialContent
+		tassert.Fatalf(t, errors.As(err, &errP), "expected partial content error, got %v", err)
+		// first half, plus half of the remaining half
+		tassert.Errorf(t, errP.Delivered == int64(len(got)) && len(got) == resumeObjSize/2+resumeObjSize/4,
+			"unexpected delivered %d (read %d)", errP.Delivered, len(got))
+		tassert.Errorf(t, bytes.Equal(got, data[:len(got)]), "content mismatch")
+	})
+
+	// resume must use the original request's context
+	t.Run("context", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			release     = make(chan struct{})
+		)
+		srv, cnt := newFlakyServer(t, data, 1, func() { <-release })
+		reqParams := AllocRp()
+		{
+			reqParams.BaseParams = BaseParams{Client: &http.Client{}, URL: srv.URL, Method: http.MethodGet}
+			reqParams.Path = apc.URLPathObjects.Join(bck.Name, "obj")
+			reqParams.Query = bck.AddToQuery(nil)
+			reqParams.ctx = ctx
+		}
+		r, _, err := reqParams.doResumable(bck, "obj", &GetArgs{ReadRetries: 2})
+		FreeRp(reqParams)
+		tassert.CheckFatal(t, err)
+		_, err = io.ReadFull(r, make([]byte, cos.KiB))
+		tassert.CheckFatal(t, err)
+		cancel()
+		close(release)
+		_, err = io.ReadAll(r)
+		r.Close()
+		tassert.Fatalf(t, cmn.IsErrPartialContent(err), "expected partial content error, got %v", err)
+		tassert.Errorf(t, cnt.Load() == 1, "expected no requests after cancellation, got %d", cnt.Load())
+	})
+}
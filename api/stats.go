@@ -7,11 +7,13 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/stats"
 
 	jsoniter "github.com/json-iterator/go"
@@ -107,6 +109,40 @@ func GetDiskRWUtilCap(bp BaseParams, tid string) (out *fs.TcdfExt, err error) {
 	return out, err
 }
 
+// returns per-peer keep-alive status as seen by the specified node:
+// last successful keep-alive and the number of consecutive failures since
+// (see related alert: cos.KeepAliveErrors)
+func GetKeepAliveStatus(bp BaseParams, node *meta.Snode) (out stats.KaliveStatus, err error) {
+	err = _nodeStats(bp, node.ID(), apc.WhatKeepalive, &out)
+	return out, err
+}
+
+// returns up to `limit` most recent error events (newest first) as seen by the specified node;
+// limit = 0: all that are currently retained (see stats.MaxRecentErrs)
+func GetRecentErrors(bp BaseParams, node *meta.Snode, limit int) (out []stats.RecentErr, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatRecentErrors}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	if limit > 0 {
+		reqParams.Query.Set(apc.QparamLimit, strconv.Itoa(limit))
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out, err
+}
+
+// returns per-disk read/write throughput, IOPS, utilization, and average queue size
+// (compare w/ GetDiskRWUtilCap and "disk.*" metrics)
+func GetDiskStats(bp BaseParams, node *meta.Snode) (out ios.AllDiskStats, err error) {
+	err = _nodeStats(bp, node.ID(), apc.WhatDiskStats, &out)
+	return out, err
+}
+
 func GetAnyStats(bp BaseParams, sid, what string) (out []byte, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
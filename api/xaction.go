@@ -16,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/xact"
 )
@@ -124,6 +125,103 @@ func AbortXaction(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	return err
 }
 
+// PauseXaction temporarily suspends a running xaction (job) cluster-wide,
+// preserving its progress; see ResumeXaction.
+// Returns an error if the xaction is not running or its kind does not support pausing
+// (currently supported: prefetch, evict/delete, and multi-object copy/transform).
+func PauseXaction(bp BaseParams, xid string) error {
+	return _pauseXaction(bp, xid, apc.ActXactPause)
+}
+
+func ResumeXaction(bp BaseParams, xid string) error {
+	return _pauseXaction(bp, xid, apc.ActXactResume)
+}
+
+func _pauseXaction(bp BaseParams, xid, action string) error {
+	if err := xact.CheckValidUUID(xid); err != nil {
+		return err
+	}
+	msg := apc.ActMsg{Action: action, Value: &xact.ArgsMsg{ID: xid}}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// XactFilter selects xactions:
+// - for bulk abort (see AbortXactions) at least one of (Kind, Bck) must be specified;
+// - (Running, Finished, SinceMins) apply to ListXactions only
+type XactFilter struct {
+	Kind      string  // xaction kind or display name, e.g. apc.ActCopyBck or "copy-bucket"
+	Bck       cmn.Bck // bucket the xactions are operating on (as source or destination)
+	Running   bool    // include running xactions
+	Finished  bool    // include finished (including aborted) xactions; neither or both: include all
+	SinceMins int     // only those that were running during the last SinceMins minutes (zero: no limit)
+}
+
+// AbortXactions aborts all running xactions that match the filter, cluster-wide,
+// and returns the IDs of those that were running at the time of the call.
+// Unlike AbortXaction with an empty selection, an all-matching (empty) filter is rejected.
+func AbortXactions(bp BaseParams, flt XactFilter) (xids []string, err error) {
+	if flt.Kind == "" && flt.Bck.IsEmpty() {
+		return nil, errors.New("abort xactions: expecting xaction kind and/or bucket")
+	}
+	args := &xact.ArgsMsg{Kind: flt.Kind, Bck: flt.Bck, OnlyRunning: true}
+	xs, err := QueryXactionSnaps(bp, args)
+	if err != nil {
+		return nil, err
+	}
+	if xids = xs.GetUUIDs(); len(xids) == 0 {
+		return nil, nil
+	}
+	args.OnlyRunning = false
+	if err := AbortXaction(bp, args); err != nil {
+		return nil, err
+	}
+	return xids, nil
+}
+
+// ListXactions returns cluster-wide summaries of matching xactions, most recent first.
+// NOTE: finished xactions are retained by the targets for a limited time and,
+// of course, not across restarts.
+func ListXactions(bp BaseParams, flt XactFilter) ([]*xact.Summary, error) {
+	args := &xact.ArgsMsg{Kind: flt.Kind, Bck: flt.Bck, OnlyRunning: flt.Running && !flt.Finished}
+	xs, err := QueryXactionSnaps(bp, args)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	var (
+		all    = xs.Summaries()
+		out    = all[:0]
+		cutoff time.Time
+	)
+	if flt.SinceMins > 0 {
+		cutoff = time.Now().Add(-time.Duration(flt.SinceMins) * time.Minute)
+	}
+	for _, sum := range all {
+		switch {
+		case flt.Running && !flt.Finished && !sum.Running:
+			continue
+		case flt.Finished && !flt.Running && sum.Running:
+			continue
+		case !cutoff.IsZero() && !sum.Running && sum.EndTime.Before(cutoff):
+			continue
+		}
+		out = append(out, sum)
+	}
+	return out, nil
+}
+
 //
 // querying and waiting
 //
@@ -185,6 +283,27 @@ func QueryXactionSnaps(bp BaseParams, args *xact.ArgsMsg) (xs xact.MultiSnap, er
 	return xs, err
 }
 
+// GetXactionErrors returns per-object failures of the `xid`-identified multi-object
+// xaction (e.g., archive, copy, or transform that runs with `ContinueOnError`).
+// Each target retains a bounded number of the most recent failures; the returned
+// slice combines all targets.
+func GetXactionErrors(bp BaseParams, xid string) (errs []core.ObjErr, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatXactObjErrs)
+	q.Set(apc.QparamUUID, xid)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&errs)
+	FreeRp(reqParams)
+	qfree(q)
+	return errs, err
+}
+
 // GetOneXactionStatus queries one of the IC (proxy) members for status
 // of the `args`-identified xaction.
 // NOTE:
@@ -202,7 +202,7 @@ func populateClusterConfig(aisClusterConfigOverride, outputClusterConfig string)
 	if outputClusterConfig == "" || aisClusterConfigOverride == "" {
 		return
 	}
-	confToWrite := newDefaultConfig()
+	confToWrite := aiscmn.DefaultClusterConfig()
 	data, err := os.ReadFile(aisClusterConfigOverride)
 	failOnError(err)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 
@@ -176,10 +177,15 @@ func rotateLogs(c *cli.Context) error {
 	}
 	// 1. node
 	if node != nil {
-		if err := api.RotateLogs(apiBP, node.ID()); err != nil {
+		freed, err := api.RotateLogs(apiBP, node.ID())
+		if err != nil {
 			return V(err)
 		}
-		actionDone(c, sname+": rotated logs")
+		if freed > 0 {
+			actionDone(c, sname+": rotated logs, freed "+cos.ToSizeIEC(freed, 2))
+		} else {
+			actionDone(c, sname+": rotated logs")
+		}
 		return nil
 	}
 	// 2. or cluster
@@ -9,10 +9,12 @@ import (
 	"archive/tar"
 	"context"
 	cryptorand "crypto/rand"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -64,6 +66,7 @@ const archGetUsage = "Get a shard and extract its content; get an archived file;
 	indent4 + "\t- '-v' to produce verbose output when getting multiple objects.\n" +
 	indent1 + "'ais archive get' examples:\n" +
 	indent4 + "\t- ais://abc/trunk-0123.tar.lz4 /tmp/out - get and extract entire shard to /tmp/out/trunk/*\n" +
+	indent4 + "\t- ais://abc /tmp/out --prefix trunk- --out-template '{shard}-{basename}' - extract all 'trunk-*' shards, flattened\n" +
 	indent4 + "\t- ais://abc/trunk-0123.tar.lz4 --archpath file45.jpeg /tmp/out - extract one named file\n" +
 	indent4 + "\t- ais://abc/trunk-0123.tar.lz4/file45.jpeg /tmp/out - same as above (and note that '--archpath' is implied)\n" +
 	indent4 + "\t- ais://abc/trunk-0123.tar.lz4/file45 /tmp/out/file456.new - same as above, with destination explicitly (re)named\n" +
@@ -91,6 +94,7 @@ var (
 			verbObjPrefixFlag,
 			nonRecursFlag,
 			inclSrcBucketNameFlag,
+			archWdsKeyFlag,
 			waitFlag,
 		},
 		commandPut: append(
@@ -116,6 +120,7 @@ var (
 			fextsFlag,
 			tformFlag,
 			outputTemplateForGenShards,
+			genSeedFlag,
 		},
 	}
 
@@ -244,6 +249,10 @@ func archMultiObjHandler(c *cli.Context) error {
 		msg.AppendIfExists = a.apndIfExist
 		msg.ListRange = a.rsrc.lr
 		msg.NonRecurs = flagIsSet(c, nonRecursFlag)
+		msg.WdsKey = parseStrFlag(c, archWdsKeyFlag)
+	}
+	if _, err := msg.CompileWdsKey(); err != nil {
+		return fmt.Errorf("%s: %v", qflprn(archWdsKeyFlag), err)
 	}
 
 	// dry-run
@@ -547,6 +556,14 @@ func genShardsHandler(c *cli.Context) error {
 		}
 	}
 
+	var (
+		seeded bool
+		seed   int64
+	)
+	if flagIsSet(c, genSeedFlag) {
+		seeded, seed = true, c.Int64(genSeedFlag.Name)
+	}
+
 	mm := memsys.NewMMSA("cli-gen-shards", true /*silent*/)
 	ext := mime
 	template := strings.TrimSuffix(objname, ext)
@@ -596,7 +613,11 @@ loop:
 				sgl := mm.NewSGL(fileSize * int64(fileCnt))
 				defer sgl.Free()
 
-				if err := genOne(sgl, ext, i*fileCnt, (i+1)*fileCnt, fileCnt, int(fileSize), fileExts, format, outFnameTemplate); err != nil {
+				var rnd *rand.ChaCha8
+				if seeded {
+					rnd = shardRand(seed, i) // (deterministic: depends on the shard index only)
+				}
+				if err := genOne(sgl, ext, i*fileCnt, (i+1)*fileCnt, fileCnt, int(fileSize), fileExts, format, outFnameTemplate, rnd); err != nil {
 					return err
 				}
 				putArgs := api.PutArgs{
@@ -620,7 +641,17 @@ loop:
 	return nil
 }
 
-func genOne(w io.Writer, shardExt string, start, end, fileCnt, fileSize int, fileExts []string, format tar.Format, outFnameTemplate string) error {
+// seeded per-shard random source
+func shardRand(seed int64, shardIdx int) *rand.ChaCha8 {
+	var b [32]byte
+	binary.LittleEndian.PutUint64(b[0:], uint64(seed))
+	binary.LittleEndian.PutUint64(b[8:], uint64(shardIdx))
+	return rand.NewChaCha8(b)
+}
+
+// rnd != nil: reproducible names, content, and timestamps (see genSeedFlag)
+func genOne(w io.Writer, shardExt string, start, end, fileCnt, fileSize int, fileExts []string, format tar.Format,
+	outFnameTemplate string, rnd *rand.ChaCha8) error {
 	var (
 		pt     *cos.ParsedTemplate
 		prefix = make([]byte, 10)
@@ -628,7 +659,12 @@ func genOne(w io.Writer, shardExt string, start, end, fileCnt, fileSize int, fil
 		oah    = cos.SimpleOAH{Size: int64(fileSize), Atime: time.Now().UnixNano()}
 		opts   = archive.Opts{CB: archive.SetTarHeader, TarFormat: format, Serialize: false}
 		writer = archive.NewWriter(shardExt, w, nil /*cksum*/, &opts)
+		src    = cryptorand.Reader
 	)
+	if rnd != nil {
+		oah.Atime = 0
+		src = rnd
+	}
 
 	// output naming template if provided
 	if outFnameTemplate != "" {
@@ -643,7 +679,7 @@ func genOne(w io.Writer, shardExt string, start, end, fileCnt, fileSize int, fil
 
 	for idx := start; idx < end; idx++ {
 		if pt == nil {
-			cryptorand.Read(prefix)
+			src.Read(prefix)
 		}
 
 		for extIdx, fext := range fileExts {
@@ -664,7 +700,7 @@ func genOne(w io.Writer, shardExt string, start, end, fileCnt, fileSize int, fil
 				name = fmt.Sprintf("%s-%0*d"+fext, hex.EncodeToString(prefix), width, idx)
 			}
 
-			if err := writer.Write(name, oah, io.LimitReader(cryptorand.Reader, int64(fileSize))); err != nil {
+			if err := writer.Write(name, oah, io.LimitReader(src, int64(fileSize))); err != nil {
 				writer.Fini()
 				return err
 			}
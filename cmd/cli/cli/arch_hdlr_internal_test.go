@@ -0,0 +1,65 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// same seed and shard index => byte-identical shards
+func TestGenShardsSeed(t *testing.T) {
+	const (
+		seed     = 42
+		fileCnt  = 10
+		fileSize = 1000
+	)
+	gen := func(shardIdx int) []byte {
+		var b bytes.Buffer
+		err := genOne(&b, archive.ExtTar, shardIdx*fileCnt, (shardIdx+1)*fileCnt, fileCnt, fileSize,
+			[]string{".jpg", ".cls"}, tar.FormatUnknown, "", shardRand(seed, shardIdx))
+		tassert.CheckFatal(t, err)
+		return b.Bytes()
+	}
+	for _, ext := range []string{archive.ExtTar, archive.ExtTgz, archive.ExtTarLz4} {
+		t.Run(ext, func(t *testing.T) {
+			var a, b bytes.Buffer
+			tassert.CheckFatal(t, genOne(&a, ext, 0, fileCnt, fileCnt, fileSize, []string{dfltFext}, tar.FormatUnknown, "",
+				shardRand(seed, 0)))
+			tassert.CheckFatal(t, genOne(&b, ext, 0, fileCnt, fileCnt, fileSize, []string{dfltFext}, tar.FormatUnknown, "",
+				shardRand(seed, 0)))
+			tassert.Errorf(t, bytes.Equal(a.Bytes(), b.Bytes()), "%s: expected identical shards", ext)
+		})
+	}
+	tassert.Errorf(t, bytes.Equal(gen(7), gen(7)), "expected identical shards")
+	tassert.Errorf(t, !bytes.Equal(gen(7), gen(8)), "expected different shards for different shard indices")
+}
+
+func TestExtractOutTemplate(t *testing.T) {
+	for _, tmpl := range []string{"", "{shard}", "{shard}/{name}", "{archpath", "/abs/{archpath}", "../{archpath}"} {
+		_, err := newOutTmpl(tmpl)
+		tassert.Errorf(t, err != nil, "expecting %q to fail validation", tmpl)
+	}
+
+	ot, err := newOutTmpl("{shard}/{archpath}")
+	tassert.CheckFatal(t, err)
+	fqn, err := ot.resolve("/tmp/out", "shard-1", "a/b.jpg")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, fqn == "/tmp/out/shard-1/a/b.jpg", "unexpected %q", fqn)
+	_, err = ot.resolve("/tmp/out", "shard-1", "../../etc/passwd")
+	tassert.Errorf(t, err != nil, "expecting error: outside destination")
+
+	// flattened: same basename across subdirs => collision
+	ot, err = newOutTmpl("{shard}-{basename}")
+	tassert.CheckFatal(t, err)
+	_, err = ot.resolve("out", "s", "x/1.jpg")
+	tassert.CheckFatal(t, err)
+	_, err = ot.resolve("out", "s", "y/1.jpg")
+	tassert.Errorf(t, err != nil, "expecting collision")
+}
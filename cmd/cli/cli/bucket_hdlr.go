@@ -150,7 +150,20 @@ const listAnyUsage = "List buckets, objects in buckets, and files in (.tar, .tgz
 const rechunkUsage = "Re-chunk bucket objects based on size threshold.\n" +
 	indent1 + "\tObjects equal to or larger than --objsize-limit will be split into --chunk-size chunks.\n" +
 	indent1 + "\tSet --objsize-limit=0 to disable chunking and restore all chunked objects to monolithic format.\n" +
-	indent1 + "\tBy default, rechunk operates only on in-cluster (cached) objects; use --sync-remote to also update remote backend."
+	indent1 + "\tBy default, rechunk operates only on in-cluster (cached) objects; use --sync-remote to also update remote backend.\n" +
+	indent1 + "e.g.:\n" +
+	indent1 + "\t* ais bucket rechunk ais://abc --objsize-limit 64MiB --chunk-size 16MiB\t- chunk objects 64MiB and larger;\n" +
+	indent1 + "\t* ais bucket rechunk ais://abc/images/ --objsize-limit 1GiB --chunk-size 128MiB --wait\t- same for a given prefix, and wait for completion;\n" +
+	indent1 + "\t* ais bucket rechunk ais://abc --objsize-limit 0 --dry-run\t- show what would be done to restore monolithic format."
+
+// ais bucket verify-chunks
+const verifyChunksUsage = "Check consistency of chunked objects in a bucket (read-only).\n" +
+	indent1 + "\tFor each chunked object, verify that every chunk referenced by its completed manifest exists\n" +
+	indent1 + "\twith the right size and checksum; also count orphan chunks and partial (multipart upload) manifests.\n" +
+	indent1 + "\tResulting counts (ok, missing_chunk, orphan, partial) are reported by 'ais show job'.\n" +
+	indent1 + "e.g.:\n" +
+	indent1 + "\t- 'ais bucket verify-chunks ais://nnn --wait'\t- check all chunked objects and wait for the job to finish;\n" +
+	indent1 + "\t- 'ais bucket verify-chunks ais://nnn --prefix images/'\t- only check objects under 'images/'."
 
 // ais bucket shard-index
 // Parent command groups the shard-index lifecycle.
@@ -249,6 +262,8 @@ var (
 		encodeObjnameFlag,
 		// 4.0
 		chunkedColumnFlag,
+		lsMinSizeFlag,
+		lsMaxSizeFlag,
 	}
 
 	bucketCmdsFlags = map[string][]cli.Flag{
@@ -294,7 +309,8 @@ var (
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			dryRunFlag,
 			nonRecursFlag, // (embedded prefix dopOLTP)
-			verboseFlag,   // NIY
+			numWorkersFlag,
+			verboseFlag, // NIY
 			nonverboseFlag,
 			dontHeadRemoteFlag,
 			evictAllBucketsFlag,
@@ -302,6 +318,7 @@ var (
 		),
 		cmdSetBprops: {
 			forceFlag,
+			checkBackendFlag,
 		},
 		cmdResetBprops: {},
 
@@ -314,9 +331,16 @@ var (
 			objSizeLimitFlag,
 			verbObjPrefixFlag,
 			syncRemoteFlag,
+			dryRunFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
 		},
+		cmdVerifyChunks: {
+			verbObjPrefixFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+			nonverboseFlag,
+		},
 		cmdShardIndexBuild: {
 			verbObjPrefixFlag,
 			nonRecursFlag, // TODO: wire into shard-index build handler (non-recursive prefix walk)
@@ -407,6 +431,14 @@ var (
 				Flags:     sortFlags(bucketCmdsFlags[apc.ActRechunk]),
 				Action:    rechunkBucketHandler,
 			},
+			{
+				Name:         cmdVerifyChunks,
+				Usage:        verifyChunksUsage,
+				ArgsUsage:    bucketArgument,
+				Flags:        sortFlags(bucketCmdsFlags[cmdVerifyChunks]),
+				Action:       verifyChunksHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
 			{
 				Name:  commandShardIndex,
 				Usage: shardIndexUsage,
@@ -659,6 +691,9 @@ func rechunkBucketHandler(c *cli.Context) error {
 		Prefix:       prefix,
 		SyncRemote:   syncRemote,
 	}
+	if flagIsSet(c, dryRunFlag) {
+		return rechunkPlan(c, bck, msg)
+	}
 	xid, err := api.RechunkBucket(apiBP, bck, msg)
 	if err != nil {
 		return V(err)
@@ -702,33 +737,105 @@ func parseRechunkConfig(c *cli.Context, bck cmn.Bck) (chunkSize, objSizeLimit in
 		}
 	}
 
-	// If either flag is missing, get from bucket and prompt for confirmation
-	if !flagIsSet(c, chunkSizeFlag) || !flagIsSet(c, objSizeLimitFlag) {
-		bckProps, err := api.HeadBucket(apiBP, bck, true /*don't add*/)
-		if err != nil {
-			return 0, 0, V(err)
-		}
+	// Both specified - nothing to confirm
+	if flagIsSet(c, chunkSizeFlag) && flagIsSet(c, objSizeLimitFlag) {
+		return chunkSize, objSizeLimit, validateRechunk(chunkSize, objSizeLimit)
+	}
 
-		// Fill in missing values from bucket
-		if !flagIsSet(c, chunkSizeFlag) {
-			chunkSize = int64(bckProps.Chunks.ChunkSize)
-		}
-		if !flagIsSet(c, objSizeLimitFlag) {
-			objSizeLimit = int64(bckProps.Chunks.ObjSizeLimit)
+	// Otherwise, get from bucket and prompt for confirmation
+	bckProps, err := api.HeadBucket(apiBP, bck, true /*don't add*/)
+	if err != nil {
+		return 0, 0, V(err)
+	}
+
+	// Fill in missing values from bucket
+	if !flagIsSet(c, chunkSizeFlag) {
+		chunkSize = int64(bckProps.Chunks.ChunkSize)
+	}
+	if !flagIsSet(c, objSizeLimitFlag) {
+		objSizeLimit = int64(bckProps.Chunks.ObjSizeLimit)
+	}
+
+	if err := validateRechunk(chunkSize, objSizeLimit); err != nil {
+		return 0, 0, err
+	}
+
+	// Prompt user for confirmation (unless --yes or --dry-run is set)
+	if !flagIsSet(c, yesFlag) && !flagIsSet(c, dryRunFlag) {
+		fmt.Fprint(c.App.Writer, "Rechunk configuration:\n")
+		fmt.Fprintf(c.App.Writer, "\tchunk_size:\t%s\n", cos.ToSizeIEC(chunkSize, 0))
+		fmt.Fprintf(c.App.Writer, "\tobjsize_limit:\t%s%s\n", cos.ToSizeIEC(objSizeLimit, 0), cos.Ternary(objSizeLimit == 0, " (chunking disabled)", ""))
+		if !confirm(c, "Proceed with these values?") {
+			return 0, 0, errors.New("operation canceled")
 		}
+	}
+
+	return chunkSize, objSizeLimit, nil
+}
+
+func validateRechunk(chunkSize, objSizeLimit int64) error {
+	if objSizeLimit > 0 && chunkSize > objSizeLimit {
+		return fmt.Errorf("chunk size (%s) cannot exceed object size limit (%s)",
+			cos.ToSizeIEC(chunkSize, 0), cos.ToSizeIEC(objSizeLimit, 0))
+	}
+	return nil
+}
+
+// (--dry-run)
+// list in-cluster objects page by page and tell which of them rechunk would
+// (re)chunk or restore to monolithic format (compare w/ xs.xactRechunk)
+func rechunkPlan(c *cli.Context, bck cmn.Bck, msg *apc.RechunkMsg) error {
+	lsmsg := &apc.LsoMsg{Prefix: msg.Prefix, Props: apc.GetPropsNameSize}
+	lsmsg.SetFlag(apc.LsCached)
+	lsmsg.SetFlag(apc.LsNoDirs)
 
-		// Prompt user for confirmation (unless --yes is set)
-		if !flagIsSet(c, yesFlag) {
-			fmt.Fprint(c.App.Writer, "Rechunk configuration:\n")
-			fmt.Fprintf(c.App.Writer, "\tchunk_size:\t%s\n", cos.ToSizeIEC(chunkSize, 0))
-			fmt.Fprintf(c.App.Writer, "\tobjsize_limit:\t%s%s\n", cos.ToSizeIEC(objSizeLimit, 0), cos.Ternary(objSizeLimit == 0, " (chunking disabled)", ""))
-			if !confirm(c, "Proceed with these values?") {
-				return 0, 0, errors.New("operation canceled")
+	var (
+		chunk, restore, skip   int
+		chunkSize, restoreSize int64
+		w                      = c.App.Writer
+	)
+	dryRunCptn(c)
+	for {
+		lst, err := api.ListObjectsPage(apiBP, bck, lsmsg, api.ListArgs{})
+		if err != nil {
+			return V(err)
+		}
+		for _, en := range lst.Entries {
+			switch {
+			case en.IsAnyFlagSet(apc.EntryIsDir):
+			case msg.ObjSizeLimit > 0 && en.Size >= msg.ObjSizeLimit:
+				chunk++
+				chunkSize += en.Size
+			case en.IsAnyFlagSet(apc.EntryIsChunked):
+				restore++
+				restoreSize += en.Size
+			default:
+				skip++
 			}
 		}
+		if lsmsg.ContinuationToken == "" {
+			break
+		}
 	}
 
-	return chunkSize, objSizeLimit, nil
+	fmt.Fprintf(w, "rechunk %s", bck.Cname(""))
+	if msg.Prefix != "" {
+		fmt.Fprintf(w, " (prefix: %q)", msg.Prefix)
+	}
+	fmt.Fprintln(w, ":")
+	if msg.ObjSizeLimit > 0 {
+		fmt.Fprintf(w, "\t- chunk %d object%s (%s) into %s chunks\n",
+			chunk, cos.Plural(chunk), cos.ToSizeIEC(chunkSize, 2), cos.ToSizeIEC(msg.ChunkSize, 0))
+	}
+	fmt.Fprintf(w, "\t- restore %d chunked object%s (%s) to monolithic format\n",
+		restore, cos.Plural(restore), cos.ToSizeIEC(restoreSize, 2))
+	fmt.Fprintf(w, "\t- leave %d monolithic object%s as is\n", skip, cos.Plural(skip))
+	if msg.SyncRemote {
+		fmt.Fprintln(w, "\t- write rechunked objects to remote backend")
+	} else {
+		fmt.Fprintln(w, "\t- in-cluster objects only (remote backend not updated)")
+	}
+	return nil
 }
 
 //
@@ -776,6 +883,46 @@ func shardIndexBuildHandler(c *cli.Context) error {
 	return nil
 }
 
+//
+// verifyChunksHandler
+//
+
+func verifyChunksHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return incorrectUsageMsg(c, "missing bucket name")
+	}
+
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), true /*optObjName*/)
+	if err != nil {
+		return err
+	}
+
+	prefix, err := parseBckObjPrefix(c, objName)
+	if err != nil {
+		return err
+	}
+
+	xid, err := api.VerifyChunks(apiBP, bck, prefix)
+	if err != nil {
+		return V(err)
+	}
+
+	_, xname := xact.GetKindName(apc.ActVerifyChunks)
+	if flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag) {
+		return waitJob(c, xname, xid, bck)
+	}
+	if flagIsSet(c, nonverboseFlag) {
+		fmt.Fprintln(c.App.Writer, xid)
+		return nil
+	}
+	text := fmt.Sprintf("%s: %s", xact.Cname(xname, xid), bck.Cname(""))
+	if prefix != "" {
+		text += fmt.Sprintf(" (prefix: %q)", prefix)
+	}
+	actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+	return nil
+}
+
 //
 // resetPropsHandler
 //
@@ -902,7 +1049,13 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currBprops *cmn.Bprops, updateP
 	}
 
 	// do
-	if _, err := api.SetBucketProps(apiBP, bck, updateProps); err != nil {
+	var err error
+	if flagIsSet(c, checkBackendFlag) {
+		_, err = api.SetBucketPropsCheck(apiBP, bck, updateProps)
+	} else {
+		_, err = api.SetBucketProps(apiBP, bck, updateProps)
+	}
+	if err != nil {
 		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
 			return herr
 		}
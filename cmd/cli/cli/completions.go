@@ -407,6 +407,9 @@ func (opts *bcmplop) buckets(c *cli.Context) {
 			return
 		}
 		for provider := range config.Backend.Conf {
+			if !apc.IsProvider(provider) {
+				continue // e.g., "ais_tls" (not a provider)
+			}
 			if provider == apc.AIS {
 				qbck := cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsAnyRemote}
 				fmt.Println(qbck)
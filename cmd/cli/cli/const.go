@@ -87,6 +87,7 @@ const (
 	commandStart = apc.ActXactStart
 	commandStop  = apc.ActXactStop
 	commandWait  = "wait"
+	commandWatch = "watch"
 
 	cmdSmap   = apc.WhatSmap
 	cmdBMD    = apc.WhatBMD
@@ -115,6 +116,7 @@ const (
 	cmdRebalance       = apc.ActRebalance
 	cmdLRU             = apc.ActLRU
 	commandRechunk     = apc.ActRechunk
+	cmdVerifyChunks    = apc.ActVerifyChunks
 	commandShardIndex  = "shard-index" // parent; xaction kind is apc.ActIndexShard
 	cmdShardIndexBuild = "build"
 	// TODO: cmdShardIndexRm = "rm" - remove existing shard indexes
@@ -152,6 +154,7 @@ const (
 	cmdMpathEnable  = "enable"
 	cmdMpathDetach  = cmdDetach
 	cmdMpathDisable = "disable"
+	cmdMpathLabel   = "label"
 
 	// mountpath commands (advanced)
 	cmdMpathRescanDisks = "rescan-disks"
@@ -655,6 +658,13 @@ var (
 
 	forceFlag = cli.BoolFlag{Name: "force,f", Usage: "Force execution of the command " + advancedUsageOnly}
 
+	// set bucket props
+	checkBackendFlag = cli.BoolFlag{
+		Name: "check",
+		Usage: "Prior to committing the change, have each target access the remote bucket using the new properties\n" +
+			indent4 + "\t(e.g., custom S3 endpoint, profile, or credentials); fail without changing anything if unreachable or access denied",
+	}
+
 	// space cleanup
 
 	forceClnFlag = cli.BoolFlag{
@@ -755,6 +765,18 @@ var (
 		Usage: "Include CHUNKED column indicating chunked storage. Also enabled by '--props all'.",
 	}
 
+	// server-side filtering by object size (apc.LsoMsg.MinSize, MaxSize)
+	lsMinSizeFlag = cli.StringFlag{
+		Name: "min-size",
+		Usage: "List only objects of size greater than or equal to the specified value\n" +
+			indent4 + "\t(in IEC or SI units, or \"raw\" bytes; e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
+	}
+	lsMaxSizeFlag = cli.StringFlag{
+		Name: "max-size",
+		Usage: "List only objects of size less than or equal to the specified value\n" +
+			indent4 + "\t(in IEC or SI units, or \"raw\" bytes; e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
+	}
+
 	// bucket summary
 	bckSummaryFlag = cli.BoolFlag{
 		Name: "summary",
@@ -899,8 +921,13 @@ var (
 	}
 
 	// gen-shards
-	fsizeFlag  = cli.StringFlag{Name: "fsize", Value: "1024", Usage: "Size of the files in a shard"}
-	fcountFlag = cli.IntFlag{Name: "fcount", Value: 5, Usage: "Number of files in a shard"}
+	fsizeFlag   = cli.StringFlag{Name: "fsize", Value: "1024", Usage: "Size of the files in a shard"}
+	fcountFlag  = cli.IntFlag{Name: "fcount", Value: 5, Usage: "Number of files in a shard"}
+	genSeedFlag = cli.Int64Flag{
+		Name: "seed",
+		Usage: "Generate reproducible shards: derive file names and content from the specified seed and shard index\n" +
+			indent4 + "\t(running gen-shards twice with the same seed produces byte-identical shards regardless of --num-workers)",
+	}
 
 	dfltFext  = ".test"
 	fextsFlag = cli.StringFlag{
@@ -933,6 +960,10 @@ var (
 		Usage: "Maximum time to wait for a job to finish; if omitted: wait forever or until Ctrl-C;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 	}
+	watchTotalFlag = cli.IntFlag{
+		Name:  "total",
+		Usage: "Expected total number of objects the job will process (when known) - to estimate remaining time (ETA)",
+	}
 	waitPodReadyTimeoutFlag = DurationFlag{
 		Name: "init-timeout",
 		Usage: "AIS target waiting time for POD to become ready;\n" +
@@ -1244,11 +1275,24 @@ var (
 		Name:  "extract,x",
 		Usage: "Extract all files from archive(s)",
 	}
+	extractTemplateFlag = cli.StringFlag{
+		Name: "out-template",
+		Usage: "when extracting archive(s): template to name and place extracted files relative to destination directory;\n" +
+			indent4 + "\tvariables: {shard} (shard name without extension), {archpath} (path in archive), {basename} (ditto, base only);\n" +
+			indent4 + "\te.g.: '{shard}/{archpath}' (default), '{archpath}' (merge all shards), '{shard}-{basename}' (flatten);\n" +
+			indent4 + "\tcollisions (two or more extracted files mapping to the same local path) are rejected",
+	}
 
 	inclSrcBucketNameFlag = cli.BoolFlag{
 		Name:  "include-src-bck",
 		Usage: "Prefix the names of archived files with the source bucket name",
 	}
+	archWdsKeyFlag = cli.StringFlag{
+		Name: "wds-key",
+		Usage: "Regular expression to normalize archived file names for WebDataset: the first capture group\n" +
+			indent1 + "\t(or the entire match) becomes the sample key, and the file is named key + extension, e.g.:\n" +
+			indent1 + "\t--wds-key '/(\\d+)\\.' - archive img/001.jpg and lbl/001.txt as 001.jpg and 001.txt, respectively",
+	}
 	omitSrcBucketNameFlag = cli.BoolFlag{
 		Name:  "omit-src-bck",
 		Usage: "When set, strip source bucket names from paths inside the archive (ie., use object names only)",
@@ -1306,7 +1350,12 @@ var (
 	}
 
 	// ETL
-	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "Mapping from old to new extensions of transformed objects' names"}
+	etlExtFlag       = cli.StringFlag{Name: "ext", Usage: "Mapping from old to new extensions of transformed objects' names"}
+	etlExtStrictFlag = cli.BoolFlag{
+		Name: "ext-strict",
+		Usage: "Do not transform source objects with extensions missing in the '--ext' map;\n" +
+			indent4 + "\tinstead, abort the job (or, with '--cont-on-err', count each such object as an error)",
+	}
 	etlNameFlag = cli.StringFlag{
 		Name:  "name",
 		Usage: "unique ETL name (leaving this field empty will have unique ID auto-generated)",
@@ -136,6 +136,7 @@ var (
 			etlAllObjsFlag,
 			continueOnErrorFlag,
 			etlExtFlag,
+			etlExtStrictFlag,
 			forceFlag,
 			copyPrependFlag,
 			copyDryRunFlag,
@@ -53,6 +53,8 @@ var clusterFeatDesc = [...]string{
 	"publish selected Go runtime metrics via Prometheus",
 	"allow downloader egress to private RFC1918/ULA addresses; loopback and link-local remain blocked",
 	"allow S3 clients that rebuild redirected requests instead of following the Location URI (forbidden when AuthN or intra-cluster signing is configured)",
+	"attribute GET and PUT counts and sizes to the request tag ('ais-request-tag' header) via (bucket, tag) Prometheus variable labels",
+	"do not delete objects referenced by aliases (see 'api.CreateAlias'); default: delete and break the aliases",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -87,6 +89,8 @@ var featTags = map[string]string{
 	"Enable-Go-Runtime-Metrics":            "telemetry,ops,overhead",
 	"Dload-Allow-Private-Egress":           "security-",
 	"S3-Redirect-Rebuild":                  "s3,compat,security-",
+	"Enable-Request-Tag-Metrics":           "telemetry,overhead",
+	"Protect-Aliased-Objects":              "integrity+",
 }
 
 // common (cluster, bucket) feature-flags (set, show) helper
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -52,7 +53,7 @@ func catHandler(c *cli.Context) error {
 	}
 	var warned bool
 	a := qparamArch{archpath: parseStrFlag(c, archpathGetFlag)}
-	return getObject(c, bck, objName, stdInOut, a, &warned, true /*quiet*/, false /*extract*/)
+	return getObject(c, bck, objName, stdInOut, a, &warned, true /*quiet*/, false /*extract*/, nil)
 }
 
 func getHandler(c *cli.Context) error {
@@ -162,6 +163,16 @@ func getHandler(c *cli.Context) error {
 	if err := a.validate(c); err != nil {
 		return err
 	}
+	var ot *outTmpl
+	if flagIsSet(c, extractTemplateFlag) {
+		if !extract {
+			return fmt.Errorf("%s requires %s", qflprn(extractTemplateFlag), extractVia)
+		}
+		var err error
+		if ot, err = newOutTmpl(parseStrFlag(c, extractTemplateFlag)); err != nil {
+			return err
+		}
+	}
 
 	// GET multiple -- currently, only prefix (TODO: list/range)
 	if flagIsSet(c, getObjPrefixFlag) {
@@ -180,16 +191,16 @@ func getHandler(c *cli.Context) error {
 		// `getMultiObj` is a fusion of 'ais ls' and GET, with progress bar and a
 		// very limited archival support (that boils down to listing archived files)
 		// TODO -- FIXME: implement '--archregx' and the rest of the `qparamArch`
-		return getMultiObj(c, bck, outFile, a.enabled(), extract)
+		return getMultiObj(c, bck, outFile, a.enabled(), extract, ot)
 	}
 
 	// GET
 	var warned bool
-	return getObject(c, bck, objName, outFile, a, &warned, false /*quiet*/, extract)
+	return getObject(c, bck, objName, outFile, a, &warned, false /*quiet*/, extract, ot)
 }
 
 // GET multiple -- currently, only prefix (TODO: list/range)
-func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bool) error {
+func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bool, ot *outTmpl) error {
 	var (
 		prefix     = parseStrFlag(c, getObjPrefixFlag)
 		origPrefix = prefix
@@ -351,7 +362,7 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bo
 		u.wg.Add(1)
 
 		// TODO: racy access to *warned (benign)
-		go u.get(c, bck, en, shardName, outFile, &warned, quiet, extract)
+		go u.get(c, bck, en, shardName, outFile, &warned, quiet, extract, ot)
 	}
 	u.wg.Wait()
 
@@ -369,7 +380,8 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bo
 // uctx - "get" extension
 //////////
 
-func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEnt, shardName, outFile string, warned *bool, quiet, extract bool) {
+func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEnt, shardName, outFile string, warned *bool, quiet, extract bool,
+	ot *outTmpl) {
 	var (
 		a       qparamArch // effectively, ignore user-specified command line and redefine to GET a given shardName
 		objName = entry.Name
@@ -386,7 +398,7 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEnt, shardName, ou
 			}
 		}
 	}
-	err := getObject(c, bck, objName, outFile, a, warned, quiet, extract)
+	err := getObject(c, bck, objName, outFile, a, warned, quiet, extract, ot)
 	if err != nil {
 		u.errCount.Inc()
 	}
@@ -404,7 +416,7 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEnt, shardName, ou
 }
 
 // get one (main function)
-func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArch, warned *bool, quiet, extract bool) error {
+func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArch, warned *bool, quiet, extract bool, ot *outTmpl) error {
 	if outFile == stdInOut && extract {
 		return errors.New("cannot extract archived files to standard output - " + NIY)
 	}
@@ -556,7 +568,7 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 		objLen = oah.Size()
 	)
 	if extract {
-		mime, err = doExtract(objName, outFile, objLen)
+		mime, err = doExtract(objName, outFile, objLen, ot)
 		if err != nil {
 			if cliConfVerbose() {
 				return fmt.Errorf("failed to extract %s (from local %q): %v", bck.Cname(objName), outFile, err)
@@ -584,6 +596,8 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 		discard = " and discard"
 	case outFile == stdInOut:
 		out = " to standard output"
+	case extract && ot != nil:
+		out = " to " + filepath.Dir(outFile) + "/"
 	case extract:
 		out = " to " + outFile
 		out = cos.TrimLastB(out, filepath.Separator)
@@ -703,11 +717,12 @@ func (a *qparamArch) getQuery(c *cli.Context, bck *cmn.Bck) (q url.Values) {
 var _ archive.ArchRCB = (*extractor)(nil)
 
 type extractor struct {
+	ot        *outTmpl // nil: extract into <shardName without extension>/<archpath>
 	shardName string
 	mime      string
 }
 
-func doExtract(objName, outFile string, objLen int64) (mime string, err error) {
+func doExtract(objName, outFile string, objLen int64, ot *outTmpl) (mime string, err error) {
 	var (
 		rfh *os.File
 		ar  archive.Reader
@@ -722,14 +737,26 @@ func doExtract(objName, outFile string, objLen int64) (mime string, err error) {
 		return
 	}
 
-	ex := &extractor{outFile, mime}
+	ex := &extractor{ot, outFile, mime}
 	err = ar.ReadUntil(ex, cos.EmptyMatchAll, "")
 	rfh.Close()
 	return
 }
 
 func (ex *extractor) Call(filename string, reader cos.ReadCloseSizer, _ any) (bool /*stop*/, error) {
-	fqn := filepath.Join(strings.TrimSuffix(ex.shardName, ex.mime), filename)
+	var (
+		fqn string
+		err error
+	)
+	if ex.ot == nil {
+		fqn = filepath.Join(strings.TrimSuffix(ex.shardName, ex.mime), filename)
+	} else {
+		shard := strings.TrimSuffix(filepath.Base(ex.shardName), ex.mime)
+		if fqn, err = ex.ot.resolve(filepath.Dir(ex.shardName), shard, filename); err != nil {
+			reader.Close()
+			return true, err
+		}
+	}
 
 	wfh, err := cos.CreateFile(fqn)
 	if err != nil {
@@ -757,6 +784,62 @@ func (ex *extractor) _write(filename string, size int64, wfh *os.File, reader io
 	return false, nil
 }
 
+//
+// outTmpl: naming extracted files (see extractTemplateFlag)
+//
+
+const (
+	otShard    = "{shard}"
+	otArchpath = "{archpath}"
+	otBasename = "{basename}"
+)
+
+type outTmpl struct {
+	seen map[string]string // local path => shard/archpath
+	tmpl string
+	mu   sync.Mutex
+}
+
+func newOutTmpl(tmpl string) (*outTmpl, error) {
+	var (
+		flag = qflprn(extractTemplateFlag)
+		rest = strings.NewReplacer(otShard, "", otArchpath, "", otBasename, "").Replace(tmpl)
+	)
+	switch {
+	case strings.TrimSpace(tmpl) == "":
+		return nil, fmt.Errorf("%s cannot be empty", flag)
+	case strings.ContainsAny(rest, "{}"):
+		return nil, fmt.Errorf("invalid %s %q: unknown variable or unbalanced braces (expecting %s, %s, and/or %s)",
+			flag, tmpl, otShard, otArchpath, otBasename)
+	case !strings.Contains(tmpl, otArchpath) && !strings.Contains(tmpl, otBasename):
+		return nil, fmt.Errorf("invalid %s %q: must include %s or %s", flag, tmpl, otArchpath, otBasename)
+	case filepath.IsAbs(tmpl):
+		return nil, fmt.Errorf("invalid %s %q: expecting path relative to destination directory", flag, tmpl)
+	}
+	if c := filepath.Clean(tmpl); c == ".." || strings.HasPrefix(c, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("invalid %s %q: cannot reference parent directory", flag, tmpl)
+	}
+	return &outTmpl{tmpl: tmpl, seen: make(map[string]string, 64)}, nil
+}
+
+func (ot *outTmpl) resolve(root, shard, archpath string) (string, error) {
+	var (
+		name = strings.NewReplacer(otShard, shard, otArchpath, archpath, otBasename, filepath.Base(archpath)).Replace(ot.tmpl)
+		fqn  = filepath.Join(root, name)
+		src  = shard + "/" + archpath
+	)
+	if rel, err := filepath.Rel(root, fqn); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: %s resolves to %q outside destination directory", qflprn(extractTemplateFlag), src, fqn)
+	}
+	ot.mu.Lock()
+	defer ot.mu.Unlock()
+	if prev, ok := ot.seen[fqn]; ok {
+		return "", fmt.Errorf("%s: name collision - both %s and %s resolve to %q", qflprn(extractTemplateFlag), prev, src, fqn)
+	}
+	ot.seen[fqn] = src
+	return fqn, nil
+}
+
 //
 // multipart download with progress bar
 //
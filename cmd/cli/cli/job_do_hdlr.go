@@ -50,6 +50,11 @@ const waitUsage = "Wait for a specific job to complete, e.g.:\n" +
 	indent1 + "\t- 'wait prefetch-listrange'\t- wait for prefetch job to finish (silent);\n" +
 	indent1 + "\t- 'wait prefetch-listrange --refresh 5s'\t- wait with progress updates every 5 seconds."
 
+const watchUsage = "Follow a running job to completion, with live progress updates, e.g.:\n" +
+	indent1 + "\t- 'job watch Pv3xR6rAz'\t- show objects and bytes processed, throughput, and elapsed time;\n" +
+	indent1 + "\t- 'job watch Pv3xR6rAz --refresh 10s --total 100000'\t- update every 10 seconds and estimate remaining time (ETA).\n" +
+	indent1 + "Exits when the job finishes; returns non-zero if the job was aborted."
+
 const downloadUsage = "Download files and objects from remote sources, e.g.:\n" +
 	indent1 + "\t- 'ais download http://example.com/file.tar ais://bucket/'\t- download from HTTP into AIS bucket;\n" +
 	indent1 + "\t- 'ais download s3://bucket/file.tar ais://local-bucket/'\t- download from S3 into AIS bucket;\n" +
@@ -104,6 +109,7 @@ var (
 		jobStartSub,
 		jobStopSub,
 		jobWaitSub,
+		jobWatchSub,
 		jobRemoveSub,
 		makeAlias(&showCmdJob, &mkaliasOpts{newName: commandShow}),
 	}
@@ -289,6 +295,22 @@ var (
 	}
 )
 
+// ais job watch
+var (
+	watchCmdsFlags = []cli.Flag{
+		refreshFlag,
+		watchTotalFlag,
+	}
+	jobWatchSub = cli.Command{
+		Name:         commandWatch,
+		Usage:        watchUsage,
+		ArgsUsage:    jobIDArgument,
+		Flags:        sortFlags(watchCmdsFlags),
+		Action:       watchJobHandler,
+		BashComplete: runningJobCompletions,
+	}
+)
+
 // ais job remove
 var (
 	removeCmdsFlags = []cli.Flag{
@@ -1547,6 +1569,100 @@ func waitJobWithRefresh(c *cli.Context, xargs *xact.ArgsMsg, xname string, bck c
 	return nil
 }
 
+func watchJobHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	var (
+		xid         = c.Args().Get(0)
+		xargs       = xact.ArgsMsg{ID: xid}
+		total       = int64(parseIntFlag(c, watchTotalFlag))
+		refreshRate = refreshRateDefault
+	)
+	if flagIsSet(c, refreshFlag) {
+		refreshRate = max(parseDurationFlag(c, refreshFlag), refreshRateMinDur)
+	}
+	if err := xact.CheckValidUUID(xid); err != nil {
+		return err
+	}
+	_, snap, err := getAnyXactSnap(&xargs)
+	if err != nil {
+		return err
+	}
+	if snap == nil {
+		return fmt.Errorf("job %q not found", xid)
+	}
+	_, xname := xact.GetKindName(snap.Kind)
+	msg := formatXactMsg(xid, xname, cmn.Bck{})
+	fmt.Fprintln(c.App.Writer, "Watching "+msg+" ...")
+
+	var (
+		prevBytes int64
+		prevTime  time.Time
+	)
+	for {
+		xs, err := api.QueryXactionSnaps(apiBP, &xargs)
+		if err != nil {
+			return V(err)
+		}
+		var (
+			now                      = time.Now()
+			objs, _, _               = xs.ObjCounts(xid)
+			bytes, _, _              = xs.ByteCounts(xid)
+			aborted, running, notyet = xs.AggregateState(xid)
+		)
+		elapsed, err := xs.TotalRunningTime(xid)
+		if err != nil {
+			return err
+		}
+		// current throughput: since the previous update or, initially, average since start
+		ival := elapsed
+		if !prevTime.IsZero() {
+			ival = now.Sub(prevTime)
+		}
+		var bps float64
+		if ival > 0 {
+			bps = float64(bytes-prevBytes) / ival.Seconds()
+		}
+		line := fmt.Sprintf("%s objects (%s), %s/s, elapsed %v", cos.FormatBigI64(objs), cos.IEC(bytes, 2),
+			cos.IEC(int64(bps), 2), elapsed.Truncate(time.Second))
+		if eta := _watchETA(objs, total, elapsed); eta != "" {
+			line += ", ETA " + eta
+		}
+		fmt.Fprint(c.App.Writer, "\r\033[K"+line)
+		prevBytes, prevTime = bytes, now
+
+		switch {
+		case aborted:
+			fmt.Fprintln(c.App.Writer)
+			return fmt.Errorf("%s was aborted", msg)
+		case !running && !notyet:
+			fmt.Fprintln(c.App.Writer)
+			if err := checkXactErrs(&xargs, nil); err != nil {
+				return err
+			}
+			actionDone(c, "Done.")
+			return nil
+		}
+		time.Sleep(refreshRate)
+	}
+}
+
+// remaining time, extrapolating the average rate (objects per second) since start
+func _watchETA(objs, total int64, elapsed time.Duration) string {
+	if total <= 0 || objs <= 0 || elapsed <= 0 {
+		return ""
+	}
+	if objs >= total {
+		return "0s"
+	}
+	remaining := time.Duration(float64(elapsed) * float64(total-objs) / float64(objs))
+	return remaining.Truncate(time.Second).String()
+}
+
 func waitDownloadHandler(c *cli.Context, id string) error {
 	refreshRate := downloadRefreshRate(c)
 	timeout := parseDurationFlag(c, dloadTimeoutFlag)
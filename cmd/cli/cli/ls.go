@@ -343,6 +343,23 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	if flagIsSet(c, noDirsFlag) {
 		msg.SetFlag(apc.LsNoDirs)
 	}
+	if flagIsSet(c, lsMinSizeFlag) {
+		size, err := parseSizeFlag(c, lsMinSizeFlag)
+		if err != nil {
+			return err
+		}
+		msg.MinSize = cos.SizeIEC(size)
+	}
+	if flagIsSet(c, lsMaxSizeFlag) {
+		size, err := parseSizeFlag(c, lsMaxSizeFlag)
+		if err != nil {
+			return err
+		}
+		msg.MaxSize = cos.SizeIEC(size)
+	}
+	if err := msg.ValidateSizeRange(); err != nil {
+		return fmt.Errorf("%s, %s: %v", qflprn(lsMinSizeFlag), qflprn(lsMaxSizeFlag), err)
+	}
 
 	var (
 		props    []string
@@ -653,8 +653,9 @@ func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action strin
 	switch verb {
 	case commandRemove:
 		msg := &apc.EvdMsg{
-			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
-			NonRecurs: flagIsSet(c, nonRecursFlag),
+			ListRange:  apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
+			NonRecurs:  flagIsSet(c, nonRecursFlag),
+			NumWorkers: parseIntFlag(c, numWorkersFlag), // (zero when unset: auto)
 		}
 		xid, err = api.DeleteMultiObj(apiBP, lr.bck, msg)
 		kind = apc.ActDeleteObjects
@@ -696,8 +697,9 @@ func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action strin
 			return "", "", "", err
 		}
 		msg := &apc.EvdMsg{
-			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
-			NonRecurs: flagIsSet(c, nonRecursFlag),
+			ListRange:  apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
+			NonRecurs:  flagIsSet(c, nonRecursFlag),
+			NumWorkers: parseIntFlag(c, numWorkersFlag), // (zero when unset: auto)
 		}
 		xid, err = api.EvictMultiObj(apiBP, lr.bck, msg)
 		kind = apc.ActEvictObjects
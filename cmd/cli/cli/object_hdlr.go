@@ -115,6 +115,7 @@ var (
 			verboseFlag, // rm -rf
 			nonverboseFlag,
 			nonRecursFlag, // (embedded prefix dopOLTP dop)
+			numWorkersFlag,
 			yesFlag,
 			dontHeadRemoteFlag,
 			encodeObjnameFlag,
@@ -144,6 +145,7 @@ var (
 			archmodeFlag,
 			// archive, client side
 			extractFlag,
+			extractTemplateFlag,
 			// bucket inventory
 			nbiFlag,
 			nbiNameFlag,
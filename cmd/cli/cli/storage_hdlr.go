@@ -126,6 +126,17 @@ const (
 		indent1 + "\t- 'ais storage mountpath attach t[abc]=/mnt/disk1 t[abc]=/mnt/disk2'\t- attach multiple.\n" +
 		indent1 + "\n" +
 		indent1 + "The mountpath must be a valid mounted filesystem accessible by the target."
+
+	mpathLabelUsage = "Set (or, with empty '--label', remove) the label of an existing mountpath.\n" +
+		indent1 + "\n" +
+		indent1 + "The label is persisted and survives restarts; 'ais storage mountpath show'\n" +
+		indent1 + "lists labeled mountpaths grouped by label.\n" +
+		indent1 + "\n" +
+		indent1 + "Examples:\n" +
+		indent1 + "\t- 'ais storage mountpath label t[abc]=/mnt/disk1 --label hdd-old'\t- set label;\n" +
+		indent1 + "\t- 'ais storage mountpath label t[abc]=/mnt/disk1 --label \"\"'\t- remove label.\n" +
+		indent1 + "\n" +
+		indent1 + "Note: the mountpath's underlying disks are not re-resolved until the next attach or restart."
 )
 
 var (
@@ -133,6 +144,9 @@ var (
 		cmdMpathAttach: {
 			mountpathLabelFlag,
 		},
+		cmdMpathLabel: {
+			mountpathLabelFlag,
+		},
 		"default": {
 			noResilverFlag,
 		},
@@ -175,6 +189,14 @@ var (
 				Action:       mpathDisableHandler,
 				BashComplete: suggestMpathActive,
 			},
+			{
+				Name:         cmdMpathLabel,
+				Usage:        mpathLabelUsage,
+				ArgsUsage:    nodeMountpathPairArgument,
+				Flags:        sortFlags(mpathCmdsFlags[cmdMpathLabel]),
+				Action:       mpathLabelHandler,
+				BashComplete: suggestTargets,
+			},
 			//
 			// advanced usage
 			//
@@ -728,6 +750,7 @@ func mpathDetachHandler(c *cli.Context) error  { return mpathAction(c, apc.ActMo
 func mpathDisableHandler(c *cli.Context) error { return mpathAction(c, apc.ActMountpathDisable) }
 func mpathRescanHandler(c *cli.Context) error  { return mpathAction(c, apc.ActMountpathRescan) }
 func mpathFshcHandler(c *cli.Context) error    { return mpathAction(c, apc.ActMountpathFSHC) }
+func mpathLabelHandler(c *cli.Context) error   { return mpathAction(c, apc.ActMountpathLabel) }
 
 func mpathAction(c *cli.Context, action string) error {
 	if c.NArg() == 0 {
@@ -775,6 +798,16 @@ func mpathAction(c *cli.Context, action string) error {
 			acted = "attached"
 			label := parseStrFlag(c, mountpathLabelFlag)
 			err = api.AttachMountpath(apiBP, si, mountpath, cos.MountpathLabel(label))
+		case apc.ActMountpathLabel:
+			if !flagIsSet(c, mountpathLabelFlag) {
+				return missingArgumentsError(c, qflprn(mountpathLabelFlag))
+			}
+			label := parseStrFlag(c, mountpathLabelFlag)
+			acted = fmt.Sprintf("labeled %q", label)
+			if label == "" {
+				acted = "unlabeled"
+			}
+			err = api.SetMountpathLabel(apiBP, si, mountpath, cos.MountpathLabel(label))
 		case apc.ActMountpathEnable:
 			acted = "enabled"
 			err = api.EnableMountpath(apiBP, si, mountpath)
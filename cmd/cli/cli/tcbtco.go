@@ -147,7 +147,7 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 			return incorrectUsageMsg(c, errFmtSameBucket, commandCopy, bckTo.Cname(""))
 		}
 		if dryRun {
-			// TODO: show object names with destinations, make the output consistent with etl dry-run
+			// TODO: show object names with destinations for copy, as well (see etlBucketPlan)
 			dryRunCptn(c)
 			actionDone(c, text2+" the entire bucket")
 		}
@@ -323,11 +323,22 @@ func etlBucket(c *cli.Context, etlNameOrPipeline string, bckFrom, bckTo cmn.Bck)
 	if err := parseExtFlag(c, &msg.Ext); err != nil {
 		return err
 	}
+	if flagIsSet(c, etlExtStrictFlag) {
+		if len(msg.Ext) == 0 {
+			return incorrectUsageMsg(c, "%s requires %s", qflprn(etlExtStrictFlag), qflprn(etlExtFlag))
+		}
+		msg.ExtStrict = true
+	}
 
 	// by default, copying objects in the cluster, with an option to override
 	// TODO: FltExistsOutside maybe later
 	fltPresence := cos.Ternary(flagIsSet(c, copyAllObjsFlag) || flagIsSet(c, etlAllObjsFlag), apc.FltExists, apc.FltPresent)
 
+	// [DRY-RUN] list source objects and show their destinations; do not start x-etl-bck
+	if msg.DryRun {
+		return etlBucketPlan(c, bckFrom, bckTo, &msg, fltPresence)
+	}
+
 	xid, err := api.ETLBucket(apiBP, bckFrom, bckTo, &msg, fltPresence)
 	if errV := handleETLHTTPError(err, transform.Name); errV != nil {
 		return errV
@@ -347,23 +358,41 @@ func etlBucket(c *cli.Context, etlNameOrPipeline string, bckFrom, bckTo cmn.Bck)
 	}
 	fmt.Fprintln(c.App.Writer, text+" ...")
 	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActETLBck, Timeout: timeout}
-	if err := waitXact(&xargs); err != nil {
-		return err
+	return waitXact(&xargs)
+}
+
+// enumerate source objects (same prefix, recursion, and presence as x-etl-bck would)
+// and print the names they'd be transformed into - without invoking the transformer
+func etlBucketPlan(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.TCBMsg, fltPresence int) error {
+	lsmsg := &apc.LsoMsg{Prefix: msg.Prefix, Props: apc.GetPropsName}
+	lsmsg.SetFlag(apc.LsNoDirs)
+	if fltPresence == apc.FltPresent {
+		lsmsg.SetFlag(apc.LsCached)
 	}
-	if !flagIsSet(c, copyDryRunFlag) {
-		return nil
+	if msg.NonRecurs {
+		lsmsg.SetFlag(apc.LsNoRecursion)
 	}
+	dryRunCptn(c)
 
-	// [DRY-RUN]
-	snaps, err := api.QueryXactionSnaps(apiBP, &xargs)
-	if err != nil {
-		return V(err)
+	// page by page (as opposed to the entire listing in memory)
+	var n int
+	for {
+		lst, err := api.ListObjectsPage(apiBP, bckFrom, lsmsg, api.ListArgs{})
+		if err != nil {
+			return V(err)
+		}
+		for _, en := range lst.Entries {
+			if en.IsAnyFlagSet(apc.EntryIsDir) {
+				continue
+			}
+			fmt.Fprintf(c.App.Writer, "%s => %s\n", bckFrom.Cname(en.Name), bckTo.Cname(msg.ToName(en.Name)))
+			n++
+		}
+		if lsmsg.ContinuationToken == "" {
+			break
+		}
 	}
-	dryRunCptn(c)
-	locObjs, outObjs, inObjs := snaps.ObjCounts(xid)
-	fmt.Fprintf(c.App.Writer, "ETL object counts:\t transformed=%d, sent=%d, received=%d", locObjs, outObjs, inObjs)
-	locBytes, outBytes, inBytes := snaps.ByteCounts(xid)
-	fmt.Fprintf(c.App.Writer, "ETL byte stats:\t transformed=%d, sent=%d, received=%d", locBytes, outBytes, inBytes)
+	fmt.Fprintf(c.App.Writer, "%d object%s to transform\n", n, cos.Plural(n))
 	return nil
 }
 
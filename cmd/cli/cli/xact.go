@@ -286,6 +286,9 @@ func flattenXactStats(snap *core.Snap, units string) nvpairList {
 			nvpair{Name: "out.obj.size", Value: printtedVal},
 		)
 	}
+	if snap.Stats.SkipObjs != 0 {
+		props = append(props, nvpair{Name: "skip.obj.n", Value: strconv.FormatInt(snap.Stats.SkipObjs, 10)})
+	}
 	// NOTE: extended stats
 	if extStats, ok := snap.Ext.(map[string]any); ok {
 		for k, v := range extStats {
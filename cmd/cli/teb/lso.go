@@ -28,6 +28,13 @@ var (
 		apc.GetPropsStatus:   "{{FormatLsObjStatus $obj}}",
 		apc.GetPropsCopies:   "{{$obj.Copies}}",
 		apc.GetPropsCached:   "{{FormatLsObjIsCached $obj}}",
+		apc.GetPropsHeat:     "{{$obj.Heat}}",
+		// virtual dirs: recursive number of objects (the size goes into the SIZE column)
+		apc.GetPropsDirSummary: "{{if $obj.Count}}{{$obj.Count}}{{end}}",
+		// chunked objects: number of chunks
+		apc.GetPropsNumChunks: "{{if $obj.Chunks}}{{$obj.Chunks}}{{end}}",
+		// EC-enabled buckets: replicated vs erasure coded
+		apc.GetPropsECStatus: "{{$obj.ECStatus}}",
 		//
 		propChunked: "{{FormatIsChunked $obj.Flags}}",
 	}
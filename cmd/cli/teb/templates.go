@@ -507,5 +507,10 @@ const (
 		"{{range $mp := $p.Mpl.WaitingDD }}" +
 		"\t\t{{ $mp }}\n" +
 		"{{end}}{{end}}" +
+		"{{if ne (len $p.Mpl.Labels) 0}}" +
+		"\tLabels:\n" +
+		"{{range $label, $mps := $p.Mpl.Labels }}" +
+		"\t\t{{ $label }}: {{ JoinList $mps }}{{FormatLabelCap $p.Tcdf $label}}\n" +
+		"{{end}}{{end}}" +
 		"{{end}}{{end}}"
 )
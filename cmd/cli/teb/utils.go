@@ -93,6 +93,7 @@ var (
 		"FormatTargetsSumm":    fmtTargetsSumm,
 		"FormatCapPctMAM":      fmtCapPctMAM,
 		"FormatCDFDisks":       fmtCDFDisks,
+		"FormatLabelCap":       fmtLabelCap,
 		"FormatFloat":          func(f float64) string { return fmt.Sprintf("%.2f", f) },
 		"FormatBool":           FmtBool,
 		"FormatBckName":        fmtBckName,
@@ -395,6 +396,16 @@ func fmtCapPctMAM(tcdf *fs.Tcdf, list bool) string {
 	return fmt.Sprintf("%s%2d%%%s %s%2d%%%s %s%2d%%", a, tcdf.PctMin, sepa, b, tcdf.PctAvg, sepa, c, tcdf.PctMax)
 }
 
+// capacity of (available) mountpaths with a given label
+func fmtLabelCap(tcdf *fs.Tcdf, label string) string {
+	c, ok := tcdf.ByLabel()[cos.MountpathLabel(label)]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("\t (used %s, available %s, %d%%)",
+		cos.ToSizeIEC(int64(c.Used), 2), cos.ToSizeIEC(int64(c.Avail), 2), c.PctUsed)
+}
+
 func fmtCDFDisks(cdf *fs.CDF) string {
 	alert, _ := fs.HasAlert(cdf.Disks)
 	if alert == "" {
@@ -66,6 +66,8 @@ type (
 		RateLimit   RateLimitConf   `json:"rate_limit"`                       // frontend and backend rate limiting - bursty and adaptive, respectively
 		EC          ECConf          `json:"ec"`                               // erasure coding
 		Chunks      ChunksConf      `json:"chunks"`                           // chunks and chunk manifests; multipart upload
+		Compression CompressionConf `json:"compression"`                      // compression of stored objects (at rest)
+		Encryption  EncryptionConf  `json:"encryption"`                       // encryption of stored objects (at rest)
 		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
 		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
 		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
@@ -73,6 +75,9 @@ type (
 		BID         uint64          `json:"bid,string" list:"omit"`           // unique ID
 		Created     int64           `json:"created,string" list:"readonly"`   // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                       // see "inherit"
+		// ais:// buckets only (and not with remote backend): object names are lowercased upon PUT and lookup,
+		// with the original (as PUT) name retained in custom metadata (see cmn.OrigNameObjMD)
+		CaseInsensitiveNames bool `json:"case_insensitive_names,omitempty"`
 	}
 
 	ExtraProps struct {
@@ -125,6 +130,10 @@ type (
 		// - for the AIS default, see `DefaultPartSize` in ais/s3/const
 		// - NOTE: the threshold is, effectively, one of the **performance tunables**
 		MultiPartSize cos.SizeIEC `json:"multipart_size,omitempty"`
+
+		// Requester-pays bucket: include `x-amz-request-payer: requester` with each request
+		// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/RequesterPaysBuckets.html
+		RequesterPays bool `json:"requester_pays,omitempty"`
 	}
 	// ExtraPropsAWSToSet is the partial-update counterpart of ExtraPropsAWS.
 	ExtraPropsAWSToSet struct {
@@ -145,6 +154,9 @@ type (
 		// at least 5 MiB. Falls back to the AIS-provided default when
 		// omitted. Primarily a performance tunable.
 		MultiPartSize *cos.SizeIEC `json:"multipart_size,omitempty"` // +gen:optional
+		// Requester-pays S3 bucket: the requester (rather than the
+		// bucket owner) is charged for requests and data transfer.
+		RequesterPays *bool `json:"requester_pays,omitempty"` // +gen:optional
 	}
 
 	ExtraPropsGCP struct {
@@ -207,6 +219,10 @@ type (
 		Mirror *MirrorConfToSet `json:"mirror,omitempty"` // +gen:optional
 		// Large-object chunking.
 		Chunks *ChunksConfToSet `json:"chunks,omitempty"` // +gen:optional
+		// Compression of stored objects (at rest).
+		Compression *CompressionConfToSet `json:"compression,omitempty"` // +gen:optional
+		// Encryption of stored objects (at rest).
+		Encryption *EncryptionConfToSet `json:"encryption,omitempty"` // +gen:optional
 		// Erasure coding (data and parity slices).
 		EC *ECConfToSet `json:"ec,omitempty"` // +gen:optional
 		// Bitwise access-permission mask. See `apc.AccessAttrs` for
@@ -221,12 +237,21 @@ type (
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"` // +gen:optional
 		// Provider-specific extras (S3, GCS, Azure, OCI, HTTP).
 		Extra *ExtraToSet `json:"extra,omitempty"` // +gen:optional
+		// Lowercase object names upon PUT and lookup (ais:// buckets without
+		// remote backend only). Objects previously written with mixed-case
+		// names won't be found once enabled.
+		CaseInsensitiveNames *bool `json:"case_insensitive_names,omitempty"` // +gen:optional
 
 		// Skip safety validations that would otherwise reject the update.
 		// Currently, the flag is used exclusively for EC, for the following two distinct use cases:
 		// - change ec.objsize_limit while EC remains enabled;
 		// - accept not-enough-targets warning when enabling EC (ie., when D + P + 1 > num-targets).
 		Force bool `json:"force,omitempty" copy:"skip" list:"omit"` // +gen:optional
+
+		// Creation time only, ais:// buckets with BackendBck only: upon successful creation,
+		// start prefetching the entire backend bucket and return the prefetch job ID.
+		// Not a bucket property (not persisted).
+		PrefetchOnAttach bool `json:"prefetch_on_attach,omitempty" copy:"skip" list:"omit"` // +gen:optional
 	}
 
 	// BackendBckToSet identifies a remote backend bucket that an
@@ -329,10 +354,14 @@ func (bp *Bprops) Validate(targetCnt int) error {
 			return fmt.Errorf("backend bucket %q must be remote", bp.BackendBck.String())
 		}
 	}
+	if bp.CaseInsensitiveNames && (bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty()) {
+		// remote backends are case-sensitive: normalized names would not match remote objects
+		return errors.New("case-insensitive object names are supported only for ais:// buckets without remote backend")
+	}
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Features} {
+	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.Compression, &bp.Encryption, &bp.LRU, &bp.Features} {
 		var err error
 		switch {
 		case pv == &bp.EC:
@@ -354,7 +383,22 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
-	if bp.Mirror.Enabled && bp.Chunks.AutoEnabled() {
+	if bp.Compression.Enabled && bp.EC.Enabled {
+		// EC encodes and restores stored bytes
+		return errors.New("compression and erasure coding cannot be enabled at the same time on the same bucket")
+	}
+	if bp.Encryption.Enabled {
+		switch {
+		case bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty():
+			return errors.New("encryption is supported only for ais:// buckets without remote backend")
+		case bp.EC.Enabled:
+			// ditto
+			return errors.New("encryption and erasure coding cannot be enabled at the same time on the same bucket")
+		case bp.Chunks.AutoEnabled() || bp.Chunks.AutoSizeLimit > 0:
+			return errors.New("encryption and chunking cannot be enabled at the same time on the same bucket")
+		}
+	}
+	if bp.Mirror.Enabled && (bp.Chunks.AutoEnabled() || bp.Chunks.AutoSizeLimit > 0) {
 		return errors.New("n-way mirroring and chunking cannot be enabled at the same time on the same bucket (MPU chunking is still allowed)")
 	}
 
@@ -451,6 +495,10 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 		}
 	}
 
+	if c.AWS.RequesterPays && provider != apc.AWS {
+		return fmt.Errorf("invalid extra.aws.requester_pays: not supported for %q buckets (expecting %q)", provider, apc.AWS)
+	}
+
 	switch provider {
 	case apc.HT:
 		if c.HTTP.OrigURLBck == "" {
@@ -0,0 +1,26 @@
+// Package archive: write, read, copy, append, list primitives
+// across all supported formats
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive
+
+// table of contents: sidecar object written alongside a TAR shard
+// (see apc.ArchiveMsg.EmitTOC) - allows readers to seek directly
+// to a given entry without scanning the shard
+
+const TOCSuffix = ".toc.json" // sidecar name: archive name + TOCSuffix
+
+type (
+	TOCEntry struct {
+		Name   string `json:"name"`
+		Offset int64  `json:"offset"` // of the entry's content (i.e., past its TAR header)
+		Size   int64  `json:"size"`
+	}
+	TOC struct {
+		Archive string     `json:"archive"`
+		Entries []TOCEntry `json:"entries"`
+	}
+)
+
+func TOCName(archName string) string { return archName + TOCSuffix }
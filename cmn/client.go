@@ -52,6 +52,7 @@ type (
 		ClientTimeout    time.Duration // http.Client.Timeout: end-to-end request
 		IdleConnTimeout  time.Duration
 		IdleConnsPerHost int
+		MaxConnsPerHost  int // 0: no limit
 		MaxIdleConns     int
 		SndRcvBufSize    int
 		WriteBufferSize  int
@@ -93,6 +94,7 @@ func NewTransport(cargs TransportArgs) *http.Transport {
 	transport.IdleConnTimeout = cos.NonZero(cargs.IdleConnTimeout, DefaultIdleConnTimeout)
 	transport.MaxIdleConnsPerHost = cos.NonZero(cargs.IdleConnsPerHost, DefaultMaxIdleConnsPerHost)
 	transport.MaxIdleConns = cos.NonZero(cargs.MaxIdleConns, DefaultMaxIdleConns)
+	transport.MaxConnsPerHost = cargs.MaxConnsPerHost
 
 	transport.WriteBufferSize = cos.NonZero(cargs.WriteBufferSize, DefaultWriteBufferSize)
 	transport.ReadBufferSize = cos.NonZero(cargs.ReadBufferSize, DefaultReadBufferSize)
@@ -10,7 +10,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"math/rand/v2"
 	"net/url"
 	"path"
 	"path/filepath"
@@ -35,6 +37,14 @@ const (
 	confDisabled = "Disabled" // common conf.String()
 )
 
+// backend config: client-side TLS to access remote AIS clusters (see BackendConfRemAisTLS)
+const BackendRemAisTLS = "ais_tls"
+
+// periodic.stats_time_overrides: metric groups
+const (
+	StatsGroupDisk = "disk" // disk read/write throughput, average sizes, utilization
+)
+
 type (
 	validator interface {
 		Validate() error
@@ -67,6 +77,9 @@ type (
 		LogDir    string         `json:"log_dir"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
 		HostNet   LocalNetConfig `json:"host_net"`
+		// failure domain (e.g., rack or zone) this node belongs to;
+		// targets: EC slices and replicas get spread across distinct domains (see meta.Smap.HrwTargetList)
+		FailureDomain string `json:"failure_domain,omitempty"`
 	}
 
 	// ais node: (local) network config
@@ -119,6 +132,7 @@ type (
 		Log         LogConf         `json:"log"`
 		EC          ECConf          `json:"ec" allow:"cluster"`
 		GetBatch    GetBatchConf    `json:"get_batch" allow:"cluster"`
+		ReadQoS     ReadQoSConf     `json:"read_qos"`
 		Net         NetConf         `json:"net" allow:"cluster"`
 		Timeout     TimeoutConf     `json:"timeout"`
 		Space       SpaceConf       `json:"space"`
@@ -172,21 +186,62 @@ type (
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		GetBatch    *GetBatchConfToSet    `json:"get_batch,omitempty"`
+		ReadQoS     *ReadQoSConfToSet     `json:"read_qos,omitempty"`
 
 		// LocalConfig
 		FSP *FSPConf `json:"fspaths,omitempty"`
 	}
 
 	BackendConf struct {
-		Conf      map[string]any `json:"-"` // backend implementation-dependent (custom marshaling to populate this field)
-		Providers map[string]Ns  `json:"-"` // conditional (build tag) providers set during validation (BackendConf.Validate)
+		Conf      map[string]any        `json:"-"` // backend implementation-dependent (custom marshaling to populate this field)
+		Providers map[string]Ns         `json:"-"` // conditional (build tag) providers set during validation (BackendConf.Validate)
+		AisTLS    *BackendConfRemAisTLS `json:"-"` // serialized as "ais_tls" alongside providers but never stored in Conf (see BackendRemAisTLS)
 	}
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
+	// client-side TLS (including mutual TLS) to access remote AIS clusters, e.g.:
+	// "backend": {"ais": {...}, "ais_tls": {"certificate": "/etc/ais/client.crt", "key": "/etc/ais/client.key"}}
+	// (see BackendRemAisTLS)
+	BackendConfRemAisTLS struct {
+		Certificate string `json:"certificate"`           // client certificate (PEM)
+		Key         string `json:"key"`                   // client private key (PEM)
+		RootCA      string `json:"root_ca,omitempty"`     // to verify remote cluster's server certificate (system pool when empty)
+		SkipVerify  bool   `json:"skip_verify,omitempty"` // do not verify remote cluster's server certificate
+	}
+
+	// cloud providers (aws, azure, gcp, oci, ht), e.g.: "backend": {"azure": {"max_conns": 1024}}
+	BackendConfCloud struct {
+		// Maximum number of connections per host, including connections in the
+		// dialing, active, and idle states; 0 (zero) means no limit.
+		// Applies to newly created backend clients (e.g., upon restart).
+		MaxConns int `json:"max_conns,omitempty"`
+		// Retry policy for backend calls (GET, PUT, HEAD, list, etc.);
+		// nil (omitted) means the backend SDK's defaults.
+		Retry *BackendConfRetry `json:"retry,omitempty"`
+	}
+
+	// backend retry policy (see BackendConfCloud), e.g.:
+	// "backend": {"aws": {"retry": {"max_attempts": 5, "base_delay": "200ms", "max_delay": "20s", "jitter": true}}}
+	// zero values (or omitted) mean the backend SDK's defaults
+	BackendConfRetry struct {
+		// Total number of attempts, including the first one; 1 disables retries.
+		MaxAttempts int `json:"max_attempts,omitempty"`
+		// Delay before the first retry; doubles with each subsequent retry.
+		BaseDelay cos.Duration `json:"base_delay,omitempty"`
+		// Upper bound on the delay between attempts.
+		MaxDelay cos.Duration `json:"max_delay,omitempty"`
+		// Randomize each delay within [0, computed delay].
+		// Note: Azure and GCP SDKs always randomize delays.
+		Jitter bool `json:"jitter,omitempty"`
+	}
+
 	MirrorConf struct {
-		Copies  int64 `json:"copies"`       // num copies
-		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
-		Enabled bool  `json:"enabled"`      // enabled (to generate copies)
+		// read (GET) copy selection: one of the ReadPolicy* enumerated below;
+		// empty means primary (see also: feat.LoadBalanceGET)
+		ReadPolicy string `json:"read_policy,omitempty"`
+		Copies     int64  `json:"copies"`       // num copies
+		Burst      int    `json:"burst_buffer"` // xaction channel (buffer) size
+		Enabled    bool   `json:"enabled"`      // enabled (to generate copies)
 	}
 	// MirrorConfToSet is the partial-update counterpart of MirrorConf.
 	MirrorConfToSet struct {
@@ -197,13 +252,18 @@ type (
 		Burst *int `json:"burst_buffer,omitempty"` // +gen:optional
 		// Toggles intra-cluster mirroring for the bucket.
 		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+		// Which copy serves reads (GET): `primary` (default), `round-robin`,
+		// or `least-busy` (the copy on the least utilized mountpath).
+		ReadPolicy *string `json:"read_policy,omitempty"` // +gen:optional
 	}
 
 	ECConf struct {
 		XactConf
 
 		// ObjSizeLimit is object size threshold _separating_ intra-cluster mirroring from
-		// erasure coding.
+		// erasure coding: objects of size <= ObjSizeLimit are replicated (P+1 full copies),
+		// while larger objects are erasure coded. The decision is made at PUT time
+		// (and when encoding an existing bucket), based on the object's size.
 		//
 		// The value 0 (zero) indicates that objects of any size
 		// are to be sliced, to produce (D) data slices and (P) erasure coded parity slices.
@@ -282,6 +342,15 @@ type (
 		// - constants minMaxMonolithicSize and MaxMonolithicSize below.
 		MaxMonolithicSize cos.SizeIEC `json:"max_monolithic_size,omitempty"`
 
+		// AutoSizeLimit is the object size threshold at or above which new content
+		// (regular PUT, promote, and cold GET) is transparently stored as chunks.
+		//  0   : disabled (default);
+		//  > 0 : store as ChunkSize chunks if object size >= threshold.
+		//
+		// Compare with ObjSizeLimit that applies to x-rechunk (existing objects).
+		// Validation: auto_size_limit <= max_monolithic_size.
+		AutoSizeLimit cos.SizeIEC `json:"auto_size_limit,omitempty"`
+
 		// Default chunk size (aka "part size") used for auto-chunking (see above);
 		// 0 (zero) means default; Validate() sets it to ChunkSizeDflt (1GiB).
 		ChunkSize cos.SizeIEC `json:"chunk_size,omitempty"`
@@ -309,6 +378,10 @@ type (
 		// Hard limit; when set, must be at or above ObjSizeLimit and
 		// within the `[1 GiB, 1 TiB]` range.
 		MaxMonolithicSize *cos.SizeIEC `json:"max_monolithic_size,omitempty"` // +gen:optional
+		// Object-size threshold at or above which regular PUT, promote, and
+		// cold GET store new content as chunks (of `chunk_size` each).
+		// `0` disables; must not exceed `max_monolithic_size`.
+		AutoSizeLimit *cos.SizeIEC `json:"auto_size_limit,omitempty"` // +gen:optional
 		// Default chunk size (a.k.a. "part size") used for auto-chunking.
 		// `0` selects the system default (1 GiB).
 		ChunkSize *cos.SizeIEC `json:"chunk_size,omitempty"` // +gen:optional
@@ -321,6 +394,47 @@ type (
 		Flags *uint64 `json:"flags,omitempty"` // +gen:optional
 	}
 
+	// bucket-scope compression of stored objects (at rest) - see docs/compression.md
+	// - applies to new and overwritten objects of size >= MinSize
+	// - reading (including range reads) transparently decompresses
+	// - stored compressed only when it actually saves space
+	CompressionConf struct {
+		// compression algorithm; currently, only "lz4" (default)
+		Algo string `json:"algo,omitempty"`
+		// objects smaller than MinSize are always stored as is
+		MinSize cos.SizeIEC `json:"min_size,omitempty"`
+		Enabled bool        `json:"enabled"`
+	}
+	// CompressionConfToSet is the partial-update counterpart of CompressionConf.
+	CompressionConfToSet struct {
+		// Compression algorithm; currently, only `lz4` (default).
+		Algo *string `json:"algo,omitempty"` // +gen:optional
+		// Objects smaller than this size are always stored uncompressed.
+		MinSize *cos.SizeIEC `json:"min_size,omitempty"` // +gen:optional
+		// Toggles compression of stored objects. Applies to new (and
+		// overwritten) objects; existing objects remain as they are.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+
+	// bucket-scope encryption of stored objects (at rest) - see docs/encryption.md
+	// - AES-256-GCM; applies to new and overwritten objects in ais:// buckets
+	// - the key itself is provided to each target via its secrets directory or environment
+	// - reading (including range reads) transparently decrypts
+	EncryptionConf struct {
+		// name of the encryption key (letters, numbers, '-' and '_')
+		KeyRef  string `json:"key_ref,omitempty"`
+		Enabled bool   `json:"enabled"`
+	}
+	// EncryptionConfToSet is the partial-update counterpart of EncryptionConf.
+	EncryptionConfToSet struct {
+		// Name of the encryption key. The key itself is provided to each
+		// target via its secrets directory or environment (see docs/encryption.md).
+		KeyRef *string `json:"key_ref,omitempty"` // +gen:optional
+		// Toggles encryption of stored objects. Applies to new (and
+		// overwritten) objects; existing objects remain as they are.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+
 	LogConf struct {
 		Level     cos.LogLevel `json:"level"`      // log level (aka verbosity)
 		MaxSize   cos.SizeIEC  `json:"max_size"`   // exceeding this size triggers log rotation
@@ -383,11 +497,15 @@ type (
 		StatsTime     cos.Duration `json:"stats_time"`      // collect and publish stats; other house-keeping
 		RetrySyncTime cos.Duration `json:"retry_sync_time"` // metasync retry
 		NotifTime     cos.Duration `json:"notif_time"`      // (IC notifications)
+		// per metric-group sampling interval that must be shorter than `stats_time` (zero: no override)
+		// currently supported groups: "disk" (see StatsGroupDisk)
+		StatsTimeOverrides map[string]cos.Duration `json:"stats_time_overrides,omitempty"`
 	}
 	PeriodConfToSet struct {
-		StatsTime     *cos.Duration `json:"stats_time,omitempty"`
-		RetrySyncTime *cos.Duration `json:"retry_sync_time,omitempty"`
-		NotifTime     *cos.Duration `json:"notif_time,omitempty"`
+		StatsTime          *cos.Duration           `json:"stats_time,omitempty"`
+		RetrySyncTime      *cos.Duration           `json:"retry_sync_time,omitempty"`
+		NotifTime          *cos.Duration           `json:"notif_time,omitempty"`
+		StatsTimeOverrides map[string]cos.Duration `json:"stats_time_overrides,omitempty"`
 	}
 
 	// maximum intra-cluster latencies (in the increasing order)
@@ -476,6 +594,18 @@ type (
 		// - SpaceConf.Validate()
 		// - lru.dont_evict_time
 		DontCleanupTime cos.Duration `json:"dont_cleanup_time,omitempty"`
+
+		// Soft-deleted objects (api.DeleteObjectWithArgs) can be restored (api.RestoreObject)
+		// for at least this long; thereafter, space cleanup removes them permanently.
+		// Zero value _translates_ as a system default 24h (trashWindowDflt).
+		TrashWindow cos.Duration `json:"trash_window,omitempty"`
+
+		// CleanupInterval: when non-zero, each target runs space cleanup periodically,
+		// irrespective of used capacity - in particular, to remove TTL-expired objects
+		// and soft-deleted objects older than TrashWindow.
+		// Zero value (default) disables periodic cleanup, in which case cleanup only runs
+		// when triggered by capacity (CleanupWM, OOS) or on demand (`ais storage cleanup`).
+		CleanupInterval cos.Duration `json:"cleanup_interval,omitempty"`
 	}
 	SpaceConfToSet struct {
 		CleanupWM       *int64        `json:"cleanupwm,omitempty"`
@@ -484,6 +614,8 @@ type (
 		OOS             *int64        `json:"out_of_space,omitempty"`
 		BatchSize       *int64        `json:"batch_size,omitempty"`
 		DontCleanupTime *cos.Duration `json:"dont_cleanup_time,omitempty"`
+		TrashWindow     *cos.Duration `json:"trash_window,omitempty"`
+		CleanupInterval *cos.Duration `json:"cleanup_interval,omitempty"`
 	}
 
 	LRUConf struct {
@@ -500,6 +632,15 @@ type (
 		// - LRUConf.Validate()
 		BatchSize int64 `json:"batch_size,omitempty"`
 
+		// PinnedPrefixes: objects with names matching any of the prefixes are never evicted
+		// (bucket-level; does not apply to space cleanup of genuine garbage)
+		// NOTE: pinning too much may defeat LRU's ability to free space
+		PinnedPrefixes []string `json:"pinned_prefixes,omitempty"`
+
+		// UseHeat: weight eviction order by object heat (decaying access count, see core.HeatHalfLife)
+		// rather than by atime alone
+		UseHeat bool `json:"use_heat,omitempty"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
@@ -514,6 +655,10 @@ type (
 		// Unit of LRU eviction processing (objects per batch). `0`
 		// selects the system default.
 		BatchSize *int64 `json:"batch_size,omitempty"` // +gen:optional
+		// Object name prefixes that LRU never evicts.
+		PinnedPrefixes *[]string `json:"pinned_prefixes,omitempty"` // +gen:optional
+		// Weight eviction order by object heat (decaying access count).
+		UseHeat *bool `json:"use_heat,omitempty"` // +gen:optional
 		// Toggles LRU-based space reclamation for the bucket.
 		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
 	}
@@ -552,11 +697,19 @@ type (
 		XactConf
 		DestRetryTime cos.Duration `json:"dest_retry_time"` // max wait for ACKs & neighbors to complete
 		Enabled       bool         `json:"enabled"`         // true=auto-rebalance | manual rebalancing
+		// objects of size up to (and including) this one get batched in the data mover
+		// to reduce per-object transport overhead; zero (default) disables batching
+		BatchSmallObjs cos.SizeIEC `json:"batch_small_objs,omitempty"`
+		// upon successful rebalance, a (re)joined target prefetches (cold-GETs) remote objects
+		// newly assigned to it - up to this total size; zero (default) disables warm-up
+		WarmupBudget cos.SizeIEC `json:"warmup_budget,omitempty"`
 	}
 	RebalanceConfToSet struct {
 		XactConfToSet
-		DestRetryTime *cos.Duration `json:"dest_retry_time,omitempty"`
-		Enabled       *bool         `json:"enabled,omitempty"`
+		DestRetryTime  *cos.Duration `json:"dest_retry_time,omitempty"`
+		Enabled        *bool         `json:"enabled,omitempty"`
+		BatchSmallObjs *cos.SizeIEC  `json:"batch_small_objs,omitempty"` // +gen:optional
+		WarmupBudget   *cos.SizeIEC  `json:"warmup_budget,omitempty"`    // +gen:optional
 	}
 
 	ResilverConf struct {
@@ -585,6 +738,11 @@ type (
 
 		// EnableReadRange: Return read range checksum otherwise return entire object checksum.
 		EnableReadRange bool `json:"enable_read_range"`
+
+		// cold GET: when the remote backend provides a well-formed content hash
+		// (e.g., MD5 of a non-multipart S3 object, Azure ContentMD5), store it as the object's checksum
+		// instead of (also) computing the configured `Type` - see UseBackendCksum
+		PreferBackend bool `json:"prefer_backend"`
 	}
 	// CksumConfToSet is the partial-update counterpart of CksumConf.
 	CksumConfToSet struct {
@@ -604,6 +762,10 @@ type (
 		// Return the range checksum for range reads. When `false`,
 		// returns the whole-object checksum instead.
 		EnableReadRange *bool `json:"enable_read_range,omitempty"` // +gen:optional
+		// On cold GET, store the checksum provided by the remote
+		// backend (when it is a real content hash) instead of
+		// computing the configured checksum type.
+		PreferBackend *bool `json:"prefer_backend,omitempty"` // +gen:optional
 	}
 
 	VersionConf struct {
@@ -910,6 +1072,10 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.SizeIEC `json:"lz4_block"`
 		LZ4FrameChecksum bool        `json:"lz4_frame_checksum"`
+		// lz4 compression level: 0 (default) - fast mode; [1, 9] - high compression (HC) modes
+		// higher levels trade sender-side CPU for better ratio (and less bandwidth over the wire);
+		// decompression cost does not depend on the level
+		LZ4CompressionLevel int `json:"lz4_level"`
 	}
 	TransportConfToSet struct {
 		MaxHeaderSize    *int          `json:"max_header,omitempty"`
@@ -918,6 +1084,8 @@ type (
 		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
 		LZ4BlockMaxSize  *cos.SizeIEC  `json:"lz4_block,omitempty"`
 		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+
+		LZ4CompressionLevel *int `json:"lz4_level,omitempty"`
 	}
 
 	// MemsysConf: restart required for changes (see ConfigRestartRequired).
@@ -928,6 +1096,12 @@ type (
 		HousekeepTime  cos.Duration `json:"hk_time"`
 		MinPctTotal    int          `json:"min_pct_total"`
 		MinPctFree     int          `json:"min_pct_free"`
+
+		// admission control: when the node is under extreme memory pressure (cos.OOM)
+		// reject new client PUTs of (or above) the OOMPutSize (default: DfltOOMPutSize) with 503;
+		// PUTs of unknown size (chunked transfer encoding) are considered large
+		OOMRejectPut bool        `json:"oom_reject_put,omitempty"`
+		OOMPutSize   cos.SizeIEC `json:"oom_put_size,omitempty"`
 	}
 	MemsysConfToSet struct {
 		MinFree        *cos.SizeIEC  `json:"min_free,omitempty"`
@@ -936,6 +1110,8 @@ type (
 		HousekeepTime  *cos.Duration `json:"hk_time,omitempty"`
 		MinPctTotal    *int          `json:"min_pct_total,omitempty"`
 		MinPctFree     *int          `json:"min_pct_free,omitempty"`
+		OOMRejectPut   *bool         `json:"oom_reject_put,omitempty"`
+		OOMPutSize     *cos.SizeIEC  `json:"oom_put_size,omitempty"`
 	}
 
 	// generic xaction --
@@ -992,6 +1168,12 @@ type (
 	RateLimitConf struct {
 		Backend  Adaptive `json:"backend"`
 		Frontend Bursty   `json:"frontend"`
+		// max in-flight cold GETs per (remote) bucket on each target; the rest is queued
+		// for up to timeout.max_host_busy; zero (default) - unlimited
+		MaxColdGetConcurrency int `json:"max_cold_get_concurrency,omitempty"`
+		// read priority class: (apc.QoSHigh | apc.QoSNormal | apc.QoSLow); empty - normal
+		// (see ReadQoSConf)
+		QoSClass string `json:"qos_class,omitempty"`
 	}
 	// RateLimitConfToSet is the partial-update counterpart of RateLimitConf.
 	RateLimitConfToSet struct {
@@ -1002,6 +1184,14 @@ type (
 		// Bursty rate limit for frontend (user-facing) GET, PUT, and
 		// DELETE traffic.
 		Frontend *BurstyToSet `json:"frontend,omitempty"` // +gen:optional
+		// Hard cap on the number of in-flight cold GETs per bucket on
+		// each target. Excess cold GETs are queued (not rejected) for
+		// up to `timeout.max_host_busy`. Zero means unlimited.
+		MaxColdGetConcurrency *int `json:"max_cold_get_concurrency,omitempty"` // +gen:optional
+		// Read priority class of the bucket's GETs: `"high"`, `"normal"`,
+		// or `"low"`; takes effect when `read_qos.max_reads` is set.
+		// Empty means normal.
+		QoSClass *string `json:"qos_class,omitempty"` // +gen:optional
 	}
 	RateLimitBase struct {
 		// optional per-operation MaxTokens override - a space-separated key:value list, e.g.:
@@ -1127,6 +1317,34 @@ type (
 	}
 )
 
+// read admission with priority (QoS) classes; per-target:
+// - up to MaxReads concurrent GETs; the rest queue by class for up to timeout.max_host_busy
+// - freed slots go to the queued classes in proportion to their respective weights
+// - the class is the bucket's rate_limit.qos_class unless overridden by apc.HdrQoSClass
+// ref: https://github.com/NVIDIA/aistore/blob/main/docs/rate_limit.md
+type (
+	ReadQoSConf struct {
+		// max concurrent object reads on each target; zero (default) - disabled
+		MaxReads int `json:"max_reads"`
+		// relative shares of freed read slots; zero - system default (see ReadQoSConf.Validate)
+		WeightHigh   int `json:"weight_high"`
+		WeightNormal int `json:"weight_normal"`
+		WeightLow    int `json:"weight_low"`
+	}
+	// ReadQoSConfToSet is the partial-update counterpart of ReadQoSConf.
+	ReadQoSConfToSet struct {
+		// Max concurrent object reads (GETs) on each target; excess reads
+		// queue by priority class. Zero disables read admission.
+		MaxReads *int `json:"max_reads,omitempty"` // +gen:optional
+		// Relative share of freed read slots given to the high class.
+		WeightHigh *int `json:"weight_high,omitempty"` // +gen:optional
+		// Relative share of freed read slots given to the normal class.
+		WeightNormal *int `json:"weight_normal,omitempty"` // +gen:optional
+		// Relative share of freed read slots given to the low (batch) class.
+		WeightLow *int `json:"weight_low,omitempty"` // +gen:optional
+	}
+)
+
 // assorted named fields and prefixes that require (cluster | node) restart for
 // changes to take an effect; note:
 // - this is NOT a "read-only" list
@@ -1163,6 +1381,8 @@ var (
 	_ validator = (*MirrorConf)(nil)
 	_ validator = (*ECConf)(nil)
 	_ validator = (*ChunksConf)(nil)
+	_ validator = (*CompressionConf)(nil)
+	_ validator = (*EncryptionConf)(nil)
 	_ validator = (*VersionConf)(nil)
 	_ validator = (*PeriodConf)(nil)
 	_ validator = (*TimeoutConf)(nil)
@@ -1182,6 +1402,7 @@ var (
 	_ validator = (*WritePolicyConf)(nil)
 	_ validator = (*TracingConf)(nil)
 	_ validator = (*GetBatchConf)(nil)
+	_ validator = (*ReadQoSConf)(nil)
 
 	_ validator = (*feat.Flags)(nil) // is called explicitly from main config validator
 
@@ -1200,6 +1421,8 @@ var (
 	_ propsValidator = (*WritePolicyConf)(nil)
 	_ propsValidator = (*RateLimitConf)(nil)
 	_ propsValidator = (*ChunksConf)(nil)
+	_ propsValidator = (*CompressionConf)(nil)
+	_ propsValidator = (*EncryptionConf)(nil)
 	_ propsValidator = (*LRUConf)(nil)
 )
 
@@ -1340,9 +1563,9 @@ func (c *LocalConfig) TestingEnv() bool {
 	return c.TestFSP.Count > 0
 }
 
-func (c *LocalConfig) AddPath(mpath string) {
+func (c *LocalConfig) AddPath(mpath, label string) {
 	debug.Assert(!c.TestingEnv())
-	c.FSP.Paths[mpath] = ""
+	c.FSP.Paths[mpath] = label
 }
 
 func (c *LocalConfig) DelPath(mpath string) {
@@ -1371,9 +1594,27 @@ func (c *PeriodConf) Validate() error {
 		return fmt.Errorf("invalid periodic.notif_time=%s (expected range [1s, 1m])",
 			c.StatsTime)
 	}
+	for group, d := range c.StatsTimeOverrides {
+		if group != StatsGroupDisk {
+			return fmt.Errorf("invalid periodic.stats_time_overrides: unknown metric group %q (expecting %q)",
+				group, StatsGroupDisk)
+		}
+		if d != 0 && (d.D() < time.Second || d.D() > c.StatsTime.D()) {
+			return fmt.Errorf("invalid periodic.stats_time_overrides[%s]=%s (expected range [1s, periodic.stats_time=%s])",
+				group, d, c.StatsTime)
+		}
+	}
 	return nil
 }
 
+// returns `stats_time` override for a given metric group, if configured, or `stats_time` otherwise
+func (c *PeriodConf) StatsTimeOf(group string) time.Duration {
+	if d, ok := c.StatsTimeOverrides[group]; ok && d != 0 {
+		return d.D()
+	}
+	return c.StatsTime.D()
+}
+
 /////////////
 // LogConf //
 /////////////
@@ -1438,15 +1679,51 @@ func (c *BackendConf) keys() (v []string) {
 	return
 }
 
+// "ais_tls" is not a provider - keeping it out of the provider-keyed Conf
 func (c *BackendConf) UnmarshalJSON(data []byte) error {
-	return jsoniter.Unmarshal(data, &c.Conf)
+	if err := jsoniter.Unmarshal(data, &c.Conf); err != nil {
+		return err
+	}
+	return c.popRemAisTLS()
 }
 
 func (c *BackendConf) MarshalJSON() (data []byte, err error) {
-	return cos.MustMarshal(c.Conf), nil
+	if c.AisTLS == nil {
+		return cos.MustMarshal(c.Conf), nil
+	}
+	conf := make(map[string]any, len(c.Conf)+1)
+	maps.Copy(conf, c.Conf)
+	conf[BackendRemAisTLS] = c.AisTLS
+	return cos.MustMarshal(conf), nil
+}
+
+func (c *BackendConf) popRemAisTLS() error {
+	v, ok := c.Conf[BackendRemAisTLS]
+	if !ok {
+		return nil
+	}
+	delete(c.Conf, BackendRemAisTLS)
+	if v == nil {
+		c.AisTLS = nil
+		return nil
+	}
+	tlsConf := &BackendConfRemAisTLS{}
+	if err := cos.MorphMarshal(v, tlsConf); err != nil {
+		return fmt.Errorf("invalid backend.%s specification: %w", BackendRemAisTLS, err)
+	}
+	c.AisTLS = tlsConf
+	return nil
 }
 
 func (c *BackendConf) Validate() (err error) {
+	if err := c.popRemAisTLS(); err != nil {
+		return err
+	}
+	if c.AisTLS != nil {
+		if err := c.AisTLS.Validate(); err != nil {
+			return err
+		}
+	}
 	for provider := range c.Conf {
 		b := cos.MustMarshal(c.Conf[provider])
 		switch provider {
@@ -1464,6 +1741,21 @@ func (c *BackendConf) Validate() (err error) {
 		case "":
 			continue
 		default:
+			if c.Conf[provider] != nil {
+				var cloudConf BackendConfCloud
+				if err := jsoniter.Unmarshal(b, &cloudConf); err != nil {
+					return fmt.Errorf("invalid %q backend specification: %w", provider, err)
+				}
+				if cloudConf.MaxConns < 0 {
+					return fmt.Errorf("invalid backend.%s.max_conns %d (expecting non-negative)", provider, cloudConf.MaxConns)
+				}
+				if cloudConf.Retry != nil {
+					if err := cloudConf.Retry.validate(provider); err != nil {
+						return err
+					}
+				}
+				c.Conf[provider] = cloudConf
+			}
 			c.setProvider(provider)
 		}
 	}
@@ -1491,6 +1783,32 @@ func (c *BackendConf) Get(provider string) (conf any) {
 	return
 }
 
+// returns configured backend.<provider>.max_conns, or 0 (unlimited)
+func (c *BackendConf) MaxConns(provider string) int {
+	return c.cloud(provider).MaxConns
+}
+
+// returns configured backend.<provider>.retry, or nil (SDK defaults)
+func (c *BackendConf) Retry(provider string) *BackendConfRetry {
+	return c.cloud(provider).Retry
+}
+
+func (c *BackendConf) cloud(provider string) (cloudConf BackendConfCloud) {
+	switch v := c.Conf[provider].(type) {
+	case nil:
+	case BackendConfCloud:
+		cloudConf = v
+	default:
+		if err := cos.MorphMarshal(v, &cloudConf); err != nil {
+			return BackendConfCloud{}
+		}
+	}
+	return cloudConf
+}
+
+// returns client-side TLS config to access remote AIS clusters, or nil if not configured
+func (c *BackendConf) RemAisTLS() *BackendConfRemAisTLS { return c.AisTLS }
+
 func (c *BackendConf) Set(provider string, newConf any) {
 	if c.Conf == nil {
 		c.Conf = make(map[string]any, 1)
@@ -1526,6 +1844,65 @@ func (c *BackendConf) EqualRemAIS(o *BackendConf, sname string) bool {
 	return true
 }
 
+//////////////////////
+// BackendConfRetry //
+//////////////////////
+
+func (c *BackendConfRetry) validate(provider string) error {
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("invalid backend.%s.retry.max_attempts %d (expecting non-negative)", provider, c.MaxAttempts)
+	}
+	if c.BaseDelay < 0 || c.MaxDelay < 0 {
+		return fmt.Errorf("invalid backend.%s.retry delays (%v, %v): expecting non-negative", provider, c.BaseDelay, c.MaxDelay)
+	}
+	if c.BaseDelay > 0 && c.MaxDelay > 0 && c.BaseDelay > c.MaxDelay {
+		return fmt.Errorf("invalid backend.%s.retry: base_delay %v exceeds max_delay %v", provider, c.BaseDelay, c.MaxDelay)
+	}
+	return nil
+}
+
+// Delay returns exponential backoff before the given retry (attempt >= 1),
+// with unset (zero) base and max delays defaulting to dflBase and dflMax, respectively
+func (c *BackendConfRetry) Delay(attempt int, dflBase, dflMax time.Duration) time.Duration {
+	base, maxDelay := c.BaseDelay.D(), c.MaxDelay.D()
+	if base == 0 {
+		base = dflBase
+	}
+	if maxDelay == 0 {
+		maxDelay = dflMax
+	}
+	delay := maxDelay
+	if attempt <= 1 {
+		delay = min(base, maxDelay)
+	} else if shift := attempt - 1; shift < 63 && base <= maxDelay>>shift {
+		delay = base << shift // (no overflow: compared against the ceiling prior to shifting)
+	}
+	if c.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int64N(int64(delay) + 1))
+	}
+	return delay
+}
+
+//////////////////////////
+// BackendConfRemAisTLS //
+//////////////////////////
+
+// both certificate and key are required; validate the files and the pair by loading them
+func (c *BackendConfRemAisTLS) Validate() error {
+	if c.Certificate == "" || c.Key == "" {
+		return fmt.Errorf("invalid backend.%s: both certificate and key are required (have %q, %q)",
+			BackendRemAisTLS, c.Certificate, c.Key)
+	}
+	if _, err := NewTLS(c.TLSArgs(), false /*intra-cluster*/); err != nil {
+		return fmt.Errorf("invalid backend.%s: %w", BackendRemAisTLS, err)
+	}
+	return nil
+}
+
+func (c *BackendConfRemAisTLS) TLSArgs() TLSArgs {
+	return TLSArgs{ClientCA: c.RootCA, Certificate: c.Certificate, Key: c.Key, SkipVerify: c.SkipVerify}
+}
+
 func (c BackendConfAIS) String() (s string) {
 	for a, urls := range c {
 		if s != "" {
@@ -1593,6 +1970,10 @@ func (c *DiskConf) Validate() (err error) {
 const (
 	dontCleanupTimeDflt = time.Hour
 	dontCleanupTimeMin  = 15 * time.Minute
+
+	trashWindowDflt = 24 * time.Hour
+
+	cleanupIntervalMin = 10 * time.Minute
 )
 
 // common for both SpaceConf and LRUConf
@@ -1613,6 +1994,16 @@ func (c *SpaceConf) Validate() error {
 		return fmt.Errorf("invalid %+v (expecting: space.dont_cleanup_time >= %v)", c, dontCleanupTimeMin)
 	}
 
+	if c.TrashWindow == 0 {
+		c.TrashWindow = cos.Duration(trashWindowDflt)
+	} else if c.TrashWindow < 0 {
+		return fmt.Errorf("invalid space.trash_window=%v (expecting positive duration)", c.TrashWindow)
+	}
+
+	if c.CleanupInterval != 0 && c.CleanupInterval.D() < cleanupIntervalMin {
+		return fmt.Errorf("invalid space.cleanup_interval=%v (expecting zero (disabled) or >= %v)", c.CleanupInterval, cleanupIntervalMin)
+	}
+
 	if c.BatchSize == 0 {
 		c.BatchSize = GCBatchSizeDflt
 	} else if n := c.BatchSize; n < GCBatchSizeMin || n > GCBatchSizeMax {
@@ -1639,7 +2030,14 @@ func (c *LRUConf) String() string {
 	if !c.Enabled {
 		return confDisabled
 	}
-	return fmt.Sprintf("lru: dont_evict_time=%v, capacity_upd_time=%v, batch_size=%d", c.DontEvictTime, c.CapacityUpdTime, c.BatchSize)
+	s := fmt.Sprintf("lru: dont_evict_time=%v, capacity_upd_time=%v, batch_size=%d", c.DontEvictTime, c.CapacityUpdTime, c.BatchSize)
+	if len(c.PinnedPrefixes) > 0 {
+		s += fmt.Sprintf(", pinned_prefixes=%v", c.PinnedPrefixes)
+	}
+	if c.UseHeat {
+		s += ", use_heat"
+	}
+	return s
 }
 
 func (c *LRUConf) Validate() (err error) {
@@ -1651,12 +2049,30 @@ func (c *LRUConf) Validate() (err error) {
 	} else if n := c.BatchSize; n < GCBatchSizeMin || n > GCBatchSizeMax {
 		return fmt.Errorf("invalid lru.batch_size=%d (expecting range [%d - %d])", n, GCBatchSizeMin, GCBatchSizeMax)
 	}
+	for _, prefix := range c.PinnedPrefixes {
+		if prefix == "" {
+			return errors.New("invalid lru.pinned_prefixes: empty prefix (would pin the entire bucket)")
+		}
+		if err := cos.ValidatePrefix("lru.pinned_prefixes", prefix); err != nil {
+			return err
+		}
+	}
 	if c.DontEvictTime.D() < dontEvictTimeMin {
 		err = fmt.Errorf("invalid %+v (expecting: lru.dont_evict_time >= %v)", c, dontEvictTimeMin)
 	}
 	return
 }
 
+// true if the object name matches any of the pinned prefixes
+func (c *LRUConf) IsPinned(objName string) bool {
+	for _, prefix := range c.PinnedPrefixes {
+		if strings.HasPrefix(objName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *LRUConf) ValidateAsProps(...any) error { return c.Validate() }
 
 ///////////////
@@ -1671,6 +2087,12 @@ func (c *CksumConf) ValidateAsProps(...any) (err error) {
 	return c.Validate()
 }
 
+// whether to store backend-provided checksum as is (see PreferBackend)
+// - requires a supported type and a value that matches it (multipart ETags, for instance, do not)
+func (c *CksumConf) UseBackendCksum(cksum *cos.Cksum) bool {
+	return c.PreferBackend && c.Type != cos.ChecksumNone && !cos.NoneC(cksum) && cksum.Validate() == nil
+}
+
 func (c *CksumConf) String() string {
 	if c.Type == cos.ChecksumNone {
 		return confDisabled
@@ -1691,7 +2113,9 @@ func (c *CksumConf) String() string {
 	if len(toValidate) > 0 {
 		toValidateStr = strings.Join(toValidate, ",")
 	}
-
+	if c.PreferBackend {
+		return fmt.Sprintf("Type: %s | Validate: %s | Prefer backend", c.Type, toValidateStr)
+	}
 	return fmt.Sprintf("Type: %s | Validate: %s", c.Type, toValidateStr)
 }
 
@@ -1725,6 +2149,13 @@ func (c *VersionConf) String() string {
 // MirrorConf //
 ////////////////
 
+// mirror.read_policy
+const (
+	ReadPolicyPrimary    = "primary"     // always read the primary (HRW) copy (default)
+	ReadPolicyRoundRobin = "round-robin" // rotate reads across all copies
+	ReadPolicyLeastBusy  = "least-busy"  // read the copy on the least utilized mountpath
+)
+
 func (c *MirrorConf) Validate() error {
 	if c.Burst < 0 {
 		return fmt.Errorf("invalid mirror.burst_buffer: %v (expected >0)", c.Burst)
@@ -1732,6 +2163,12 @@ func (c *MirrorConf) Validate() error {
 	if c.Copies < 2 || c.Copies > 32 {
 		return fmt.Errorf("invalid mirror.copies: %d (expected value in range [2, 32])", c.Copies)
 	}
+	switch c.ReadPolicy {
+	case "", ReadPolicyPrimary, ReadPolicyRoundRobin, ReadPolicyLeastBusy:
+	default:
+		return fmt.Errorf("invalid mirror.read_policy %q (expecting one of: %q, %q, %q)", c.ReadPolicy,
+			ReadPolicyPrimary, ReadPolicyRoundRobin, ReadPolicyLeastBusy)
+	}
 	return nil
 }
 
@@ -1747,6 +2184,9 @@ func (c *MirrorConf) String() string {
 		return confDisabled
 	}
 
+	if c.ReadPolicy != "" && c.ReadPolicy != ReadPolicyPrimary {
+		return fmt.Sprintf("%d copies, read: %s", c.Copies, c.ReadPolicy)
+	}
 	return fmt.Sprintf("%d copies", c.Copies)
 }
 
@@ -1850,10 +2290,16 @@ const (
 const (
 	chunksmms = "chunks.max_monolithic_size"
 	chunksosl = "chunks.objsize_limit"
+	chunksasl = "chunks.auto_size_limit"
 )
 
 func (c *ChunksConf) AutoEnabled() bool { return c.ObjSizeLimit > 0 }
 
+// new content (PUT, promote, cold GET) gets chunked at or above AutoSizeLimit
+func (c *ChunksConf) AutoChunk(size int64) bool {
+	return c.AutoSizeLimit > 0 && size >= int64(c.AutoSizeLimit)
+}
+
 func (c *ChunksConf) Validate() error {
 	switch {
 	case c.MaxMonolithicSize < 0:
@@ -1875,6 +2321,13 @@ func (c *ChunksConf) Validate() error {
 		return fmt.Errorf("invalid %s %d (%s) - cannot be greater than %s %d (%s)",
 			chunksosl, c.ObjSizeLimit, c.ObjSizeLimit, chunksmms, c.MaxMonolithicSize, c.MaxMonolithicSize)
 	}
+	switch {
+	case c.AutoSizeLimit < 0:
+		return fmt.Errorf("invalid %s %d (expecting a non-negative integer)", chunksasl, c.AutoSizeLimit)
+	case c.AutoSizeLimit > c.MaxMonolithicSize:
+		return fmt.Errorf("invalid %s %d (%s) - cannot be greater than %s %d (%s)",
+			chunksasl, c.AutoSizeLimit, c.AutoSizeLimit, chunksmms, c.MaxMonolithicSize, c.MaxMonolithicSize)
+	}
 
 	// ChunkSize is always normalized
 	if c.ChunkSize == 0 {
@@ -1919,6 +2372,60 @@ func (c *ChunksConf) String() string {
 	return s
 }
 
+/////////////////////
+// CompressionConf //
+/////////////////////
+
+func (c *CompressionConf) Validate() error {
+	if c.MinSize < 0 {
+		return fmt.Errorf("invalid compression.min_size %d (expecting non-negative)", c.MinSize)
+	}
+	switch c.Algo {
+	case "":
+		if c.Enabled {
+			c.Algo = apc.LZ4Compression
+		}
+	case apc.LZ4Compression:
+	default:
+		return fmt.Errorf("invalid compression.algo %q (expecting %q)", c.Algo, apc.LZ4Compression)
+	}
+	return nil
+}
+
+func (c *CompressionConf) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *CompressionConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return fmt.Sprintf("%s (min-size %s)", c.Algo, c.MinSize.String())
+}
+
+////////////////////
+// EncryptionConf //
+////////////////////
+
+func (c *EncryptionConf) Validate() error {
+	switch {
+	case c.KeyRef == "":
+		if c.Enabled {
+			return errors.New("encryption.key_ref is required when encryption is enabled")
+		}
+	case !cos.IsAlphaNice(c.KeyRef):
+		return fmt.Errorf("invalid encryption.key_ref %q: %s", c.KeyRef, cos.OnlyNice)
+	}
+	return nil
+}
+
+func (c *EncryptionConf) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *EncryptionConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return "aes-gcm (key " + c.KeyRef + ")"
+}
+
 /////////////////////
 // WritePolicyConf //
 /////////////////////
@@ -2649,6 +3156,8 @@ func ValidateMpath(mpath string) (string, error) {
 // MemsysConf //
 ////////////////
 
+const DfltOOMPutSize = 16 * cos.MiB
+
 func (c *MemsysConf) Validate() (err error) {
 	if c.MinFree > 0 && c.MinFree < 100*cos.MiB {
 		return fmt.Errorf("invalid memsys.min_free %s (cannot be less than 100MB, optimally at least 2GB)", c.MinFree)
@@ -2671,9 +3180,24 @@ func (c *MemsysConf) Validate() (err error) {
 	if c.MinPctFree < 0 || c.MinPctFree > 95 {
 		return fmt.Errorf("invalid memsys.min_pct_free %d%%", c.MinPctFree)
 	}
+	if c.OOMPutSize < 0 {
+		return fmt.Errorf("invalid memsys.oom_put_size %s", c.OOMPutSize)
+	}
 	return nil
 }
 
+// whether to reject PUT of a given size (negative when unknown) under extreme memory pressure
+func (c *MemsysConf) OOMReject(size int64) bool {
+	if !c.OOMRejectPut {
+		return false
+	}
+	threshold := int64(c.OOMPutSize)
+	if threshold == 0 {
+		threshold = DfltOOMPutSize
+	}
+	return size < 0 || size >= threshold
+}
+
 ///////////////////
 // TransportConf //
 ///////////////////
@@ -2687,6 +3211,8 @@ const (
 
 	DfltTransportTick         = time.Second
 	DfltTransportIdleTeardown = 4 * DfltTransportTick // note: request scope
+
+	MaxLZ4CompressionLevel = 9 // lz4 HC; 0 (default) stands for lz4 fast
 )
 
 // NOTE: uncompressed block sizes - the enum currently supported by the github.com/pierrec/lz4
@@ -2696,6 +3222,10 @@ func (c *TransportConf) Validate() (err error) {
 		return fmt.Errorf("invalid transport.block_size %s, expecting one of: [64K, 256K, 1MB, 4MB]",
 			c.LZ4BlockMaxSize)
 	}
+	if c.LZ4CompressionLevel < 0 || c.LZ4CompressionLevel > MaxLZ4CompressionLevel {
+		return fmt.Errorf("invalid transport.lz4_level %d, expecting [0, %d] range (where 0 is default, fast)",
+			c.LZ4CompressionLevel, MaxLZ4CompressionLevel)
+	}
 	// this is the system-wide default and, simultaneously, the minimum;
 	// xactions that utilize intra-cluster transport may override this knob for themselves
 	// but only indirectly and only by increasing
@@ -2868,6 +3398,12 @@ func (c *RebalanceConf) Validate() error {
 		return fmt.Errorf("invalid rebalance.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
 	}
+	if c.BatchSmallObjs < 0 || c.BatchSmallObjs > cos.MiB {
+		return fmt.Errorf("invalid rebalance.batch_small_objs: %s (expected range [0, 1MiB])", c.BatchSmallObjs)
+	}
+	if c.WarmupBudget < 0 {
+		return fmt.Errorf("invalid rebalance.warmup_budget: %s (expecting non-negative)", c.WarmupBudget)
+	}
 	return nil
 }
 
@@ -2961,6 +3497,12 @@ func (c *RateLimitConf) Validate() error {
 		return fmt.Errorf("%s: invalid frontend.burst_size %d (expecting positive integer <= (%d%% of maxTokens %d)",
 			tag, c.Frontend.Size, cos.DfltRateMaxBurstPct, c.Frontend.MaxTokens)
 	}
+	if c.MaxColdGetConcurrency < 0 || c.MaxColdGetConcurrency >= math.MaxInt32 {
+		return fmt.Errorf("%s: invalid max_cold_get_concurrency %d", tag, c.MaxColdGetConcurrency)
+	}
+	if !apc.IsValidQoSClass(c.QoSClass) {
+		return fmt.Errorf("%s: invalid qos_class %q (expecting one of %v)", tag, c.QoSClass, apc.SupportedQoS)
+	}
 
 	//
 	// optional, per-operation
@@ -3016,6 +3558,33 @@ func (*RateLimitConf) verbs(tag, name, value string) error {
 // NOTE: separately, frontend-rate-limiter validation in `makeNewBckProps`
 func (c *RateLimitConf) ValidateAsProps(...any) error { return c.Validate() }
 
+/////////////////
+// ReadQoSConf //
+/////////////////
+
+const (
+	readQoSWeightHigh   = 8
+	readQoSWeightNormal = 4
+	readQoSWeightLow    = 1
+	readQoSWeightMax    = 1000
+)
+
+func (c *ReadQoSConf) Validate() error {
+	if c.MaxReads < 0 || c.MaxReads >= math.MaxInt32 {
+		return fmt.Errorf("invalid read_qos.max_reads=%d (expecting non-negative integer)", c.MaxReads)
+	}
+	c.WeightHigh = cos.NonZero(c.WeightHigh, readQoSWeightHigh)
+	c.WeightNormal = cos.NonZero(c.WeightNormal, readQoSWeightNormal)
+	c.WeightLow = cos.NonZero(c.WeightLow, readQoSWeightLow)
+	for _, w := range [...]int{c.WeightHigh, c.WeightNormal, c.WeightLow} {
+		if w < 1 || w > readQoSWeightMax {
+			return fmt.Errorf("invalid read_qos weights (%d, %d, %d): expecting range [1, %d]",
+				c.WeightHigh, c.WeightNormal, c.WeightLow, readQoSWeightMax)
+		}
+	}
+	return nil
+}
+
 //////////////////
 // GetBatchConf //
 //////////////////
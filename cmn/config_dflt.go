@@ -1,49 +1,50 @@
-// Package main contains logic for the aisinit container
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
 /*
  * Copyright (c) 2024-2026, NVIDIA CORPORATION. All rights reserved.
  */
-package main
+package cmn
 
 import (
 	"time"
 
-	aisapc "github.com/NVIDIA/aistore/api/apc"
-	aiscmn "github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
 var (
-	defaultAuth = aiscmn.AuthConf{
+	defaultAuth = AuthConf{
 		Enabled: false,
 	}
 
-	defaultCksum = aiscmn.CksumConf{
+	defaultCksum = CksumConf{
 		Type:            cos.ChecksumCesXxh,
 		ValidateColdGet: false,
 	}
 
-	defaultClientConf = aiscmn.ClientConf{
+	defaultClientConf = ClientConf{
 		Timeout:        cos.Duration(10 * time.Second),
 		TimeoutLong:    cos.Duration(5 * time.Minute),
 		ListObjTimeout: cos.Duration(5 * time.Minute),
 	}
 
-	defaultTransport = aiscmn.TransportConf{
-		MaxHeaderSize:    4096,
-		Burst:            1024,
-		IdleTeardown:     cos.Duration(4 * time.Second),
-		QuiesceTime:      cos.Duration(10 * time.Second),
-		LZ4BlockMaxSize:  cos.SizeIEC(256 * cos.KiB),
-		LZ4FrameChecksum: false,
+	defaultTransport = TransportConf{
+		MaxHeaderSize:       4096,
+		Burst:               1024,
+		IdleTeardown:        cos.Duration(4 * time.Second),
+		QuiesceTime:         cos.Duration(10 * time.Second),
+		LZ4BlockMaxSize:     cos.SizeIEC(256 * cos.KiB),
+		LZ4FrameChecksum:    false,
+		LZ4CompressionLevel: 0,
 	}
 
-	defaultXconf = aiscmn.XactConf{
-		Compression: aisapc.CompressNever,
+	defaultXconf = XactConf{
+		Compression: apc.CompressNever,
 		SbundleMult: 2,
 		Burst:       512,
 	}
 
-	defaultDisk = aiscmn.DiskConf{
+	defaultDisk = DiskConf{
 		DiskUtilLowWM:    20,
 		DiskUtilHighWM:   80,
 		DiskUtilMaxWM:    95,
@@ -52,22 +53,22 @@ var (
 		IostatTimeSmooth: cos.Duration(8 * time.Second),
 	}
 
-	defaultNet = aiscmn.NetConf{
-		L4: aiscmn.L4Conf{
+	defaultNet = NetConf{
+		L4: L4Conf{
 			Proto:         "tcp",
 			SndRcvBufSize: 131072,
 		},
-		HTTP: aiscmn.HTTPConf{
+		HTTP: HTTPConf{
 			UseHTTPS:               false,
 			Chunked:                true,
 			IdleConnTimeout:        cos.Duration(30 * time.Second),
-			BackendIdleConnTimeout: cos.Duration(aiscmn.DefaultIdleConnTimeout), // default 6s; configurable since 5.0 w/ no upper limit
+			BackendIdleConnTimeout: cos.Duration(DefaultIdleConnTimeout), // default 6s; configurable since 5.0 w/ no upper limit
 			MaxIdleConnsPerHost:    128,
 			MaxIdleConns:           4096,
 		},
 	}
 
-	defaultFSHC = aiscmn.FSHCConf{
+	defaultFSHC = FSHCConf{
 		TestFileCount: 4,
 		HardErrs:      2,
 		IOErrs:        10,
@@ -75,11 +76,11 @@ var (
 		Enabled:       true,
 	}
 
-	defaultDownloader = aiscmn.DownloaderConf{
+	defaultDownloader = DownloaderConf{
 		Timeout: cos.Duration(time.Hour),
 	}
 
-	defaultEC = aiscmn.ECConf{
+	defaultEC = ECConf{
 		XactConf:     defaultXconf,
 		Enabled:      false,
 		ObjSizeLimit: 262144,
@@ -87,7 +88,7 @@ var (
 		ParitySlices: 2,
 	}
 
-	defaultChunks = aiscmn.ChunksConf{
+	defaultChunks = ChunksConf{
 		ObjSizeLimit:      0,
 		ChunkSize:         cos.SizeIEC(cos.GiB),
 		MaxMonolithicSize: cos.SizeIEC(cos.TiB),
@@ -95,13 +96,13 @@ var (
 		Flags:             0,
 	}
 
-	defaultKeepalive = aiscmn.KeepaliveConf{
-		Proxy: aiscmn.KeepaliveTrackerConf{
+	defaultKeepalive = KeepaliveConf{
+		Proxy: KeepaliveTrackerConf{
 			Interval: cos.Duration(10 * time.Second),
 			Name:     "heartbeat",
 			Factor:   3,
 		},
-		Target: aiscmn.KeepaliveTrackerConf{
+		Target: KeepaliveTrackerConf{
 			Interval: cos.Duration(10 * time.Second),
 			Name:     "heartbeat",
 			Factor:   3,
@@ -110,7 +111,7 @@ var (
 		NumRetries:  3,
 	}
 
-	defaultLog = aiscmn.LogConf{
+	defaultLog = LogConf{
 		Level:     "3",
 		MaxSize:   cos.SizeIEC(64 * cos.MiB),
 		MaxTotal:  cos.SizeIEC(512 * cos.MiB),
@@ -118,7 +119,7 @@ var (
 		StatsTime: cos.Duration(3 * time.Minute),
 	}
 
-	defaultSpace = aiscmn.SpaceConf{
+	defaultSpace = SpaceConf{
 		CleanupWM:       65,
 		LowWM:           75,
 		HighWM:          90,
@@ -127,35 +128,35 @@ var (
 		DontCleanupTime: cos.Duration(60 * time.Minute),
 	}
 
-	defaultMemsys = aiscmn.MemsysConf{
+	defaultMemsys = MemsysConf{
 		MinFree:        cos.SizeIEC(6 * cos.GiB),
 		DefaultBufSize: cos.SizeIEC(64 * cos.KiB),
 		SizeToGC:       cos.SizeIEC(6 * cos.GiB),
 		HousekeepTime:  cos.Duration(120 * time.Second),
 	}
 
-	defaultLRU = aiscmn.LRUConf{
+	defaultLRU = LRUConf{
 		Enabled:         false,
 		DontEvictTime:   cos.Duration(120 * time.Minute),
 		CapacityUpdTime: cos.Duration(10 * time.Minute),
 		BatchSize:       32768,
 	}
 
-	defaultMirror = aiscmn.MirrorConf{
+	defaultMirror = MirrorConf{
 		Enabled: false,
 		Copies:  2,
 		Burst:   512,
 	}
 
-	defaultPeriodic = aiscmn.PeriodConf{
+	defaultPeriodic = PeriodConf{
 		StatsTime:     cos.Duration(10 * time.Second),
 		NotifTime:     cos.Duration(30 * time.Second),
 		RetrySyncTime: cos.Duration(2 * time.Second),
 	}
 
-	defaultRebalance = aiscmn.RebalanceConf{
-		XactConf: aiscmn.XactConf{
-			Compression: aisapc.CompressNever,
+	defaultRebalance = RebalanceConf{
+		XactConf: XactConf{
+			Compression: apc.CompressNever,
 			SbundleMult: 2,
 			Burst:       2048,
 		},
@@ -163,11 +164,11 @@ var (
 		DestRetryTime: cos.Duration(2 * time.Minute),
 	}
 
-	defaultResilver = aiscmn.ResilverConf{
+	defaultResilver = ResilverConf{
 		Enabled: true,
 	}
 
-	defaultTimeout = aiscmn.TimeoutConf{
+	defaultTimeout = TimeoutConf{
 		CplaneOperation: cos.Duration(2 * time.Second),
 		MaxKeepalive:    cos.Duration(5 * time.Second),
 		MaxHostBusy:     cos.Duration(20 * time.Second),
@@ -178,27 +179,27 @@ var (
 		ObjectMD:        cos.Duration(2 * time.Hour),
 		ColdGetConflict: cos.Duration(5 * time.Second),
 	}
-	defaultVersioning = aiscmn.VersionConf{
+	defaultVersioning = VersionConf{
 		Enabled:         true,
 		ValidateWarmGet: false,
 	}
 
-	defaultWritePolicy = aiscmn.WritePolicyConf{
+	defaultWritePolicy = WritePolicyConf{
 		Data: "",
 		MD:   "",
 	}
 
-	defaultRateLimit = aiscmn.RateLimitConf{
-		Backend: aiscmn.Adaptive{
-			RateLimitBase: aiscmn.RateLimitBase{
+	defaultRateLimit = RateLimitConf{
+		Backend: Adaptive{
+			RateLimitBase: RateLimitBase{
 				Interval:  cos.Duration(time.Minute),
 				MaxTokens: 1000,
 				Enabled:   false,
 			},
 			NumRetries: 3,
 		},
-		Frontend: aiscmn.Bursty{
-			RateLimitBase: aiscmn.RateLimitBase{
+		Frontend: Bursty{
+			RateLimitBase: RateLimitBase{
 				Interval:  cos.Duration(time.Minute),
 				MaxTokens: 1000,
 				Enabled:   false,
@@ -207,7 +208,7 @@ var (
 		},
 	}
 
-	defaultGetBatch = aiscmn.GetBatchConf{
+	defaultGetBatch = GetBatchConf{
 		XactConf:         defaultXconf,
 		MaxWait:          cos.Duration(10 * time.Second),
 		NumWarmupWorkers: 1,
@@ -216,16 +217,18 @@ var (
 	}
 )
 
+// DefaultClusterConfig returns built-in cluster config defaults - the config aisinit writes
+// prior to applying user overrides; see also ClusterConfig.DiffDefaults
 // [removed in 4.3] Dsort: defaultDsort
-func newDefaultConfig() *aiscmn.ClusterConfig {
-	return &aiscmn.ClusterConfig{
+func DefaultClusterConfig() *ClusterConfig {
+	return &ClusterConfig{
 		Auth:        defaultAuth,
 		Cksum:       defaultCksum,
 		Client:      defaultClientConf,
 		Transport:   defaultTransport,
-		TCB:         &aiscmn.TCBConf{XactConf: defaultXconf},
-		TCO:         &aiscmn.TCOConf{XactConf: defaultXconf},
-		Arch:        &aiscmn.ArchConf{XactConf: defaultXconf},
+		TCB:         &TCBConf{XactConf: defaultXconf},
+		TCO:         &TCOConf{XactConf: defaultXconf},
+		Arch:        &ArchConf{XactConf: defaultXconf},
 		Disk:        defaultDisk,
 		Net:         defaultNet,
 		FSHC:        defaultFSHC,
@@ -0,0 +1,82 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// cluster config vs built-in defaults (see apc.WhatNodeConfigDiff)
+// - built-in defaults: DefaultClusterConfig, loaded (JSON-parsed) and normalized
+//   by the respective sections' Validate() - the same way node config gets loaded at startup
+// - cluster config only: local (per-node) config is not compared
+// - not compared: identity and versioning fields (uuid, lastupdate_time, config_version), and
+//   the `auth`, `backend`, and `ext` sections - the latter may carry secrets and have no
+//   meaningful built-in defaults (see api.GetDaemonConfigDiff, apc.WhatNodeConfigDiff)
+
+type (
+	ConfigDiffEntry struct {
+		Current string `json:"current"`
+		Default string `json:"default"`
+	}
+	ConfigDiff map[string]ConfigDiffEntry // dotted key (e.g. "versioning.enabled") => values
+)
+
+var configDiffSkip = []string{"uuid", "lastupdate_time", "config_version", "auth", "backend", "ext"}
+
+func (c *ClusterConfig) DiffDefaults() ConfigDiff {
+	var (
+		dvals = configVals(dfltClusterConfig())
+		diff  = make(ConfigDiff, 16)
+	)
+	for name, cur := range configVals(c) {
+		if d := dvals[name]; d != cur {
+			diff[name] = ConfigDiffEntry{Current: cur, Default: d}
+		}
+	}
+	return diff
+}
+
+func dfltClusterConfig() *ClusterConfig {
+	c := &Config{}
+	b, err := jsoniter.Marshal(DefaultClusterConfig())
+	debug.AssertNoErr(err)
+	err = jsoniter.Unmarshal(b, &c.ClusterConfig)
+	debug.AssertNoErr(err)
+
+	c.ClusterConfig.ensureDefaults()
+	_ = IterFields(&c.ClusterConfig, func(name string, field IterField) (error, bool) {
+		err, _ := c.validateFld(name, field)
+		debug.AssertNoErr(err)
+		return nil, false
+	}, IterOpts{VisitAll: true})
+	return &c.ClusterConfig
+}
+
+func configVals(c *ClusterConfig) map[string]string {
+	vals := make(map[string]string, 256)
+	_ = IterFields(c, func(name string, field IterField) (error, bool) {
+		if !_hasPrefix(name, configDiffSkip) {
+			vals[name] = fmt.Sprintf("%v", field.Value()) // (not using field.String - see WritePolicy)
+		}
+		return nil, false
+	}, IterOpts{OnlyRead: true, IncludeOmitEmpty: true})
+	return vals
+}
+
+func _hasPrefix(name string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if name == p || strings.HasPrefix(name, p+IterFieldNameSepa) {
+			return true
+		}
+	}
+	return false
+}
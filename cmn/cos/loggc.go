@@ -19,12 +19,23 @@ const gcLogs = "GC logs:"
 
 // GCLogs keeps the total size of accumulated logs below maxtotal, removing the
 // oldest files (except the current one) separately per log type.
+// Removal runs asynchronously.
 func GCLogs(logdir string, maxtotal int64, verbose bool) {
+	gclogs(logdir, maxtotal, verbose, false /*sync*/)
+}
+
+// GCLogsNow is the synchronous version of the above that also returns
+// the total size of removed logs.
+func GCLogsNow(logdir string, maxtotal int64, verbose bool) (freed int64) {
+	return gclogs(logdir, maxtotal, verbose, true /*sync*/)
+}
+
+func gclogs(logdir string, maxtotal int64, verbose, sync bool) (freed int64) {
 	dentries, err := os.ReadDir(logdir)
 	if err != nil {
 		nlog.Errorln(gcLogs, "cannot read log dir", logdir, "err:", err)
 		_ = CreateDir(logdir) // (local non-containerized + kill/restart under test)
-		return
+		return 0
 	}
 
 	var (
@@ -41,6 +52,8 @@ func GCLogs(logdir string, maxtotal int64, verbose bool) {
 			if verbose {
 				nlog.Infoln(gcLogs, "skipping:", logtype, "total:", tot, "max:", maxtotal)
 			}
+		case l > 1 && sync:
+			freed += rmLogs(tot, maxtotal, logdir, logtype, finfos, verbose)
 		case l > 1:
 			go rmLogs(tot, maxtotal, logdir, logtype, finfos, verbose)
 			if i == 0 {
@@ -54,6 +67,7 @@ func GCLogs(logdir string, maxtotal int64, verbose bool) {
 			}
 		}
 	}
+	return freed
 }
 
 // e.g. name: ais.ip-10-0-2-19.root.log.INFO.20180404-031540.2249
@@ -76,7 +90,7 @@ func sizeLogs(dentries []os.DirEntry, logtype string, finfos []iofs.FileInfo) (_
 	return finfos, tot
 }
 
-func rmLogs(tot, maxtotal int64, logdir, logtype string, finfos []iofs.FileInfo, verbose bool) {
+func rmLogs(tot, maxtotal int64, logdir, logtype string, finfos []iofs.FileInfo, verbose bool) (freed int64) {
 	less := func(i, j int) bool {
 		return finfos[i].ModTime().Before(finfos[j].ModTime())
 	}
@@ -91,6 +105,7 @@ func rmLogs(tot, maxtotal int64, logdir, logtype string, finfos []iofs.FileInfo,
 		fqn := filepath.Join(logdir, finfo.Name())
 		if err := RemoveFile(fqn); err == nil {
 			tot -= finfo.Size()
+			freed += finfo.Size()
 			if verbose {
 				nlog.Infoln(gcLogs, "removed", fqn)
 			}
@@ -104,5 +119,5 @@ func rmLogs(tot, maxtotal int64, logdir, logtype string, finfos []iofs.FileInfo,
 	nlog.Infoln(gcLogs, "done, new total:", tot)
 
 	clear(finfos)
-	finfos = finfos[:0]
+	return freed
 }
@@ -7,6 +7,7 @@ package cos_test
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -45,6 +46,28 @@ func TestGCLogs(t *testing.T) {
 	tassert.Errorf(t, os.IsNotExist(err), "oldest log must be removed")
 }
 
+func TestGCLogsNow(t *testing.T) {
+	const maxTotal = 2 * cos.MiB
+
+	dir := t.TempDir()
+	base := time.Now().Add(-time.Hour)
+	for i := range 4 {
+		fqn := filepath.Join(dir, "ais.host.INFO."+strconv.Itoa(i))
+		tassert.CheckFatal(t, os.WriteFile(fqn, make([]byte, cos.MiB), 0o644))
+		mtime := base.Add(time.Duration(i) * time.Minute)
+		tassert.CheckFatal(t, os.Chtimes(fqn, mtime, mtime))
+	}
+
+	freed := cos.GCLogsNow(dir, maxTotal, true /*verbose*/)
+
+	// synchronous: no waiting
+	tassert.Errorf(t, freed == 3*cos.MiB, "expecting %d freed, got %d", 3*cos.MiB, freed)
+	tassert.Errorf(t, dirSize(t, dir) == cos.MiB, "expecting only the current log to remain")
+
+	freed = cos.GCLogsNow(dir, maxTotal, true)
+	tassert.Errorf(t, freed == 0, "expecting nothing to free, got %d", freed)
+}
+
 func TestGCLogsKeepsSingleLargeLog(t *testing.T) {
 	dir := t.TempDir()
 	fqn := filepath.Join(dir, "ais.host.INFO.1")
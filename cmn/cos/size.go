@@ -1,6 +1,6 @@
 // Package cos provides common low-level types and utilities for all aistore projects.
 /*
- * Copyright (c) 2022-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2022-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package cos
 
@@ -46,14 +46,30 @@ type SizeIEC int64
 
 func IEC[T int | int64 | SizeIEC](v T, digits int) string { return ToSizeIEC(int64(v), digits) }
 
-func (siz SizeIEC) MarshalJSON() ([]byte, error) { return jsoniter.Marshal(siz.String()) }
-func (siz SizeIEC) String() string               { return ToSizeIEC(int64(siz), 0) }
+func (siz SizeIEC) String() string { return ToSizeIEC(int64(siz), 0) }
 
+// lossless: falls back to bytes when the (rounded) String() representation would not parse back
+// into the same value (e.g., 1500 => "1500B" rather than "1KiB"); whole IEC units serialize
+// as before (e.g., "4MiB"), and both forms parse on nodes running older versions
+func (siz SizeIEC) MarshalJSON() ([]byte, error) {
+	s := siz.String()
+	if n, err := ParseSize(s, UnitsIEC); err != nil || n != int64(siz) {
+		s = strconv.FormatInt(int64(siz), 10) + "B"
+	}
+	return jsoniter.Marshal(s)
+}
+
+// accepts IEC strings (e.g., "4MiB", "1500B") and plain numbers (bytes)
 func (siz *SizeIEC) UnmarshalJSON(b []byte) (err error) {
 	var (
 		n   int64
 		val string
 	)
+	if len(b) > 0 && b[0] != '"' {
+		err = jsoniter.Unmarshal(b, &n)
+		*siz = SizeIEC(n)
+		return
+	}
 	if err = jsoniter.Unmarshal(b, &val); err != nil {
 		return
 	}
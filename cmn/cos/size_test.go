@@ -0,0 +1,79 @@
+// Package cos_test: unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestSizeIECMarshal(t *testing.T) {
+	tests := []struct {
+		size cos.SizeIEC
+		json string
+	}{
+		{0, `"0B"`},
+		{100, `"100B"`},
+		{cos.KiB, `"1KiB"`},
+		{4 * cos.MiB, `"4MiB"`}, // whole units: same as before
+		{cos.GiB, `"1GiB"`},
+		{1500, `"1500B"`}, // would otherwise round to "1KiB"
+		{cos.GiB + 1, `"1073741825B"`},
+	}
+	for _, tt := range tests {
+		b, err := jsoniter.Marshal(tt.size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.json {
+			t.Errorf("%d: expected %s, got %s", tt.size, tt.json, b)
+		}
+		var out cos.SizeIEC
+		if err := jsoniter.Unmarshal(b, &out); err != nil || out != tt.size {
+			t.Errorf("%s: expected %d, got %d (%v)", b, tt.size, out, err)
+		}
+	}
+}
+
+// compatibility: both IEC strings and plain numbers (bytes)
+func TestSizeIECUnmarshal(t *testing.T) {
+	tests := []struct {
+		json string
+		size cos.SizeIEC
+	}{
+		{`"1KiB"`, cos.KiB},
+		{`"4MiB"`, 4 * cos.MiB},
+		{`"1500B"`, 1500},
+		{`"1500"`, 1500},
+		{`1500`, 1500},
+		{`0`, 0},
+		{`1073741825`, cos.GiB + 1},
+	}
+	for _, tt := range tests {
+		var out cos.SizeIEC
+		if err := jsoniter.Unmarshal([]byte(tt.json), &out); err != nil {
+			t.Errorf("%s: %v", tt.json, err)
+			continue
+		}
+		if out != tt.size {
+			t.Errorf("%s: expected %d, got %d", tt.json, tt.size, out)
+		}
+	}
+
+	// within a struct (as in cmn/config)
+	var conf struct {
+		A cos.SizeIEC `json:"a"`
+		B cos.SizeIEC `json:"b"`
+	}
+	if err := jsoniter.Unmarshal([]byte(`{"a": "64KiB", "b": 1500}`), &conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.A != 64*cos.KiB || conf.B != 1500 {
+		t.Errorf("expected {%d, %d}, got {%d, %d}", 64*cos.KiB, 1500, conf.A, conf.B)
+	}
+}
@@ -12,6 +12,9 @@ const (
 	StreamsOutObjSize  = "stream.out.size"
 	StreamsInObjCount  = "stream.in.n"
 	StreamsInObjSize   = "stream.in.size"
+
+	// objects that could not be sent: header exceeds cmn.MaxTransportHeader
+	ErrStreamsOutHdrCount = "err.stream.out.hdr.n"
 )
 
 type (
@@ -205,6 +205,12 @@ type (
 		ETLErrCtx
 		Ecode int
 	}
+	// transformer did not respond within the configured (or per-request) timeout
+	ErrETLTimeout struct {
+		ETLName string
+		ObjName string
+		Timeout time.Duration
+	}
 	ETLErrCtx struct {
 		TID              string
 		ETLName          string
@@ -271,6 +277,14 @@ type (
 		retries int
 		total   time.Duration
 	}
+
+	// truncated stream: the reader delivered `Delivered` bytes (out of `Size`) and failed;
+	// the caller may resume with a ranged GET starting at offset `Delivered`
+	ErrPartialContent struct {
+		err       error
+		Delivered int64
+		Size      int64
+	}
 )
 
 var (
@@ -279,11 +293,13 @@ var (
 	ErrQuiesceTimeout   = errors.New("timed out waiting for quiescence")
 	ErrNotEnoughTargets = errors.New("not enough target nodes")
 	ErrNoMountpaths     = errors.New("no mountpaths")
+	ErrClusterReadOnly  = errors.New("cluster is read-only (maintenance mode)") // see apc.ActClusterReadOnly
 
 	// aborts
-	ErrXactRenewAbort   = errors.New("renewal abort")
-	ErrXactUserAbort    = errors.New("user abort")              // via apc.ActXactStop
-	ErrXactICNotifAbort = errors.New("IC(notifications) abort") // ditto
+	ErrXactRenewAbort    = errors.New("renewal abort")
+	ErrXactUserAbort     = errors.New("user abort")              // via apc.ActXactStop
+	ErrXactICNotifAbort  = errors.New("IC(notifications) abort") // ditto
+	ErrXactDeadlineAbort = errors.New("deadline abort")          // maintenance: rebalance failed to evacuate data in time
 
 	ErrGetTxBenign = errors.New("Warning: failed to transmit GET response") //nolint:staticcheck // making an exception for Warning
 )
@@ -848,6 +864,24 @@ func IsErrInitMissingBackend(err error) bool {
 	return ok
 }
 
+// ErrETLTimeout
+
+func NewErrETLTimeout(etlName, objName string, timeout time.Duration) *ErrETLTimeout {
+	return &ErrETLTimeout{ETLName: etlName, ObjName: objName, Timeout: timeout}
+}
+
+func (e *ErrETLTimeout) Error() string {
+	return fmt.Sprintf("etl=%q: timed out (%v) transforming %s", e.ETLName, e.Timeout, e.ObjName)
+}
+
+func IsErrETLTimeout(err error) bool {
+	if _, ok := err.(*ErrETLTimeout); ok {
+		return true
+	}
+	var wrapped *ErrETLTimeout
+	return errors.As(err, &wrapped)
+}
+
 // ErrETL
 
 func NewErrETL(ctx *ETLErrCtx, reason string, ecode ...int) *ErrETL {
@@ -1125,6 +1159,27 @@ func (e *ErrBackendRetry) Error() string {
 
 func (e *ErrBackendRetry) Unwrap() error { return e.err }
 
+// ErrPartialContent
+
+func NewErrPartialContent(err error, delivered, size int64) *ErrPartialContent {
+	debug.Assert(err != nil)
+	return &ErrPartialContent{err: err, Delivered: delivered, Size: size}
+}
+
+func (e *ErrPartialContent) Error() string {
+	return fmt.Sprintf("partial content: delivered %d out of %d bytes: %v", e.Delivered, e.Size, e.err)
+}
+
+func (e *ErrPartialContent) Unwrap() error { return e.err }
+
+func IsErrPartialContent(err error) bool {
+	if _, ok := err.(*ErrPartialContent); ok {
+		return true
+	}
+	var wrapped *ErrPartialContent
+	return errors.As(err, &wrapped)
+}
+
 //
 // more is-error helpers
 //
@@ -54,6 +54,8 @@ const (
 	EnableGoRuntimeMetrics    // publish selected Go runtime metrics via Prometheus
 	DloadAllowPrivateEgress   // allow downloader egress to private RFC1918/ULA addresses; loopback and link-local remain blocked
 	S3RedirectRebuild         // allow S3 clients that rebuild redirected requests instead of following the Location URI (forbidden when AuthN or intra-cluster signing is configured)
+	EnableRequestTagMetrics   // attribute GET and PUT counts and sizes to the request tag (apc.HdrRequestTag) via (bucket, tag) Prometheus variable labels
+	ProtectAliasedObjects     // do not delete objects referenced by aliases (apc.ActCreateAlias); default: delete and break the aliases
 )
 
 var Cluster = [...]string{
@@ -85,6 +87,8 @@ var Cluster = [...]string{
 	"Enable-Go-Runtime-Metrics",
 	"Dload-Allow-Private-Egress",
 	"S3-Redirect-Rebuild",
+	"Enable-Request-Tag-Metrics",
+	"Protect-Aliased-Objects",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -99,6 +103,7 @@ var Bucket = [...]string{
 	"S3-ListObjectVersions",
 	"Resume-Interrupted-MPU",
 	"Count-Object-NotFound-Stats",
+	"Protect-Aliased-Objects",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -106,10 +111,10 @@ var Bucket = [...]string{
 // as cmn.Validator and cmn.PropsValidator
 func (f *Flags) Validate() error {
 	if f.IsSet(DisableColdGET) && f.IsSet(StreamingColdGET) {
-		return fmt.Errorf("feature flags %q and %q are mutually exclusive", DisableColdGET.name(), StreamingColdGET.name())
+		return fmt.Errorf("feature flags %q and %q are mutually exclusive", DisableColdGET.Name(), StreamingColdGET.Name())
 	}
 	if f.IsSet(S3ReverseProxy) && f.IsSet(S3RedirectRebuild) {
-		return fmt.Errorf("feature flags %q and %q are mutually exclusive", S3ReverseProxy.name(), S3RedirectRebuild.name())
+		return fmt.Errorf("feature flags %q and %q are mutually exclusive", S3ReverseProxy.Name(), S3RedirectRebuild.Name())
 	}
 	return nil
 }
@@ -120,7 +125,7 @@ func (f *Flags) ValidateAsProps(requiresProxyMediation ...any) error {
 		return err
 	}
 	if protected := requiresProxyMediation[0].(bool); protected {
-		return fmt.Errorf("feature flag %q is incompatible with configuration that requires proxy mediation", S3RedirectRebuild.name())
+		return fmt.Errorf("feature flag %q is incompatible with configuration that requires proxy mediation", S3RedirectRebuild.Name())
 	}
 	return nil
 }
@@ -150,7 +155,8 @@ func CSV2Feat(s string) (Flags, error) {
 	return 0, errors.New("unknown feature flag '" + s + "'")
 }
 
-func (f Flags) name() string {
+// registered name of a single flag (see Cluster)
+func (f Flags) Name() string {
 	for i, n := range Cluster {
 		if f&(1<<i) != 0 {
 			return n
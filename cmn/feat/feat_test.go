@@ -0,0 +1,66 @@
+// Package feat: global runtime-configurable feature flags
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package feat_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// names must be listed in the exact order of their respective bits
+func TestFeatNames(t *testing.T) {
+	consts := []feat.Flags{
+		feat.EnforceIntraClusterAccess,
+		feat.SkipVC,
+		feat.DontAutoDetectFshare,
+		feat.S3APIviaRoot,
+		feat.FsyncPUT,
+		feat.LZ4Block1MB,
+		feat.LZ4FrameChecksum,
+		feat.DontAllowPassingFQNtoETL,
+		feat.IgnoreLimitedCoexistence,
+		feat.S3PresignedRequest,
+		feat.DontOptimizeVirtualDir,
+		feat.DisableColdGET,
+		feat.StreamingColdGET,
+		feat.S3ReverseProxy,
+		feat.S3UsePathStyle,
+		feat.DontDeleteWhenRebalancing,
+		feat.DontSetControlPlaneToS,
+		feat.TrustCryptoSafeChecksums,
+		feat.S3ListObjectVersions,
+		feat.EnableDetailedPromMetrics,
+		feat.ForceContainerCPUMem,
+		feat.ResumeInterruptedMPU,
+		feat.KeepUnknownFQN,
+		feat.LoadBalanceGET,
+		feat.CountObjectNotFoundStats,
+		feat.EnableGoRuntimeMetrics,
+		feat.DloadAllowPrivateEgress,
+		feat.S3RedirectRebuild,
+		feat.EnableRequestTagMetrics,
+		feat.ProtectAliasedObjects,
+	}
+	tassert.Fatalf(t, len(feat.Cluster) == len(consts), "expected %d names, got %d", len(consts), len(feat.Cluster))
+
+	seen := make(map[string]bool, len(feat.Cluster))
+	for i, name := range feat.Cluster {
+		tassert.Errorf(t, !seen[name], "duplicate name %q", name)
+		seen[name] = true
+
+		f, err := feat.CSV2Feat(name)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, f == consts[i], "%q: expected bit %d, got %s", name, i, f)
+		names := consts[i].Names()
+		tassert.Errorf(t, len(names) == 1 && names[0] == name, "bit %d: expected [%s], got %v", i, name, names)
+		tassert.Errorf(t, consts[i].Name() == name, "bit %d: expected %q, got %q", i, name, consts[i].Name())
+	}
+	for _, name := range feat.Bucket {
+		tassert.Errorf(t, seen[name], "bucket-scope %q is not a (cluster) feature", name)
+	}
+	tassert.Errorf(t, feat.IsBucketScope("Protect-Aliased-Objects"), "expected bucket scope")
+}
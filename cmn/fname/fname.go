@@ -60,4 +60,7 @@ const (
 	RebalanceMarker     = "rebalance"
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
+
+	// per mountpath: bucket-to-bucket copy checkpoints (see apc.TCBMsg.Resume)
+	TCBCkptDir = ".ais.tcb"
 )
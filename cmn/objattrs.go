@@ -42,6 +42,39 @@ const (
 
 	// as the name implies
 	OrigFntl = "orig_fntl"
+
+	// ETL result tag: transforming ETL name(s), source version, and source checksum
+	// (see apc.TCBMsg.CacheResults)
+	ETLSourceObjMD = "etl_source"
+
+	// per-object TTL: expiration time (unix nanoseconds) computed at PUT time
+	// from apc.HdrObjTTL; expired objects get removed by space cleanup
+	ExpiresObjMD = "expires"
+
+	// ID of the target that holds the object regardless of HRW (see apc.MoveObjMsg.Pin);
+	// global rebalance and space cleanup won't relocate or remove it
+	PinnedObjMD = "pinned"
+
+	// backend storage class (access tier) and access control list of the remote object,
+	// e.g. "STANDARD_IA" and `READ:uri="http://acs.amazonaws.com/groups/global/AllUsers"` (AWS);
+	// captured when copying remote => ais only with apc.TCBMsg.PreserveBackendMeta,
+	// and restored when writing the object back to the same backend
+	StorageClassObjMD = "storage_class"
+	ACLObjMD          = "acl"
+
+	// original (as PUT) object name in buckets with case-insensitive names
+	// (see Bprops.CaseInsensitiveNames)
+	OrigNameObjMD = "orig_name"
+
+	// backend Content-Encoding of the remote object (e.g. "gzip") when its in-cluster
+	// copy holds the encoded bytes; see apc.QparamDecodeContentEncoding
+	ContentEncodingObjMD = "content_encoding"
+
+	// alias (apc.ActCreateAlias): name of the object (in the same bucket) that GET and HEAD follow;
+	// the aliased object, in turn, keeps the names of its aliases (JSON-encoded list) - see also
+	// feat.ProtectAliasedObjects
+	AliasObjMD     = "alias"
+	AliasedByObjMD = "aliased_by"
 )
 
 const (
@@ -98,6 +131,23 @@ type (
 		ChunkCount   int   `json:"count"`          // number of chunks
 		MaxChunkSize int64 `json:"max_chunk_size"` // size of the largest chunk (bytes)
 	}
+
+	// object's chunk layout (api.GetObjectManifest)
+	// non-chunked (monolithic) objects are represented as a single synthetic chunk
+	// with Chunked = false
+	ChunkManifest struct {
+		UploadID string      `json:"upload_id,omitempty"` // chunked only
+		Chunks   []ChunkInfo `json:"chunks"`              // ordered by chunk number
+		Size     int64       `json:"size"`                // total size
+		Chunked  bool        `json:"chunked"`
+	}
+	ChunkInfo struct {
+		Cksum  *cos.Cksum `json:"cksum,omitempty"` // nil when not computed
+		ETag   string     `json:"etag,omitempty"`  // S3 multipart
+		Num    int        `json:"num"`             // 1-based chunk (part) number
+		Offset int64      `json:"offset"`          // within the object
+		Size   int64      `json:"size"`
+	}
 )
 
 type headerSerializer interface {
@@ -29,9 +29,13 @@ type (
 		Version  string `json:"version,omitempty" msg:"v,omitempty"`     // e.g., GCP int64 generation, AWS version (string), etc.
 		Location string `json:"location,omitempty" msg:"t,omitempty"`    // [tnode:mountpath]
 		Custom   string `json:"custom-md,omitempty" msg:"m,omitempty"`   // custom metadata: ETag, MD5, CRC, user-defined ...
+		ECStatus string `json:"ec-status,omitempty" msg:"e,omitempty"`   // EC-protected objects only: { apc.ECStatusReplicated, apc.ECStatusEncoded }
 		Size     int64  `json:"size,string,omitempty" msg:"s,omitempty"` // size in bytes
+		Heat     uint32 `json:"heat,omitempty" msg:"h,omitempty"`        // decaying access count (see core.HeatHalfLife)
 		Copies   int16  `json:"copies,omitempty" msg:"c,omitempty"`      // ## copies (NOTE: for non-replicated object copies == 1)
 		Flags    uint16 `json:"flags,omitempty" msg:"f,omitempty"`       // enum { EntryIsCached, EntryIsDir, EntryInArch, ...}
+		Count    int64  `json:"count,omitempty" msg:"o,omitempty"`       // virtual dirs only: recursive number of objects (see apc.GetPropsDirSummary)
+		Chunks   int32  `json:"chunks,omitempty" msg:"k,omitempty"`      // chunked objects only: number of chunks (see apc.GetPropsNumChunks)
 	}
 
 	LsoEntries []*LsoEnt
@@ -42,5 +46,6 @@ type (
 		ContinuationToken string     `json:"continuation_token"`
 		Entries           LsoEntries `json:"entries"`
 		Flags             uint32     `json:"flags"`
+		PageSize          int64      `json:"page_size,omitempty"` // effective page size (see apc.MaxPageSizeCustom)
 	}
 )
@@ -62,12 +62,24 @@ func (z *LsoEnt) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Custom")
 				return
 			}
+		case "e":
+			z.ECStatus, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "ECStatus")
+				return
+			}
 		case "s":
 			z.Size, err = dc.ReadInt64()
 			if err != nil {
 				err = msgp.WrapError(err, "Size")
 				return
 			}
+		case "h":
+			z.Heat, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "Heat")
+				return
+			}
 		case "c":
 			z.Copies, err = dc.ReadInt16()
 			if err != nil {
@@ -80,6 +92,18 @@ func (z *LsoEnt) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Flags")
 				return
 			}
+		case "o":
+			z.Count, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Count")
+				return
+			}
+		case "k":
+			z.Chunks, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "Chunks")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -94,8 +118,8 @@ func (z *LsoEnt) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(13)
+	var zb0001Mask uint16 /* 13 bits */
 	if z.Checksum == "" {
 		zb0001Len--
 		zb0001Mask |= 0x2
@@ -116,18 +140,34 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if z.Size == 0 {
+	if z.ECStatus == "" {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if z.Copies == 0 {
+	if z.Size == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if z.Flags == 0 {
+	if z.Heat == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
+	if z.Copies == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.Flags == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.Count == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x800
+	}
+	if z.Chunks == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -207,6 +247,18 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 		}
 	}
 	if (zb0001Mask & 0x40) == 0 { // if not empty
+		// write "e"
+		err = en.Append(0xa1, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.ECStatus)
+		if err != nil {
+			err = msgp.WrapError(err, "ECStatus")
+			return
+		}
+	}
+	if (zb0001Mask & 0x80) == 0 { // if not empty
 		// write "s"
 		err = en.Append(0xa1, 0x73)
 		if err != nil {
@@ -218,7 +270,19 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
+	if (zb0001Mask & 0x100) == 0 { // if not empty
+		// write "h"
+		err = en.Append(0xa1, 0x68)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint32(z.Heat)
+		if err != nil {
+			err = msgp.WrapError(err, "Heat")
+			return
+		}
+	}
+	if (zb0001Mask & 0x200) == 0 { // if not empty
 		// write "c"
 		err = en.Append(0xa1, 0x63)
 		if err != nil {
@@ -230,7 +294,7 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x100) == 0 { // if not empty
+	if (zb0001Mask & 0x400) == 0 { // if not empty
 		// write "f"
 		err = en.Append(0xa1, 0x66)
 		if err != nil {
@@ -242,12 +306,36 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	if (zb0001Mask & 0x800) == 0 { // if not empty
+		// write "o"
+		err = en.Append(0xa1, 0x6f)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt64(z.Count)
+		if err != nil {
+			err = msgp.WrapError(err, "Count")
+			return
+		}
+	}
+	if (zb0001Mask & 0x1000) == 0 { // if not empty
+		// write "k"
+		err = en.Append(0xa1, 0x6b)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt32(z.Chunks)
+		if err != nil {
+			err = msgp.WrapError(err, "Chunks")
+			return
+		}
+	}
 	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *LsoEnt) Msgsize() (s int) {
-	s = 1 + 2 + msgp.StringPrefixSize + len(z.Name) + 3 + msgp.StringPrefixSize + len(z.Checksum) + 2 + msgp.StringPrefixSize + len(z.Atime) + 2 + msgp.StringPrefixSize + len(z.Version) + 2 + msgp.StringPrefixSize + len(z.Location) + 2 + msgp.StringPrefixSize + len(z.Custom) + 2 + msgp.Int64Size + 2 + msgp.Int16Size + 2 + msgp.Uint16Size
+	s = 1 + 2 + msgp.StringPrefixSize + len(z.Name) + 3 + msgp.StringPrefixSize + len(z.Checksum) + 2 + msgp.StringPrefixSize + len(z.Atime) + 2 + msgp.StringPrefixSize + len(z.Version) + 2 + msgp.StringPrefixSize + len(z.Location) + 2 + msgp.StringPrefixSize + len(z.Custom) + 2 + msgp.StringPrefixSize + len(z.ECStatus) + 2 + msgp.Int64Size + 2 + msgp.Uint32Size + 2 + msgp.Int16Size + 2 + msgp.Uint16Size + 2 + msgp.Int64Size + 2 + msgp.Int32Size
 	return
 }
 
@@ -390,6 +478,12 @@ func (z *LsoRes) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Flags")
 				return
 			}
+		case "PageSize":
+			z.PageSize, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "PageSize")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -403,9 +497,9 @@ func (z *LsoRes) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *LsoRes) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 4
+	// map header, size 5
 	// write "UUID"
-	err = en.Append(0x84, 0xa4, 0x55, 0x55, 0x49, 0x44)
+	err = en.Append(0x85, 0xa4, 0x55, 0x55, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -458,6 +552,16 @@ func (z *LsoRes) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Flags")
 		return
 	}
+	// write "PageSize"
+	err = en.Append(0xa8, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.PageSize)
+	if err != nil {
+		err = msgp.WrapError(err, "PageSize")
+		return
+	}
 	return
 }
 
@@ -471,6 +575,6 @@ func (z *LsoRes) Msgsize() (s int) {
 			s += z.Entries[za0001].Msgsize()
 		}
 	}
-	s += 6 + msgp.Uint32Size
+	s += 6 + msgp.Uint32Size + 9 + msgp.Int64Size
 	return
 }
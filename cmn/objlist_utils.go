@@ -83,6 +83,18 @@ func (be *LsoEnt) CopyWithProps(propsSet cos.StrSet) (ne *LsoEnt) {
 	if propsSet.Contains(apc.GetPropsCopies) {
 		ne.Copies = be.Copies
 	}
+	if propsSet.Contains(apc.GetPropsHeat) {
+		ne.Heat = be.Heat
+	}
+	if propsSet.Contains(apc.GetPropsNumChunks) {
+		ne.Chunks = be.Chunks
+	}
+	if propsSet.Contains(apc.GetPropsECStatus) {
+		ne.ECStatus = be.ECStatus
+	}
+	if propsSet.Contains(apc.GetPropsDirSummary) && be.IsAnyFlagSet(apc.EntryIsDir) {
+		ne.Size, ne.Count = be.Size, be.Count
+	}
 	return
 }
 
@@ -141,6 +141,18 @@ var _ = Describe("API", func() {
 					},
 				},
 			),
+			Entry("case-insensitive names",
+				cmn.Bprops{
+					Provider: apc.AIS,
+				},
+				cmn.BpropsToSet{
+					CaseInsensitiveNames: apc.Ptr(true),
+				},
+				cmn.Bprops{
+					Provider:             apc.AIS,
+					CaseInsensitiveNames: true,
+				},
+			),
 			Entry("all fields",
 				cmn.Bprops{},
 				cmn.BpropsToSet{
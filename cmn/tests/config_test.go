@@ -6,6 +6,7 @@ package tests_test
 
 import (
 	"crypto/tls"
+	"math"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -117,6 +118,22 @@ func TestChunksConfValidate(t *testing.T) {
 			in:      cmn.ChunksConf{ObjSizeLimit: 64 * cos.MiB, ChunkSize: cmn.ChunkSizeMax + 1, MaxMonolithicSize: 0},
 			wantErr: true,
 		},
+		{
+			name:          "auto_size_limit within max_monolithic_size",
+			in:            cmn.ChunksConf{AutoSizeLimit: 64 * cos.MiB},
+			wantChunkSize: cmn.ChunkSizeDflt,
+			wantMaxMono:   cmn.MaxMonolithicSize,
+		},
+		{
+			name:    "negative auto_size_limit rejected",
+			in:      cmn.ChunksConf{AutoSizeLimit: -1},
+			wantErr: true,
+		},
+		{
+			name:    "auto_size_limit above max_monolithic_size rejected",
+			in:      cmn.ChunksConf{AutoSizeLimit: 2 * cos.GiB, MaxMonolithicSize: cos.GiB},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -135,6 +152,32 @@ func TestChunksConfValidate(t *testing.T) {
 	}
 }
 
+func TestPeriodConfStatsTimeOverrides(t *testing.T) {
+	dur := func(d time.Duration) cos.Duration { return cos.Duration(d) }
+	base := cmn.PeriodConf{StatsTime: dur(10 * time.Second), RetrySyncTime: dur(2 * time.Second), NotifTime: dur(30 * time.Second)}
+
+	c := base
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.StatsTimeOf(cmn.StatsGroupDisk) == 10*time.Second, "expecting global stats_time")
+
+	c.StatsTimeOverrides = map[string]cos.Duration{cmn.StatsGroupDisk: dur(5 * time.Second)}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.StatsTimeOf(cmn.StatsGroupDisk) == 5*time.Second, "expecting disk override")
+
+	c.StatsTimeOverrides = map[string]cos.Duration{cmn.StatsGroupDisk: 0}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.StatsTimeOf(cmn.StatsGroupDisk) == 10*time.Second, "zero override must fall back to stats_time")
+
+	for _, overrides := range []map[string]cos.Duration{
+		{"uptime": dur(5 * time.Second)},                  // unknown group
+		{cmn.StatsGroupDisk: dur(100 * time.Millisecond)}, // too small
+		{cmn.StatsGroupDisk: dur(time.Minute)},            // exceeds stats_time
+	} {
+		c.StatsTimeOverrides = overrides
+		tassert.Errorf(t, c.Validate() != nil, "expecting validation error for %v", overrides)
+	}
+}
+
 func TestValidateMpath(t *testing.T) {
 	mpaths := []string{
 		"tmp", // not absolute path
@@ -263,6 +306,42 @@ func TestGCOClone_NoAuthTracingAlias(t *testing.T) {
 	}
 }
 
+func TestLRUConfPinnedPrefixes(t *testing.T) {
+	lru := func(prefixes ...string) cmn.LRUConf {
+		return cmn.LRUConf{
+			DontEvictTime:   cos.Duration(time.Hour),
+			CapacityUpdTime: cos.Duration(time.Minute),
+			PinnedPrefixes:  prefixes,
+		}
+	}
+	tests := []struct {
+		name    string
+		lru     cmn.LRUConf
+		wantErr bool
+	}{
+		{name: "none", lru: lru()},
+		{name: "valid", lru: lru("hot/", "models/v1")},
+		{name: "empty prefix", lru: lru("hot/", ""), wantErr: true},
+		{name: "invalid prefix", lru: lru("../hot"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.lru.Validate()
+			if tt.wantErr {
+				tassert.Fatalf(t, err != nil, "expected error, got nil; lru=%+v", tt.lru)
+				return
+			}
+			tassert.CheckFatal(t, err)
+		})
+	}
+
+	c := lru("hot/", "models/v1")
+	tassert.Fatalf(t, c.IsPinned("hot/a.bin"), "expected pinned")
+	tassert.Fatalf(t, c.IsPinned("models/v12/b.bin"), "expected pinned")
+	tassert.Fatalf(t, !c.IsPinned("cold/hot/a.bin"), "expected not pinned")
+	tassert.Fatalf(t, !c.IsPinned("hot"), "expected not pinned")
+}
+
 func TestHTTPConfValidateTLS(t *testing.T) {
 	const crt = "crt.pem"
 
@@ -329,6 +408,115 @@ func TestTLSConfValidate(t *testing.T) {
 	}
 }
 
+func TestBackendConfRemAisTLS(t *testing.T) {
+	tmp := t.TempDir()
+	tests := []struct {
+		name string
+		conf cmn.BackendConfRemAisTLS
+	}{
+		{name: "empty"},
+		{name: "cert without key", conf: cmn.BackendConfRemAisTLS{Certificate: "crt.pem"}},
+		{name: "key without cert", conf: cmn.BackendConfRemAisTLS{Key: "key.pem"}},
+		{name: "nonexistent files", conf: cmn.BackendConfRemAisTLS{
+			Certificate: filepath.Join(tmp, "crt.pem"), Key: filepath.Join(tmp, "key.pem"),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tassert.Fatalf(t, tt.conf.Validate() != nil, "expected error, got nil; conf=%+v", tt.conf)
+		})
+	}
+
+	// via backend config
+	bc := cmn.BackendConf{Conf: map[string]any{cmn.BackendRemAisTLS: map[string]any{"certificate": "crt.pem"}}}
+	tassert.Fatalf(t, bc.Validate() != nil, "expected backend.%s validation error", cmn.BackendRemAisTLS)
+	tassert.Fatalf(t, (&cmn.BackendConf{}).RemAisTLS() == nil, "expected nil when not configured")
+
+	// not a provider: never in the provider-keyed map, and round-trips as is
+	bc = cmn.BackendConf{}
+	err := jsoniter.Unmarshal([]byte(`{"aws":{},"ais_tls":{"certificate":"crt.pem","key":"key.pem"}}`), &bc)
+	tassert.CheckFatal(t, err)
+	_, ok := bc.Conf[cmn.BackendRemAisTLS]
+	tassert.Fatalf(t, !ok, "expected %q to be removed from backend providers", cmn.BackendRemAisTLS)
+	tassert.Fatalf(t, len(bc.Conf) == 1, "expected a single provider, got %v", bc.Conf)
+	tassert.Fatalf(t, bc.RemAisTLS() != nil && bc.RemAisTLS().Certificate == "crt.pem", "expected %s config, got %+v",
+		cmn.BackendRemAisTLS, bc.RemAisTLS())
+	b, err := jsoniter.Marshal(&bc)
+	tassert.CheckFatal(t, err)
+	var back cmn.BackendConf
+	tassert.CheckFatal(t, jsoniter.Unmarshal(b, &back))
+	tassert.Fatalf(t, back.RemAisTLS() != nil && *back.RemAisTLS() == *bc.RemAisTLS(), "round-trip mismatch: %s", string(b))
+}
+
+func TestBackendConfRetry(t *testing.T) {
+	// invalid
+	for _, retry := range []map[string]any{
+		{"max_attempts": -1},
+		{"base_delay": "-1s"},
+		{"base_delay": "10s", "max_delay": "1s"},
+	} {
+		bc := cmn.BackendConf{Conf: map[string]any{apc.AWS: map[string]any{"retry": retry}}}
+		tassert.Fatalf(t, bc.Validate() != nil, "expected validation error, retry=%v", retry)
+	}
+
+	// valid
+	bc := cmn.BackendConf{Conf: map[string]any{apc.AWS: map[string]any{
+		"max_conns": 16,
+		"retry":     map[string]any{"max_attempts": 5, "base_delay": "100ms", "max_delay": "1s"},
+	}}}
+	tassert.CheckFatal(t, bc.Validate())
+	tassert.Fatalf(t, bc.MaxConns(apc.AWS) == 16, "expected max_conns 16, got %d", bc.MaxConns(apc.AWS))
+	tassert.Fatalf(t, bc.Retry(apc.Azure) == nil, "expected nil when not configured")
+
+	rconf := bc.Retry(apc.AWS)
+	tassert.Fatalf(t, rconf != nil && rconf.MaxAttempts == 5, "unexpected retry config %+v", rconf)
+	for i, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second} {
+		delay := rconf.Delay(i+1, time.Second, 20*time.Second)
+		tassert.Errorf(t, delay == expected, "retry %d: expected %v, got %v", i+1, expected, delay)
+	}
+	tassert.Errorf(t, rconf.Delay(1000, 0, 0) == time.Second, "expected max_delay upon overflow")
+
+	// large base, mid-range attempts: must not wrap around (e.g., (5<<40)<<22 == 1<<62 in int64)
+	const maxDelay = time.Duration(math.MaxInt64)
+	rconf = &cmn.BackendConfRetry{BaseDelay: cos.Duration(5 << 40), MaxDelay: cos.Duration(maxDelay)}
+	prev := time.Duration(0)
+	for attempt := 1; attempt < 64; attempt++ {
+		delay := rconf.Delay(attempt, 0, 0)
+		tassert.Errorf(t, delay >= prev, "attempt %d: delay %v decreased (prev %v)", attempt, delay, prev)
+		if shift := attempt - 1; shift <= 20 {
+			tassert.Errorf(t, delay == time.Duration(5<<40)<<shift, "attempt %d: expected %v, got %v",
+				attempt, time.Duration(5<<40)<<shift, delay)
+		} else {
+			tassert.Errorf(t, delay == maxDelay, "attempt %d: expected max_delay, got %v", attempt, delay)
+		}
+		prev = delay
+	}
+
+	// jitter and defaults
+	rconf = &cmn.BackendConfRetry{Jitter: true}
+	for attempt := 1; attempt < 10; attempt++ {
+		delay := rconf.Delay(attempt, time.Second, 5*time.Second)
+		tassert.Errorf(t, delay >= 0 && delay <= 5*time.Second, "attempt %d: delay %v out of range", attempt, delay)
+	}
+}
+
+func TestReadQoSConf(t *testing.T) {
+	c := cmn.ReadQoSConf{MaxReads: 64}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.WeightHigh == 8 && c.WeightNormal == 4 && c.WeightLow == 1,
+		"expected default weights, got (%d, %d, %d)", c.WeightHigh, c.WeightNormal, c.WeightLow)
+
+	for _, c := range []cmn.ReadQoSConf{{MaxReads: -1}, {WeightHigh: -1}, {WeightLow: 1001}} {
+		tassert.Errorf(t, c.Validate() != nil, "expected validation error, %+v", c)
+	}
+
+	rl := cmn.RateLimitConf{QoSClass: apc.QoSLow}
+	tassert.CheckFatal(t, rl.Validate())
+	rl.QoSClass = "urgent"
+	tassert.Errorf(t, rl.Validate() != nil, "expected invalid qos_class")
+}
+
 func TestHTTPConfIterFieldsPubTLSPaths(t *testing.T) {
 	http := cmn.HTTPConf{
 		TLSConf: cmn.TLSConf{Certificate: "main.crt", CertKey: "main.key"},
@@ -545,3 +733,43 @@ func TestHTTPConfJSONFlat(t *testing.T) {
 	b, _ := jsoniter.Marshal(c)
 	tassert.Fatalf(t, !strings.Contains(string(b), `"tls"`), "unexpected nesting: %s", b)
 }
+
+func TestConfigDiffDefaults(t *testing.T) {
+	confPath := filepath.Join(thisFileDir(t), "configs", "config.json")
+	localConfPath := filepath.Join(thisFileDir(t), "configs", "confignet.json")
+	config := cmn.Config{}
+	err := cmn.LoadConfig(confPath, localConfPath, apc.Proxy, &config)
+	tassert.CheckFatal(t, err)
+
+	dflt := cmn.DefaultClusterConfig()
+	config.Mirror.Copies = dflt.Mirror.Copies + 1
+	config.Space.CleanupWM = dflt.Space.CleanupWM + 5
+	config.Versioning.Enabled = !dflt.Versioning.Enabled
+
+	diff := config.ClusterConfig.DiffDefaults()
+	tests := []struct {
+		name     string
+		current  string
+		expected string
+	}{
+		{"mirror.copies", "3", "2"},
+		{"space.cleanupwm", "70", "65"},
+		{"versioning.enabled", "false", "true"},
+	}
+	for _, tt := range tests {
+		e, ok := diff[tt.name]
+		tassert.Errorf(t, ok, "expected %q in diff", tt.name)
+		tassert.Errorf(t, e.Current == tt.current && e.Default == tt.expected, "%s: expected (%s, %s), got (%s, %s)",
+			tt.name, tt.current, tt.expected, e.Current, e.Default)
+	}
+	for name := range diff {
+		tassert.Errorf(t, !strings.HasPrefix(name, "uuid") && !strings.HasPrefix(name, "auth") &&
+			!strings.HasPrefix(name, "backend"), "unexpected %q in diff", name)
+	}
+
+	// unchanged defaults are not reported
+	config.Mirror.Copies = dflt.Mirror.Copies
+	diff = config.ClusterConfig.DiffDefaults()
+	_, ok := diff["mirror.copies"]
+	tassert.Errorf(t, !ok, "unexpected %q in diff", "mirror.copies")
+}
@@ -0,0 +1,58 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Approximate per-bucket object counts, maintained incrementally by each target:
+// - incremented when LOM.RenameFinalize stores a new object (PUT, cold GET, copy,
+//   migration, chunked upload) and upon restore from trash
+// - decremented when LOM.RemoveObj removes an existing object (delete, evict, LRU,
+//   rebalance cleanup, etc.) and upon moving it to trash
+// - reset to the exact number upon the next full (no prefix) bucket summary
+// The counts may drift - e.g., after a crash, or on filesystems that do not support
+// RENAME_NOREPLACE (see fs.RenameNoReplace). See api.GetBucketApproxCount.
+
+var bcounts sync.Map // BID => *atomic.Int64
+
+func _bcount(bck *meta.Bck) *atomic.Int64 {
+	bid := bck.Props.BID
+	if v, ok := bcounts.Load(bid); ok {
+		return v.(*atomic.Int64)
+	}
+	v, _ := bcounts.LoadOrStore(bid, &atomic.Int64{})
+	return v.(*atomic.Int64)
+}
+
+func BcountInc(bck *meta.Bck) { _bcount(bck).Inc() }
+func BcountDec(bck *meta.Bck) { _bcount(bck).Dec() }
+
+// reconcile (see xs.XactNsumm)
+func BcountSet(bck *meta.Bck, n int64) { _bcount(bck).Store(n) }
+
+func BcountGet(bck *meta.Bck) int64 {
+	if bck.Props == nil {
+		return 0
+	}
+	v, ok := bcounts.Load(bck.Props.BID)
+	if !ok {
+		return 0
+	}
+	return max(v.(*atomic.Int64).Load(), 0)
+}
+
+// upon bucket destruction (eviction)
+func BcountDel(bcks ...*meta.Bck) {
+	for _, bck := range bcks {
+		if bck.Props != nil {
+			bcounts.Delete(bck.Props.BID)
+		}
+	}
+}
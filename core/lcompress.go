@@ -0,0 +1,337 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// Objects stored compressed (at rest) - see cmn.CompressionConf
+//
+// On-disk layout: independently compressed fixed-size blocks followed by the block index
+// (to support random access - range reads and archived files) and the trailer:
+//
+//	[block 0] ... [block N-1] [N x uint64: block offsets] [trailer]
+//
+// where each block is [uint32: length | raw-flag] [lz4 block or raw data]
+// and the trailer is [uint64: plain size] [uint32: block size] [uint32: N] [uint64: magic].
+//
+// Size, checksum, and all other object attributes always refer to the original (plain) content.
+
+const (
+	cmprBlockSize   = 64 * 1024
+	cmprBlockHdr    = 4             // uint32
+	cmprIdxEntry    = 8             // uint64
+	cmprTrailerSize = 8 + 4 + 4 + 8 // (see above)
+	cmprRawFl       = uint32(1) << 31
+	cmprMagic       = uint64(0x6169732d6c7a3401) // "ais-lz4" v1
+)
+
+var errCmprTrailer = errors.New("invalid compressed object trailer")
+
+type (
+	cmprReader struct {
+		lom   *LOM
+		src   cos.LomReader // stored file or, when also encrypted, encReader (see core/lencrypt.go)
+		index []int64       // block offsets, plus the offset of the index itself (as the end-of-blocks sentinel)
+		blk   []byte        // current decompressed block
+		cbuf  []byte        // compressed block
+		size  int64         // plain size
+		off   int64         // Read() offset
+		curr  int           // current block (-1 none)
+		mu    sync.Mutex
+	}
+)
+
+// interface guard
+var _ cos.LomReader = (*cmprReader)(nil)
+
+func (lom *LOM) IsCompressed() bool { return lom.md.flags&lmflCompressed != 0 }
+
+// SetCompressed marks the object as stored compressed; the flag is persisted
+// with the next Persist/PersistMain call on this LOM.
+func (lom *LOM) SetCompressed(v bool) {
+	if v {
+		lom.md.flags |= lmflCompressed
+	} else {
+		lom.md.flags &^= lmflCompressed
+	}
+}
+
+// CompressWork compresses plain-content work file in place, if and only when:
+// - the bucket is configured to compress objects of this size, and
+// - compression does reduce the size
+// Returns true if the work file is now compressed (in which case the caller marks
+// the object via SetCompressed after finalizing it).
+func (lom *LOM) CompressWork(wfqn string) (bool, error) {
+	conf := &lom.Bprops().Compression
+	lsize := lom.Lsize(true)
+	if !conf.Enabled || lsize == 0 || lsize < int64(conf.MinSize) {
+		return false, nil
+	}
+	src, err := os.Open(wfqn)
+	if err != nil {
+		return false, err
+	}
+	cfqn := lom.GenFQN(fs.WorkCT, fs.WorkfileCompress)
+	ok, err := lom._compress(src, cfqn, lsize)
+	cos.Close(src)
+	if err != nil || !ok {
+		if errRm := cos.RemoveFile(cfqn); errRm != nil && !cos.IsNotExist(errRm) {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return false, err
+	}
+	if err := cos.Rename(cfqn, wfqn); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (lom *LOM) _compress(src io.Reader, cfqn string, lsize int64) (bool, error) {
+	dst, err := lom.CreateWork(cfqn)
+	if err != nil {
+		return false, err
+	}
+	var (
+		c          lz4.Compressor
+		plain, ps  = g.pmm.AllocSize(cmprBlockSize)
+		comp, cs   = g.pmm.AllocSize(int64(cmprBlockHdr + lz4.CompressBlockBound(cmprBlockSize)))
+		nblocks    = int((lsize + cmprBlockSize - 1) / cmprBlockSize)
+		index      = make([]byte, 0, nblocks*cmprIdxEntry+cmprTrailerSize)
+		off, total int64
+		werr, rerr error
+		ok         = true
+	)
+	defer func() {
+		ps.Free(plain)
+		cs.Free(comp)
+	}()
+	plain = plain[:cmprBlockSize]
+	for rerr == nil {
+		var n int
+		n, rerr = io.ReadFull(src, plain)
+		if n == 0 {
+			break
+		}
+		total += int64(n)
+		data := comp[cmprBlockHdr:]
+		cn, _ := c.CompressBlock(plain[:n], data)
+		if cn == 0 || cn >= n { // incompressible
+			binary.BigEndian.PutUint32(comp, uint32(n)|cmprRawFl)
+			copy(data, plain[:n])
+			cn = n
+		} else {
+			binary.BigEndian.PutUint32(comp, uint32(cn))
+		}
+		index = binary.BigEndian.AppendUint64(index, uint64(off))
+		if _, werr = dst.Write(comp[:cmprBlockHdr+cn]); werr != nil {
+			break
+		}
+		off += int64(cmprBlockHdr + cn)
+		if off+int64(len(index)) >= lsize { // not worth it
+			ok = false
+			break
+		}
+	}
+	switch {
+	case werr != nil:
+		cos.Close(dst)
+		return false, werr
+	case !ok:
+		cos.Close(dst)
+		return false, nil
+	case rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF:
+		cos.Close(dst)
+		return false, rerr
+	case total != lsize:
+		cos.Close(dst)
+		return false, fmt.Errorf("%s: size mismatch (%d vs %d)", lom.Cname(), total, lsize)
+	}
+	debug.Assert(len(index) == nblocks*cmprIdxEntry)
+
+	// index and trailer
+	index = binary.BigEndian.AppendUint64(index, uint64(lsize))
+	index = binary.BigEndian.AppendUint32(index, cmprBlockSize)
+	index = binary.BigEndian.AppendUint32(index, uint32(nblocks))
+	index = binary.BigEndian.AppendUint64(index, cmprMagic)
+	if off+int64(len(index)) >= lsize {
+		cos.Close(dst)
+		return false, nil
+	}
+	if _, err := dst.Write(index); err != nil {
+		cos.Close(dst)
+		return false, err
+	}
+	if lom.IsFeatureSet(feat.FsyncPUT) {
+		if err := dst.Sync(); err != nil {
+			cos.Close(dst)
+			return false, err
+		}
+	}
+	return true, dst.Close()
+}
+
+// copy compressed and/or encrypted object as is (destination remains such);
+// the destination checksum, however, must be computed over the original content
+func (lom *LOM) copyEncoded(workFQN string, buf []byte, dstCksumTy string) (*cos.CksumHash, error) {
+	if _, _, err := cos.CopyFile(lom.FQN, workFQN, buf, cos.ChecksumNone); err != nil {
+		return nil, err
+	}
+	if dstCksumTy == cos.ChecksumNone {
+		return &cos.CksumHash{Cksum: *cos.NoneCksum}, nil
+	}
+	if srcCksum := lom.Checksum(); srcCksum != nil && srcCksum.Ty() == dstCksumTy && srcCksum.Val() != "" {
+		return &cos.CksumHash{Cksum: *srcCksum.Clone()}, nil
+	}
+	r, err := lom.openEncoded(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	_, cksum, err := cos.ChecksumReader(r, dstCksumTy)
+	cos.Close(r)
+	return cksum, err
+}
+
+// given stored (compressed) content of the size `fsize`
+func (lom *LOM) newCmprReader(src cos.LomReader, fsize int64) (*cmprReader, error) {
+	r := &cmprReader{lom: lom, src: src, curr: -1}
+	if err := r.init(fsize); err != nil {
+		return nil, fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	return r, nil
+}
+
+func (r *cmprReader) init(fsize int64) error {
+	if fsize < cmprTrailerSize {
+		return errCmprTrailer
+	}
+	var trailer [cmprTrailerSize]byte
+	if _, err := r.src.ReadAt(trailer[:], fsize-cmprTrailerSize); err != nil {
+		return err
+	}
+	var (
+		size    = int64(binary.BigEndian.Uint64(trailer[:]))
+		bsize   = binary.BigEndian.Uint32(trailer[8:])
+		nblocks = int64(binary.BigEndian.Uint32(trailer[12:]))
+		magic   = binary.BigEndian.Uint64(trailer[16:])
+		idxOff  = fsize - cmprTrailerSize - nblocks*cmprIdxEntry
+	)
+	if magic != cmprMagic || bsize != cmprBlockSize || idxOff < 0 || nblocks != (size+cmprBlockSize-1)/cmprBlockSize {
+		return errCmprTrailer
+	}
+	if size != r.lom.Lsize(true) {
+		return cmn.NewErrLmetaCorrupted(r.lom.whingeSize(size))
+	}
+	b := make([]byte, nblocks*cmprIdxEntry)
+	if _, err := r.src.ReadAt(b, idxOff); err != nil {
+		return err
+	}
+	r.index = make([]int64, nblocks+1)
+	for i := range nblocks {
+		r.index[i] = int64(binary.BigEndian.Uint64(b[i*cmprIdxEntry:]))
+	}
+	r.index[nblocks] = idxOff
+	r.size = size
+	return nil
+}
+
+func (r *cmprReader) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.off)
+	r.off += int64(n)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *cmprReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	r.mu.Lock()
+	for n < len(p) && err == nil {
+		if off >= r.size {
+			err = io.EOF
+			break
+		}
+		i := int(off / cmprBlockSize)
+		if i != r.curr {
+			if err = r.load(i); err != nil {
+				break
+			}
+		}
+		m := copy(p[n:], r.blk[off-int64(i)*cmprBlockSize:])
+		n += m
+		off += int64(m)
+	}
+	r.mu.Unlock()
+	return n, err
+}
+
+// read and decompress block #i
+func (r *cmprReader) load(i int) error {
+	if r.blk == nil {
+		r.blk, _ = g.pmm.AllocSize(cmprBlockSize)
+		r.cbuf, _ = g.pmm.AllocSize(cmprBlockHdr + cmprBlockSize)
+	}
+	var (
+		plen = min(int64(cmprBlockSize), r.size-int64(i)*cmprBlockSize)
+		clen = r.index[i+1] - r.index[i]
+	)
+	if clen <= cmprBlockHdr || clen > cmprBlockHdr+cmprBlockSize {
+		return fmt.Errorf("%s: invalid compressed block #%d (%d)", r.lom.Cname(), i, clen)
+	}
+	cbuf := r.cbuf[:clen]
+	if _, err := r.src.ReadAt(cbuf, r.index[i]); err != nil {
+		return err
+	}
+	hdr := binary.BigEndian.Uint32(cbuf)
+	data := cbuf[cmprBlockHdr:]
+	r.curr = -1 // about to overwrite r.blk
+	if hdr&cmprRawFl != 0 {
+		if int64(hdr&^cmprRawFl) != plen || int64(len(data)) != plen {
+			return fmt.Errorf("%s: invalid raw block #%d", r.lom.Cname(), i)
+		}
+		r.blk = r.blk[:copy(r.blk[:plen], data)]
+	} else {
+		if int(hdr) != len(data) {
+			return fmt.Errorf("%s: invalid compressed block #%d header", r.lom.Cname(), i)
+		}
+		n, err := lz4.UncompressBlock(data, r.blk[:cmprBlockSize])
+		if err != nil {
+			return fmt.Errorf("%s: block #%d: %w", r.lom.Cname(), i, err)
+		}
+		if int64(n) != plen {
+			return fmt.Errorf("%s: block #%d: decompressed size %d != %d", r.lom.Cname(), i, n, plen)
+		}
+		r.blk = r.blk[:n]
+	}
+	r.curr = i
+	return nil
+}
+
+func (r *cmprReader) Close() error {
+	if r.blk != nil {
+		g.pmm.Free(r.blk)
+		g.pmm.Free(r.cbuf)
+		r.blk, r.cbuf = nil, nil
+	}
+	return r.src.Close()
+}
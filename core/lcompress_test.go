@@ -0,0 +1,165 @@
+// Package core_test provides tests for objects stored compressed
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LOM compression", func() {
+	const (
+		tmpDir     = "/tmp/lcompress_test"
+		oneMpath   = tmpDir + "/onempath"
+		bucketName = "LCOMPRESS_TEST_Bucket"
+	)
+
+	localBck := cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+	var (
+		mix     = fs.Mountpath{Path: oneMpath}
+		bmdMock = mock.NewBaseBownerMock(
+			meta.NewBck(
+				bucketName, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:       cmn.CksumConf{Type: cos.ChecksumOneXxh},
+					Compression: cmn.CompressionConf{Enabled: true, Algo: apc.LZ4Compression, MinSize: cos.KiB},
+					BID:         302,
+				},
+			),
+		)
+	)
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(oneMpath)
+		_, _ = fs.AddTestMpath(oneMpath, "daeID")
+		_ = mock.NewTarget(bmdMock)
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(oneMpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	// write work file and finalize it (compare w/ ais/tgtobj.go poi.fini)
+	put := func(objName string, data []byte) (*core.LOM, bool) {
+		fqn := mix.MakePathFQN(&localBck, fs.ObjCT, objName)
+		lom := newBasicLom(fqn, int64(len(data)))
+		wfqn := lom.GenFQN(fs.WorkCT, fs.WorkfilePut)
+		Expect(cos.CreateDir(filepath.Dir(wfqn))).NotTo(HaveOccurred())
+		Expect(os.WriteFile(wfqn, data, cos.PermRWR)).NotTo(HaveOccurred())
+
+		compressed, err := lom.CompressWork(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+		lom.SetCompressed(compressed)
+		lom.IncVersion()
+		Expect(persist(lom)).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+
+		loaded := newBasicLom(fqn)
+		Expect(loaded.Load(false, false)).NotTo(HaveOccurred())
+		Expect(loaded.Lsize()).To(Equal(int64(len(data))))
+		return loaded, compressed
+	}
+
+	compressible := func(size int) []byte {
+		var b bytes.Buffer
+		for i := 0; b.Len() < size; i++ {
+			b.WriteString("line " + strconv.Itoa(i) + ": the quick brown fox jumps over the lazy dog\n")
+		}
+		return b.Bytes()[:size]
+	}
+
+	It("should store compressible objects compressed and read them back", func() {
+		data := compressible(5*64*cos.KiB + 123)
+		lom, compressed := put("cmpr/obj", data)
+		Expect(compressed).To(BeTrue())
+		Expect(lom.IsCompressed()).To(BeTrue())
+
+		finfo, err := os.Stat(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(finfo.Size()).To(BeNumerically("<", len(data)))
+
+		lom.Lock(false)
+		defer lom.Unlock(false)
+
+		lh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		got, err := io.ReadAll(lh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(data))
+
+		// random access across block boundaries
+		for _, rng := range [][2]int{{0, 1}, {64*cos.KiB - 10, 20}, {100_000, 200_000}, {len(data) - 7, 7}} {
+			buf := make([]byte, rng[1])
+			n, err := lh.ReadAt(buf, int64(rng[0]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf[:n]).To(Equal(data[rng[0] : rng[0]+rng[1]]))
+		}
+		buf := make([]byte, 10)
+		_, err = lh.ReadAt(buf, int64(len(data)-5))
+		Expect(err).To(Equal(io.EOF))
+
+		// section (range read)
+		r, err := lom.NewSectionReader(lh, 70_000, 1000)
+		Expect(err).NotTo(HaveOccurred())
+		got, err = io.ReadAll(r)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(data[70_000:71_000]))
+		Expect(lh.Close()).NotTo(HaveOccurred())
+
+		// checksum is computed over the original content
+		cksum, err := lom.ComputeCksum(cos.ChecksumOneXxh, true /*locked*/)
+		Expect(err).NotTo(HaveOccurred())
+		expected, err := cos.ChecksumBytes(data, cos.ChecksumOneXxh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cksum.Value()).To(Equal(expected.Value()))
+	})
+
+	It("should store incompressible and small objects as is", func() {
+		data := make([]byte, 256*cos.KiB)
+		_, _ = rand.Read(data)
+		lom, compressed := put("cmpr/random", data)
+		Expect(compressed).To(BeFalse())
+		Expect(lom.IsCompressed()).To(BeFalse())
+		Expect(lom.FQN).To(BeAnExistingFile())
+
+		lom, compressed = put("cmpr/small", compressible(100)) // below min_size
+		Expect(compressed).To(BeFalse())
+		Expect(lom.IsCompressed()).To(BeFalse())
+	})
+
+	It("should honor min_size for objects smaller than a compression block", func() {
+		data := compressible(2 * cos.KiB) // above min_size
+		lom, compressed := put("cmpr/above-min", data)
+		Expect(compressed).To(BeTrue())
+		Expect(lom.IsCompressed()).To(BeTrue())
+
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		lh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		got, err := io.ReadAll(lh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(data))
+		Expect(lh.Close()).NotTo(HaveOccurred())
+	})
+})
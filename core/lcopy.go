@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"slices"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -195,6 +198,90 @@ func (lom *LOM) RestoreToLocation() (exists bool) {
 	return exists
 }
 
+// RepairFromCopies is RestoreToLocation that validates content checksums (read-repair):
+// - restores main replica from the first healthy local copy
+// - rewrites (re-mirrors) the copies found corrupted along the way
+// - returns the number of repaired replicas including main (zero: no healthy copies)
+// - when nothing's repaired, returns the first non-checksum error, if any (otherwise, bad checksum)
+// - caller must remove corrupted main replica beforehand
+func (lom *LOM) RepairFromCopies() (n int, err error) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	var (
+		corrupted []*fs.Mountpath
+		saved     = lom.md.pushrt()
+		avail     = fs.GetAvail()
+		buf, slab = g.pmm.Alloc()
+	)
+	defer slab.Free(buf)
+	for path, mi := range avail {
+		if path == lom.mi.Path {
+			continue
+		}
+		fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName)
+		if err := cos.Stat(fqn); err != nil {
+			continue
+		}
+		dst, errR := lom._repair(fqn, buf)
+		if errR == nil {
+			lom.md = dst.md
+			lom.md.poprt(saved)
+			FreeLOM(dst)
+			n++
+			break
+		}
+		if dst != nil {
+			FreeLOM(dst)
+		}
+		switch {
+		case cos.IsErrBadCksum(errR):
+			corrupted = append(corrupted, mi)
+			if err == nil {
+				err = errR
+			}
+		case err == nil || cos.IsErrBadCksum(err):
+			err = errR
+		}
+		nlog.Warningln("failed to restore", lom.Cname(), "from", fqn, "err:", errR)
+	}
+	if n == 0 {
+		if err == nil {
+			err = cos.NewErrNotFound(T, lom.Cname()+" (no local replicas)")
+		}
+		return 0, err
+	}
+	err = nil
+	for _, mi := range corrupted {
+		// remove first - otherwise, lom.Copy is a no-op when metadata matches
+		fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName)
+		if err := cos.RemoveFile(fqn); err != nil {
+			nlog.Warningln("failed to remove corrupted copy", fqn, "err:", err)
+			continue
+		}
+		if err := lom.Copy(mi, buf); err != nil {
+			nlog.Warningln("failed to rewrite corrupted copy", fqn, "err:", err)
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (lom *LOM) _repair(fqn string, buf []byte) (dst *LOM, err error) {
+	src := lom.CloneTo(fqn)
+	defer FreeLOM(src)
+	if err = src.InitFQN(fqn, lom.Bucket()); err != nil {
+		return
+	}
+	if err = src.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if err = src.ValidateContentChecksum(true /*locked*/); err != nil {
+		return
+	}
+	return src.Copy2FQN(lom.FQN, buf)
+}
+
 func (lom *LOM) _restore(fqn string, buf []byte) (dst *LOM, err error) {
 	src := lom.CloneTo(fqn)
 	defer FreeLOM(src)
@@ -414,9 +501,15 @@ func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested er
 	}
 
 	workFQN := dst.GenFQN(fs.WorkCT, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, dstCksumTy)
-	if err != nil {
-		return err, nil, false
+	if dstCksum = lom._reflink(dst, workFQN, dstCksumTy); dstCksum == nil {
+		if lom.IsEncoded() {
+			dstCksum, err = lom.copyEncoded(workFQN, buf, dstCksumTy)
+		} else {
+			_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, dstCksumTy)
+		}
+		if err != nil {
+			return err, nil, false
+		}
 	}
 
 	if !sameBucket {
@@ -464,17 +557,74 @@ func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested er
 	return err, nested, locked
 }
 
+// same mountpath: try to reflink instead of reading and writing the data
+// - requires the source checksum of the destination's type (since there's no data to compute it over)
+// - returns nil when not applicable or not supported by the filesystem (caller falls back to copying)
+func (lom *LOM) _reflink(dst *LOM, workFQN, dstCksumTy string) *cos.CksumHash {
+	if lom.IsChunked() || lom.mi.Path != dst.mi.Path {
+		return nil
+	}
+	var cksum *cos.CksumHash
+	if dstCksumTy != cos.ChecksumNone {
+		srcCksum := lom.Checksum()
+		if srcCksum == nil || srcCksum.Ty() != dstCksumTy || srcCksum.Val() == "" {
+			return nil
+		}
+		cksum = &cos.CksumHash{Cksum: *srcCksum.Clone()}
+	} else {
+		cksum = &cos.CksumHash{Cksum: *cos.NoneCksum}
+	}
+	if err := fs.Reflink(lom.FQN, workFQN); err != nil {
+		if cmn.Rom.V(5, cos.ModCore) {
+			nlog.Infoln("reflink", lom.Cname(), "=>", dst.Cname(), "not supported, falling back to copy:", err)
+		}
+		return nil
+	}
+	T.StatsUpdater().Inc(CopyLocalCount)
+	return cksum
+}
+
+// (cmn.ReadPolicyRoundRobin)
+var rrCopy atomic.Uint64
+
 // load-balanced GET from replicated lom
-// - picks least-utilized mountpath
-// - returns (open reader + its FQN) or (nil, "")
-func (lom *LOM) OpenCopy() (cos.LomReader, string) {
+// - picks least-utilized mountpath (cmn.ReadPolicyLeastBusy) or next in turn (cmn.ReadPolicyRoundRobin)
+// - returns (open reader + its FQN and mountpath) or (nil, "", nil) when the pick is the primary
+func (lom *LOM) OpenCopy(policy string) (cos.LomReader, string, *fs.Mountpath) {
 	debug.Assert(lom.IsLocked() > apc.LockNone, lom.Cname(), " is not locked")
 	debug.Assert(!lom.IsChunked())
 
 	if !lom.HasCopies() {
-		return nil, ""
+		return nil, "", nil
 	}
 
+	var fqn string
+	switch policy {
+	case cmn.ReadPolicyLeastBusy:
+		fqn = lom.leastBusyCopy()
+	case cmn.ReadPolicyRoundRobin:
+		fqn = lom.nextCopy()
+	default:
+		debug.Assert(false, policy)
+		return nil, "", nil
+	}
+	if fqn == lom.FQN {
+		return nil, "", nil
+	}
+	cmi := lom.md.copies[fqn]
+	if lom.IsEncoded() {
+		if lh, err := lom.openEncoded(fqn); err == nil {
+			return lh, fqn, cmi
+		}
+		return nil, "", nil
+	}
+	if lh, err := os.Open(fqn); err == nil { // (compare w/ lom.Open())
+		return lh, fqn, cmi
+	}
+	return nil, "", nil
+}
+
+func (lom *LOM) leastBusyCopy() string {
 	var (
 		fqn   = lom.FQN
 		utils = fs.GetAllMpathUtils()
@@ -488,13 +638,20 @@ func (lom *LOM) OpenCopy() (cos.LomReader, string) {
 			fqn, curr = cfqn, cutil
 		}
 	}
-	if fqn == lom.FQN {
-		return nil, ""
-	}
-	if lh, err := os.Open(fqn); err == nil { // (compare w/ lom.Open())
-		return lh, fqn
+	return fqn
+}
+
+// sorted for the order to be the same across (concurrent) readers
+func (lom *LOM) nextCopy() string {
+	var (
+		buf  [8]string
+		fqns = buf[:0]
+	)
+	for cfqn := range lom.md.copies {
+		fqns = append(fqns, cfqn)
 	}
-	return nil, ""
+	slices.Sort(fqns)
+	return fqns[rrCopy.Inc()%uint64(len(fqns))]
 }
 
 // returns the least-utilized mountpath that does _not_ have a copy of this `lom` yet
@@ -40,6 +40,7 @@ type (
 	ETLArgs struct {
 		TransformArgs string          // optional and ETL-specific; can be used to indicate transformation on a per-object basis
 		Pipeline      apc.ETLPipeline // intermediate ETL pod's address or destination target's address for direct put
+		Timeout       time.Duration   // per-request timeout (zero: ETL's obj_timeout)
 	}
 	GetROC func(lom *LOM, latestVer, sync bool, args *ETLArgs) ReadResp
 
@@ -0,0 +1,352 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Objects stored encrypted (at rest) - see cmn.EncryptionConf
+//
+// AES-256-GCM over fixed-size blocks (to support random access - range reads and archived files):
+//
+//	[sealed block 0] ... [sealed block N-1]
+//
+// where each sealed block is up to encBlockSize bytes of ciphertext followed by the GCM tag;
+// an empty object is a single sealed empty block.
+//
+// Per-block nonce is the object's (random) nonce with its last 8 bytes XOR-ed with the block number;
+// additional authenticated data is the block number and the last-block marker, to detect
+// reordered and truncated content.
+//
+// The key reference and the nonce are stored in the object's metadata (see lmeta.enc).
+// When the bucket also compresses, compression applies first (see core/lcompress.go).
+
+const (
+	encBlockSize = 64 * 1024
+	encTagSize   = 16
+	encNonceSize = 12
+	encKeySize   = 32 // AES-256
+	encSealed    = encBlockSize + encTagSize
+	encSepa      = ":" // lmeta.enc: <key ref>:<hex nonce>
+)
+
+type (
+	encReader struct {
+		lom   *LOM
+		fh    *os.File
+		aead  cipher.AEAD
+		blk   []byte // current decrypted block
+		cbuf  []byte // sealed block
+		nonce [encNonceSize]byte
+		size  int64 // decrypted size
+		fsize int64 // stored size
+		off   int64 // Read() offset
+		curr  int   // current block (-1 none)
+		nblks int
+		mu    sync.Mutex
+	}
+)
+
+// interface guard
+var _ cos.LomReader = (*encReader)(nil)
+
+// key ref => *encKey; cached keys get reloaded every encKeyTTL,
+// to pick up updated (rotated) and removed (revoked) keys
+const encKeyTTL = time.Minute
+
+type encKey struct {
+	aead   cipher.AEAD
+	loaded int64 // mono time
+}
+
+var encKeys sync.Map
+
+func (lom *LOM) IsEncrypted() bool { return lom.md.enc != "" }
+
+// stored compressed and/or encrypted (i.e., not as is)
+func (lom *LOM) IsEncoded() bool { return lom.IsCompressed() || lom.IsEncrypted() }
+
+// SetEncrypted records the encryption returned by EncryptWork (empty: not encrypted);
+// persisted with the next Persist/PersistMain call on this LOM.
+func (lom *LOM) SetEncrypted(enc string) { lom.md.enc = enc }
+
+// EncryptWork encrypts work file in place if the bucket is configured to do so;
+// returns the (key reference, nonce) to be recorded via SetEncrypted after finalizing the object.
+// Unlike compression, failure to encrypt must fail the write.
+func (lom *LOM) EncryptWork(wfqn string) (string, error) {
+	conf := &lom.Bprops().Encryption
+	if !conf.Enabled {
+		return "", nil
+	}
+	aead, err := encAEAD(conf.KeyRef)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	var nonce [encNonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", err
+	}
+	src, err := os.Open(wfqn)
+	if err != nil {
+		return "", err
+	}
+	efqn := lom.GenFQN(fs.WorkCT, fs.WorkfileEncrypt)
+	err = lom._encrypt(src, efqn, aead, nonce[:])
+	cos.Close(src)
+	if err == nil {
+		err = cos.Rename(efqn, wfqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(efqn); errRm != nil && !cos.IsNotExist(errRm) {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return "", err
+	}
+	return conf.KeyRef + encSepa + hex.EncodeToString(nonce[:]), nil
+}
+
+func (lom *LOM) _encrypt(src *os.File, efqn string, aead cipher.AEAD, nonce []byte) error {
+	finfo, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	dst, err := lom.CreateWork(efqn)
+	if err != nil {
+		return err
+	}
+	var (
+		plain, ps = g.pmm.AllocSize(encBlockSize)
+		sealed, s = g.pmm.AllocSize(encSealed)
+		nblks     = max(1, int((finfo.Size()+encBlockSize-1)/encBlockSize))
+		bnonce    [encNonceSize]byte
+		aad       [9]byte
+	)
+	defer func() {
+		ps.Free(plain)
+		s.Free(sealed)
+	}()
+	plain = plain[:encBlockSize]
+	for i := range nblks {
+		n, err := io.ReadFull(src, plain)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			cos.Close(dst)
+			return err
+		}
+		if i < nblks-1 && n != encBlockSize {
+			cos.Close(dst)
+			return fmt.Errorf("%s: unexpected short read (%d, block #%d)", lom.Cname(), n, i)
+		}
+		encBlockNonce(bnonce[:], nonce, i)
+		encBlockAAD(aad[:], i, i == nblks-1)
+		out := aead.Seal(sealed[:0], bnonce[:], plain[:n], aad[:])
+		if _, err := dst.Write(out); err != nil {
+			cos.Close(dst)
+			return err
+		}
+	}
+	if lom.IsFeatureSet(feat.FsyncPUT) {
+		if err := dst.Sync(); err != nil {
+			cos.Close(dst)
+			return err
+		}
+	}
+	return dst.Close()
+}
+
+func encBlockNonce(dst, nonce []byte, i int) {
+	copy(dst, nonce)
+	x := binary.BigEndian.Uint64(dst[encNonceSize-8:]) ^ uint64(i)
+	binary.BigEndian.PutUint64(dst[encNonceSize-8:], x)
+}
+
+func encBlockAAD(dst []byte, i int, last bool) {
+	binary.BigEndian.PutUint64(dst, uint64(i))
+	dst[8] = 0
+	if last {
+		dst[8] = 1
+	}
+}
+
+//
+// keys
+//
+
+func encAEAD(keyRef string) (cipher.AEAD, error) {
+	if v, ok := encKeys.Load(keyRef); ok {
+		if ek := v.(*encKey); mono.Since(ek.loaded) < encKeyTTL {
+			return ek.aead, nil
+		}
+	}
+	key, err := loadEncKey(keyRef)
+	if err != nil {
+		encKeys.Delete(keyRef)
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	encKeys.Store(keyRef, &encKey{aead: aead, loaded: mono.NanoTime()})
+	return aead, nil
+}
+
+// secrets directory first, environment second (see env.AisEncryptionKeysDir)
+func loadEncKey(keyRef string) ([]byte, error) {
+	if dir := os.Getenv(env.AisEncryptionKeysDir); dir != "" {
+		b, err := os.ReadFile(filepath.Join(dir, keyRef))
+		if err == nil {
+			return decodeEncKey(keyRef, string(b))
+		}
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("encryption key %q: %w", keyRef, err)
+		}
+	}
+	name := env.AisEncryptionKeyPrefix + strings.ReplaceAll(strings.ToUpper(keyRef), "-", "_")
+	if v := os.Getenv(name); v != "" {
+		return decodeEncKey(keyRef, v)
+	}
+	return nil, fmt.Errorf("encryption key %q not found (see %s and %s)", keyRef, env.AisEncryptionKeysDir, name)
+}
+
+// 32 bytes, hex or base64 encoded
+func decodeEncKey(keyRef, s string) (key []byte, err error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 2*encKeySize {
+		key, err = hex.DecodeString(s)
+	} else {
+		key, err = base64.StdEncoding.DecodeString(s)
+	}
+	if err == nil && len(key) != encKeySize {
+		err = fmt.Errorf("invalid size %d (expecting %d bytes)", len(key), encKeySize)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encryption key %q: %w", keyRef, err)
+	}
+	return key, nil
+}
+
+//
+// read
+//
+
+func (lom *LOM) newEncReader(fh *os.File, fsize int64) (*encReader, error) {
+	keyRef, snonce, ok := strings.Cut(lom.md.enc, encSepa)
+	if !ok || hex.DecodedLen(len(snonce)) != encNonceSize {
+		return nil, cmn.NewErrLmetaCorrupted(fmt.Errorf("%s: invalid encryption metadata %q", lom.Cname(), lom.md.enc))
+	}
+	aead, err := encAEAD(keyRef)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	r := &encReader{lom: lom, fh: fh, aead: aead, fsize: fsize, curr: -1}
+	if _, err := hex.Decode(r.nonce[:], []byte(snonce)); err != nil {
+		return nil, cmn.NewErrLmetaCorrupted(fmt.Errorf("%s: invalid encryption nonce: %w", lom.Cname(), err))
+	}
+	r.nblks = int((fsize + encSealed - 1) / encSealed)
+	last := fsize - int64(r.nblks-1)*encSealed
+	if r.nblks == 0 || last < encTagSize || (r.nblks > 1 && last == encTagSize) {
+		return nil, fmt.Errorf("%s: invalid encrypted size %d", lom.Cname(), fsize)
+	}
+	r.size = fsize - int64(r.nblks)*encTagSize
+	if !lom.IsCompressed() && r.size != lom.Lsize(true) {
+		return nil, cmn.NewErrLmetaCorrupted(lom.whingeSize(r.size))
+	}
+	return r, nil
+}
+
+func (r *encReader) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.off)
+	r.off += int64(n)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *encReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	r.mu.Lock()
+	for n < len(p) && err == nil {
+		if off >= r.size {
+			err = io.EOF
+			break
+		}
+		i := int(off / encBlockSize)
+		if i != r.curr {
+			if err = r.load(i); err != nil {
+				break
+			}
+		}
+		m := copy(p[n:], r.blk[off-int64(i)*encBlockSize:])
+		n += m
+		off += int64(m)
+	}
+	r.mu.Unlock()
+	return n, err
+}
+
+// read and decrypt block #i
+func (r *encReader) load(i int) error {
+	if r.blk == nil {
+		r.blk, _ = g.pmm.AllocSize(encBlockSize)
+		r.cbuf, _ = g.pmm.AllocSize(encSealed)
+	}
+	var (
+		off    = int64(i) * encSealed
+		cbuf   = r.cbuf[:min(encSealed, r.fsize-off)]
+		bnonce [encNonceSize]byte
+		aad    [9]byte
+	)
+	if _, err := r.fh.ReadAt(cbuf, off); err != nil {
+		return err
+	}
+	encBlockNonce(bnonce[:], r.nonce[:], i)
+	encBlockAAD(aad[:], i, i == r.nblks-1)
+	r.curr = -1 // Open overwrites r.blk (and zeroes it on failure)
+	blk, err := r.aead.Open(r.blk[:0], bnonce[:], cbuf, aad[:])
+	if err != nil {
+		return fmt.Errorf("%s: failed to decrypt block #%d: %w", r.lom.Cname(), i, err)
+	}
+	r.blk = blk
+	r.curr = i
+	return nil
+}
+
+func (r *encReader) Close() error {
+	if r.blk != nil {
+		g.pmm.Free(r.blk)
+		g.pmm.Free(r.cbuf)
+		r.blk, r.cbuf = nil, nil
+	}
+	return r.fh.Close()
+}
@@ -0,0 +1,63 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// cached keys get reloaded upon expiration: rotated and removed keys take effect
+func TestEncKeyRefresh(t *testing.T) {
+	const keyRef = "refresh-test"
+	var (
+		dir   = t.TempDir()
+		fqn   = filepath.Join(dir, keyRef)
+		nonce = make([]byte, encNonceSize)
+		seal  = func(aead cipher.AEAD) []byte { return aead.Seal(nil, nonce, []byte("plain"), nil) }
+		put   = func(b byte) {
+			key := bytes.Repeat([]byte{b}, encKeySize)
+			tassert.CheckFatal(t, os.WriteFile(fqn, []byte(hex.EncodeToString(key)), cos.PermRWR))
+		}
+		expire = func() {
+			v, ok := encKeys.Load(keyRef)
+			tassert.Fatalf(t, ok, "expected cached key")
+			v.(*encKey).loaded = mono.NanoTime() - int64(encKeyTTL) - 1
+		}
+	)
+	t.Setenv(env.AisEncryptionKeysDir, dir)
+	t.Cleanup(func() { encKeys.Delete(keyRef) })
+
+	put(1)
+	aead1, err := encAEAD(keyRef)
+	tassert.CheckFatal(t, err)
+
+	// rotated: cached until expired
+	put(2)
+	aead, err := encAEAD(keyRef)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(seal(aead), seal(aead1)), "expected cached key")
+	expire()
+	aead, err = encAEAD(keyRef)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !bytes.Equal(seal(aead), seal(aead1)), "expected rotated key")
+
+	// removed
+	tassert.CheckFatal(t, os.Remove(fqn))
+	expire()
+	_, err = encAEAD(keyRef)
+	tassert.Errorf(t, err != nil, "expected removed key to fail")
+	_, ok := encKeys.Load(keyRef)
+	tassert.Errorf(t, !ok, "expected removed key uncached")
+}
@@ -0,0 +1,227 @@
+// Package core_test provides tests for objects stored encrypted
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LOM encryption", func() {
+	const (
+		tmpDir     = "/tmp/lencrypt_test"
+		oneMpath   = tmpDir + "/onempath"
+		bucketName = "LENCRYPT_TEST_Bucket"
+		cmprBucket = "LENCRYPT_TEST_Cmpr"
+		nokeyBck   = "LENCRYPT_TEST_NoKey"
+		keyRef     = "test-key-1"
+		keyEnv     = env.AisEncryptionKeyPrefix + "TEST_KEY_1"
+	)
+
+	var (
+		mix      = fs.Mountpath{Path: oneMpath}
+		localBck = cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		cmprBck  = cmn.Bck{Name: cmprBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		noKey    = cmn.Bck{Name: nokeyBck, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmdMock  = mock.NewBaseBownerMock(
+			meta.NewBck(
+				bucketName, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:      cmn.CksumConf{Type: cos.ChecksumOneXxh},
+					Encryption: cmn.EncryptionConf{Enabled: true, KeyRef: keyRef},
+					BID:        401,
+				},
+			),
+			meta.NewBck(
+				cmprBucket, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:       cmn.CksumConf{Type: cos.ChecksumOneXxh},
+					Compression: cmn.CompressionConf{Enabled: true, Algo: apc.LZ4Compression},
+					Encryption:  cmn.EncryptionConf{Enabled: true, KeyRef: keyRef},
+					BID:         402,
+				},
+			),
+			meta.NewBck(
+				nokeyBck, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:      cmn.CksumConf{Type: cos.ChecksumOneXxh},
+					Encryption: cmn.EncryptionConf{Enabled: true, KeyRef: "missing-key"},
+					BID:        403,
+				},
+			),
+		)
+	)
+
+	BeforeEach(func() {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		os.Setenv(keyEnv, hex.EncodeToString(key))
+		_ = cos.CreateDir(oneMpath)
+		_, _ = fs.AddTestMpath(oneMpath, "daeID")
+		_ = mock.NewTarget(bmdMock)
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(oneMpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	// write work file and finalize it (compare w/ ais/tgtobj.go poi.fini)
+	put := func(bck *cmn.Bck, objName string, data []byte) *core.LOM {
+		fqn := mix.MakePathFQN(bck, fs.ObjCT, objName)
+		lom := newBasicLom(fqn, int64(len(data)))
+		wfqn := lom.GenFQN(fs.WorkCT, fs.WorkfilePut)
+		Expect(cos.CreateDir(filepath.Dir(wfqn))).NotTo(HaveOccurred())
+		Expect(os.WriteFile(wfqn, data, cos.PermRWR)).NotTo(HaveOccurred())
+
+		compressed, err := lom.CompressWork(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		enc, err := lom.EncryptWork(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+		lom.SetCompressed(compressed)
+		lom.SetEncrypted(enc)
+		lom.IncVersion()
+		Expect(persist(lom)).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+
+		loaded := newBasicLom(fqn)
+		Expect(loaded.Load(false, false)).NotTo(HaveOccurred())
+		Expect(loaded.Lsize()).To(Equal(int64(len(data))))
+		Expect(loaded.IsEncrypted()).To(BeTrue())
+		return loaded
+	}
+
+	readAll := func(lom *core.LOM) []byte {
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		lh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		got, err := io.ReadAll(lh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lh.Close()).NotTo(HaveOccurred())
+		return got
+	}
+
+	It("should store objects encrypted and read them back", func() {
+		data := make([]byte, 3*64*cos.KiB+77)
+		_, _ = rand.Read(data)
+		lom := put(&localBck, "enc/obj", data)
+
+		stored, err := os.ReadFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(stored)).To(Equal(len(data) + 4*16))
+		Expect(bytes.Contains(stored, data[:64])).To(BeFalse())
+
+		Expect(readAll(lom)).To(Equal(data))
+
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		lh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+
+		// random access across block boundaries
+		for _, rng := range [][2]int{{0, 1}, {64*cos.KiB - 10, 20}, {100_000, 90_000}, {len(data) - 7, 7}} {
+			buf := make([]byte, rng[1])
+			n, err := lh.ReadAt(buf, int64(rng[0]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf[:n]).To(Equal(data[rng[0] : rng[0]+rng[1]]))
+		}
+
+		// section (range read)
+		r, err := lom.NewSectionReader(lh, 70_000, 1000)
+		Expect(err).NotTo(HaveOccurred())
+		got, err := io.ReadAll(r)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(data[70_000:71_000]))
+		Expect(lh.Close()).NotTo(HaveOccurred())
+
+		// checksum is computed over the original content
+		cksum, err := lom.ComputeCksum(cos.ChecksumOneXxh, true /*locked*/)
+		Expect(err).NotTo(HaveOccurred())
+		expected, err := cos.ChecksumBytes(data, cos.ChecksumOneXxh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cksum.Value()).To(Equal(expected.Value()))
+	})
+
+	It("should encrypt empty objects", func() {
+		lom := put(&localBck, "enc/empty", []byte{})
+		Expect(readAll(lom)).To(BeEmpty())
+	})
+
+	It("should compress, then encrypt", func() {
+		var b bytes.Buffer
+		for i := 0; b.Len() < 300*cos.KiB; i++ {
+			b.WriteString("line " + strconv.Itoa(i) + ": the quick brown fox jumps over the lazy dog\n")
+		}
+		data := b.Bytes()
+		lom := put(&cmprBck, "enc/cmpr", data)
+		Expect(lom.IsCompressed()).To(BeTrue())
+
+		finfo, err := os.Stat(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(finfo.Size()).To(BeNumerically("<", len(data)))
+		Expect(readAll(lom)).To(Equal(data))
+	})
+
+	It("should detect tampering", func() {
+		data := make([]byte, 100*cos.KiB)
+		_, _ = rand.Read(data)
+		lom := put(&localBck, "enc/tamper", data)
+
+		stored, err := os.ReadFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		stored[70_000] ^= 1
+		Expect(os.WriteFile(lom.FQN, stored, cos.PermRWR)).NotTo(HaveOccurred())
+
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		lh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		_, err = io.ReadAll(lh)
+		Expect(err).To(HaveOccurred())
+
+		// the failed block must not leave stale (zeroed) content behind
+		buf := make([]byte, 100)
+		n, err := lh.ReadAt(buf, 1000)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buf[:n]).To(Equal(data[1000:1100]))
+		_, err = lh.ReadAt(buf, 70_000)
+		Expect(err).To(HaveOccurred())
+		n, err = lh.ReadAt(buf, 1000)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buf[:n]).To(Equal(data[1000:1100]))
+		Expect(lh.Close()).NotTo(HaveOccurred())
+	})
+
+	It("should fail to write when the key is missing", func() {
+		fqn := mix.MakePathFQN(&noKey, fs.ObjCT, "enc/nokey")
+		lom := newBasicLom(fqn, 10)
+		wfqn := lom.GenFQN(fs.WorkCT, fs.WorkfilePut)
+		Expect(cos.CreateDir(filepath.Dir(wfqn))).NotTo(HaveOccurred())
+		Expect(os.WriteFile(wfqn, []byte("0123456789"), cos.PermRWR)).NotTo(HaveOccurred())
+
+		_, err := lom.EncryptWork(wfqn)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("missing-key"))
+	})
+})
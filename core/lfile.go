@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -88,9 +89,12 @@ var _ io.ReaderAt = (*os.File)(nil) // sanity (whereby UfestReader is guarded el
 // see also: lom.GetROC()
 func (lom *LOM) Open() (lh cos.LomReader, err error) {
 	debug.Assert(lom.IsLocked() > apc.LockNone, lom.Cname(), " is not locked")
-	if lom.IsChunked() {
+	switch {
+	case lom.IsChunked():
 		lh, err = lom.NewUfestReader()
-	} else {
+	case lom.IsEncoded():
+		lh, err = lom.openEncoded(lom.FQN)
+	default:
 		lh, err = os.Open(lom.FQN)
 	}
 	switch {
@@ -106,6 +110,41 @@ func (lom *LOM) Open() (lh cos.LomReader, err error) {
 	}
 }
 
+// open object (or its copy) stored compressed and/or encrypted:
+// file => [decrypt] => [decompress] => reader
+func (lom *LOM) openEncoded(fqn string) (cos.LomReader, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		cos.Close(fh)
+		return nil, err
+	}
+	var (
+		lh    cos.LomReader = fh
+		fsize               = finfo.Size()
+	)
+	if lom.IsEncrypted() {
+		er, err := lom.newEncReader(fh, fsize)
+		if err != nil {
+			cos.Close(fh)
+			return nil, err
+		}
+		lh, fsize = er, er.size
+	}
+	if lom.IsCompressed() {
+		cr, err := lom.newCmprReader(lh, fsize)
+		if err != nil {
+			cos.Close(lh)
+			return nil, err
+		}
+		lh = cr
+	}
+	return lh, nil
+}
+
 //
 // create
 //
@@ -205,8 +244,12 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 		// NOTE: making "rlock" exception to be able to forcefully rm corrupted object in the GET path
 		return len(force) > 0 && force[0] && locked == apc.LockRead
 	})
-	err = lom.RemoveMain()
-	if err == nil {
+	err = os.Remove(lom.FQN)
+	switch {
+	case err == nil:
+		BcountDec(lom.Bck())
+		err = lom._cleanup()
+	case os.IsNotExist(err):
 		err = lom._cleanup()
 	}
 	lom.md.lid = 0
@@ -245,6 +288,54 @@ func (lom *LOM) _cleanup() error {
 	return errors.Join(errs...)
 }
 
+//
+// trash (soft delete)
+//
+
+// MoveToTrash moves the object to its bucket's trash (fs.TrashCT) on the same mountpath
+// and removes its copies, if any; the time of deletion is recorded as the trashed file's mtime.
+// The caller must w-lock and load the object (see also: RestoreFromTrash).
+func (lom *LOM) MoveToTrash() error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	if lom.IsChunked() {
+		return cmn.NewErrUnsupp("soft-delete", "chunked object "+lom.Cname())
+	}
+	if lom.HasCopies() {
+		if err := lom.DelAllCopies(); err != nil {
+			return err
+		}
+	}
+	tfqn := lom.GenFQN(fs.TrashCT)
+	if err := lom.RenameMainTo(tfqn); err != nil {
+		return err
+	}
+	BcountDec(lom.Bck())
+	lom.UncacheDel()
+	now := time.Now()
+	return os.Chtimes(tfqn, now, now)
+}
+
+// RestoreFromTrash moves previously soft-deleted object back in place
+// and loads it; the caller must w-lock.
+func (lom *LOM) RestoreFromTrash() error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	tfqn := lom.GenFQN(fs.TrashCT)
+	if err := cos.Stat(tfqn); err != nil {
+		if cos.IsNotExist(err) {
+			return cos.NewErrNotFound(T, "soft-deleted "+lom.Cname())
+		}
+		return err
+	}
+	if err := cos.Stat(lom.FQN); err == nil {
+		return cos.NewErrAlreadyExists(T, lom.Cname())
+	}
+	if err := lom.RenameToMain(tfqn); err != nil {
+		return err
+	}
+	BcountInc(lom.Bck())
+	return lom.Load(false /*cache it*/, true /*locked*/)
+}
+
 //
 // rename
 //
@@ -314,6 +405,26 @@ func (lom *LOM) _enotdir(path string, e error) (bool, error) {
 	return false, nil
 }
 
+// renames work => main while telling a new object from an overwrite
+// without stat-ing the latter (see bcount)
+func (lom *LOM) renameNew(wfqn string) error {
+	err := fs.RenameNoReplace(wfqn, lom.FQN)
+	switch {
+	case err == nil:
+		BcountInc(lom.Bck())
+		return nil
+	case cos.IsNotExist(err):
+		// e.g., first object in a (virtual) directory - see cos.Rename slow path
+		if err = lom.RenameToMain(wfqn); err == nil {
+			BcountInc(lom.Bck())
+		}
+		return err
+	default:
+		// EEXIST (overwrite) or EINVAL (not supported)
+		return lom.RenameToMain(wfqn)
+	}
+}
+
 func (lom *LOM) RenameFinalize(wfqn string) error {
 	bdir := lom.mi.MakePathBck(lom.Bucket())
 	if err := cos.Stat(bdir); err != nil {
@@ -331,8 +442,11 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 		saved = lom.PushFntl(short)
 	}
 
-	err := lom.RenameToMain(wfqn)
+	err := lom.renameNew(wfqn)
 	if err == nil {
+		// (callers that store compressed and/or encrypted set it back - see CompressWork and EncryptWork)
+		lom.SetCompressed(false)
+		lom.md.enc = ""
 		if len(saved) > 0 {
 			if _, ok := lom.GetCustomKey(cmn.OrigFntl); !ok {
 				lom.SetCustomKey(cmn.OrigFntl, saved[0])
@@ -501,7 +615,9 @@ func (lom *LOM) newSection(lh cos.LomReader, off, size int64, own bool) (cos.Rea
 		return &ufestSection{LimitedReader: io.LimitedReader{R: r, N: size}, baseSection: base}, nil
 	}
 
-	fh, ok := lh.(*os.File)
-	debug.Assert(ok)
-	return &fileSection{SectionReader: io.NewSectionReader(fh, off, size), baseSection: base}, nil
+	if debug.ON() {
+		_, ok := lh.(*os.File)
+		debug.Assert(ok || lom.IsEncoded())
+	}
+	return &fileSection{SectionReader: io.NewSectionReader(lh, off, size), baseSection: base}, nil
 }
@@ -0,0 +1,50 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object heat: access frequency that, unlike atime, distinguishes genuinely hot
+// objects from one-off reads.
+//
+// Heat is a saturating 16-bit counter that gets incremented upon every warm GET
+// and halves every HeatHalfLife since the last access (atime). It is kept in lmeta
+// flags and is therefore persisted along with other object metadata (best-effort,
+// in-memory updates are not flushed on their own).
+
+const HeatHalfLife = time.Hour
+
+const maxHeat = lmflHeatMask >> lmflHeatShift
+
+func (md *lmeta) heat() uint64 { return (md.flags & lmflHeatMask) >> lmflHeatShift }
+
+func (md *lmeta) setHeat(h uint64) {
+	md.flags = (md.flags &^ lmflHeatMask) | (min(h, maxHeat) << lmflHeatShift)
+}
+
+// current (decayed) heat as of `now` (Unix nanoseconds)
+func (lom *LOM) Heat(now int64) int64 {
+	return int64(decayHeat(lom.md.heat(), lom.md.Atime, now))
+}
+
+// must be called prior to updating atime (that is, with the previous access time in place)
+func (lom *LOM) IncHeat(now int64) {
+	lom.md.setHeat(decayHeat(lom.md.heat(), lom.md.Atime, now) + 1)
+}
+
+func decayHeat(h uint64, atime, now int64) uint64 {
+	if h == 0 || atime <= 0 || !cos.IsValidAtime(atime) || now <= atime {
+		return h
+	}
+	n := (now - atime) / int64(HeatHalfLife)
+	if n >= 16 { // all bits shifted out
+		return 0
+	}
+	return h >> n
+}
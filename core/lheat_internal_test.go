@@ -0,0 +1,45 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLomHeat(t *testing.T) {
+	var (
+		now   = time.Now().UnixNano()
+		hl    = int64(HeatHalfLife)
+		tests = []struct {
+			heat, expected uint64
+			atime          int64
+		}{
+			{0, 0, now - hl},
+			{8, 8, now},
+			{8, 8, now - hl + 1},
+			{8, 4, now - hl},
+			{8, 1, now - 3*hl},
+			{8, 0, now - 4*hl},
+			{maxHeat, 0, now - 16*hl},
+			{8, 8, 0}, // no atime - no decay
+		}
+	)
+	for _, test := range tests {
+		got := decayHeat(test.heat, test.atime, now)
+		tassert.Errorf(t, got == test.expected, "decay(%d, -%v): expected %d, got %d",
+			test.heat, time.Duration(now-test.atime), test.expected, got)
+	}
+
+	// saturation; other flags must remain intact
+	md := lmeta{flags: lmflShardIdx | lmflHRW}
+	md.setHeat(maxHeat + 100)
+	tassert.Errorf(t, md.heat() == maxHeat, "expected saturated heat %d, got %d", maxHeat, md.heat())
+	md.setHeat(3)
+	tassert.Errorf(t, md.heat() == 3, "expected heat 3, got %d", md.heat())
+	tassert.Errorf(t, md.flags&(lmflShardIdx|lmflHRW) == lmflShardIdx|lmflHRW, "flags clobbered: %x", md.flags)
+}
@@ -91,6 +91,7 @@ func (lom *LOM) InitBck(bck *meta.Bck) (err error) {
 	if err = lom.bck.InitFast(T.Bowner()); err != nil {
 		return
 	}
+	lom.ObjName = lom.bck.NormObjName(lom.ObjName)
 	uname := lom.bck.MakeUname(lom.ObjName)
 	lom.md.uname = cos.UnsafeSptr(uname)
 	lom.mi, lom.digest, err = fs.Hrw(uname)
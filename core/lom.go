@@ -40,6 +40,9 @@ const (
 const (
 	RemoteDeletedDelCount = "remote.deleted.del.n"
 
+	// same-mountpath copy via reflink (no data read or written)
+	CopyLocalCount = "copy.local.n"
+
 	// lcache stats
 	LcacheCollisionCount = "lcache.collision.n"
 	LcacheEvictedCount   = "lcache.evicted.n"
@@ -48,10 +51,11 @@ const (
 )
 
 type (
-	lmeta struct { // sizeof = 72
+	lmeta struct { // sizeof = 96
 		copies fs.MPI
 		uname  *string
 		cmn.ObjAttrs
+		enc     string // encryption key reference and nonce, or empty (see core/lencrypt.go)
 		atimefs uint64 // (high bit `lomDirtyMask` | int64: atime)
 		lid     lomBID // (for bitwise structure, see lombid.go)
 		flags   uint64 // reserve (storage-class, compression/encryption, write-back, etc.)
@@ -469,6 +473,20 @@ func (lom *LOM) HrwTarget(smap *meta.Smap) (tsi *meta.Snode, local bool, err err
 	return
 }
 
+// pinned to this target regardless of HRW (see apc.ActMoveObject)
+func (lom *LOM) IsPinned() bool {
+	tid, ok := lom.GetCustomKey(cmn.PinnedObjMD)
+	return ok && tid == T.SID()
+}
+
+// alias (see apc.ActCreateAlias): zero-size object referring to another object in the same bucket
+func (lom *LOM) AliasOf() (string, bool) { return lom.GetCustomKey(cmn.AliasObjMD) }
+
+func (lom *LOM) IsAlias() bool {
+	_, ok := lom.AliasOf()
+	return ok
+}
+
 // Returns stored checksum (if present) and computed checksum (if requested)
 // MAY compute and store a missing (xxhash) checksum.
 // If xattr checksum is different than lom's metadata checksum, returns error
@@ -826,8 +844,8 @@ exist:
 		return err
 	}
 
-	// fstat & atime
-	if !lom.md.lid.haslmfl(lmflChunk) {
+	// fstat & atime (note: compressed and encrypted objects are validated upon open)
+	if !lom.md.lid.haslmfl(lmflChunk) && !lom.IsEncoded() {
 		if lom.md.Size != size { // corruption or tampering
 			return cmn.NewErrLmetaCorrupted(lom.whingeSize(size))
 		}
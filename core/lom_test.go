@@ -1097,6 +1097,105 @@ var _ = Describe("LOM", func() {
 				Expect(lom.GetCopies()).To(BeNil())
 			})
 		})
+
+		Describe("MoveToTrash and RestoreFromTrash", func() {
+			It("should soft-delete object with copies and restore it in place", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				expectedHash := getTestFileHash(lom.FQN)
+				trashFQN := lom.GenFQN(fs.TrashCT)
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(lom.HasCopies()).To(BeTrue())
+
+				// soft delete: main moved to trash (same mountpath), copies removed
+				Expect(lom.MoveToTrash()).NotTo(HaveOccurred())
+				Expect(lom.FQN).NotTo(BeAnExistingFile())
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+				Expect(trashFQN).To(BeARegularFile())
+				Expect(filepath.Dir(filepath.Dir(trashFQN))).To(HavePrefix(lom.Mountpath().Path))
+				finfo, err := os.Stat(trashFQN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(time.Since(finfo.ModTime())).To(BeNumerically("<", time.Minute)) // time of deletion
+
+				// restore
+				lom = newBasicLom(mirrorFQNs[0])
+				Expect(lom.RestoreFromTrash()).NotTo(HaveOccurred())
+				Expect(trashFQN).NotTo(BeAnExistingFile())
+				Expect(lom.Lsize()).To(BeEquivalentTo(testFileSize))
+				Expect(lom.Version()).To(Equal(desiredVersion))
+				Expect(getTestFileHash(lom.FQN)).To(Equal(expectedHash))
+
+				// nothing (left) to restore
+				err = lom.RestoreFromTrash()
+				Expect(err).To(BeAssignableToTypeOf(&cos.ErrNotFound{}))
+			})
+
+			It("should not restore over an existing object", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.Lock(true)
+				Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(lom.MoveToTrash()).NotTo(HaveOccurred())
+				lom.Unlock(true)
+
+				// re-created in the meantime
+				_ = prepareLOM(mirrorFQNs[0])
+				lom = newBasicLom(mirrorFQNs[0])
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				err := lom.RestoreFromTrash()
+				Expect(err).To(BeAssignableToTypeOf(&cos.ErrAlreadyExists{}))
+				Expect(lom.GenFQN(fs.TrashCT)).To(BeARegularFile())
+			})
+		})
+	})
+
+	Describe("approximate bucket count", func() {
+		It("should count new objects, not overwrites and non-existing", func() {
+			var (
+				bck   = meta.NewBck(bucketLocalA, apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 1})
+				lom1  = &core.LOM{ObjName: "bcount/obj1"}
+				lom2  = &core.LOM{ObjName: "bcount/obj2"}
+				count = func() int64 { return core.BcountGet(lom1.Bck()) }
+			)
+			Expect(lom1.InitBck(bck)).NotTo(HaveOccurred())
+			Expect(lom2.InitBck(bck)).NotTo(HaveOccurred())
+			Expect(cos.CreateDir(lom1.Mountpath().MakePathBck(bck.Bucket()))).NotTo(HaveOccurred())
+			Expect(cos.CreateDir(lom2.Mountpath().MakePathBck(bck.Bucket()))).NotTo(HaveOccurred())
+			core.BcountSet(lom1.Bck(), 0)
+
+			finalize := func(lom *core.LOM) {
+				wfqn := lom.GenFQN(fs.WorkCT, "bcount")
+				createTestFile(wfqn, cos.KiB)
+				lom.SetSize(cos.KiB)
+				Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+				Expect(persist(lom)).NotTo(HaveOccurred())
+			}
+
+			lom1.Lock(true)
+			defer lom1.Unlock(true)
+			lom2.Lock(true)
+			defer lom2.Unlock(true)
+
+			finalize(lom1) // new (and new directory)
+			Expect(count()).To(BeEquivalentTo(1))
+			finalize(lom1) // overwrite
+			Expect(count()).To(BeEquivalentTo(1))
+			finalize(lom2) // new
+			Expect(count()).To(BeEquivalentTo(2))
+
+			Expect(lom2.RemoveObj()).NotTo(HaveOccurred())
+			Expect(count()).To(BeEquivalentTo(1))
+			Expect(lom2.RemoveObj()).NotTo(HaveOccurred()) // does not exist
+			Expect(count()).To(BeEquivalentTo(1))
+
+			Expect(lom1.MoveToTrash()).NotTo(HaveOccurred())
+			Expect(count()).To(BeEquivalentTo(0))
+			Expect(lom1.RestoreFromTrash()).NotTo(HaveOccurred())
+			Expect(count()).To(BeEquivalentTo(1))
+		})
 	})
 
 	Describe("local and cloud bucket with the same name", func() {
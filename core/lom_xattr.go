@@ -100,6 +100,7 @@ const (
 	packedCustom
 	packedLid
 	packedFlags
+	packedEnc
 )
 
 const (
@@ -111,6 +112,7 @@ const (
 	haveCustom
 	haveLid
 	haveFlags
+	haveEnc
 )
 
 // packing format: separators
@@ -484,6 +486,12 @@ func (md *lmeta) unpack(buf []byte) error {
 			debug.Assert(flags&lmflHRW == 0, "unexpected persisted HRW bit")
 			md.flags = (md.flags & lmflHRW) | (flags &^ lmflHRW)
 			seen |= haveFlags
+		case packedEnc:
+			if seen&haveEnc != 0 {
+				return errors.New(badLmeta + " #8")
+			}
+			md.enc = string(record[cos.SizeofI16:])
+			seen |= haveEnc
 		default:
 			return errors.New(badLmeta + " #101")
 		}
@@ -492,6 +500,9 @@ func (md *lmeta) unpack(buf []byte) error {
 	if seen&haveSize != haveSize {
 		return errors.New(badLmeta + " #103")
 	}
+	if seen&haveEnc == 0 {
+		md.enc = ""
+	}
 	return md._setCksum(cksumType, cksumValue, seen&haveCksumT != 0, seen&haveCksumV != 0)
 }
 
@@ -552,6 +563,13 @@ func (md *lmeta) pack(mdSize int64) (buf []byte) {
 		buf = _pcustom(buf, custom)
 	}
 
+	// encryption
+	if md.enc != "" {
+		buf = g.smm.AppendBytes(buf, recdupSepa[:])
+		buf = _prso(buf, packedEnc)
+		buf = g.smm.AppendString(buf, md.enc)
+	}
+
 	// checksum, prepend, and return
 	buf[0] = MetaverLOM
 	buf[1] = mdCksumTyXXHash
@@ -68,8 +68,13 @@ func (lid lomBID) clrlmfl(fl lomFlags) lomBID {
 //
 
 const (
-	lmflHRW      = uint64(1) << 63 // high bit: object is at HRW location (runtime-only, never persisted)
-	lmflShardIdx = uint64(1) << 0  // persisted: object has an associated shard index in ais://.sys-shardidx
+	lmflHRW        = uint64(1) << 63 // high bit: object is at HRW location (runtime-only, never persisted)
+	lmflShardIdx   = uint64(1) << 0  // persisted: object has an associated shard index in ais://.sys-shardidx
+	lmflCompressed = uint64(1) << 1  // persisted: object is stored compressed (see core/lcompress.go)
+
+	// persisted: object heat - a 16-bit decaying access counter (see core/lheat.go)
+	lmflHeatShift = 32
+	lmflHeatMask  = uint64(0xffff) << lmflHeatShift
 )
 
 // runtime-only bits may need a (future) mask, e.g.:
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -68,6 +69,15 @@ func (b *Bck) MakeUname(name string, withExtraCap ...bool) []byte {
 	return (*cmn.Bck)(b).MakeUname(name, withExtraCap...)
 }
 
+// object name as stored - lowercased when the bucket has case-insensitive names
+// (see cmn.Bprops.CaseInsensitiveNames); must be called prior to HRW
+func (b *Bck) NormObjName(name string) string {
+	if b.Props != nil && b.Props.CaseInsensitiveNames {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
 // SysObjName returns the deterministic, collision-free object name for
 // storing content in a system bucket (.sys-*). name must be non-empty.
 //
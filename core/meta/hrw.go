@@ -153,6 +153,7 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+// When targets are configured with failure domains, prefers distinct domains (see spreadFD).
 
 func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
@@ -163,7 +164,12 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	}
 	b := cos.UnsafeBptr(uname)
 	digest := onexxh.Checksum64S(*b, cos.MLCG32)
-	hlist := newHrwList(count)
+	fd := smap.hasFailureDomains()
+	n := count
+	if fd {
+		n = cnt // rank them all, to spread (below)
+	}
+	hlist := newHrwList(n)
 
 	for _, tsi := range smap.Tmap {
 		cs := xoshiro256.Hash(tsi.digest() ^ digest)
@@ -173,6 +179,9 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 		hlist.add(cs, tsi)
 	}
 	sis = hlist.get()
+	if fd {
+		sis = spreadFD(sis, count)
+	}
 	if count != cnt && len(sis) < count {
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, len(sis), smap)
 		return nil, err
@@ -180,6 +189,39 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	return sis, nil
 }
 
+func (smap *Smap) hasFailureDomains() bool {
+	for _, tsi := range smap.Tmap {
+		if tsi.FailureDomain != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// reorder HRW-ranked targets to prefer distinct failure domains, and trim to count:
+// the top-ranked target from each domain first, then all the rest - both in HRW order
+// (targets with no configured domain count as one "empty" domain)
+// - is deterministic: same smap and name => same list
+// - sis[0] (the HRW owner) stays in place
+// - any shorter list is a prefix of a longer one
+func spreadFD(sis Nodes, count int) Nodes {
+	var (
+		out  = make(Nodes, 0, len(sis))
+		rest = make(Nodes, 0, len(sis))
+		seen = make(map[string]struct{}, len(sis))
+	)
+	for _, tsi := range sis {
+		if _, ok := seen[tsi.FailureDomain]; ok {
+			rest = append(rest, tsi)
+			continue
+		}
+		seen[tsi.FailureDomain] = struct{}{}
+		out = append(out, tsi)
+	}
+	out = append(out, rest...)
+	return out[:min(count, len(out))]
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
@@ -0,0 +1,50 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHrwTargetListFailureDomains(t *testing.T) {
+	const (
+		numRacks   = 3
+		perRack    = 4
+		numObjects = 200
+	)
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, numRacks*perRack), Pmap: make(meta.NodeMap)}
+	for i := range numRacks * perRack {
+		si := &meta.Snode{FailureDomain: fmt.Sprintf("rack-%d", i%numRacks)}
+		si.Init(fmt.Sprintf("t%07d", i), apc.Target, nil)
+		smap.Tmap.Add(si)
+	}
+	for i := range numObjects {
+		uname := fmt.Sprintf("ais/@#nnn/bucket/obj-%d", i)
+		sis, err := smap.HrwTargetList(&uname, numRacks+1)
+		tassert.CheckFatal(t, err)
+
+		// first numRacks targets: all distinct domains
+		domains := make(map[string]struct{}, numRacks)
+		for _, si := range sis[:numRacks] {
+			domains[si.FailureDomain] = struct{}{}
+		}
+		tassert.Fatalf(t, len(domains) == numRacks, "%s: expecting %d distinct domains, got %v", uname, numRacks, sis)
+
+		// HRW owner does not change
+		owner, err := smap.HrwName2T([]byte(uname))
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, sis[0].ID() == owner.ID(), "%s: owner %s vs %s", uname, sis[0], owner)
+
+		// shorter list is a prefix of the longer one
+		short, err := smap.HrwTargetList(&uname, 2)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, short[0] == sis[0] && short[1] == sis[1], "%s: %v is not a prefix of %v", uname, short, sis)
+	}
+}
@@ -80,6 +80,7 @@ type (
 		UUID         string  `json:"uuid"`          // is assigned once at creation time, never changes
 		CreationTime string  `json:"creation_time"` // UTC creation timestamp, cos.DateTimeSec
 		Version      int64   `json:"version,string"`
+		ReadOnly     bool    `json:"read_only,omitempty"` // cluster-wide read-only (maintenance) mode
 	}
 )
 
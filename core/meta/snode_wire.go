@@ -37,5 +37,8 @@ type (
 
 		// added in v5.0
 		VerifyingKey []byte `json:"verifying_key,omitempty" msg:"v,omitempty"`
+
+		// failure domain (rack, zone) - from local config, empty when not configured
+		FailureDomain string `json:"failure_domain,omitempty" msg:"fd,omitempty"`
 	}
 )
@@ -32,3 +32,4 @@ func (*IOS) RescanDisks(string, string, []string) ios.RescanDisksResult {
 func (*IOS) RemoveMpath(string, bool)      {}
 func (*IOS) LogAppend(l []string) []string { return l }
 func (*IOS) DiskStats(cos.AllDiskStats)    {}
+func (*IOS) DiskStatsExt(ios.AllDiskStats) {}
@@ -38,5 +38,7 @@ func (*StatsTracker) AddWith(...cos.NamedVal64)
 func (*StatsTracker) RegExtMetric(*meta.Snode, string, string, *stats.Extra)    {}
 func (*StatsTracker) GetMetricNames() cos.StrKVs                                { return nil }
 func (*StatsTracker) GetStats() *stats.Node                                     { return nil }
+func (*StatsTracker) AddRecentErr(string, *cmn.Bck, string, error)              {}
+func (*StatsTracker) GetRecentErrs(int) []stats.RecentErr                       { return nil }
 func (*StatsTracker) ResetStats(bool)                                           {}
 func (*StatsTracker) PromHandler() http.Handler                                 { return nil }
@@ -153,6 +153,8 @@ func (c *Uchunk) Size() int64  { return c.size }
 func (c *Uchunk) Num() uint16  { return c.num }
 func (c *Uchunk) Path() string { return c.path }
 
+func (c *Uchunk) Cksum() *cos.Cksum { return c.cksum }
+
 // validate and set
 func (c *Uchunk) SetCksum(cksum *cos.Cksum) {
 	if !cos.NoneC(cksum) {
@@ -1397,6 +1399,10 @@ type (
 		cidx int
 		// global
 		goff int64
+		// read-ahead (see SetReadAhead)
+		ra    int   // number of chunks to prefetch ahead of the current one
+		raidx int   // next chunk (index) to prefetch
+		nra   int64 // total prefetched
 	}
 )
 
@@ -1439,6 +1445,9 @@ func (r *UfestReader) Read(p []byte) (n int, err error) {
 			if err != nil {
 				return n, fmt.Errorf("%s: failed to open chunk (%d/%d)", r.u._rtag(), r.cidx+1, u.count)
 			}
+			if r.ra > 0 {
+				r.readAhead()
+			}
 		}
 
 		// read
@@ -1488,6 +1497,29 @@ func (r *UfestReader) Read(p []byte) (n int, err error) {
 	return n, nil
 }
 
+// SetReadAhead enables sequential readers to have the next (up to) n chunks
+// prefetched into page cache while reading the current one
+func (r *UfestReader) SetReadAhead(n int) { r.ra = n }
+
+// number of chunks prefetched so far
+func (r *UfestReader) NumReadAhead() int64 { return r.nra }
+
+func (r *UfestReader) readAhead() {
+	u := r.u
+	end := min(r.cidx+1+r.ra, int(u.count))
+	for i := max(r.raidx, r.cidx+1); i < end; i++ {
+		c := &u.chunks[i]
+		if err := fs.ReadAhead(c.path, c.size); err != nil {
+			if cmn.Rom.V(4, cos.ModCore) {
+				nlog.Warningln(r.u._rtag(), "read-ahead failure:", err)
+			}
+			break // (best effort)
+		}
+		r.nra++
+	}
+	r.raidx = max(r.raidx, end)
+}
+
 func (r *UfestReader) Close() error {
 	if r.cfh != nil {
 		cos.Close(r.cfh)
@@ -60,6 +60,11 @@ type (
 		AddErr(error, ...int)
 		ErrCnt() int // used by sentinel and quiesce
 
+		// per-object failures (multi-object xactions; see api.GetXactionErrors)
+		// returns non-nil when exceeding apc.MaxErrs thresholds (if any) - the caller then aborts
+		AddErrObj(objName string, err error, logExtra ...int) error
+		ErrObjs() []ObjErr
+
 		// to support api.QueryXactionSnaps
 		CtlMsg() string
 		Snap() *Snap // (struct below)
@@ -85,6 +90,12 @@ var (
 )
 
 type (
+	// per-object failure as recorded by multi-object xactions
+	ObjErr struct {
+		ObjName string `json:"obj_name"` // canonical (bucket/object) name
+		Err     string `json:"err"`
+	}
+
 	AllRunningInOut struct {
 		Kind    string
 		Running []string
@@ -18,6 +18,7 @@ type (
 		OutBytes int64 `json:"out-bytes,string" msg:"ob"`
 		InObjs   int64 `json:"in-objs,string" msg:"io"` // receive
 		InBytes  int64 `json:"in-bytes,string" msg:"ib"`
+		SkipObjs int64 `json:"skip-objs,string" msg:"so"` // skipped as already done (e.g., apc.TCBMsg.Resume)
 	}
 
 	Snap struct {
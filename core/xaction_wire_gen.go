@@ -1,7 +1,7 @@
-package core
-
 // Code generated by github.com/tinylib/msgp DO NOT EDIT.
 
+package core
+
 import (
 	"github.com/tinylib/msgp/msgp"
 )
@@ -121,9 +121,10 @@ func (z *Snap) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *Snap) EncodeMsg(en *msgp.Writer) (err error) {
-	// omitempty: check for empty values
+	// check for omitted fields
 	zb0001Len := uint32(14)
 	var zb0001Mask uint16 /* 14 bits */
+	_ = zb0001Mask
 	if z.CtlMsg == "" {
 		zb0001Len--
 		zb0001Mask |= 0x80
@@ -133,150 +134,151 @@ func (z *Snap) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	if zb0001Len == 0 {
-		return
-	}
-	// write "s"
-	err = en.Append(0xa1, 0x73)
-	if err != nil {
-		return
-	}
-	err = en.WriteTime(z.StartTime)
-	if err != nil {
-		err = msgp.WrapError(err, "StartTime")
-		return
-	}
-	// write "e"
-	err = en.Append(0xa1, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteTime(z.EndTime)
-	if err != nil {
-		err = msgp.WrapError(err, "EndTime")
-		return
-	}
-	// write "b"
-	err = en.Append(0xa1, 0x62)
-	if err != nil {
-		return
-	}
-	err = z.Bck.EncodeMsg(en)
-	if err != nil {
-		err = msgp.WrapError(err, "Bck")
-		return
-	}
-	// write "sb"
-	err = en.Append(0xa2, 0x73, 0x62)
-	if err != nil {
-		return
-	}
-	err = z.SrcBck.EncodeMsg(en)
-	if err != nil {
-		err = msgp.WrapError(err, "SrcBck")
-		return
-	}
-	// write "db"
-	err = en.Append(0xa2, 0x64, 0x62)
-	if err != nil {
-		return
-	}
-	err = z.DstBck.EncodeMsg(en)
-	if err != nil {
-		err = msgp.WrapError(err, "DstBck")
-		return
-	}
-	// write "i"
-	err = en.Append(0xa1, 0x69)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.ID)
-	if err != nil {
-		err = msgp.WrapError(err, "ID")
-		return
-	}
-	// write "k"
-	err = en.Append(0xa1, 0x6b)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Kind)
-	if err != nil {
-		err = msgp.WrapError(err, "Kind")
-		return
-	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
-		// write "m"
-		err = en.Append(0xa1, 0x6d)
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "s"
+		err = en.Append(0xa1, 0x73)
 		if err != nil {
 			return
 		}
-		err = en.WriteString(z.CtlMsg)
+		err = en.WriteTime(z.StartTime)
 		if err != nil {
-			err = msgp.WrapError(err, "CtlMsg")
+			err = msgp.WrapError(err, "StartTime")
+			return
+		}
+		// write "e"
+		err = en.Append(0xa1, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteTime(z.EndTime)
+		if err != nil {
+			err = msgp.WrapError(err, "EndTime")
+			return
+		}
+		// write "b"
+		err = en.Append(0xa1, 0x62)
+		if err != nil {
+			return
+		}
+		err = z.Bck.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Bck")
+			return
+		}
+		// write "sb"
+		err = en.Append(0xa2, 0x73, 0x62)
+		if err != nil {
+			return
+		}
+		err = z.SrcBck.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "SrcBck")
+			return
+		}
+		// write "db"
+		err = en.Append(0xa2, 0x64, 0x62)
+		if err != nil {
+			return
+		}
+		err = z.DstBck.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "DstBck")
+			return
+		}
+		// write "i"
+		err = en.Append(0xa1, 0x69)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.ID)
+		if err != nil {
+			err = msgp.WrapError(err, "ID")
+			return
+		}
+		// write "k"
+		err = en.Append(0xa1, 0x6b)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Kind)
+		if err != nil {
+			err = msgp.WrapError(err, "Kind")
+			return
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// write "m"
+			err = en.Append(0xa1, 0x6d)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.CtlMsg)
+			if err != nil {
+				err = msgp.WrapError(err, "CtlMsg")
+				return
+			}
+		}
+		// write "ae"
+		err = en.Append(0xa2, 0x61, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.AbortErr)
+		if err != nil {
+			err = msgp.WrapError(err, "AbortErr")
+			return
+		}
+		// write "r"
+		err = en.Append(0xa1, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Err)
+		if err != nil {
+			err = msgp.WrapError(err, "Err")
+			return
+		}
+		// write "p"
+		err = en.Append(0xa1, 0x70)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt64(z.Packed)
+		if err != nil {
+			err = msgp.WrapError(err, "Packed")
+			return
+		}
+		// write "x"
+		err = en.Append(0xa1, 0x78)
+		if err != nil {
+			return
+		}
+		err = z.Stats.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Stats")
+			return
+		}
+		// write "a"
+		err = en.Append(0xa1, 0x61)
+		if err != nil {
+			return
+		}
+		err = en.WriteBool(z.AbortedX)
+		if err != nil {
+			err = msgp.WrapError(err, "AbortedX")
+			return
+		}
+		// write "l"
+		err = en.Append(0xa1, 0x6c)
+		if err != nil {
+			return
+		}
+		err = en.WriteBool(z.IdleX)
+		if err != nil {
+			err = msgp.WrapError(err, "IdleX")
 			return
 		}
-	}
-	// write "ae"
-	err = en.Append(0xa2, 0x61, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.AbortErr)
-	if err != nil {
-		err = msgp.WrapError(err, "AbortErr")
-		return
-	}
-	// write "r"
-	err = en.Append(0xa1, 0x72)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Err)
-	if err != nil {
-		err = msgp.WrapError(err, "Err")
-		return
-	}
-	// write "p"
-	err = en.Append(0xa1, 0x70)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt64(z.Packed)
-	if err != nil {
-		err = msgp.WrapError(err, "Packed")
-		return
-	}
-	// write "x"
-	err = en.Append(0xa1, 0x78)
-	if err != nil {
-		return
-	}
-	err = z.Stats.EncodeMsg(en)
-	if err != nil {
-		err = msgp.WrapError(err, "Stats")
-		return
-	}
-	// write "a"
-	err = en.Append(0xa1, 0x61)
-	if err != nil {
-		return
-	}
-	err = en.WriteBool(z.AbortedX)
-	if err != nil {
-		err = msgp.WrapError(err, "AbortedX")
-		return
-	}
-	// write "l"
-	err = en.Append(0xa1, 0x6c)
-	if err != nil {
-		return
-	}
-	err = en.WriteBool(z.IdleX)
-	if err != nil {
-		err = msgp.WrapError(err, "IdleX")
-		return
 	}
 	return
 }
@@ -341,6 +343,12 @@ func (z *Stats) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "InBytes")
 				return
 			}
+		case "so":
+			z.SkipObjs, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "SkipObjs")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -354,9 +362,9 @@ func (z *Stats) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *Stats) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+	// map header, size 7
 	// write "o"
-	err = en.Append(0x86, 0xa1, 0x6f)
+	err = en.Append(0x87, 0xa1, 0x6f)
 	if err != nil {
 		return
 	}
@@ -415,11 +423,21 @@ func (z *Stats) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "InBytes")
 		return
 	}
+	// write "so"
+	err = en.Append(0xa2, 0x73, 0x6f)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.SkipObjs)
+	if err != nil {
+		err = msgp.WrapError(err, "SkipObjs")
+		return
+	}
 	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *Stats) Msgsize() (s int) {
-	s = 1 + 2 + msgp.Int64Size + 2 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
+	s = 1 + 2 + msgp.Int64Size + 2 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
 	return
 }
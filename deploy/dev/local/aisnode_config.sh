@@ -98,6 +98,7 @@ cat > "$AIS_CONF_FILE" <<EOL
 		"objsize_limit":        "0",
 		"chunk_size":           "1GiB",
 		"max_monolithic_size":  "1TiB",
+		"auto_size_limit":      "0",
 		"checkpoint_every":     0,
 		"flags":                0
 	},
@@ -141,7 +142,9 @@ cat > "$AIS_CONF_FILE" <<EOL
 		"highwm":            ${AIS_SPACE_HIGHWM:-90},
 		"out_of_space":      ${AIS_SPACE_OOS:-95},
 		"batch_size":        32768,
-		"dont_cleanup_time": "120m"
+		"dont_cleanup_time": "120m",
+		"trash_window":      "24h",
+		"cleanup_interval":  "0s"
 	},
 	"lru": {
 		"dont_evict_time":   "120m",
@@ -172,7 +175,8 @@ cat > "$AIS_CONF_FILE" <<EOL
 		"validate_cold_get":	false,
 		"validate_warm_get":	false,
 		"validate_obj_move":	false,
-		"enable_read_range":	false
+		"enable_read_range":	false,
+		"prefer_backend":	false
 	},
 	"transport": {
 		"max_header":		4096,
@@ -180,7 +184,8 @@ cat > "$AIS_CONF_FILE" <<EOL
 		"idle_teardown":	"${AIS_TRANSPORT_IDLE_TEARDOWN:-4s}",
 		"quiescent":		"${AIS_TRANSPORT_QUIESCENT:-10s}",
 		"lz4_block":		"${AIS_TRANSPORT_LZ4_BLOCK:-256kb}",
-		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false}
+		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false},
+		"lz4_level":		${AIS_TRANSPORT_LZ4_LEVEL:-0}
 	},
 	"memsys": {
 		"min_free":		"2gb",
@@ -293,6 +298,12 @@ cat > "$AIS_CONF_FILE" <<EOL
 		"max_soft_errs":     8,
 		"max_gfn":           5
 	},
+	"read_qos": {
+		"max_reads":     0,
+		"weight_high":   8,
+		"weight_normal": 4,
+		"weight_low":    1
+	},
 	"features": "0"
 }
 EOL
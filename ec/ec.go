@@ -116,7 +116,8 @@ const (
 	ActClearRequests  = "clear-requests"
 	ActEnableRequests = "enable-requests"
 
-	URLMeta = "meta" /// .. - metadata requests
+	URLMeta   = "meta"   /// .. - metadata requests
+	URLStatus = "status" // local slice (replica) status (see TargetStatus)
 
 	// EC switches to disk from SGL when memory pressure is high and the amount of
 	// memory required to encode an object exceeds the limit
@@ -328,8 +329,18 @@ func unique(prefix string, bck *meta.Bck, objName string) string {
 	return cos.UnsafeS(b)
 }
 
+// IsECCopy returns true if the object of a given size is to be replicated rather than
+// erasure coded: objects at or below ObjSizeLimit (if positive) are replicated, larger
+// ones are sliced (see ECConf.ObjSizeLimit)
 func IsECCopy(size int64, ecConf *cmn.ECConf) bool {
-	return size < ecConf.ObjSizeLimit || ecConf.ObjSizeLimit == cmn.ObjSizeToAlwaysReplicate
+	switch {
+	case ecConf.ObjSizeLimit == cmn.ObjSizeToAlwaysReplicate:
+		return true
+	case ecConf.ObjSizeLimit > 0:
+		return size <= ecConf.ObjSizeLimit
+	default:
+		return false
+	}
 }
 
 // returns whether EC must use disk instead of keeping everything in memory.
@@ -110,6 +110,33 @@ func (md *Metadata) Clone() *Metadata {
 	return clone
 }
 
+// TargetStatus is a single target's view of an erasure-coded object:
+// EC metadata (nil if not present) and whether the slice (replica) itself is present
+type TargetStatus struct {
+	MD      *Metadata `json:"md,omitempty"`
+	Present bool      `json:"present"`
+}
+
+// ObjectStatus returns local (this target's) status of an object's slice or replica
+func ObjectStatus(bck *meta.Bck, objName string) (*TargetStatus, error) {
+	md, err := ObjectMetadata(bck, objName)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			return &TargetStatus{}, nil
+		}
+		return nil, err
+	}
+	contentType := fs.ECSliceCT
+	if md.SliceID == 0 {
+		contentType = fs.ObjCT
+	}
+	ct, err := core.NewCTFromBO(bck, objName, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetStatus{MD: md, Present: cos.Stat(ct.FQN()) == nil}, nil
+}
+
 // ObjectMetadata returns metadata for an object or its slice if any exists
 func ObjectMetadata(bck *meta.Bck, objName string) (*Metadata, error) {
 	ct, err := core.NewCTFromBO(bck, objName, fs.ECMetaCT)
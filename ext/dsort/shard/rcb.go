@@ -33,6 +33,7 @@ type rcbCtx struct {
 	extractedCount int
 	toDisk         bool
 	fromTar        bool
+	encoded        bool // the shard is stored compressed and/or encrypted (see core.LOM.IsEncoded)
 }
 
 // implements archive.ArchRCB callback
@@ -82,6 +83,7 @@ func (c *rcbCtx) xtar(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop
 	if c.tw == nil {
 		// tar (and zip - below)
 		args.fileType = fs.ObjCT
+		args.encoded = c.encoded // offsets in the decoded content, not in the stored file
 	} else {
 		// tar.gz and tar.lz4
 		if err := c.tw.WriteHeader(header); err != nil {
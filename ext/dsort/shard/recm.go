@@ -50,6 +50,7 @@ type (
 		extractMethod bits          // method which needs to be used to extract a record
 		offset        int64         // offset of the body in the shard
 		buf           []byte        // helper buffer for `CopyBuffer` methods
+		encoded       bool          // the shard is stored compressed and/or encrypted (offsets do not apply to its file)
 	}
 
 	// loads content from local or remote target
@@ -70,6 +71,7 @@ type (
 		keyExtractor    KeyExtractor
 		contents        *sync.Map
 		extractionPaths *sync.Map // Keys correspond to all paths to record contents on disk.
+		encodedShards   *sync.Map // Names of the shards that are stored compressed and/or encrypted (see FreeMem).
 
 		enqueued struct {
 			mu      sync.Mutex
@@ -91,6 +93,7 @@ func NewRecordManager(bck cmn.Bck, extractCreator RW, keyExtractor KeyExtractor,
 		keyExtractor:        keyExtractor,
 		contents:            &sync.Map{},
 		extractionPaths:     &sync.Map{},
+		encodedShards:       &sync.Map{},
 	}
 }
 
@@ -118,6 +121,10 @@ func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64
 
 	debug.Assert(!args.extractMethod.Has(ExtractToWriter) || args.w != nil)
 
+	if args.encoded {
+		recm.encodedShards.Store(args.shardName, struct{}{})
+	}
+
 	r, ske, needRead := recm.keyExtractor.PrepareExtractor(args.recordName, args.r, ext)
 	switch {
 	case args.extractMethod.Has(ExtractToMem):
@@ -141,7 +148,7 @@ func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64
 			return size, errors.WithStack(err)
 		}
 		recm.contents.Store(fullContentPath, sgl)
-	case args.extractMethod.Has(ExtractToDisk) && recm.extractCreator.SupportsOffset():
+	case args.extractMethod.Has(ExtractToDisk) && recm.extractCreator.SupportsOffset() && !args.encoded:
 		mdSize, size = recm.extractCreator.MetadataSize(), r.Size()
 		storeType = OffsetStoreType
 		contentPath, _ = recm.encodeRecordName(storeType, args.shardName, args.recordName)
@@ -306,9 +313,14 @@ func (recm *RecordManager) FreeMem(fullContentPath, newStoreType string, value a
 
 	debug.Assert(obj.StoreType == SGLStoreType, obj.StoreType+" vs "+SGLStoreType) // only SGLs are supported
 
+	shardName, _ := parseRecordUname(record.Name)
+	if newStoreType == OffsetStoreType {
+		if _, encoded := recm.encodedShards.Load(shardName); encoded {
+			newStoreType = DiskStoreType
+		}
+	}
 	switch newStoreType {
 	case OffsetStoreType:
-		shardName, _ := parseRecordUname(record.Name)
 		obj.ContentPath = shardName
 		obj.MetadataSize = recm.extractCreator.MetadataSize()
 	case DiskStoreType:
@@ -361,6 +373,7 @@ func (recm *RecordManager) Cleanup() {
 		return true
 	})
 	recm.contents = nil
+	recm.encodedShards = nil
 
 	// NOTE: may call oom.FreeToOS
 	core.T.PageMM().FreeSpec(memsys.FreeSpec{
@@ -107,7 +107,7 @@ func (trw *tarRW) Extract(lom *core.LOM, r cos.ReadReaderAt, extractor RecordExt
 	if err != nil {
 		return 0, 0, err
 	}
-	c := &rcbCtx{parent: trw, tw: nil, extractor: extractor, shardName: lom.ObjName, toDisk: toDisk, fromTar: true}
+	c := &rcbCtx{parent: trw, tw: nil, extractor: extractor, shardName: lom.ObjName, toDisk: toDisk, fromTar: true, encoded: lom.IsEncoded()}
 	buf, slab := core.T.PageMM().AllocSize(lom.Lsize())
 	c.buf = buf
 
@@ -188,8 +188,13 @@ type (
 
 	HealthByTarget []*HealthStatus
 	HealthStatus   struct {
-		TargetID string `json:"target_id"`
-		Status   string `json:"health_status"` // enum { HealthStatusRunning, ... } above
+		ReadyTime time.Time `json:"ready_time"` // last readiness probe (or transition) time
+		TargetID  string    `json:"target_id"`
+		Status    string    `json:"health_status"`    // K8s pod phase: "Pending", "Running", etc.
+		Reason    string    `json:"reason,omitempty"` // container waiting reason, e.g. "CrashLoopBackOff"
+		ReadyMsg  string    `json:"ready_msg,omitempty"`
+		Restarts  int32     `json:"restarts"` // total container restarts
+		Ready     bool      `json:"ready"`    // pod's `Ready` condition
 	}
 
 	CPUMemByTarget []*CPUMemUsed
@@ -449,3 +454,13 @@ func (il InfoList) Len() int           { return len(il) }
 func (il InfoList) Less(i, j int) bool { return il[i].Name < il[j].Name }
 func (il InfoList) Swap(i, j int)      { il[i], il[j] = il[j], il[i] }
 func (il *InfoList) Append(i Info)     { *il = append(*il, i) }
+
+//////////////////
+// HealthStatus //
+//////////////////
+
+// Healthy returns false for pods that are not running, not ready, or waiting to restart
+// (e.g. CrashLoopBackOff) - the conditions that AIS-level ETL stage does not reflect.
+func (hs *HealthStatus) Healthy() bool {
+	return hs.Status == string(corev1.PodRunning) && hs.Ready && hs.Reason == ""
+}
@@ -7,6 +7,7 @@ package etl
 import (
 	"bytes"
 	cryptorand "crypto/rand"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -277,17 +278,42 @@ var _ = Describe("CommunicatorTest", func() {
 		}))
 		defer slow.Close()
 
-		bc := &baseComm{client: &http.Client{Timeout: objTimeout}}
+		bc := &baseComm{client: &http.Client{Timeout: objTimeout}, msg: &InitSpecMsg{InitMsgBase: InitMsgBase{EtlName: "slow-etl"}}}
 		reqArgs := &cmn.HreqArgs{Method: http.MethodPut, Base: slow.URL, Path: "/"}
 
-		_, _, err := bc.doWithTimeout(reqArgs, nil)
+		_, ecode, err := bc.doWithTimeout(reqArgs, nil, objName, 0)
 
 		Expect(err).To(HaveOccurred())
-		Expect(cos.IsErrClientTimeout(err)).To(BeTrue(), "expected timeout error, got: %v", err)
+		Expect(cmn.IsErrETLTimeout(err)).To(BeTrue(), "expected ETL timeout error, got: %v", err)
+		Expect(ecode).To(Equal(http.StatusGatewayTimeout))
 		// reqCount is the authoritative proof: exactly one attempt means no retry.
 		Expect(reqCount.Load()).To(Equal(int32(1)), "server received %d requests; expected exactly 1 (no retry on timeout)", reqCount.Load())
 	})
 
+	It("doWithTimeout applies per-request timeout", func() {
+		const timeout = 100 * time.Millisecond
+
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			time.Sleep(10 * timeout)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer slow.Close()
+
+		bc := &baseComm{client: &http.Client{Timeout: time.Minute}, msg: &InitSpecMsg{InitMsgBase: InitMsgBase{EtlName: "slow-etl"}}}
+		reqArgs := &cmn.HreqArgs{Method: http.MethodPut, Base: slow.URL, Path: "/"}
+
+		started := time.Now()
+		_, _, err := bc.doWithTimeout(reqArgs, nil, objName, timeout)
+
+		var terr *cmn.ErrETLTimeout
+		Expect(errors.As(err, &terr)).To(BeTrue(), "expected ETL timeout error, got: %v", err)
+		Expect(terr.ETLName).To(Equal("slow-etl"))
+		Expect(terr.ObjName).To(Equal(objName))
+		Expect(terr.Timeout).To(Equal(timeout))
+		Expect(time.Since(started)).To(BeNumerically("<", 5*timeout))
+		Expect(bc.client.Timeout).To(Equal(time.Minute)) // shared client unchanged
+	})
+
 	// ---------------------------------------------------------------------------
 	// retryer: connection reset is a soft error — retry up to SoftErr limit,
 	// fail when exhausted. Tests bypass doWithTimeout's hardcoded Sleep so they
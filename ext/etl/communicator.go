@@ -78,6 +78,7 @@ type (
 		TransformArgs string
 		Pipeline      apc.ETLPipeline
 		LatestVer     bool
+		Timeout       time.Duration // per-request timeout (zero: obj_timeout); not supported by Hpull
 		// TODO: add sync option
 	}
 
@@ -257,7 +258,7 @@ func (pc *pushComm) ProcessDownloadJob(ctx *ETLObjDownloadCtx) (cos.ReadCloseSiz
 		reqArgs.Query = query
 	}
 
-	resp, ecode, err := pc.doWithTimeout(reqArgs, nil)
+	resp, ecode, err := pc.doWithTimeout(reqArgs, nil, ctx.ObjName, 0)
 
 	if err != nil {
 		return nil, ecode, fmt.Errorf("failed to send object to ETL pod: %v", err)
@@ -310,8 +311,15 @@ func handleRespEcode(ecode int, oah cos.OAH, r cos.ReadOpenCloser, err error) co
 
 // doWithTimeout sends the ETL request using the pre-built ETL client (see setupConnection).
 // The client carries Timeout = obj_timeout, so every attempt gets the full configured budget of timeout.
-func (c *baseComm) doWithTimeout(reqArgs *cmn.HreqArgs, getBody getBodyFunc) (r cos.ReadCloseSizer, ecode int, err error) {
-	rtyr := &retryer{client: c.client, reqArgs: reqArgs, getBody: getBody}
+// Non-zero `timeout` overrides obj_timeout for this request only; exceeding it returns ErrETLTimeout.
+func (c *baseComm) doWithTimeout(reqArgs *cmn.HreqArgs, getBody getBodyFunc, objName string, timeout time.Duration) (r cos.ReadCloseSizer, ecode int, err error) {
+	client := c.client
+	if timeout > 0 {
+		clone := *c.client // (shares transport and connection pool)
+		clone.Timeout = timeout
+		client = &clone
+	}
+	rtyr := &retryer{client: client, reqArgs: reqArgs, getBody: getBody}
 	args := &cmn.RetryArgs{
 		Call:      rtyr.call,
 		IsFatal:   cos.IsErrClientTimeout, // a timeout means obj_timeout was exceeded; caller's responsibility
@@ -321,6 +329,9 @@ func (c *baseComm) doWithTimeout(reqArgs *cmn.HreqArgs, getBody getBodyFunc) (r
 		BackOff:   true,
 	}
 	if ecode, err = args.Do(); err != nil {
+		if cos.IsErrClientTimeout(err) {
+			return nil, http.StatusGatewayTimeout, cmn.NewErrETLTimeout(c.ETLName(), objName, client.Timeout)
+		}
 		return nil, ecode, err
 	}
 	// Status-code handling (including >= 400) is the caller's responsibility.
@@ -358,6 +369,11 @@ func (pc *pushComm) doRequest(lom *core.LOM, args *core.ETLArgs, latestVer, sync
 		if ecode, err := lomLoad(lom, pc.xctn.Kind()); err != nil {
 			return core.ReadResp{Err: err, Ecode: ecode}
 		}
+		if lom.IsEncoded() {
+			// stored compressed and/or encrypted - the container must receive the content, not the file
+			getBody = func() core.ReadResp { return lom.GetROC(false, false) }
+			break
+		}
 		query.Set(apc.QparamETLFQN, url.PathEscape(lom.FQN))
 	}
 
@@ -382,8 +398,12 @@ func (pc *pushComm) doRequest(lom *core.LOM, args *core.ETLArgs, latestVer, sync
 		}
 	}
 
+	var timeout time.Duration
+	if args != nil {
+		timeout = args.Timeout
+	}
 	// note: `Content-Length` header is set during `retryer.call()` below
-	r, ecode, err := pc.doWithTimeout(reqArgs, getBody)
+	r, ecode, err := pc.doWithTimeout(reqArgs, getBody, lom.Cname(), timeout)
 	if err != nil {
 		return core.ReadResp{Err: err, Ecode: ecode}
 	}
@@ -397,7 +417,8 @@ func (pc *pushComm) doRequest(lom *core.LOM, args *core.ETLArgs, latestVer, sync
 }
 
 func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM, args *InlineTransArgs) (size int64, ecode int, err error) {
-	resp := pc.doRequest(lom, &core.ETLArgs{TransformArgs: args.TransformArgs, Pipeline: args.Pipeline}, args.LatestVer, false /* sync */) // TODO: support sync
+	etlArgs := &core.ETLArgs{TransformArgs: args.TransformArgs, Pipeline: args.Pipeline, Timeout: args.Timeout}
+	resp := pc.doRequest(lom, etlArgs, args.LatestVer, false /* sync */) // TODO: support sync
 	if resp.Err != nil {
 		return 0, resp.Ecode, resp.Err
 	}
@@ -459,7 +480,8 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 // TODO: support `sync` option as well
 func (*redirectComm) redirectArgs(lom *core.LOM, latestVer bool) (string, url.Values) {
 	query := make(url.Values, 4)
-	if !cmn.Rom.Features().IsSet(feat.DontAllowPassingFQNtoETL) {
+	// (the lom is loaded; when stored compressed and/or encrypted the container GETs the content instead)
+	if !cmn.Rom.Features().IsSet(feat.DontAllowPassingFQNtoETL) && !lom.IsEncoded() {
 		// TODO -- FIXME: consider chunked case
 		query.Set(apc.QparamETLFQN, url.PathEscape(lom.FQN))
 	}
@@ -494,7 +516,11 @@ func (rc *redirectComm) OfflineTransform(lom *core.LOM, latestVer, _ bool, args
 		reqArgs.Header.Add(apc.HdrNodeURL, args.Pipeline.Pack())
 	}
 
-	r, ecode, err := rc.doWithTimeout(reqArgs, nil)
+	var timeout time.Duration
+	if args != nil {
+		timeout = args.Timeout
+	}
+	r, ecode, err := rc.doWithTimeout(reqArgs, nil, clone.Cname(), timeout)
 	if err != nil {
 		return core.ReadResp{Err: err, Ecode: ecode}
 	}
@@ -324,16 +324,46 @@ func PodLogs(etlName string) (logs Logs, err error) {
 	}, nil
 }
 
-func PodHealth(etlName string) (string, error) {
+// PodHealth returns K8s-level health of the ETL pod running on this target:
+// pod phase, container restarts, and the most recent readiness condition.
+// Note that a pod in CrashLoopBackOff may still report "Running" phase -
+// hence, restarts and waiting reason.
+func PodHealth(etlName string) (*HealthStatus, error) {
 	_, boot := mgr.getByName(etlName)
 	if boot == nil {
-		return "", cos.NewErrNotFound(core.T, etlName)
+		return nil, cos.NewErrNotFound(core.T, etlName)
 	}
 	client, err := k8s.GetClient()
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	p, err := client.Pod(boot.pod.GetName())
+	if err != nil {
+		return nil, err
+	}
+	hs := &HealthStatus{TargetID: core.T.SID(), Status: string(p.Status.Phase)}
+	for i := range p.Status.ContainerStatuses {
+		cs := &p.Status.ContainerStatuses[i]
+		hs.Restarts += cs.RestartCount
+		if cs.State.Waiting != nil && hs.Reason == "" {
+			hs.Reason = cs.State.Waiting.Reason
+		}
+	}
+	for i := range p.Status.Conditions {
+		cond := &p.Status.Conditions[i]
+		if cond.Type != corev1.PodReady {
+			continue
+		}
+		hs.Ready = cond.Status == corev1.ConditionTrue
+		hs.ReadyMsg = cond.Message
+		if !cond.LastProbeTime.IsZero() {
+			hs.ReadyTime = cond.LastProbeTime.Time
+		} else {
+			hs.ReadyTime = cond.LastTransitionTime.Time
+		}
+		break
 	}
-	return client.Health(boot.pod.GetName())
+	return hs, nil
 }
 
 func PodMetrics(etlName string) (*CPUMemUsed, error) {
@@ -275,17 +275,8 @@ func (wss *wsSession) createTask(lom *core.LOM, latestVer, sync bool, woc io.Wri
 
 	task.w = woc
 	task.ctrlmsg.Path = lom.ObjName
-	switch {
-	case latestVer, sync, cmn.Rom.Features().IsSet(feat.DontAllowPassingFQNtoETL): // TODO -- FIXME: consider chunked case
-		srcResp := lom.GetROC(latestVer, sync)
-		if srcResp.Err != nil {
-			if woc != nil {
-				cos.Close(woc)
-			}
-			return nil, 0, srcResp.Err
-		}
-		task.r = srcResp.R
-	default:
+	passFQN := !latestVer && !sync && !cmn.Rom.Features().IsSet(feat.DontAllowPassingFQNtoETL) // TODO -- FIXME: consider chunked case
+	if passFQN {
 		// default to FQN
 		if ecode, err := lomLoad(lom, wss.txctn.Kind()); err != nil {
 			if woc != nil {
@@ -293,7 +284,20 @@ func (wss *wsSession) createTask(lom *core.LOM, latestVer, sync bool, woc io.Wri
 			}
 			return nil, ecode, err
 		}
+		// stored compressed and/or encrypted - send the content, not the file
+		passFQN = !lom.IsEncoded()
+	}
+	if passFQN {
 		task.ctrlmsg.FQN = url.PathEscape(lom.FQN)
+	} else {
+		srcResp := lom.GetROC(latestVer, sync)
+		if srcResp.Err != nil {
+			if woc != nil {
+				cos.Close(woc)
+			}
+			return nil, 0, srcResp.Err
+		}
+		task.r = srcResp.R
 	}
 
 	debug.IncCounter(task.txctn.ID() + "-task") // count for tasks in this session
@@ -96,6 +96,33 @@ func (tcdf *Tcdf) Alerts() (flags cos.NodeStateFlags) {
 	return
 }
 
+// capacity of the labeled mountpaths grouped by label (unlabeled mountpaths are not included);
+// note: labeled mountpaths that share a filesystem are counted more than once
+func (tcdf *Tcdf) ByLabel() map[cos.MountpathLabel]*Capacity {
+	var out map[cos.MountpathLabel]*Capacity
+	for _, cdf := range tcdf.Mountpaths {
+		if cdf.Label.IsNil() {
+			continue
+		}
+		if out == nil {
+			out = make(map[cos.MountpathLabel]*Capacity, 2)
+		}
+		c, ok := out[cdf.Label]
+		if !ok {
+			c = &Capacity{}
+			out[cdf.Label] = c
+		}
+		c.Used += cdf.Used
+		c.Avail += cdf.Avail
+	}
+	for _, c := range out {
+		if total := c.Used + c.Avail; total > 0 {
+			c.PctUsed = int32(c.Used * 100 / total)
+		}
+	}
+	return out
+}
+
 // [convention] <DISK-NAME>[(<alert>)]
 // Returns "" and (-1) when no alerts found otherwise, returns alert name and its index in the DISK-NAME string
 func HasAlert(disks []string) (alert string, idx int) {
@@ -26,6 +26,7 @@ const (
 	ECMetaCT    = "mt"
 	ChunkCT     = "ch"
 	ChunkMetaCT = "ut"
+	TrashCT     = "tr" // soft-deleted objects (see core/lfile: MoveToTrash, RestoreFromTrash)
 
 	// ext
 	DsortFileCT = "ds"
@@ -66,6 +67,7 @@ type (
 	ecMetaCR    struct{}
 	objChunkCR  struct{}
 	chunkMetaCR struct{}
+	trashCR     struct{}
 	dsortCR     struct{}
 )
 
@@ -91,6 +93,7 @@ var (
 	_ contentRes = (*ecMetaCR)(nil)
 	_ contentRes = (*objChunkCR)(nil)
 	_ contentRes = (*chunkMetaCR)(nil)
+	_ contentRes = (*trashCR)(nil)
 )
 
 // register all content types
@@ -110,6 +113,7 @@ func (csm *contentSpecMgr) regAll() {
 	csm._reg(ECMetaCT, &ecMetaCR{})
 	csm._reg(ChunkCT, &objChunkCR{})
 	csm._reg(ChunkMetaCT, &chunkMetaCR{})
+	csm._reg(TrashCT, &trashCR{})
 
 	csm._reg(DsortFileCT, &dsortCR{})
 	csm._reg(DsortWorkCT, &dsortCR{})
@@ -291,6 +295,14 @@ func (*ecMetaCR) parseUbase(base string) ContentInfo {
 	return ContentInfo{Base: base, Ok: true}
 }
 
+// trash: at most one soft-deleted instance per object name (the most recent);
+// the time of deletion is the file's mtime
+func (*trashCR) makeUbase(base string, _ ...string) string { return base }
+
+func (*trashCR) parseUbase(base string) ContentInfo {
+	return ContentInfo{Base: base, Ok: true}
+}
+
 func (*dsortCR) makeUbase(base string, _ ...string) string { return base }
 
 func (*dsortCR) parseUbase(base string) ContentInfo {
@@ -34,9 +34,12 @@ const (
 	WorkfileTransform    = "transform"      // ETL offline transform
 	WorkfileCopy         = "copy"           // copy object
 	WorkfileAppend       = "append"         // APPEND to object (as file)
+	WorkfileSparse       = "sparse"         // pre-sized object being filled with ranged writes
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
 	WorkfileShardIdx     = "shardidx"       // write shard index to ais://.sys-shardidx
+	WorkfileCompress     = "compress"       // compress object at rest (see bucket props: compression)
+	WorkfileEncrypt      = "encrypt"        // encrypt object at rest (see bucket props: encryption)
 )
 
 type ParsedFQN struct {
@@ -207,6 +210,8 @@ func CleanPathErr(err error) {
 			what = "chunk"
 		case ChunkMetaCT:
 			what = "chunk manifest"
+		case TrashCT:
+			what = "soft-deleted object"
 		default:
 			what = fmt.Sprintf("content type '%s'(?)", parsed.ContentType)
 		}
@@ -705,6 +705,23 @@ func ToMPL() (mpl *apc.MountpathList) {
 	sort.Strings(mpl.Available)
 	sort.Strings(mpl.WaitingDD)
 	sort.Strings(mpl.Disabled)
+
+	// group by label
+	for _, mpis := range []MPI{avail, disabled} {
+		for _, mi := range mpis {
+			if mi.Label.IsNil() {
+				continue
+			}
+			if mpl.Labels == nil {
+				mpl.Labels = make(map[string][]string, 2)
+			}
+			lab := string(mi.Label)
+			mpl.Labels[lab] = append(mpl.Labels[lab], mi.Path)
+		}
+	}
+	for _, mpaths := range mpl.Labels {
+		sort.Strings(mpaths)
+	}
 	return mpl
 }
 
@@ -824,6 +841,53 @@ func enable(mpath, cleanMpath, tid string, config *cmn.Config) (enabledMi *Mount
 	return enabledMi, nil
 }
 
+// SetMpathLabel (re)labels available or disabled mountpath.
+// The mountpath is never modified in place - it gets replaced with a relabeled clone.
+// Note that the mountpath's disks are not re-resolved - that happens upon attach and restart.
+func SetMpathLabel(mpath string, label cos.MountpathLabel, cb func()) (*Mountpath, error) {
+	cleanMpath, err := cmn.ValidateMpath(mpath)
+	if err != nil {
+		return nil, err
+	}
+
+	mfs.mu.Lock()
+	defer mfs.mu.Unlock()
+
+	availableCopy, disabledCopy := cloneMPI()
+	mpis := availableCopy
+	mi, ok := mpis[cleanMpath]
+	if !ok {
+		mpis = disabledCopy
+		if mi, ok = mpis[cleanMpath]; !ok {
+			return nil, cmn.NewErrMpathNotFound(mpath, "" /*fqn*/, false /*disabled*/)
+		}
+	}
+	if mi.Label == label {
+		return nil, nil // nothing to do
+	}
+	clone := mi.relabel(label)
+	mpis[cleanMpath] = clone
+	PutMPI(availableCopy, disabledCopy)
+	cb()
+	return clone, nil
+}
+
+// (LOM caches start empty)
+func (mi *Mountpath) relabel(label cos.MountpathLabel) *Mountpath {
+	clone := &Mountpath{
+		Path:       mi.Path,
+		Label:      label,
+		FS:         mi.FS,
+		Disks:      mi.Disks,
+		flags:      ratomic.LoadUint64(&mi.flags),
+		PathDigest: mi.PathDigest,
+	}
+	clone.capacity.Used = ratomic.LoadUint64(&mi.capacity.Used)
+	clone.capacity.Avail = ratomic.LoadUint64(&mi.capacity.Avail)
+	clone.capacity.PctUsed = ratomic.LoadInt32(&mi.capacity.PctUsed)
+	return clone
+}
+
 // Remove removes mountpaths from the target's mountpaths. It searches
 // for the mountpath in `available` and, if not found, in `disabled`.
 func Remove(mpath string, cb ...func()) (*Mountpath, error) {
@@ -1371,6 +1435,9 @@ func DiskStats(allds cos.AllDiskStats, tcdf *Tcdf, config *cmn.Config, refreshCa
 	}
 }
 
+// typed per-disk stats including IOPS and average queue size (see api.GetDiskStats)
+func DiskStatsExt(allds ios.AllDiskStats) { mfs.ios.DiskStatsExt(allds) }
+
 //
 // cap status: get, refresh, periodic
 //
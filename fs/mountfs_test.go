@@ -239,6 +239,41 @@ func TestMountpathAddAndDisableMultiple(t *testing.T) {
 	tools.AssertMountpathCount(t, 1, 1)
 }
 
+func TestMountpathSetLabel(t *testing.T) {
+	initFS()
+
+	mp1, mp2 := "/tmp/mp1", "/tmp/mp2"
+	tools.AddMpath(t, mp1)
+	tools.AddMpath(t, mp2)
+	_, err := fs.Disable(mp2)
+	tassert.CheckFatal(t, err)
+
+	var ncb int
+	cb := func() { ncb++ }
+	prev := fs.GetAvail()[mp1]
+	for _, mpath := range []string{mp1, mp2} {
+		mi, err := fs.SetMpathLabel(mpath, "hdd-old", cb)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, mi != nil && mi.Label == "hdd-old", "expected %q to be relabeled", mpath)
+	}
+	// replaced, not modified in place
+	avail, disabled := fs.Get()
+	tassert.Errorf(t, prev.Label != "hdd-old" && avail[mp1] != prev && avail[mp1].Label == "hdd-old", "expected relabeled clone of %s", prev)
+	tassert.Errorf(t, disabled[mp2].Label == "hdd-old", "expected %s relabeled", mp2)
+	// same label: nothing to do
+	mi, err := fs.SetMpathLabel(mp1, "hdd-old", cb)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, mi == nil && ncb == 2, "expected no-op (%v, %d)", mi, ncb)
+
+	_, err = fs.SetMpathLabel("/nonexistingpath", "hdd-old", cb)
+	tassert.Errorf(t, cmn.IsErrMpathNotFound(err), "expected mountpath-not-found, got %v", err)
+
+	mpl := fs.ToMPL()
+	tassert.Fatalf(t, len(mpl.Labels) == 1, "expected a single label, got %v", mpl.Labels)
+	mpaths := mpl.Labels["hdd-old"]
+	tassert.Errorf(t, len(mpaths) == 2 && mpaths[0] == mp1 && mpaths[1] == mp2, "unexpected %v", mpaths)
+}
+
 func TestMoveToDeleted(t *testing.T) {
 	initFS()
 
@@ -333,3 +368,17 @@ func BenchmarkMakePathFQN(b *testing.B) {
 		cos.Assert(s != "")
 	}
 }
+
+func TestTcdfByLabel(t *testing.T) {
+	tcdf := &fs.Tcdf{Mountpaths: map[string]*fs.CDF{
+		"/mp1": {Label: "hdd", Capacity: fs.Capacity{Used: 30, Avail: 70}},
+		"/mp2": {Label: "hdd", Capacity: fs.Capacity{Used: 10, Avail: 90}},
+		"/mp3": {Label: "nvme", Capacity: fs.Capacity{Used: 50, Avail: 50}},
+		"/mp4": {Capacity: fs.Capacity{Used: 1, Avail: 1}},
+	}}
+	byLabel := tcdf.ByLabel()
+	tassert.Fatalf(t, len(byLabel) == 2, "expected 2 labels, got %v", byLabel)
+	hdd, nvme := byLabel["hdd"], byLabel["nvme"]
+	tassert.Errorf(t, hdd.Used == 40 && hdd.Avail == 160 && hdd.PctUsed == 20, "hdd: unexpected %+v", hdd)
+	tassert.Errorf(t, nvme.Used == 50 && nvme.Avail == 50 && nvme.PctUsed == 50, "nvme: unexpected %+v", nvme)
+}
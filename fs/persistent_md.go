@@ -28,6 +28,7 @@ var mdFilesDirs = [...]string{
 	fname.BmdPrevious,
 	fname.Vmd,
 	fname.Smap,
+	fname.TCBCkptDir,
 }
 
 func MarkerExists(marker string) bool {
@@ -0,0 +1,10 @@
+//go:build darwin
+
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+// ReadAhead is a no-op (see namesake linux function)
+func ReadAhead(string, int64) error { return nil }
@@ -0,0 +1,23 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// ReadAhead initiates asynchronous read of the given file into page cache
+// via posix_fadvise(2) POSIX_FADV_WILLNEED; does not block on IO
+func ReadAhead(path string, size int64) error {
+	fh, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	err = unix.Fadvise(int(fh.Fd()), 0, size, unix.FADV_WILLNEED)
+	fh.Close()
+	return err
+}
@@ -0,0 +1,15 @@
+//go:build darwin
+
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import "golang.org/x/sys/unix"
+
+// Reflink clones `src` into (new) `dst` via clonefile(2) - APFS only
+// (see namesake linux function)
+func Reflink(src, dst string) error {
+	return unix.Clonefile(src, dst, unix.CLONE_NOFOLLOW)
+}
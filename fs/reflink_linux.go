@@ -0,0 +1,42 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	"golang.org/x/sys/unix"
+)
+
+// Reflink creates `dst` that shares data extents with `src` via FICLONE ioctl(2)
+// - no data is read or written; supported by xfs (reflink=1), btrfs, and a few others
+// - both must reside on the same filesystem
+// - unlike hardlink, `dst` is a separate inode with its own xattrs (and therefore, metadata)
+// - returns error when not supported, in which case the caller must fall back to copying
+func Reflink(src, dst string) error {
+	srcfh, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	dstfh, err := cos.CreateFile(dst)
+	if err != nil {
+		cos.Close(srcfh)
+		return err
+	}
+	err = unix.IoctlFileClone(int(dstfh.Fd()), int(srcfh.Fd()))
+	cos.Close(srcfh)
+	if erc := dstfh.Close(); err == nil {
+		err = erc
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(dst); nerr != nil {
+			nlog.Errorln("reflink: nested err:", nerr)
+		}
+	}
+	return err
+}
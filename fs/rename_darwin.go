@@ -0,0 +1,15 @@
+//go:build darwin
+
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import "golang.org/x/sys/unix"
+
+// RenameNoReplace renames `src` => `dst` via renamex_np(2) with RENAME_EXCL
+// (see namesake linux function)
+func RenameNoReplace(src, dst string) error {
+	return unix.RenamexNp(src, dst, unix.RENAME_EXCL)
+}
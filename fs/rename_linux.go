@@ -0,0 +1,14 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import "golang.org/x/sys/unix"
+
+// RenameNoReplace renames `src` => `dst` via renameat2(2) with RENAME_NOREPLACE
+// - fails with EEXIST when `dst` exists (in which case nothing is renamed)
+// - fails with EINVAL when not supported by the underlying filesystem
+func RenameNoReplace(src, dst string) error {
+	return unix.Renameat2(unix.AT_FDCWD, src, unix.AT_FDCWD, dst, unix.RENAME_NOREPLACE)
+}
@@ -7,6 +7,7 @@ package fs_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/NVIDIA/aistore/fs"
@@ -85,3 +86,23 @@ func BenchmarkIsDirEmpty(b *testing.B) {
 		})
 	}
 }
+
+func TestReflink(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		src  = filepath.Join(dir, "src")
+		dst  = filepath.Join(dir, "dst")
+		data = []byte("reflink me")
+	)
+	tassert.CheckFatal(t, os.WriteFile(src, data, 0o644))
+
+	if err := fs.Reflink(src, dst); err != nil {
+		// e.g., ext4 and tmpfs - callers fall back to copying
+		_, errStat := os.Stat(dst)
+		tassert.Errorf(t, os.IsNotExist(errStat), "failed reflink must not leave %q behind", dst)
+		t.Skipf("reflink not supported by the underlying filesystem: %v", err)
+	}
+	b, err := os.ReadFile(dst)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == string(data), "expected %q, got %q", data, b)
+}
@@ -5,9 +5,19 @@
  */
 package ios
 
+// typed per-disk I/O stats (stable schema; see api.GetDiskStats)
+// all rates and averages are computed over the most recent iostat interval
+// (see `disk.iostat_time_short` and `disk.iostat_time_long`)
 type (
 	DiskStats struct {
-		RBps, Ravg, WBps, Wavg, Util int64
+		RBps     int64   `json:"read_bps"`       // read bytes per second
+		WBps     int64   `json:"write_bps"`      // written bytes per second
+		RIOPS    int64   `json:"read_iops"`      // completed reads per second
+		WIOPS    int64   `json:"write_iops"`     // completed writes per second
+		Ravg     int64   `json:"avg_read_size"`  // average read size (bytes)
+		Wavg     int64   `json:"avg_write_size"` // average write size (bytes)
+		Util     int64   `json:"util"`           // utilization, percentage
+		AvgQueue float64 `json:"avg_queue_size"` // average number of in-flight I/Os (aqu-sz)
 	}
-	AllDiskStats map[string]DiskStats
+	AllDiskStats map[string]DiskStats // by disk name
 )
@@ -52,6 +52,7 @@ func (ds *blockStats) WriteBytes() int64 { return ds.writeSectors * 512 }
 func (ds *blockStats) IOMs() int64       { return ds.ioMs }
 func (ds *blockStats) WriteMs() int64    { return ds.writeMs }
 func (ds *blockStats) ReadMs() int64     { return ds.readMs }
+func (*blockStats) IOMsWeighted() int64  { return 0 } // TODO: not implemented
 
 // NVMe multipathing - Linux only
 // * nvmeInN:     instance I namespace N
@@ -96,6 +96,8 @@ func (ds *blockStats) IOMs() int64       { return ds.ioMs }
 func (ds *blockStats) WriteMs() int64    { return ds.writeMs }
 func (ds *blockStats) ReadMs() int64     { return ds.readMs }
 
+func (ds *blockStats) IOMsWeighted() int64 { return ds.ioMsWeighted }
+
 // NVMe multipathing
 // * nvmeInN:     instance I namespace N
 // * nvmeIcCnN:   instance I controller C namespace N
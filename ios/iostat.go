@@ -42,6 +42,7 @@ type (
 		RescanDisks(mpath, fsname string, disks []string) RescanDisksResult
 		RemoveMpath(mpath string, testingEnv bool)
 		DiskStats(m cos.AllDiskStats)
+		DiskStatsExt(m AllDiskStats)
 	}
 
 	MpathUtil sync.Map
@@ -61,18 +62,22 @@ const (
 // internal
 type (
 	cache struct {
-		ioms   map[string]int64 // IO millis
-		util   map[string]int64 // utilization
-		rms    map[string]int64 // read millis
-		rbytes map[string]int64 // read bytes
-		reads  map[string]int64 // completed read requests
-		rbps   map[string]int64 // read B/s
-		ravg   map[string]int64 // average read size
-		wms    map[string]int64 // write millis
-		wbytes map[string]int64 // written bytes
-		writes map[string]int64 // completed write requests
-		wbps   map[string]int64 // write B/s
-		wavg   map[string]int64 // average write size
+		ioms   map[string]int64   // IO millis
+		util   map[string]int64   // utilization
+		rms    map[string]int64   // read millis
+		rbytes map[string]int64   // read bytes
+		reads  map[string]int64   // completed read requests
+		rbps   map[string]int64   // read B/s
+		ravg   map[string]int64   // average read size
+		wms    map[string]int64   // write millis
+		wbytes map[string]int64   // written bytes
+		writes map[string]int64   // completed write requests
+		wbps   map[string]int64   // write B/s
+		wavg   map[string]int64   // average write size
+		iomsw  map[string]int64   // weighted IO millis
+		riops  map[string]int64   // reads per second
+		wiops  map[string]int64   // writes per second
+		aqu    map[string]float64 // average queue size
 
 		mpathUtil   map[string]int64 // Average utilization of the disks, range [0, 100].
 		mpathUtilRO MpathUtil        // Read-only copy of `mpathUtil`.
@@ -159,6 +164,10 @@ func newCache(num int) *cache {
 		writes:    make(map[string]int64, num),
 		wbps:      make(map[string]int64, num),
 		wavg:      make(map[string]int64, num),
+		iomsw:     make(map[string]int64, num),
+		riops:     make(map[string]int64, num),
+		wiops:     make(map[string]int64, num),
+		aqu:       make(map[string]float64, num),
 		mpathUtil: make(map[string]int64, num),
 	}
 }
@@ -388,6 +397,23 @@ func (ios *ios) DiskStats(m cos.AllDiskStats) {
 	}
 }
 
+// extended (typed) variant of the above
+func (ios *ios) DiskStatsExt(m AllDiskStats) {
+	cache := ios.refresh()
+	for disk := range cache.ioms {
+		m[disk] = DiskStats{
+			RBps:     cache.rbps[disk],
+			WBps:     cache.wbps[disk],
+			RIOPS:    cache.riops[disk],
+			WIOPS:    cache.wiops[disk],
+			Ravg:     cache.ravg[disk],
+			Wavg:     cache.wavg[disk],
+			Util:     cache.util[disk],
+			AvgQueue: cache.aqu[disk],
+		}
+	}
+}
+
 // update iostat cache
 func (ios *ios) refresh() *cache {
 	var (
@@ -474,6 +500,9 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.util[disk] = 0
 		ncache.ravg[disk] = 0
 		ncache.wavg[disk] = 0
+		ncache.riops[disk] = 0
+		ncache.wiops[disk] = 0
+		ncache.aqu[disk] = 0
 		ds := ios.blockStats[disk]
 		ncache.ioms[disk] = ds.IOMs()
 		ncache.rms[disk] = ds.ReadMs()
@@ -482,6 +511,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.wms[disk] = ds.WriteMs()
 		ncache.wbytes[disk] = ds.WriteBytes()
 		ncache.writes[disk] = ds.Writes()
+		ncache.iomsw[disk] = ds.IOMsWeighted()
 
 		if _, ok := statsCache.ioms[disk]; !ok {
 			missingInfo = true
@@ -494,7 +524,13 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 			writes     = _nonneg(ncache.writes[disk] - statsCache.writes[disk])
 			readBytes  = _nonneg(ncache.rbytes[disk] - statsCache.rbytes[disk])
 			writeBytes = _nonneg(ncache.wbytes[disk] - statsCache.wbytes[disk])
+			ioMsW      = _nonneg(ncache.iomsw[disk] - statsCache.iomsw[disk])
 		)
+		if elapsedMillis > 0 {
+			ncache.aqu[disk] = float64(ioMsW) / float64(elapsedMillis)
+		} else {
+			ncache.aqu[disk] = statsCache.aqu[disk]
+		}
 		if elapsedMillis > 0 {
 			if ioMs >= elapsedMillis {
 				ncache.util[disk] = 100 // (unlikely)
@@ -516,9 +552,13 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		if elapsedSeconds > 0 {
 			ncache.rbps[disk] = cos.DivRoundI64(readBytes, elapsedSeconds)
 			ncache.wbps[disk] = cos.DivRoundI64(writeBytes, elapsedSeconds)
+			ncache.riops[disk] = cos.DivRoundI64(reads, elapsedSeconds)
+			ncache.wiops[disk] = cos.DivRoundI64(writes, elapsedSeconds)
 		} else {
 			ncache.rbps[disk] = statsCache.rbps[disk]
 			ncache.wbps[disk] = statsCache.wbps[disk]
+			ncache.riops[disk] = statsCache.riops[disk]
+			ncache.wiops[disk] = statsCache.wiops[disk]
 		}
 		// averages
 		switch {
@@ -332,6 +332,9 @@ func (j *clnJogger) _lwalk(lom *core.LOM, fqn string) error {
 		return cmn.ErrSkip
 	}
 	stats.loads.Inc()
+	if lom.IsPinned() {
+		return cmn.ErrSkip
+	}
 
 	// check the expected location, request specific props to establish identity
 	// TODO -- FIXME: HeadObjT2T() must support batch request to ensure scalability
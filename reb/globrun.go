@@ -76,6 +76,9 @@ type (
 		Oxid   string    // oldRMD g[version]
 		NID    int64     // newRMD version
 		Flags  uint32    // xact.ArgsMsg.Flags
+		Warmup bool      // this target has (re)joined: warm up upon success (see Rebalance.WarmupBudget)
+		// maintenance: abort if not done in time (see apc.ActValRmNode.Deadline)
+		Deadline time.Duration
 	}
 )
 
@@ -103,6 +106,7 @@ type (
 		opaque [regOpaqueSize]byte // []byte{rebMsgRegular, rebID} => hdr.Opaque
 		stats  rebStats            // observability: stage waiting times, counters via CtlMsg (`ais show job`)
 		ecUsed bool
+		warmup bool // (see ExtArgs.Warmup)
 	}
 )
 
@@ -241,6 +245,7 @@ func (reb *Reb) Run(smap *meta.Smap, extArgs *ExtArgs) {
 			prefix: extArgs.Prefix, // ditto
 			logHdr: logHdr,
 			ecUsed: bmd.IsECUsed(),
+			warmup: extArgs.Warmup,
 		}
 	)
 	if rargs.bck != nil {
@@ -284,6 +289,10 @@ func (reb *Reb) Run(smap *meta.Smap, extArgs *ExtArgs) {
 
 	debug.Assert(reb.dm != nil)
 	debug.Assert(reb.dm.IsOpen())
+	if extArgs.Deadline > 0 {
+		timer := deadline(rargs.xreb, extArgs.Deadline, logHdr)
+		defer timer.Stop()
+	}
 	if extArgs.Bck == nil {
 		nlog.Infoln(logHdr, "initializing")
 	} else {
@@ -340,6 +349,18 @@ func (reb *Reb) Run(smap *meta.Smap, extArgs *ExtArgs) {
 	offGFN()
 }
 
+// abort upon ExtArgs.Deadline, locally (the primary also aborts cluster-wide upon the same deadline)
+func deadline(xreb core.Xact, d time.Duration, logHdr string) *time.Timer {
+	return time.AfterFunc(d, func() {
+		if xreb.IsDone() {
+			return
+		}
+		if xreb.Abort(cmn.ErrXactDeadlineAbort) {
+			nlog.Errorln(logHdr, "failed to complete within", d, "- aborting", xreb.Name())
+		}
+	})
+}
+
 func (reb *Reb) skip(nid int64, allowEq bool) bool {
 	// before _renew() commits successfully, reb.rebID() still reflects the previous generation
 	cur := reb.rebID()
@@ -458,6 +479,8 @@ func (reb *Reb) _renew(rargs *rargs, xreb *xs.Rebalance, haveStreams bool) error
 			Smap:             rargs.smap,
 			XactConf:         rargs.config.Rebalance.XactConf,
 			SkipGenericStats: true, // do not auto-increment In/OutObjs
+			BatchSmallObjs:   rargs.config.Rebalance.BatchSmallObjs > 0,
+			BatchThresh:      int64(rargs.config.Rebalance.BatchSmallObjs),
 		}
 		debug.Assert(reb.dm == nil)
 		reb.dm = bundle.NewDM(trname, reb.recvObj, cmn.OwtRebalance, extra)
@@ -536,6 +559,13 @@ func (reb *Reb) runEC(rargs *rargs) error {
 
 	reb.runECjoggers(rargs)
 
+	// send remaining batches of small CTs, if any
+	if dm := reb.dm; dm != nil {
+		if err := dm.Flush(); err != nil {
+			rargs.xreb.Abort(err)
+		}
+	}
+
 	if err := rargs.xreb.AbortErr(); err != nil {
 		nlog.Warningln(rargs.logHdr, "finish ec run, abort ec-joggers: [", err)
 		return err
@@ -580,6 +610,13 @@ func (reb *Reb) runNoEC(rargs *rargs) error {
 		debug.Assert(len(rargs.nwp.workCh) == 0)
 	}
 
+	// send remaining batches of small objects, if any
+	if dm := reb.dm; dm != nil {
+		if err := dm.Flush(); err != nil {
+			rargs.xreb.Abort(err) // (ditto: failure to send == abort)
+		}
+	}
+
 	if err := rargs.xreb.AbortErr(); err != nil {
 		nlog.Warningln(rargs.logHdr, "finish no-ec run, abort joggers: [", err, "]")
 		return err
@@ -668,6 +705,9 @@ func (reb *Reb) fini(rargs *rargs, err error, tstats cos.StatsUpdater) {
 	switch {
 	case que != core.QuiAborted && que != core.QuiTimeout && ecnt == 0:
 		nlog.Infoln(rargs.logHdr, "done", xname)
+		if rargs.warmup {
+			rargs.runWarmup()
+		}
 	default:
 		nlog.Warningln(rargs.logHdr, "finished [ que:", que, "errors:", ecnt, "]", xname)
 	}
@@ -819,6 +859,11 @@ func getROC(lom *core.LOM) (cos.ReadOpenCloser, error) {
 		lom.Unlock(false)
 		return nil, cmn.ErrSkip
 	}
+	if lom.IsPinned() {
+		// relocated on purpose (apc.ActMoveObject)
+		lom.Unlock(false)
+		return nil, cmn.ErrSkip
+	}
 	if lom.Checksum() == nil {
 		if _, err := lom.ComputeSetCksum(true); err != nil {
 			lom.Unlock(false)
@@ -845,6 +890,22 @@ func (rargs *rargs) pingall() bool {
 	return true
 }
 
+// post-rebalance warm-up of the (re)joined target - remote buckets only (see xs.XactWarmup)
+func (rargs *rargs) runWarmup() {
+	budget := int64(rargs.config.Rebalance.WarmupBudget)
+	if budget <= 0 {
+		return
+	}
+	rns := xreg.RenewWarmup(cos.GenUUID(), budget)
+	if rns.Err != nil {
+		nlog.Warningln(rargs.logHdr, "failed to start warm-up:", rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get()
+	nlog.Infoln(rargs.logHdr, "starting", xctn.Name())
+	go xctn.Run(nil)
+}
+
 func (rargs *rargs) doSend(lom *core.LOM, tsi *meta.Snode, roc cos.ReadOpenCloser) error {
 	debug.Assert(tsi.ID() != core.T.SID(), "unexpected local destination")
 	var (
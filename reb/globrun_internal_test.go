@@ -0,0 +1,115 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// objects pinned to this target (apc.ActMoveObject) must not be sent anywhere by rebalance
+func TestGetROCPinned(t *testing.T) {
+	fs.NewTestMFS(mock.NewIOS())
+	_, err := fs.AddTestMpath(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+
+	bck := cmn.Bck{Name: "reb-pinned", Provider: apc.AIS, Ns: cmn.NsGlobal}
+	bmd := mock.NewBaseBownerMock(
+		meta.NewBck(bck.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, BID: 0xa1b2}),
+	)
+	core.T = mock.NewTarget(bmd)
+
+	tests := []struct {
+		name   string
+		pinned bool
+	}{
+		{"moved", false},
+		{"moved-and-pinned", true},
+	}
+	for _, test := range tests {
+		lom := &core.LOM{ObjName: test.name}
+		tassert.CheckFatal(t, lom.InitCmnBck(&bck))
+		fh, err := cos.CreateFile(lom.FQN)
+		tassert.CheckFatal(t, err)
+		_, err = fh.WriteString("0123456789")
+		fh.Close()
+		tassert.CheckFatal(t, err)
+		lom.SetSize(10)
+		lom.IncVersion()
+		lom.SetAtimeUnix(1)
+		if test.pinned {
+			lom.SetCustomKey(cmn.PinnedObjMD, core.T.SID())
+		}
+		lom.Lock(true)
+		err = lom.Persist()
+		lom.Unlock(true)
+		tassert.CheckFatal(t, err)
+
+		roc, err := getROC(lom)
+		if test.pinned {
+			tassert.Errorf(t, err == cmn.ErrSkip, "%s: expected ErrSkip, got %v", test.name, err)
+			tassert.Errorf(t, roc == nil, "%s: unexpected reader", test.name)
+			// must not be left locked
+			tassert.Errorf(t, lom.TryLock(true), "%s: left locked", test.name)
+			lom.Unlock(true)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		roc.Close()
+	}
+}
+
+// maintenance: target-local evacuation deadline (see apc.ActValRmNode.Deadline)
+func TestDeadline(t *testing.T) {
+	xreg.Init()
+	fs.NewTestMFS(mock.NewIOS()) // (finishing x-rebalance refreshes capacity)
+	_, err := fs.AddTestMpath(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+
+	newXreb := func() *xs.Rebalance {
+		xreb := &xs.Rebalance{}
+		xreb.InitBase(cos.GenUUID(), apc.ActRebalance, nil)
+		return xreb
+	}
+
+	t.Run("expired", func(t *testing.T) {
+		xreb := newXreb()
+		deadline(xreb, 10*time.Millisecond, "test")
+		select {
+		case err := <-xreb.ChanAbort():
+			tassert.Errorf(t, err == cmn.ErrXactDeadlineAbort, "expected %v, got %v", cmn.ErrXactDeadlineAbort, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("not aborted upon deadline")
+		}
+		tassert.Errorf(t, xreb.AbortErr() == cmn.ErrXactDeadlineAbort, "expected %v, got %v", cmn.ErrXactDeadlineAbort, xreb.AbortErr())
+	})
+
+	t.Run("finished", func(t *testing.T) {
+		xreb := newXreb()
+		xreb.Finish()
+		deadline(xreb, time.Millisecond, "test")
+		time.Sleep(50 * time.Millisecond)
+		tassert.Errorf(t, !xreb.IsAborted(), "finished rebalance must not be aborted")
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		xreb := newXreb()
+		timer := deadline(xreb, 20*time.Millisecond, "test")
+		timer.Stop()
+		time.Sleep(50 * time.Millisecond)
+		tassert.Errorf(t, !xreb.IsAborted(), "must not be aborted once the timer is stopped")
+	})
+}
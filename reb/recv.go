@@ -411,6 +411,8 @@ func (reb *Reb) receiveCT(req *stageNtfn, hdr *transport.ObjHdr, reader io.Reade
 		req.md.SliceID = md.SliceID
 		if err = reb.sendFromDisk(ct, req.md, moveTo, xreb, dm, workFQN); err != nil {
 			nlog.Errorln("failed to move slice to", moveTo, "[", err, "]")
+		} else if err = dm.Flush(); err != nil { // (the slice must precede its metadata update)
+			nlog.Errorln("failed to flush slice to", moveTo, "[", err, "]")
 		}
 	}
 	// Broadcast updated MD
@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/load"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
@@ -44,13 +45,17 @@ const (
 	flagRmMisplacedEC
 	flagRmSysBck
 	flagRmInvalid
-	flagRmAll = flagRmOldWork | flagRmMisplacedLOMs | flagRmMisplacedEC | flagRmSysBck | flagRmInvalid
+	flagRmTrash
+	flagRmAll = flagRmOldWork | flagRmMisplacedLOMs | flagRmMisplacedEC | flagRmSysBck | flagRmInvalid | flagRmTrash
 )
 
 const (
 	sparseLogCnt  = 100
 	ctlMsgBufSize = 256
 	initCap       = 64
+
+	// stale copy-bucket checkpoints (see xs.tcbCkpt) - removed when not written for this long
+	tcbCkptMaxAge = 7 * 24 * time.Hour
 )
 
 type (
@@ -85,6 +90,8 @@ type (
 		keepPeerMissing  atomic.Int64 // cluster-HRW peer returned 404: keep local copy (last-known good)
 		keepDiverged     atomic.Int64 // peer holds same name but different content: keep local copy
 		errHEAD          atomic.Int64 // HEAD-to-peer failed with non-404 error
+		expired          atomic.Int64 // objects removed upon expiration of their per-object TTL
+		trashN           atomic.Int64 // soft-deleted objects queued for removal (beyond space.trash_window)
 	}
 )
 
@@ -123,6 +130,7 @@ type (
 		oldWork []string
 		sysBck  []string
 		invalid []string
+		trash   []string
 		nmisplc int64
 		norphan int64
 		nvisits int64
@@ -220,6 +228,14 @@ func (s *clnStats) write(sb *cos.SB) {
 		sb.WriteString(" invalid:")
 		sb.WriteString(strconv.FormatInt(v, 10))
 	}
+	if v := s.expired.Load(); v > 0 {
+		sb.WriteString(" expired:")
+		sb.WriteString(strconv.FormatInt(v, 10))
+	}
+	if v := s.trashN.Load(); v > 0 {
+		sb.WriteString(" trash:")
+		sb.WriteString(strconv.FormatInt(v, 10))
+	}
 	if v := s.rmFiles.Load(); v > 0 {
 		sb.WriteString(" rm:")
 		sb.WriteString(strconv.FormatInt(v, 10))
@@ -420,6 +436,7 @@ func (j *clnJ) keepMisplaced() (string, bool) {
 func (j *clnJ) jog(providers []string) {
 	// globally
 	j.rmDeleted()
+	j.rmOldCkpts()
 
 	// traverse
 	if len(j.ini.Args.Buckets) != 0 {
@@ -431,6 +448,7 @@ func (j *clnJ) jog(providers []string) {
 	j.oldWork = slices.Clip(j.oldWork)
 	j.sysBck = slices.Clip(j.sysBck)
 	j.invalid = slices.Clip(j.invalid)
+	j.trash = slices.Clip(j.trash)
 	j.misplaced.loms = slices.Clip(j.misplaced.loms)
 	j.misplaced.ec = slices.Clip(j.misplaced.ec)
 
@@ -501,7 +519,7 @@ func (j *clnJ) _jogBck() {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT},
+		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT, fs.TrashCT},
 		Callback: j.visit,
 		Sorted:   false,
 	}
@@ -534,6 +552,7 @@ func (j *clnJ) visit(fqn string, de fs.DirEntry) error {
 			// closed. Surface the deferral so operators don't conflate
 			// "nothing to clean" with "lots to clean, just too fresh".
 			j.ini.Xaction.stats.tooFresh.Add(1)
+			j.ini.StatsT.Inc(stats.CleanupTooEarlyCount)
 			return nil
 		}
 	}
@@ -673,6 +692,17 @@ func (j *clnJ) visitCT(parsed *fs.ParsedFQN, fqn string) {
 		j.appendOldWork(fqn)
 		j.rmAnyBatch(flagRmOldWork)
 
+	// soft-deleted objects: remove permanently upon expiration of the trash window
+	// (the time of deletion is the file's mtime - see core/lfile: MoveToTrash)
+	case fs.TrashCT:
+		finfo, err := os.Lstat(fqn)
+		if err != nil || finfo.ModTime().Add(j.config.Space.TrashWindow.D()).After(j.now) {
+			return
+		}
+		j.trash = append(j.trash, fqn)
+		j.ini.Xaction.stats.trashN.Add(1)
+		j.rmAnyBatch(flagRmTrash)
+
 	case fs.ChunkCT:
 		contentInfo := fs.CSM.ParseUbase(parsed.ObjName, fs.ChunkCT)
 		if !contentInfo.Ok {
@@ -861,6 +891,13 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		return
 	}
 
+	// per-object TTL (see apc.HdrObjTTL): takes precedence over the atime gate below
+	// and does not depend on capacity watermarks
+	if lom.IsHRW() && j.expired(lom) {
+		j.rmExpired(lom)
+		return
+	}
+
 	// inner atime gate (LRU semantics): skip if the LOM xattr atime says it
 	// was recently served. Distinct from visit()'s outer mtime gate, which
 	// catches just-written files. Bumping a separate counter so an operator
@@ -872,6 +909,7 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 			nlog.Infoln("too early for", lom.String(), "atime", lom.Atime().String(), "dont-cleanup", j.dont())
 		}
 		xcln.stats.recentlyAccessed.Add(1)
+		j.ini.StatsT.Inc(stats.CleanupTooEarlyCount)
 		return
 	}
 	xcln.stats.visits.Add(1)
@@ -893,7 +931,8 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 			}
 			return
 		}
-		if j.peerHasIdentical(lom) {
+		// (pinned objects stay put - see apc.ActMoveObject)
+		if !lom.IsPinned() && j.peerHasIdentical(lom) {
 			lom = lom.Clone()
 			j.misplaced.loms = append(j.misplaced.loms, lom)
 			j.rmAnyBatch(flagRmMisplacedLOMs)
@@ -929,6 +968,46 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	}
 }
 
+func (j *clnJ) expired(lom *core.LOM) bool {
+	v, ok := lom.GetCustomKey(cmn.ExpiresObjMD)
+	if !ok {
+		return false
+	}
+	expires, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		nlog.Warningln(j.String(), "invalid expiration time", lom.Cname(), v)
+		return false
+	}
+	// TODO: not supporting erasure-coded buckets yet (slices and metafiles on other targets)
+	return expires < j.now.UnixNano() && !lom.ECEnabled()
+}
+
+func (j *clnJ) rmExpired(lom *core.LOM) {
+	xcln := j.ini.Xaction
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	// re-check under lock (may've been overwritten)
+	lom.Uncache()
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil || !j.expired(lom) {
+		return
+	}
+	size := lom.Lsize()
+	if err := lom.RemoveObj(); err != nil {
+		e := fmt.Errorf("%s rm expired %s: %v", j, lom, err)
+		xcln.AddErr(e, 0)
+		return
+	}
+	if cmn.Rom.V(4, cos.ModSpace) {
+		nlog.Infoln(j.String(), "removed expired", lom.Cname())
+	}
+	j.ini.StatsT.Inc(stats.CleanupStoreCount)
+	j.ini.StatsT.Add(stats.CleanupStoreSize, size)
+	xcln.stats.expired.Add(1)
+	xcln.stats.rmFiles.Add(1)
+	xcln.stats.rmBytes.Add(size)
+}
+
 // true when cluster-HRW peer (not us) confirms identical content
 func (j *clnJ) peerHasIdentical(lom *core.LOM) bool {
 	smap := j.p.smap
@@ -998,6 +1077,10 @@ func (j *clnJ) rmAnyBatch(specifier int) {
 		if int64(len(j.invalid)) < batch {
 			return
 		}
+	case flagRmTrash:
+		if int64(len(j.trash)) < batch {
+			return
+		}
 	default:
 		debug.Assert(false, "invalid rm-batch specifier: ", specifier)
 		return
@@ -1027,6 +1110,44 @@ func (j *clnJ) rmDeleted() {
 	}
 }
 
+// remove copy-bucket journals (<mountpath>/.ais.tcb) of the copies that were
+// interrupted and never resumed
+func (j *clnJ) rmOldCkpts() {
+	var (
+		nfiles, nbytes int64
+		fqns           []string
+		xcln           = j.ini.Xaction
+		dir            = filepath.Join(j.mi.Path, fname.TCBCkptDir)
+	)
+	dents, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			xcln.AddErr(err)
+		}
+		return
+	}
+	for _, dent := range dents {
+		if dent.IsDir() {
+			continue
+		}
+		finfo, err := dent.Info()
+		if err != nil || finfo.ModTime().Add(tcbCkptMaxAge).After(j.now) {
+			continue
+		}
+		fqns = append(fqns, filepath.Join(dir, dent.Name()))
+	}
+	if len(fqns) == 0 {
+		return
+	}
+	j.rmFQNs(fqns, "tcb-ckpt", &nfiles, &nbytes)
+
+	j.ini.StatsT.Add(stats.CleanupStoreSize, nbytes)
+	j.ini.StatsT.Add(stats.CleanupStoreCount, nfiles)
+	xcln.ObjsAdd(int(nfiles), nbytes)
+	xcln.stats.rmFiles.Add(nfiles)
+	xcln.stats.rmBytes.Add(nbytes)
+}
+
 func (j *clnJ) rmExtraCopies(lom *core.LOM) {
 	xcln := j.ini.Xaction
 	if !lom.TryLock(true) {
@@ -1111,7 +1232,8 @@ func (j *clnJ) rmLeftovers(specifier int) {
 		xcln           = j.ini.Xaction
 	)
 	old, ml, me, sys, inv := len(j.oldWork), len(j.misplaced.loms), len(j.misplaced.ec), len(j.sysBck), len(j.invalid)
-	nlog.Infoln(j.String(), "[ old:", old, "misplaced obj:", ml, "misplaced ec:", me, "sysbck:", sys, "invalid:", inv, "]")
+	nlog.Infoln(j.String(), "[ old:", old, "misplaced obj:", ml, "misplaced ec:", me, "sysbck:", sys, "invalid:", inv,
+		"trash:", len(j.trash), "]")
 
 	// 1. rm older work
 	if specifier&flagRmOldWork != 0 {
@@ -1215,6 +1337,13 @@ func (j *clnJ) rmLeftovers(specifier int) {
 		j.now = time.Now()
 	}
 
+	// 6. rm soft-deleted objects beyond space.trash_window
+	if specifier&flagRmTrash != 0 {
+		j.rmFQNs(j.trash, "trashed", &nfiles, &nbytes)
+		j.trash = j.trash[:0]
+		j.now = time.Now()
+	}
+
 	j.ini.StatsT.Add(stats.CleanupStoreSize, nbytes)
 	j.ini.StatsT.Add(stats.CleanupStoreCount, nfiles)
 	xcln.ObjsAdd(int(nfiles), nbytes)
@@ -9,6 +9,7 @@ import (
 	"math/rand/v2"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
@@ -232,6 +234,176 @@ var _ = Describe("AIStore content cleanup tests", func() {
 		})
 	})
 
+	Describe("Per-object TTL", func() {
+		createTTLObject := func(objectName string, expires, atime time.Time) string {
+			lom := &core.LOM{ObjName: objectName}
+			Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+
+			createTestFile(lom.FQN, 1024)
+			lom = newBasicLom(lom.FQN, 1024)
+			lom.IncVersion()
+			lom.SetAtimeUnix(atime.UnixNano())
+			lom.SetCustomKey(cmn.ExpiresObjMD, strconv.FormatInt(expires.UnixNano(), 10))
+			lom.Lock(true)
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			// beyond dont_cleanup_time
+			old := now.Add(-3 * time.Hour)
+			Expect(os.Chtimes(lom.FQN, old, old)).To(Succeed())
+			return lom.FQN
+		}
+
+		It("should remove expired objects regardless of atime", func() {
+			fqn := createTTLObject("expired.bin", now.Add(-time.Minute), now /*recently accessed*/)
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).NotTo(BeAnExistingFile())
+		})
+
+		It("should keep objects that have not expired yet", func() {
+			fqn := createTTLObject("not-expired.bin", now.Add(time.Hour), now.Add(-3*time.Hour))
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).To(BeAnExistingFile())
+		})
+	})
+
+	Describe("Trash (soft delete)", func() {
+		BeforeEach(func() {
+			config := cmn.GCO.BeginUpdate()
+			config.Space.TrashWindow = cos.Duration(24 * time.Hour)
+			cmn.GCO.CommitUpdate(config)
+		})
+
+		// soft-delete and backdate the time of deletion (see core/lfile: MoveToTrash)
+		trashObject := func(objectName string, deleted time.Time) string {
+			lom := &core.LOM{ObjName: objectName}
+			Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+			createTestLOM(lom.FQN, 1024)
+
+			lom = newBasicLom(lom.FQN)
+			lom.Lock(true)
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(lom.MoveToTrash()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			trashFQN := lom.GenFQN(fs.TrashCT)
+			Expect(os.Chtimes(trashFQN, deleted, deleted)).To(Succeed())
+			return trashFQN
+		}
+
+		It("should keep soft-deleted objects within trash window", func() {
+			fqn := trashObject("trashed-recently.bin", now.Add(-3*time.Hour))
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).To(BeAnExistingFile())
+		})
+
+		It("should remove soft-deleted objects beyond trash window", func() {
+			fqn := trashObject("trashed-long-ago.bin", now.Add(-25*time.Hour))
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).NotTo(BeAnExistingFile())
+		})
+	})
+
+	Describe("Copy-bucket checkpoints (apc.TCBMsg.Resume)", func() {
+		createJournal := func(name string, mtime time.Time) string {
+			dir := filepath.Join(mpaths[0], fname.TCBCkptDir)
+			Expect(cos.CreateDir(dir)).To(Succeed())
+			fqn := filepath.Join(dir, name)
+			Expect(os.WriteFile(fqn, []byte("obj-1\nobj-2\n"), cos.PermRWR)).To(Succeed())
+			Expect(os.Chtimes(fqn, mtime, mtime)).To(Succeed())
+			return fqn
+		}
+
+		It("should keep recent journals", func() {
+			fqn := createJournal("recent", now.Add(-24*time.Hour))
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).To(BeAnExistingFile())
+		})
+
+		It("should remove abandoned journals", func() {
+			fqn := createJournal("abandoned", now.Add(-8*24*time.Hour))
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).NotTo(BeAnExistingFile())
+		})
+	})
+
+	Describe("Pinned objects (apc.ActMoveObject)", func() {
+		var peer *meta.Snode
+
+		BeforeEach(func() {
+			// two-target cluster: this (mock) target and a peer that, for the test
+			// objects below, is the cluster-HRW owner holding an identical copy
+			self := &meta.Snode{}
+			self.Init(core.T.SID(), apc.Target, nil)
+			peer = &meta.Snode{}
+			peer.Init("peer-id", apc.Target, nil)
+			smap := &meta.Smap{Tmap: meta.NodeMap{self.ID(): self, peer.ID(): peer}, Version: 1}
+
+			tmock := core.T.(*mock.TargetMock)
+			tmock.SO = &sowner{smap: smap}
+			core.T = &t2tIdentical{TargetMock: tmock}
+		})
+
+		// object that (cluster-HRW) belongs to the peer, is locally HRW-placed,
+		// and is old enough for cleanup
+		createMovedObject := func(prefix string, pinned bool) string {
+			var lom *core.LOM
+			smap := core.T.Sowner().Get()
+			for i := 0; ; i++ {
+				lom = &core.LOM{ObjName: prefix + strconv.Itoa(i)}
+				Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+				if tsi, err := smap.HrwHash2T(lom.Digest()); err == nil && tsi.ID() == peer.ID() {
+					break
+				}
+			}
+			old := now.Add(-3 * time.Hour)
+			createTestFile(lom.FQN, 1024)
+			lom = newBasicLom(lom.FQN, 1024)
+			lom.IncVersion()
+			lom.SetCksum(cos.NewCksum(cos.ChecksumOneXxh, "0123456789abcdef")) // (to establish identity w/ peer)
+			lom.SetAtimeUnix(old.UnixNano())
+			if pinned {
+				lom.SetCustomKey(cmn.PinnedObjMD, core.T.SID())
+			}
+			lom.Lock(true)
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			Expect(os.Chtimes(lom.FQN, old, old)).To(Succeed())
+			return lom.FQN
+		}
+
+		It("should remove unpinned migrated-away leftover", func() {
+			fqn := createMovedObject("moved-", false)
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).NotTo(BeAnExistingFile())
+		})
+
+		It("should keep pinned object even when its HRW owner has an identical copy", func() {
+			fqn := createMovedObject("pinned-", true)
+
+			space.RunCleanup(ini)
+
+			Expect(fqn).To(BeAnExistingFile())
+			lom := newBasicLom(fqn)
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.IsPinned()).To(BeTrue())
+		})
+	})
+
 	Describe("Workfile cleanup", func() {
 		It("should remove old workfiles", func() {
 			objectName := "test-object-for-workfile.txt"
@@ -1010,6 +1182,27 @@ var _ = Describe("AIStore content cleanup tests", func() {
 // HELPERS (compare w/ core/lom_test.go)
 //
 
+type (
+	sowner struct {
+		smap *meta.Smap
+	}
+	slisteners struct{}
+
+	// HEAD(object) from the cluster-HRW peer returns attributes identical to the local ones
+	t2tIdentical struct {
+		*mock.TargetMock
+	}
+)
+
+func (so *sowner) Get() *meta.Smap            { return so.smap }
+func (*sowner) Listeners() meta.SmapListeners { return &slisteners{} }
+func (*slisteners) Reg(meta.Slistener)        {}
+func (*slisteners) Unreg(meta.Slistener)      {}
+
+func (*t2tIdentical) HeadObjT2T(lom *core.LOM, _ *meta.Snode, _ ...string) (*cmn.ObjectPropsV2, error) {
+	return &cmn.ObjectPropsV2{Bck: *lom.Bucket(), Name: lom.ObjName, ObjAttrs: *lom.ObjAttrs(), Present: true}, nil
+}
+
 func newBasicLom(fqn string, size ...int64) *core.LOM {
 	lom := &core.LOM{}
 	err := lom.InitFQN(fqn, nil)
@@ -70,8 +70,12 @@ type (
 
 // private
 type (
-	// minHeap keeps fileInfo sorted by access time with oldest on top of the heap.
-	minHeap []*core.LOM
+	lruEnt struct {
+		lom *core.LOM
+		key int64 // atime or, with lru.use_heat, heat-weighted atime (see evictKey)
+	}
+	// minHeap keeps entries sorted by eviction key with the oldest (coldest) on top of the heap.
+	minHeap []lruEnt
 
 	// parent (contains mpath joggers)
 	lruP struct {
@@ -100,6 +104,7 @@ type (
 		newest      int64
 		now         int64
 		totalSize   int64 // difference between lowWM size and used size
+		npinned     int64 // per bucket: skipped objects that match lru.pinned_prefixes
 		allowDelObj bool
 	}
 	lruFactory struct {
@@ -326,6 +331,11 @@ func (j *lruJ) jogBck() error {
 	}
 	// 3. evict
 	j.evict(math.MaxInt64)
+
+	if j.npinned > 0 {
+		nlog.Infoln(j.String()+":", j.bck.Cname(""), "skipped pinned objects:", j.npinned)
+		j.npinned = 0
+	}
 	return nil
 }
 
@@ -355,21 +365,29 @@ func (j *lruJ) _visit(lom *core.LOM, parsedFQN *fs.ParsedFQN) (pushed bool) {
 		return false
 	}
 	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
+		j.ini.StatsT.Inc(stats.LruTooEarlyCount)
 		return false
 	}
 	if lom.HasCopies() && lom.IsCopy() {
 		return false
 	}
+	if lom.Bprops().LRU.IsPinned(lom.ObjName) {
+		j.npinned++
+		return false
+	}
 
-	hlen := int64(j.heap.Len())
-	if lom.AtimeUnix() > j.newest {
+	var (
+		hlen = int64(j.heap.Len())
+		key  = j.evictKey(lom)
+	)
+	if key > j.newest {
 		// not adding - have a full batch already and this object is newer
 		if hlen >= j.batch() {
 			return false
 		}
-		j.newest = lom.AtimeUnix()
+		j.newest = key
 	}
-	heap.Push(j.heap, lom) // note: free(this lom) upon heap.Pop
+	heap.Push(j.heap, lruEnt{lom: lom, key: key}) // note: free(this lom) upon heap.Pop
 
 	// evict entire oldest batch once per window; allow multiple if overshot
 	if hlen >= j.window() {
@@ -379,6 +397,16 @@ func (j *lruJ) _visit(lom *core.LOM, parsedFQN *fs.ParsedFQN) (pushed bool) {
 	return true
 }
 
+// with lru.use_heat, each unit of (decayed) heat postpones eviction by one heat half-life,
+// as if the object was last accessed that much later
+func (j *lruJ) evictKey(lom *core.LOM) int64 {
+	atime := lom.AtimeUnix()
+	if !lom.Bprops().LRU.UseHeat {
+		return atime
+	}
+	return atime + lom.Heat(j.now)*int64(core.HeatHalfLife)
+}
+
 func (j *lruJ) walk(fqn string, de fs.DirEntry) error {
 	var parsed fs.ParsedFQN
 	if de.IsDir() {
@@ -408,7 +436,7 @@ func (j *lruJ) evict(batch int64) {
 		xlru     = j.ini.Xaction
 	)
 	for h.Len() > 0 && j.totalSize > 0 && fevicted < batch {
-		lom := heap.Pop(h).(*core.LOM)
+		lom := heap.Pop(h).(lruEnt).lom
 		objSize := lom.Lsize()
 		ok := j.evictObj(lom)
 		core.FreeLOM(lom)
@@ -546,9 +574,9 @@ func (j *lruJ) allow() (bool, error) {
 //////////////
 
 func (h minHeap) Len() int           { return len(h) }
-func (h minHeap) Less(i, j int) bool { return h[i].Atime().Before(h[j].Atime()) }
+func (h minHeap) Less(i, j int) bool { return h[i].key < h[j].key }
 func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-func (h *minHeap) Push(x any)        { *h = append(*h, x.(*core.LOM)) }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(lruEnt)) }
 func (h *minHeap) Pop() any {
 	old := *h
 	n := len(old)
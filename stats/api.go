@@ -8,6 +8,7 @@ package stats
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -47,6 +48,7 @@ const (
 	VlabBucket    = "bucket"
 	VlabXkind     = "xkind"
 	VlabMountpath = "mountpath"
+	VlabTag       = "tag" // request tag (see req_tag.go)
 )
 
 type (
@@ -66,6 +68,10 @@ type (
 		ResetStats(errorsOnly bool)
 		GetMetricNames() cos.StrKVs // (name, kind) pairs
 
+		// recent error events (see recent_errs.go)
+		AddRecentErr(op string, bck *cmn.Bck, objName string, err error)
+		GetRecentErrs(limit int) []RecentErr
+
 		// for aistore modules, to add their respective metrics
 		RegExtMetric(node *meta.Snode, name, kind string, extra *Extra)
 	}
@@ -82,6 +88,17 @@ type (
 	}
 )
 
+// per-peer keep-alive state, as seen by a given node (see api.GetKeepAliveStatus)
+// - primary: all other nodes in the cluster
+// - non-primary: the primary (and whichever nodes keep-alived with this one)
+type (
+	PeerKalive struct {
+		LastOK   time.Time `json:"last_ok"`   // last successful keep-alive (zero time: never)
+		NumFails int64     `json:"num_fails"` // consecutive failures since then
+	}
+	KaliveStatus map[string]*PeerKalive // by node ID
+)
+
 type (
 	Extra struct {
 		Labels  cos.StrKVs // static or (same) constant
@@ -131,6 +131,7 @@ type (
 	// implemented by the stats runners
 	statsLogger interface {
 		log(now int64, uptime time.Duration, config *cmn.Config)
+		sampleDisk(config *cmn.Config) // in-between log() calls (see periodic.stats_time_overrides)
 		statsTime(newval time.Duration)
 		standingBy() bool
 	}
@@ -156,6 +157,7 @@ type (
 		prev      string      // prev ctracker.write
 		sorted    []string    // sorted names
 		mem       sys.MemStat
+		rerrs     recentErrs
 		next      int64 // mono.Nano
 		startedUp atomic.Bool
 	}
@@ -168,6 +170,8 @@ var (
 	BckXlabs      = []string{VlabBucket, VlabXkind}
 	EmptyBckXlabs = map[string]string{VlabBucket: "", VlabXkind: ""}
 
+	TagVlabs = []string{VlabBucket, VlabTag}
+
 	mpathVlabs = []string{VlabMountpath}
 )
 
@@ -464,6 +468,13 @@ waitStartup:
 	r.ticker = time.NewTicker(statsTime)
 	r.startedUp.Store(true)
 
+	// optional, tighter disk sampling
+	var (
+		diskTicker *time.Ticker
+		diskTime   time.Duration
+	)
+	diskTicker, diskTime = _diskTicker(diskTicker, 0, config)
+
 	var (
 		lastNgr           int64
 		lastKaliveErrInc  int64
@@ -487,6 +498,7 @@ waitStartup:
 				r.ticker.Reset(statsTime)
 				logger.statsTime(statsTime)
 			}
+			diskTicker, diskTime = _diskTicker(diskTicker, diskTime, config)
 
 			// 2. flush logs (NOTE: stats runner is solely responsible)
 			flushTime := cos.NonZero(config.Log.FlushTime.D(), dfltPeriodicFlushTime)
@@ -516,13 +528,45 @@ waitStartup:
 
 			// 5. FD count
 			lastFDs = _checkFDs(now, lastFDs)
+		case <-_tickerC(diskTicker):
+			logger.sampleDisk(cmn.GCO.Get())
 		case <-r.stopCh:
 			r.ticker.Stop()
+			if diskTicker != nil {
+				diskTicker.Stop()
+			}
 			return nil
 		}
 	}
 }
 
+// (re)start, reset, or stop disk ticker upon periodic.stats_time_overrides change
+func _diskTicker(ticker *time.Ticker, prev time.Duration, config *cmn.Config) (*time.Ticker, time.Duration) {
+	d := config.Periodic.StatsTimeOf(cmn.StatsGroupDisk)
+	if d >= config.Periodic.StatsTime.D() {
+		d = 0 // no override
+	}
+	switch {
+	case d == prev:
+	case d == 0:
+		ticker.Stop()
+		ticker = nil
+	case ticker == nil:
+		ticker = time.NewTicker(d)
+	default:
+		ticker.Reset(d)
+	}
+	return ticker, d
+}
+
+// nil ticker => nil channel (blocks forever)
+func _tickerC(ticker *time.Ticker) <-chan time.Time {
+	if ticker == nil {
+		return nil
+	}
+	return ticker.C
+}
+
 func (r *runner) StartedUp() bool { return r.startedUp.Load() }
 
 // - check OOM and OOCPU
@@ -612,8 +656,15 @@ func (r *runner) GetStats() *Node {
 // TODO: reset prometheus as well (assuming, there's an API)
 func (r *runner) ResetStats(errorsOnly bool) {
 	r.core.reset(errorsOnly)
+	r.rerrs.reset()
+}
+
+func (r *runner) AddRecentErr(op string, bck *cmn.Bck, objName string, err error) {
+	r.rerrs.add(op, bck, objName, err)
 }
 
+func (r *runner) GetRecentErrs(limit int) []RecentErr { return r.rerrs.get(limit) }
+
 func (r *runner) GetMetricNames() cos.StrKVs {
 	out := make(cos.StrKVs, 48)
 	for name, v := range r.core.Tracker {
@@ -750,6 +801,14 @@ func hkLogs(int64) time.Duration {
 	return maxLogSizeCheckTime
 }
 
+// on demand (apc.ActRotateLogs): rotate and, synchronously, run the same GC as above;
+// returns the total size of removed logs
+func RotateLogs() int64 {
+	nlog.Flush(nlog.ActRotate)
+	config := cmn.GCO.Get()
+	return cos.GCLogsNow(config.LogDir, int64(config.Log.MaxTotal), cmn.Rom.V(4, cos.ModStats))
+}
+
 //
 // common helpers
 //
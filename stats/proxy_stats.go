@@ -167,6 +167,8 @@ func (r *Prunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 	r._memload(r.node.PageMM(), 0, 0)
 }
 
+func (*Prunner) sampleDisk(*cmn.Config) {}
+
 func (r *Prunner) statsTime(newval time.Duration) {
 	r.core.statsTime = newval
 }
@@ -0,0 +1,87 @@
+// Package stats provides methods and functionality to register, track, log,
+// and export metrics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// recent errors (see apc.WhatRecentErrors and api.GetRecentErrors)
+// - bounded in-memory ring: the last `MaxRecentErrs` error events
+// - complements error counters ("err.*" metrics) with the actual error details
+// - messages are truncated and scrubbed of credentials (auth headers, signed URLs, etc.)
+// - reset along with error counters (see ResetStats)
+
+const (
+	MaxRecentErrs = 256
+	maxRecentMsg  = 512
+)
+
+type (
+	RecentErr struct {
+		Time    time.Time `json:"time"`
+		Op      string    `json:"op"` // e.g., "get", "put", "del"
+		Bucket  string    `json:"bucket,omitempty"`
+		ObjName string    `json:"obj_name,omitempty"`
+		Msg     string    `json:"msg"`
+	}
+	recentErrs struct {
+		ring [MaxRecentErrs]RecentErr
+		cnt  int
+		next int
+		mu   sync.Mutex
+	}
+)
+
+// "Authorization: Bearer ...", "X-Amz-Signature=...", "token=...", etc.
+var reSecret = regexp.MustCompile(
+	`(?i)(authorization|x-amz-security-token|x-amz-signature|x-amz-credential|signature|token|secret|password|passwd|access[-_]?key)` +
+		`(["']?\s*[:=]\s*)(bearer\s+|basic\s+)?("[^"]*"|[^\s&,;"']+)`)
+
+func redactErrMsg(msg string) string {
+	if len(msg) > maxRecentMsg {
+		msg = msg[:maxRecentMsg] + "..."
+	}
+	return reSecret.ReplaceAllString(msg, "$1$2$3***")
+}
+
+func (re *recentErrs) add(op string, bck *cmn.Bck, objName string, err error) {
+	e := RecentErr{Time: time.Now(), Op: op, ObjName: objName, Msg: redactErrMsg(err.Error())}
+	if bck != nil {
+		e.Bucket = bck.Cname("")
+	}
+	re.mu.Lock()
+	re.ring[re.next] = e
+	re.next = (re.next + 1) % MaxRecentErrs
+	re.cnt = min(re.cnt+1, MaxRecentErrs)
+	re.mu.Unlock()
+}
+
+// newest first; limit <= 0: all
+func (re *recentErrs) get(limit int) []RecentErr {
+	re.mu.Lock()
+	n := re.cnt
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	out := make([]RecentErr, n)
+	for i := range n {
+		out[i] = re.ring[(re.next-1-i+MaxRecentErrs)%MaxRecentErrs]
+	}
+	re.mu.Unlock()
+	return out
+}
+
+func (re *recentErrs) reset() {
+	re.mu.Lock()
+	clear(re.ring[:])
+	re.cnt, re.next = 0, 0
+	re.mu.Unlock()
+}
@@ -0,0 +1,72 @@
+// Package stats provides methods and functionality to register, track, log,
+// and export metrics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"sync"
+)
+
+// request tags (apc.HdrRequestTag) => VlabTag values
+// - opt-in via feat.EnableRequestTagMetrics
+// - bounded cardinality: the first MaxReqTags distinct (valid) tags are reported as is;
+//   all other (and invalid) tags are reported as ReqTagOther
+
+const (
+	MaxReqTags   = 64
+	ReqTagOther  = "other"
+	maxReqTagLen = 64
+)
+
+type reqTags struct {
+	m  map[string]struct{}
+	mu sync.RWMutex
+}
+
+var rtags = reqTags{m: make(map[string]struct{}, MaxReqTags)}
+
+// returns (bucket, tag) variable labels
+func ReqTagVlabs(bname, tag string) map[string]string {
+	return map[string]string{VlabBucket: bname, VlabTag: rtags.get(tag)}
+}
+
+func (rt *reqTags) get(tag string) string {
+	if !validReqTag(tag) {
+		return ReqTagOther
+	}
+	rt.mu.RLock()
+	_, ok := rt.m[tag]
+	rt.mu.RUnlock()
+	if ok {
+		return tag
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if _, ok := rt.m[tag]; ok {
+		return tag
+	}
+	if len(rt.m) >= MaxReqTags {
+		return ReqTagOther
+	}
+	rt.m[tag] = struct{}{}
+	return tag
+}
+
+// [A-Za-z0-9._-], up to maxReqTagLen
+func validReqTag(tag string) bool {
+	if tag == "" || len(tag) > maxReqTagLen || tag == ReqTagOther {
+		return false
+	}
+	for _, c := range tag {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '.', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,28 @@
+// Package stats provides methods and functionality to register, track, log,
+// and export metrics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReqTagsBounded(t *testing.T) {
+	rt := reqTags{m: make(map[string]struct{})}
+	for i := range MaxReqTags {
+		tag := "team-" + strconv.Itoa(i)
+		tassert.Errorf(t, rt.get(tag) == tag, "expecting %q", tag)
+	}
+	tassert.Errorf(t, rt.get("team-0") == "team-0", "expecting known tag to be reported as is")
+	tassert.Errorf(t, rt.get("one-too-many") == ReqTagOther, "expecting %q over the limit", ReqTagOther)
+
+	for _, tag := range []string{"", "a b", "x/y", ReqTagOther, strings.Repeat("a", maxReqTagLen+1)} {
+		tassert.Errorf(t, !validReqTag(tag), "expecting %q to be invalid", tag)
+	}
+}
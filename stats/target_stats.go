@@ -45,12 +45,33 @@ const (
 	GetSize = "get.size"
 	PutSize = "put.size"
 
+	// GET from remote buckets: bytes served (to clients) from in-cluster (cached) objects vs. bytes fetched
+	// from the remote backend (cold GET - entire objects, even when the client reads a range);
+	// the former is, effectively, the egress saved
+	GetCacheSize   = "get.cache.size"
+	GetBackendSize = "get.backend.size"
+
+	// GET and PUT attributed to the request tag (apc.HdrRequestTag; see feat.EnableRequestTagMetrics)
+	TagGetCount = "tag.get.n"
+	TagGetSize  = "tag.get.size"
+	TagPutCount = "tag.put.n"
+	TagPutSize  = "tag.put.size"
+
 	// common latencies
 	AppendLatency    = "append.ns"
 	GetRedirLatency  = "get.redir.ns"
 	PutRedirLatency  = "put.redir.ns"
 	HeadLatencyTotal = "head.ns.total"
 
+	// read-repair: warm GET that restored corrupted object from a healthy local replica
+	GetRepairedCount = "get.repaired.n"
+
+	// GET mirrored object: number of reads served by each mountpath (see mirror.read_policy)
+	GetCopyCount = "get.copy.n"
+
+	// GET chunked object with read-ahead (apc.QparamReadAhead): number of prefetched chunks
+	GetReadAheadCount = "get.readahead.n"
+
 	// out-of-band
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
@@ -78,8 +99,22 @@ const (
 	RatelimPutRetryCount        = "ratelim.retry.put.n"
 	RatelimPutRetryLatencyTotal = "ratelim.retry.put.ns.total"
 
+	// cold GETs waiting for rate_limit.max_cold_get_concurrency (gauge)
+	RatelimColdGetQueued = "ratelim.coldget.queued"
+
+	// read admission (read_qos): per-class queued GETs and most recent admission wait (gauges)
+	QoSHighQueued   = "qos.high.queued"
+	QoSNormalQueued = "qos.normal.queued"
+	QoSLowQueued    = "qos.low.queued"
+	QoSHighWait     = "qos.high.wait"
+	QoSNormalWait   = "qos.normal.wait"
+	QoSLowWait      = "qos.low.wait"
+
 	// compare w/ common `DeleteCount`
 	RemoteDeletedDelCount = core.RemoteDeletedDelCount
+
+	// local (same-mountpath) copy via reflink
+	CopyLocalCount = core.CopyLocalCount
 )
 
 // 2. object metadata in memory
@@ -96,12 +131,19 @@ const (
 	GetBlobSize = "getblob.size"
 
 	// LRU eviction
-	LruEvictCount = "lru.evict.n"
-	LruEvictSize  = "lru.evict.size"
+	LruEvictCount    = "lru.evict.n"
+	LruEvictSize     = "lru.evict.size"
+	LruTooEarlyCount = "lru.tooearly.n"
 
 	// space cleanup
-	CleanupStoreCount = "cleanup.store.n"
-	CleanupStoreSize  = "cleanup.store.size"
+	CleanupStoreCount    = "cleanup.store.n"
+	CleanupStoreSize     = "cleanup.store.size"
+	CleanupTooEarlyCount = "cleanup.tooearly.n"
+
+	// scrub (validate checksums of present objects)
+	ScrubOKCount       = "scrub.ok.n"
+	ScrubCorruptCount  = "scrub.corrupt.n"
+	ScrubRepairedCount = "scrub.repaired.n"
 
 	// ETL (ext/etl)
 	ETLInlineCount         = "etl.inline.n"
@@ -110,6 +152,7 @@ const (
 	ETLOfflineCount        = "etl.offline.n"
 	ETLOfflineLatencyTotal = "etl.offline.ns.total"
 	ETLOfflineSize         = "etl.offline.size"
+	ErrETLTimeoutCount     = errPrefix + "etl.timeout.n"
 
 	// downloader (ext/dload)
 	// (not to confuse with blob downloader)
@@ -152,6 +195,7 @@ const (
 	_ = cos.StreamsOutObjSize
 	_ = cos.StreamsInObjCount
 	_ = cos.StreamsInObjSize
+	_ = cos.ErrStreamsOutHdrCount
 )
 
 // variable label used for prometheus disk metrics
@@ -318,6 +362,11 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "total cumulative size (bytes) of LRU evictions",
 		},
 	)
+	r.reg(snode, LruTooEarlyCount, KindCounter,
+		&Extra{
+			Help: "number of objects LRU skipped as accessed within lru.dont_evict_time",
+		},
+	)
 
 	// removing $deleted objects is currently not counted
 	r.reg(snode, CleanupStoreCount, KindCounter,
@@ -330,6 +379,31 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "space cleanup: total size (bytes) of all removed misplaced objects and old work files (not including removed deleted objects)",
 		},
 	)
+	r.reg(snode, CleanupTooEarlyCount, KindCounter,
+		&Extra{
+			Help: "space cleanup: number of objects and files skipped as written or accessed within space.dont_cleanup_time",
+		},
+	)
+
+	// scrub
+	r.reg(snode, ScrubOKCount, KindCounter,
+		&Extra{
+			Help:    "scrub: number of objects with validated (matching) checksums",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ScrubCorruptCount, KindCounter,
+		&Extra{
+			Help:    "scrub: number of corrupted objects (checksum mismatch)",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ScrubRepairedCount, KindCounter,
+		&Extra{
+			Help:    "scrub: number of corrupted objects repaired from remote backend, local replicas, or EC slices",
+			VarLabs: BckXlabs,
+		},
+	)
 
 	// out-of-band (x 3)
 	r.reg(snode, VerChangeCount, KindCounter,
@@ -412,6 +486,60 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckXlabs,
 		},
 	)
+	r.reg(snode, GetCacheSize, KindSize,
+		&Extra{
+			Help:    "GET: total cumulative size (bytes) served from in-cluster objects of remote buckets (warm GET)",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, GetBackendSize, KindSize,
+		&Extra{
+			Help:    "GET: total cumulative size (bytes) fetched from remote backends (cold GET)",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, TagGetCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of operations attributed to a given request tag",
+			VarLabs: TagVlabs,
+		},
+	)
+	r.reg(snode, TagGetSize, KindSize,
+		&Extra{
+			Help:    "GET: total cumulative size (bytes) attributed to a given request tag",
+			VarLabs: TagVlabs,
+		},
+	)
+	r.reg(snode, TagPutCount, KindCounter,
+		&Extra{
+			Help:    "PUT: number of operations attributed to a given request tag",
+			VarLabs: TagVlabs,
+		},
+	)
+	r.reg(snode, TagPutSize, KindSize,
+		&Extra{
+			Help:    "PUT: total cumulative size (bytes) attributed to a given request tag",
+			VarLabs: TagVlabs,
+		},
+	)
+	r.reg(snode, GetRepairedCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of corrupted objects (checksum mismatch) repaired from local replicas (n-way mirror)",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, GetCopyCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of reads of mirrored (n-way replicated) objects served from a given mountpath",
+			VarLabs: mpathVlabs,
+		},
+	)
+	r.reg(snode, GetReadAheadCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of chunks prefetched into page cache ahead of sequential reads of chunked objects",
+			VarLabs: BckVlabs,
+		},
+	)
 	r.reg(snode, GetBlobSize, KindSize,
 		&Extra{
 			Help:    "BLOB DOWNLOAD: total cumulative size (bytes)",
@@ -484,6 +612,11 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "intra-cluster streaming communications: total cumulative size (bytes) of all received objects",
 		},
 	)
+	r.reg(snode, cos.ErrStreamsOutHdrCount, KindCounter,
+		&Extra{
+			Help: "intra-cluster streaming communications: number of objects that could not be sent because of oversized (object) header",
+		},
+	)
 
 	// downloader (ext/dload)
 	r.reg(snode, DloadSize, KindSize,
@@ -524,6 +657,32 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckXlabs,
 		},
 	)
+	r.reg(snode, RatelimColdGetQueued, KindGauge,
+		&Extra{
+			Help:    "number of cold GETs currently queued due to per-bucket rate_limit.max_cold_get_concurrency",
+			StrName: "ratelim_coldget_queued",
+		},
+	)
+
+	// read admission (QoS)
+	for _, cls := range [...]struct{ queued, wait, name string }{
+		{QoSHighQueued, QoSHighWait, "high"},
+		{QoSNormalQueued, QoSNormalWait, "normal"},
+		{QoSLowQueued, QoSLowWait, "low"},
+	} {
+		r.reg(snode, cls.queued, KindGauge,
+			&Extra{
+				Help:    "number of " + cls.name + "-priority GETs currently queued due to read_qos.max_reads",
+				StrName: "qos_" + cls.name + "_queued",
+			},
+		)
+		r.reg(snode, cls.wait, KindGauge,
+			&Extra{
+				Help:    "time (nanoseconds) the most recently admitted " + cls.name + "-priority GET waited in the read_qos queue",
+				StrName: "qos_" + cls.name + "_wait_ns",
+			},
+		)
+	}
 
 	// ETL inline
 	r.reg(snode, ETLInlineCount, KindCounter,
@@ -548,6 +707,13 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 	// Dsort (requires `-tags=dsort`)
 	r.regDsort(snode)
 
+	r.reg(snode, ErrETLTimeoutCount, KindCounter,
+		&Extra{
+			Help:    "ETL: number of inline and offline transform requests that exceeded the timeout (slow or unresponsive transformer)",
+			VarLabs: BckXlabs,
+		},
+	)
+
 	// ETL offline
 	r.reg(snode, ETLOfflineCount, KindCounter,
 		&Extra{
@@ -569,6 +735,11 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 	)
 
 	// core
+	r.reg(snode, CopyLocalCount, KindCounter,
+		&Extra{
+			Help: "number of object copies on the same mountpath done via reflink, without reading or writing the data",
+		},
+	)
 	r.reg(snode, LcacheCollisionCount, KindCounter,
 		&Extra{
 			Help: "number of LOM cache collisions (core, internal)",
@@ -766,22 +937,9 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 
 	// 1. disk stats
 	refreshCap := r.Tcdf.Alerts() != 0
-	fs.DiskStats(r.disk.stats, nil /*fs.TcdfExt*/, config, refreshCap)
+	r._disk(config, refreshCap)
 
 	s := r.core
-	for disk, stats := range r.disk.stats {
-		n := r.nameRbps(disk)
-		v := s.Tracker[n]
-		if v == nil {
-			nlog.Warningln("missing:", n)
-			continue
-		}
-		s.set(n, stats.RBps)
-		s.set(r.nameRavg(disk), stats.Ravg)
-		s.set(r.nameWbps(disk), stats.WBps)
-		s.set(r.nameWavg(disk), stats.Wavg)
-		s.set(r.nameUtil(disk), stats.Util)
-	}
 
 	// 2 copy stats, reset latencies
 	s.updateUptime(uptime)
@@ -1041,6 +1199,30 @@ func _apps(sb *cos.SB, prefix string, items []string, total int) {
 	}
 }
 
+// when periodic.stats_time_overrides[disk] is configured
+func (r *Trunner) sampleDisk(config *cmn.Config) {
+	r._disk(config, false /*refresh cap*/)
+}
+
+func (r *Trunner) _disk(config *cmn.Config, refreshCap bool) {
+	fs.DiskStats(r.disk.stats, nil /*fs.TcdfExt*/, config, refreshCap)
+
+	s := r.core
+	for disk, stats := range r.disk.stats {
+		n := r.nameRbps(disk)
+		v := s.Tracker[n]
+		if v == nil {
+			nlog.Warningln("missing:", n)
+			continue
+		}
+		s.set(n, stats.RBps)
+		s.set(r.nameRavg(disk), stats.Ravg)
+		s.set(r.nameWbps(disk), stats.WBps)
+		s.set(r.nameWavg(disk), stats.Wavg)
+		s.set(r.nameUtil(disk), stats.Util)
+	}
+}
+
 func (r *Trunner) statsTime(newval time.Duration) {
 	r.core.statsTime = newval
 }
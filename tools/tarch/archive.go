@@ -24,8 +24,6 @@ import (
 	"github.com/NVIDIA/aistore/tools/trand"
 )
 
-var pool1m, pool128k, pool32k sync.Pool
-
 var (
 	_ archive.ArchRCB = (*rcbCtx)(nil)
 	_ archive.ArchRCB = (*rcbDummy)(nil)
@@ -212,74 +210,57 @@ func (*dummyFile) Sys() any           { return nil }
 // assorted buf pools
 //
 
-func newBuf(l int) (buf []byte) {
-	switch {
-	case l > cos.MiB:
-		debug.Assertf(false, "buf size exceeds 1MB: %d", l)
-	case l > 128*cos.KiB:
-		return newBuf1m()
-	case l > 32*cos.KiB:
-		return newBuf128k()
+// pooled buffer sizes, smallest to largest; requests that exceed the largest tier
+// are rounded up to the next power of two and get pooled by size (see sizedPool)
+var tiers = [...]int{32 * cos.KiB, 128 * cos.KiB, cos.MiB, 16 * cos.MiB}
+
+var (
+	pools  [len(tiers)]sync.Pool
+	sized  = make(map[int]*sync.Pool, 4)
+	sizedM sync.Mutex
+)
+
+func newBuf(l int) []byte {
+	pool, c := bufPool(l)
+	// TODO: keep slices, not pointers
+	if v := pool.Get(); v != nil {
+		pbuf := v.(*[]byte)
+		return *pbuf
 	}
-	return newBuf32k()
+	return make([]byte, c)
 }
 
 func freeBuf(buf []byte) {
 	c := cap(buf)
+	pool, size := bufPool(c)
+	debug.Assertf(size == c, "unexpected buf size: %d", c)
 	buf = buf[:c]
-	switch c {
-	case cos.MiB:
-		freeBuf1m(buf)
-	case 128 * cos.KiB:
-		freeBuf128k(buf)
-	case 32 * cos.KiB:
-		freeBuf32k(buf)
-	default:
-		debug.Assertf(false, "unexpected buf size: %d", c)
-	}
+	pool.Put(&buf)
 }
 
-// TODO: keep slices, not pointers - here and below
-func newBuf1m() (buf []byte) {
-	if v := pool1m.Get(); v != nil {
-		pbuf := v.(*[]byte)
-		buf = *pbuf
-	} else {
-		buf = make([]byte, cos.MiB)
+// returns pool and the size of its buffers
+func bufPool(l int) (*sync.Pool, int) {
+	for i, c := range tiers {
+		if l <= c {
+			return &pools[i], c
+		}
 	}
-	return
-}
-
-func freeBuf1m(buf []byte) {
-	pool1m.Put(&buf)
+	return sizedPool(l)
 }
 
-func newBuf128k() (buf []byte) {
-	if v := pool128k.Get(); v != nil {
-		pbuf := v.(*[]byte)
-		buf = *pbuf
-	} else {
-		buf = make([]byte, 128*cos.KiB)
+func sizedPool(l int) (*sync.Pool, int) {
+	c := tiers[len(tiers)-1]
+	for c < l {
+		c <<= 1
 	}
-	return
-}
-
-func freeBuf128k(buf []byte) {
-	pool128k.Put(&buf)
-}
-
-func newBuf32k() (buf []byte) {
-	if v := pool32k.Get(); v != nil {
-		pbuf := v.(*[]byte)
-		buf = *pbuf
-	} else {
-		buf = make([]byte, 32*cos.KiB)
+	sizedM.Lock()
+	pool, ok := sized[c]
+	if !ok {
+		pool = &sync.Pool{}
+		sized[c] = pool
 	}
-	return
-}
-
-func freeBuf32k(buf []byte) {
-	pool32k.Put(&buf)
+	sizedM.Unlock()
+	return pool, c
 }
 
 ///////////
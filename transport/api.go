@@ -77,7 +77,7 @@ type (
 		Burst        int           // this stream's burst capacity (may indirectly relate to the above)
 		SbundleMult  int           // so-many TCP connections per Rx endpoint, with round-robin
 		SizePDU      int32         // NOTE: 0(zero): no PDUs; must be <= `maxSizePDU`; unknown size _requires_ PDUs
-		MaxHdrSize   int32         // overrides config.Transport.MaxHeaderSize
+		MaxHdrSize   int32         // overrides config.Transport.MaxHeaderSize (initial size: grows as needed up to cmn.MaxTransportHeader)
 		IdleTeardown time.Duration // when exceeded, causes PUT to terminate (and to renew upon the very next send)
 	}
 
@@ -171,7 +171,14 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 //     network errors that may cause sudden and instant termination of the underlying
 //     stream(s).
 func (s *Stream) Send(obj *Obj) (err error) {
-	debug.Assertf(len(obj.Hdr.Opaque) < len(s.maxhdr)-sizeofh, "(%d, %d)", len(obj.Hdr.Opaque), len(s.maxhdr))
+	debug.Assertf(len(obj.Hdr.Opaque) < cmn.MaxTransportHeader-sizeofh, "(%d, %d)", len(obj.Hdr.Opaque), cmn.MaxTransportHeader)
+	if size := obj.Hdr.packedSize(); size > cmn.MaxTransportHeader {
+		// fail fast (see also: Read() => insObjHeader that resizes the header buffer as needed)
+		err = &ErrHdrTooLong{loghdr: s.loghdr, cname: obj.Hdr.Cname(), size: size}
+		g.tstats.Inc(cos.ErrStreamsOutHdrCount)
+		s.doCmpl(obj, err)
+		return
+	}
 	if err = s.startSend(obj); err != nil {
 		s.doCmpl(obj, err) // take a shortcut
 		return
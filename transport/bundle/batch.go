@@ -0,0 +1,226 @@
+// Package bundle provides multi-streaming transport with the functionality
+// to dynamically (un)register receive endpoints, establish long-lived flows, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bundle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+// Batching small objects (see Extra.BatchSmallObjs):
+// - on the send side, objects of size (0, Extra.BatchThresh] are read in memory and coalesced
+//   into per-destination batches, each batch transmitted as a single transport object
+//   (transport.OpcBatch) - one header per batch rather than per object;
+// - a batch is sent when it reaches batchSize, upon DM.Flush, and upon (graceful) DM.Close;
+// - on the receive side, wrapRecvData splits the batch and invokes the user's receive
+//   callback for each batched object in the order they were sent;
+// - each batched object's send-completion callback is called upon completion of its batch.
+
+const (
+	dfltBatchThresh = 16 * cos.KiB
+	batchSize       = 4 * cos.MiB // max size of a batch in bytes (soft limit)
+)
+
+var errBatchDropped = errors.New("batch dropped")
+
+type (
+	dmBatch struct {
+		tsi  *meta.Snode
+		buf  []byte
+		objs []transport.Obj // batched objects: headers and send-completion callbacks
+		mu   sync.Mutex
+	}
+	dmBatches struct {
+		m  map[string]*dmBatch // by destination ID
+		mu sync.Mutex
+	}
+)
+
+func (dm *DM) batchable(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode) bool {
+	if !dm.BatchSmallObjs || tsi == nil || roc == nil || dm.SizePDU != 0 || obj.Hdr.IsControl() {
+		return false
+	}
+	size := obj.Size()
+	return size > 0 && size <= cos.NonZero(dm.BatchThresh, int64(dfltBatchThresh))
+}
+
+func (dm *DM) maxHdrSize() int {
+	return cos.NonZero(int(dm.MaxHdrSize), cos.NonZero(dm.Config.Transport.MaxHeaderSize, cmn.DfltTransportHeader))
+}
+
+// read the object in memory and add it to the destination's batch;
+// the reader is closed right away while obj.SentCB is deferred until the batch is sent;
+// obj (allocated via transport.AllocSend) is copied into the batch and freed upon return -
+// same as with non-batched sends, the caller must not use it after this call
+func (dm *DM) sendBatched(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode) error {
+	batch := dm.batches.get(tsi)
+	batch.mu.Lock()
+
+	obj.Hdr.SID = core.T.SID()
+	l := len(batch.buf)
+	batch.buf = transport.AppendBatchHdr(batch.buf, &obj.Hdr, dm.maxHdrSize())
+	off, size := len(batch.buf), int(obj.Size())
+	batch.buf = slices.Grow(batch.buf, size)[:off+size]
+	_, err := io.ReadFull(roc, batch.buf[off:])
+	cos.Close(roc)
+	if err != nil {
+		batch.buf = batch.buf[:l]
+		batch.mu.Unlock()
+		_doCmpl(obj, nil, err)
+		err = fmt.Errorf("%s: failed to batch %s: %w", dm, obj, err)
+		transport.FreeSend(obj)
+		return err
+	}
+	batch.objs = append(batch.objs, *obj)
+	transport.FreeSend(obj)
+
+	var (
+		buf  []byte
+		objs []transport.Obj
+	)
+	if len(batch.buf) >= batchSize {
+		buf, objs = batch.detach()
+	}
+	batch.mu.Unlock()
+
+	if buf == nil {
+		return nil
+	}
+	return dm.sendBatch(tsi, buf, objs)
+}
+
+// Flush sends all pending batches (no-op when batching is disabled);
+// senders must call it upon completion of all (batched) sends - in particular,
+// prior to waiting for the corresponding ACKs or entering the next stage
+func (dm *DM) Flush() (err error) {
+	if !dm.BatchSmallObjs {
+		return nil
+	}
+	for _, batch := range dm.batches.all() {
+		batch.mu.Lock()
+		buf, objs := batch.detach()
+		batch.mu.Unlock()
+		if buf == nil {
+			continue
+		}
+		if errV := dm.sendBatch(batch.tsi, buf, objs); errV != nil && err == nil {
+			err = errV
+		}
+	}
+	return err
+}
+
+// drop pending batches (abort)
+func (dm *DM) dropBatches(err error) {
+	if !dm.BatchSmallObjs {
+		return
+	}
+	if err == nil {
+		err = errBatchDropped
+	}
+	for _, batch := range dm.batches.all() {
+		batch.mu.Lock()
+		_, objs := batch.detach()
+		batch.mu.Unlock()
+		dm.batchSent(nil, nil, objs, err)
+	}
+}
+
+func (dm *DM) sendBatch(tsi *meta.Snode, buf []byte, objs []transport.Obj) error {
+	o := transport.AllocSend()
+	o.Hdr.Opcode = transport.OpcBatch
+	o.Hdr.ObjAttrs.Size = int64(len(buf))
+	o.SentCB, o.CmplArg = dm.batchSent, objs
+	return dm.data.streams.Send(o, cos.NewByteReader(buf), tsi)
+}
+
+// batch send-completion: complete each batched object
+func (dm *DM) batchSent(_ *transport.ObjHdr, _ io.ReadCloser, arg any, err error) {
+	objs, ok := arg.([]transport.Obj)
+	debug.Assert(ok)
+	for i := range objs {
+		obj := &objs[i]
+		switch {
+		case obj.SentCB != nil:
+			obj.SentCB(&obj.Hdr, nil, obj.CmplArg, err)
+		case dm.parent != nil && dm.parent.SentCB != nil:
+			dm.parent.SentCB(&obj.Hdr, nil, obj.CmplArg, err)
+		}
+	}
+}
+
+// split received batch and deliver its objects one by one
+func (dm *DM) recvBatch(hdr *transport.ObjHdr, reader io.Reader) error {
+	buf := make([]byte, hdr.ObjAttrs.Size)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return fmt.Errorf("%s: failed to receive batch from %s: %w", dm, meta.Tname(hdr.SID), err)
+	}
+	var err error
+	for b := buf; len(b) > 0; {
+		bhdr, payload, rest, errN := transport.NextBatched(b)
+		if errN != nil {
+			return fmt.Errorf("%s: batch from %s: %w", dm, meta.Tname(hdr.SID), errN)
+		}
+		if errV := dm.wrapRecvData(&bhdr, bytes.NewReader(payload), nil); errV != nil && err == nil {
+			err = errV
+		}
+		b = rest
+	}
+	return err
+}
+
+/////////////
+// dmBatch //
+/////////////
+
+// (under lock)
+func (batch *dmBatch) detach() (buf []byte, objs []transport.Obj) {
+	if len(batch.objs) == 0 {
+		return nil, nil
+	}
+	buf, objs = batch.buf, batch.objs
+	batch.buf, batch.objs = nil, nil
+	return buf, objs
+}
+
+///////////////
+// dmBatches //
+///////////////
+
+func (bs *dmBatches) get(tsi *meta.Snode) *dmBatch {
+	bs.mu.Lock()
+	if bs.m == nil {
+		bs.m = make(map[string]*dmBatch, 8)
+	}
+	batch, ok := bs.m[tsi.ID()]
+	if !ok {
+		batch = &dmBatch{tsi: tsi}
+		bs.m[tsi.ID()] = batch
+	}
+	bs.mu.Unlock()
+	return batch
+}
+
+func (bs *dmBatches) all() []*dmBatch {
+	bs.mu.Lock()
+	all := make([]*dmBatch, 0, len(bs.m))
+	for _, batch := range bs.m {
+		all = append(all, batch)
+	}
+	bs.mu.Unlock()
+	return all
+}
@@ -42,16 +42,19 @@ type (
 		Smap             *meta.Smap // TODO: xactions to pass
 		SizePDU          int32
 		MaxHdrSize       int32
-		SkipGenericStats bool // if true, DM does not auto-increment In/OutObjs - caller does
+		SkipGenericStats bool  // if true, DM does not auto-increment In/OutObjs - caller does
+		BatchSmallObjs   bool  // coalesce small objects into per-destination batches (see batch.go)
+		BatchThresh      int64 // max size of an object to batch; zero defaults to 16KiB
 	}
 	// data mover is an easy-to-use stream bundle
 	DM struct {
-		parent *transport.Parent // optional: (parent xaction, term-cb, sent-cb)
-		data   bp                // data
-		ack    bp                // ACKs and control
-		Extra                    // (embed)
-		owt    cmn.OWT
-		stage  struct {
+		parent  *transport.Parent // optional: (parent xaction, term-cb, sent-cb)
+		data    bp                // data
+		ack     bp                // ACKs and control
+		Extra                     // (embed)
+		batches dmBatches
+		owt     cmn.OWT
+		stage   struct {
 			regmtx sync.Mutex
 			regged atomic.Bool
 			opened atomic.Bool
@@ -278,7 +281,14 @@ func (dm *DM) Close(err error) {
 			err = xctn.AbortErr()
 		}
 	}
-	// nil: close gracefully via `fin`, otherwise abort
+	// nil: flush pending batches and close gracefully via `fin`, otherwise abort
+	if err == nil {
+		if errF := dm.Flush(); errF != nil {
+			nlog.Warningln(dm.String(), "flush:", errF)
+		}
+	} else {
+		dm.dropBatches(err)
+	}
 	dm.data.streams.Close(err == nil)
 	if dm.useACKs() {
 		dm.ack.streams.Close(err == nil)
@@ -291,6 +301,7 @@ func (dm *DM) Close(err error) {
 }
 
 func (dm *DM) Abort() {
+	dm.dropBatches(nil)
 	dm.data.streams.Abort()
 	if dm.useACKs() {
 		dm.ack.streams.Abort()
@@ -300,16 +311,22 @@ func (dm *DM) Abort() {
 }
 
 func (dm *DM) Send(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode, xctns ...core.Xact) (err error) {
-	err = dm.data.streams.Send(obj, roc, tsi)
+	// obj gets freed once sent (or batched) - read what's needed for stats upfront
+	size, isCtrl := obj.Size(), obj.Hdr.IsControl()
+	if dm.batchable(obj, roc, tsi) {
+		err = dm.sendBatched(obj, roc, tsi)
+	} else {
+		err = dm.data.streams.Send(obj, roc, tsi)
+	}
 
 	// xaction Tx stats: data only
-	if err == nil && !obj.Hdr.IsControl() && !dm.SkipGenericStats {
+	if err == nil && !isCtrl && !dm.SkipGenericStats {
 		xctn := dm.xctn()
 		if len(xctns) > 0 {
 			xctn = xctns[0]
 		}
 		if xctn != nil {
-			if size := obj.Size(); size >= 0 { // known size (ie., non-PDU mode)
+			if size >= 0 { // known size (ie., non-PDU mode)
 				xctn.OutObjsAdd(1, size)
 			}
 		}
@@ -346,6 +363,10 @@ func (dm *DM) quicb(time.Duration /*total*/) core.QuiRes {
 }
 
 func (dm *DM) wrapRecvData(hdr *transport.ObjHdr, reader io.Reader, err error) error {
+	if err == nil && hdr.Opcode == transport.OpcBatch {
+		return dm.recvBatch(hdr, reader)
+	}
+
 	// SDM is shared between xactions (with further demux across work items).
 	// Per-xaction accounting such as InObjsAdd
 	// and `laterx` update happens after WID demux in SDM's recv callback.
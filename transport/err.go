@@ -5,9 +5,12 @@
 package transport
 
 import (
+	"fmt"
+	"math"
 	"strings"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
@@ -44,6 +47,12 @@ type (
 		dst    string // destination node ID // TODO: needed?
 		ctx    string
 	}
+	// Tx: object header exceeds cmn.MaxTransportHeader (or any single field - 64KiB)
+	ErrHdrTooLong struct {
+		loghdr string
+		cname  string
+		size   int
+	}
 	// Rx
 	ErrSBR struct {
 		err    error
@@ -81,6 +90,22 @@ func (e *errStreamTerm) Error() string {
 
 func (e *errStreamTerm) Unwrap() error { return e.err }
 
+///////////////////
+// ErrHdrTooLong //
+///////////////////
+
+func (e *ErrHdrTooLong) Error() string {
+	if e.size == math.MaxInt {
+		return fmt.Sprintf("%s: %s: object header field exceeds %d bytes", e.loghdr, e.cname, math.MaxUint16)
+	}
+	return fmt.Sprintf("%s: %s: object header size %d exceeds %d maximum", e.loghdr, e.cname, e.size, cmn.MaxTransportHeader)
+}
+
+func IsErrHdrTooLong(err error) bool {
+	_, ok := err.(*ErrHdrTooLong)
+	return ok
+}
+
 ////////////
 // ErrSBR //
 ////////////
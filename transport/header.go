@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"slices"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -47,6 +48,10 @@ const (
 	OpcReconnect = iota + 46351
 )
 
+// data mover's batch of small objects (see bundle.Extra.BatchSmallObjs);
+// unlike group 2 above, carries payload and therefore is not a control opcode
+const OpcBatch = OpcDone - 1
+
 // group 3: transport's internal range of 16 `Obj.Hdr.Opcode` values
 const (
 	opcFin = iota + math.MaxUint16 - 16
@@ -85,6 +90,34 @@ func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
 	return
 }
 
+// serialized size of the object header (see insObjHeader);
+// returns math.MaxInt if any single field exceeds its (uint16) length limit
+func (hdr *ObjHdr) packedSize() int {
+	var (
+		size  = sizeProtoHdr + cos.SizeofI16 /*opcode*/ + cos.SizeofI64*2 /*size, atime*/
+		cksum = hdr.ObjAttrs.Checksum()
+		flds  = [...]string{hdr.SID, hdr.Bck.Name, hdr.Bck.Provider, hdr.Bck.Ns.Name, hdr.Bck.Ns.UUID, hdr.ObjName,
+			cos.UnsafeS(hdr.Opaque), hdr.Demux, hdr.ObjAttrs.Version(), "" /*custom md term*/}
+	)
+	if cksum != nil {
+		size += len(cksum.Ty()) + len(cksum.Val())
+	}
+	size += cos.SizeofI16 * 2
+	for _, fld := range flds {
+		if len(fld) > math.MaxUint16 {
+			return math.MaxInt
+		}
+		size += cos.SizeofI16 + len(fld)
+	}
+	for k, v := range hdr.ObjAttrs.GetCustomMD() {
+		if len(k) > math.MaxUint16 || len(v) > math.MaxUint16 {
+			return math.MaxInt
+		}
+		size += cos.SizeofI16*2 + len(k) + len(v)
+	}
+	return size
+}
+
 func (pdu *spdu) insHeader() {
 	buf, plen := pdu.buf, pdu.plength()
 	word1 := uint64(plen) | pduFl
@@ -147,10 +180,47 @@ func insAttrs(off int, to []byte, attr *cmn.ObjAttrs) int {
 	return off
 }
 
+// AppendBatchHdr appends serialized (proto + object) header to a batch of small objects;
+// the caller then appends exactly hdr.ObjAttrs.Size bytes of the object's payload
+// (see OpcBatch and NextBatched)
+func AppendBatchHdr(b []byte, hdr *ObjHdr, maxHdrSize int) []byte {
+	debug.Assert(hdr.ObjAttrs.Size >= 0)
+	l := len(b)
+	b = slices.Grow(b, maxHdrSize)
+	off := insObjHeader(b[l:l+maxHdrSize], hdr, false /*usePDU*/)
+	return b[:l+off]
+}
+
 //
 // proto header: deserialization
 //
 
+// NextBatched returns the next (header, payload) from a batch of small objects,
+// along with the remaining (not yet parsed) part of the batch
+// NOTE: both the header and the payload alias the batch - same rules as in the ObjHdr comment
+func NextBatched(b []byte) (hdr ObjHdr, payload, rest []byte, err error) {
+	if len(b) < sizeProtoHdr {
+		return hdr, nil, nil, fmt.Errorf("invalid batch: truncated header (%d)", len(b))
+	}
+	_, word1 := extUint64(0, b)
+	_, checksum := extUint64(cos.SizeofI64, b)
+	if chc := xoshiro256.Hash(word1); checksum != chc || word1&allFlags != 0 {
+		return hdr, nil, nil, fmt.Errorf("invalid batch: bad header checksum %x != %x (word1 %x)", checksum, chc, word1)
+	}
+	hlen := int(word1)
+	off := sizeProtoHdr + hlen
+	if off > len(b) {
+		return hdr, nil, nil, fmt.Errorf("invalid batch: header length %d out of bounds (%d)", hlen, len(b))
+	}
+	hdr = ExtObjHeader(b[sizeProtoHdr:off], hlen)
+	size := hdr.ObjAttrs.Size
+	if size < 0 || int64(off)+size > int64(len(b)) {
+		return hdr, nil, nil, fmt.Errorf("invalid batch: %s size %d out of bounds (%d)", hdr.Cname(), size, len(b)-off)
+	}
+	end := off + int(size)
+	return hdr, b[off:end], b[end:], nil
+}
+
 func (it *iterator) extProtoHdr(hbuf []byte) (hlen int, flags uint64, err error) {
 	off, word1 := extUint64(0, hbuf)
 	hlen = int(word1 & ^allFlags)
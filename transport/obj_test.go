@@ -418,6 +418,93 @@ func TestObjAttrs(t *testing.T) {
 	}
 }
 
+func TestLongHdrs(t *testing.T) {
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	var (
+		receivedCount atomic.Int64
+		longName      = strings.Repeat("a/", 4*cos.KiB) // exceeds default (initial) header buffer
+		longVal       = strings.Repeat("v", 32*cos.KiB) // ditto
+		tooLong       = strings.Repeat("x", 60*cos.KiB) // fits uint16 field, but not the max header
+		hdrs          = make([]transport.ObjHdr, 3)
+		bck           = cmn.Bck{Name: "long", Provider: apc.AIS}
+	)
+	hdrs[0] = transport.ObjHdr{Bck: bck, ObjName: longName}
+	hdrs[1] = transport.ObjHdr{Bck: bck, ObjName: "obj"}
+	hdrs[1].ObjAttrs.SetCustomKey("long", longVal)
+	hdrs[2] = transport.ObjHdr{Bck: bck, ObjName: "too-long"}
+	for i := range 3 {
+		hdrs[2].ObjAttrs.SetCustomKey(strconv.Itoa(i), tooLong)
+	}
+
+	recvFunc := func(hdr *transport.ObjHdr, _ io.Reader, err error) error {
+		cos.Assert(err == nil)
+		switch hdr.ObjName {
+		case longName:
+		case "obj":
+			v, _ := hdr.ObjAttrs.GetCustomKey("long")
+			cos.Assert(v == longVal)
+		default:
+			cos.AssertMsg(false, "unexpected "+hdr.ObjName)
+		}
+		receivedCount.Inc()
+		return nil
+	}
+	trname := "longhdrs"
+	err := transport.Handle(trname, recvFunc)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{Config: cmn.GCO.Get()})
+
+	for i := range 2 {
+		tassert.CheckFatal(t, stream.Send(&transport.Obj{Hdr: hdrs[i]}))
+	}
+	err = stream.Send(&transport.Obj{Hdr: hdrs[2]})
+	tassert.Fatalf(t, transport.IsErrHdrTooLong(err), "expecting header-too-long error, got %v", err)
+	stream.Fin()
+
+	tassert.Fatalf(t, receivedCount.Load() == 2, "invalid received count: %d, expected: 2", receivedCount.Load())
+}
+
+func TestBatchedHdrs(t *testing.T) {
+	var (
+		b    []byte
+		hdrs = make([]transport.ObjHdr, 100)
+	)
+	for i := range hdrs {
+		hdr := &hdrs[i]
+		hdr.Bck = cmn.Bck{Name: "batch", Provider: apc.AIS}
+		hdr.ObjName = "obj-" + strconv.Itoa(i)
+		hdr.SID = "sid"
+		hdr.ObjAttrs.Size = int64(i * 10)
+		hdr.ObjAttrs.SetCksum(cos.ChecksumOneXxh, strconv.Itoa(i))
+		b = transport.AppendBatchHdr(b, hdr, memsys.PageSize)
+		b = append(b, strings.Repeat(string(rune('a'+i%26)), i*10)...)
+	}
+	for i := range hdrs {
+		hdr, payload, rest, err := transport.NextBatched(b)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, hdr.ObjName == hdrs[i].ObjName && hdr.SID == "sid", "entry %d: unexpected %+v", i, hdr)
+		tassert.Fatalf(t, hdr.ObjAttrs.Size == hdrs[i].ObjAttrs.Size && int64(len(payload)) == hdr.ObjAttrs.Size,
+			"entry %d: size %d, payload %d", i, hdr.ObjAttrs.Size, len(payload))
+		tassert.Fatalf(t, hdr.ObjAttrs.Checksum().Equal(hdrs[i].ObjAttrs.Checksum()), "entry %d: checksum mismatch", i)
+		tassert.Fatalf(t, strings.Trim(string(payload), string(rune('a'+i%26))) == "", "entry %d: payload mismatch", i)
+		b = rest
+	}
+	tassert.Fatalf(t, len(b) == 0, "expected fully consumed batch, %d bytes remain", len(b))
+
+	// corrupted and truncated
+	b = transport.AppendBatchHdr(nil, &hdrs[10], memsys.PageSize)
+	_, _, _, err := transport.NextBatched(b) // payload missing
+	tassert.Errorf(t, err != nil, "expected error on truncated payload")
+	b[3]++
+	_, _, _, err = transport.NextBatched(b)
+	tassert.Errorf(t, err != nil, "expected error on corrupted header")
+}
+
 func receive10G(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
 	cos.Assert(err == nil || cos.IsAnyEOF(err))
 	written, _ := io.Copy(io.Discard, objReader)
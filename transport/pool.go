@@ -26,7 +26,7 @@ func AllocSend() *Obj {
 	return sendPool.Get().(*Obj)
 }
 
-func freeSend(obj *Obj) { // sendobj & stream_bundle
+func FreeSend(obj *Obj) { // sendobj & bundle (batched sends)
 	*obj = sobj0
 	sendPool.Put(obj)
 }
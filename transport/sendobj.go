@@ -31,10 +31,11 @@ type (
 	}
 	lz4Stream struct {
 		s             *Stream
-		zw            *lz4.Writer // orig reader => zw
-		sgl           *memsys.SGL // zw => bb => network
-		blockMaxSize  int         // *uncompressed* block max size
-		frameChecksum bool        // true: checksum lz4 frames
+		zw            *lz4.Writer          // orig reader => zw
+		sgl           *memsys.SGL          // zw => bb => network
+		blockMaxSize  int                  // *uncompressed* block max size
+		frameChecksum bool                 // true: checksum lz4 frames
+		level         lz4.CompressionLevel // fast (default) or one of the HC levels
 	}
 	sendoff struct {
 		obj Obj
@@ -88,6 +89,7 @@ func (s *Stream) initCompression(extra *Extra) {
 	s.lz4s.s = s
 	s.lz4s.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
 	s.lz4s.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
+	s.lz4s.level = lz4Level(extra.Config.Transport.LZ4CompressionLevel)
 	if s.lz4s.blockMaxSize >= memsys.MaxPageSlabSize {
 		s.lz4s.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
 	} else {
@@ -95,6 +97,15 @@ func (s *Stream) initCompression(extra *Extra) {
 	}
 }
 
+// config (transport.lz4_level) => lz4 enum: 0 => fast, [1, 9] => HC levels
+func lz4Level(level int) lz4.CompressionLevel {
+	if level <= 0 {
+		return lz4.Fast
+	}
+	debug.Assert(level <= cmn.MaxLZ4CompressionLevel, level)
+	return lz4.Level1 << (level - 1)
+}
+
 func (s *Stream) compressed() bool { return s.lz4s != nil }
 func (s *Stream) usePDU() bool     { return s.pdu != nil }
 
@@ -152,7 +163,7 @@ func (s *Stream) doCmpl(obj *Obj, err error) {
 			s.sentCB(&obj.Hdr, obj.Reader, obj.CmplArg, err)
 		}
 	}
-	freeSend(obj)
+	FreeSend(obj)
 }
 
 // Retry is currently only safe if we haven't sent any bytes yet
@@ -179,6 +190,7 @@ func (s *Stream) doRequest() error {
 		lz4.BlockChecksumOption(false),
 		lz4.ChecksumOption(s.lz4s.frameChecksum),
 		lz4.BlockSizeOption(lz4.BlockSize(s.lz4s.blockMaxSize)),
+		lz4.CompressionLevelOption(s.lz4s.level),
 	)
 	debug.AssertNoErr(err)
 
@@ -239,6 +251,9 @@ repeat:
 			}
 			return s.deactivate()
 		}
+		if size := obj.Hdr.packedSize(); size > len(s.maxhdr) {
+			s.growHdr(size) // (size <= cmn.MaxTransportHeader - see Send)
+		}
 		l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU())
 		s.header = s.maxhdr[:l]
 		s.sendoff.ins = inHdr
@@ -251,6 +266,14 @@ repeat:
 	}
 }
 
+// auto-size the header buffer to accommodate long names and/or large custom metadata
+func (s *Stream) growHdr(size int) {
+	debug.Assert(size <= cmn.MaxTransportHeader, size)
+	nlog.Warningln(s.String(), "header size", size, "exceeds the current buffer", len(s.maxhdr), "- growing")
+	g.mm.Free(s.maxhdr)
+	s.maxhdr, _ = g.mm.AllocSize(min(int64(size)<<1, cmn.MaxTransportHeader))
+}
+
 func (s *Stream) sendHdr(b []byte) (n int, err error) {
 	n = copy(b, s.header[s.sendoff.off:])
 	s.sendoff.off += int64(n)
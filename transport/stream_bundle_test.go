@@ -222,3 +222,65 @@ func addTarget(smap *meta.Smap, ts *httptest.Server, i int) {
 	tid := "t_" + strconv.FormatInt(int64(i), 10)
 	smap.Tmap[tid] = &meta.Snode{PubNet: netinfo, ControlNet: netinfo, DataNet: netinfo}
 }
+
+// batched small objects: delivered upon Flush (and not before), in order
+func TestDMBatchFlush(t *testing.T) {
+	const (
+		trname  = "dm-batch"
+		numObjs = 50
+		objSize = cos.KiB
+	)
+	tMock := mock.NewTarget(nil)
+	tMock.SO = &sowner{}
+	core.T = tMock
+
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+	netinfo := meta.NetInfo{URL: ts.URL}
+	tsi := &meta.Snode{PubNet: netinfo, ControlNet: netinfo, DataNet: netinfo}
+	tsi.Init("t_0", apc.Target, nil)
+	smap.Tmap = meta.NodeMap{tMock.SID(): tMock.Snode(), tsi.ID(): tsi}
+	smap.Version = 1
+
+	var (
+		received = make(chan string, numObjs)
+		numSent  atomic.Int64
+	)
+	recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+		if err != nil && !cos.IsOkEOF(err) {
+			return err
+		}
+		written, _ := io.Copy(io.Discard, objReader)
+		cos.Assert(written == objSize)
+		received <- hdr.ObjName
+		return nil
+	}
+	dm := bundle.NewDM(trname, recv, cmn.OwtRebalance, bundle.Extra{Config: cmn.GCO.Get(), BatchSmallObjs: true})
+	tassert.CheckFatal(t, dm.RegRecv())
+	defer dm.UnregRecv()
+	dm.Open()
+
+	payload := make([]byte, objSize)
+	for i := range numObjs {
+		hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "batch", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = objSize
+		o := &transport.Obj{Hdr: hdr, SentCB: func(*transport.ObjHdr, io.ReadCloser, any, error) { numSent.Inc() }}
+		tassert.CheckFatal(t, dm.Send(o, cos.NewByteReader(payload), tsi))
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	tassert.Fatalf(t, len(received) == 0 && numSent.Load() == 0, "expected nothing sent prior to flush, got (%d, %d)",
+		len(received), numSent.Load())
+
+	tassert.CheckFatal(t, dm.Flush())
+	for i := range numObjs {
+		select {
+		case name := <-received:
+			tassert.Fatalf(t, name == "obj-"+strconv.Itoa(i), "expected obj-%d, got %q", i, name)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for obj-%d", i)
+		}
+	}
+	dm.Close(nil)
+	tassert.Errorf(t, numSent.Load() == numObjs, "expected %d send completions, got %d", numObjs, numSent.Load())
+}
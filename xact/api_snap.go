@@ -6,8 +6,10 @@ package xact
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
@@ -19,6 +21,25 @@ import (
 // `api.QueryXactionSnaps` control structure
 type (
 	MultiSnap map[string][]*core.Snap // by target ID (tid)
+
+	// cluster-wide (all targets) view of a given xaction (see `api.ListXactions`)
+	Summary struct {
+		StartTime time.Time `json:"start-time"` // earliest
+		EndTime   time.Time `json:"end-time"`   // latest; zero when still running
+		Bck       cmn.Bck   `json:"bck"`
+		SrcBck    cmn.Bck   `json:"src-bck"`
+		DstBck    cmn.Bck   `json:"dst-bck"`
+		ID        string    `json:"id"`
+		Kind      string    `json:"kind"`
+		AbortErr  string    `json:"abort-err,omitempty"`
+		Objs      int64     `json:"objs"`
+		Bytes     int64     `json:"bytes"`
+		InObjs    int64     `json:"in-objs"`
+		OutObjs   int64     `json:"out-objs"`
+		Nodes     int       `json:"nodes"`
+		Running   bool      `json:"running"`
+		Aborted   bool      `json:"aborted"`
+	}
 )
 
 // NOTE: when xaction UUID is not specified: require the same kind _and_
@@ -212,6 +233,59 @@ func (xs MultiSnap) TotalRunningTime(xid string) (time.Duration, error) {
 	return end.Sub(start), nil
 }
 
+// Summaries aggregates per-target snaps by xaction ID; sorted by start time, most recent first
+func (xs MultiSnap) Summaries() []*Summary {
+	var (
+		all = make(map[string]*Summary, 8)
+		out = make([]*Summary, 0, 8)
+	)
+	for _, snaps := range xs {
+		for _, xsnap := range snaps {
+			sum, ok := all[xsnap.ID]
+			if !ok {
+				sum = &Summary{
+					ID:     xsnap.ID,
+					Kind:   xsnap.Kind,
+					Bck:    xsnap.Bck,
+					SrcBck: xsnap.SrcBck,
+					DstBck: xsnap.DstBck,
+				}
+				all[xsnap.ID] = sum
+				out = append(out, sum)
+			}
+			sum.add(xsnap)
+		}
+	}
+	for _, sum := range out {
+		if sum.Running {
+			sum.EndTime = time.Time{}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartTime.After(out[j].StartTime) })
+	return out
+}
+
+func (sum *Summary) add(xsnap *core.Snap) {
+	sum.Nodes++
+	if !xsnap.StartTime.IsZero() && (sum.StartTime.IsZero() || xsnap.StartTime.Before(sum.StartTime)) {
+		sum.StartTime = xsnap.StartTime
+	}
+	if xsnap.EndTime.After(sum.EndTime) {
+		sum.EndTime = xsnap.EndTime
+	}
+	sum.Objs += xsnap.Stats.Objs
+	sum.Bytes += xsnap.Stats.Bytes
+	sum.InObjs += xsnap.Stats.InObjs
+	sum.OutObjs += xsnap.Stats.OutObjs
+	sum.Running = sum.Running || xsnap.IsRunning()
+	if xsnap.IsAborted() {
+		sum.Aborted = true
+		if sum.AbortErr == "" {
+			sum.AbortErr = xsnap.AbortErr
+		}
+	}
+}
+
 func (xs MultiSnap) ToJSON(tid string, indent bool) ([]byte, error) {
 	out := make(map[string][]string, len(xs))
 	for sid, snaps := range xs {
@@ -0,0 +1,48 @@
+// Package xact_test: aggregating per-target snaps
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestMultiSnapSummaries(t *testing.T) {
+	var (
+		now = time.Now()
+		xs  = xact.MultiSnap{
+			"t1": {
+				{ID: "x1", Kind: "copy-bck", StartTime: now.Add(-time.Hour), EndTime: now.Add(-time.Minute),
+					Stats: core.Stats{Objs: 10, Bytes: 100}},
+				{ID: "x2", Kind: "evict-listrange", StartTime: now.Add(-time.Minute)},
+			},
+			"t2": {
+				{ID: "x1", Kind: "copy-bck", StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Second),
+					Stats: core.Stats{Objs: 5, Bytes: 50}, AbortedX: true, AbortErr: "aborted"},
+				{ID: "x2", Kind: "evict-listrange", StartTime: now.Add(-2 * time.Minute), EndTime: now},
+			},
+		}
+		sums = xs.Summaries()
+	)
+	tassert.Fatalf(t, len(sums) == 2, "expected 2 summaries, got %d", len(sums))
+
+	// most recent first
+	x2, x1 := sums[0], sums[1]
+	tassert.Fatalf(t, x2.ID == "x2" && x1.ID == "x1", "unexpected order: %s, %s", x2.ID, x1.ID)
+
+	tassert.Errorf(t, x1.Nodes == 2, "x1: expected 2 nodes, got %d", x1.Nodes)
+	tassert.Errorf(t, x1.Objs == 15 && x1.Bytes == 150, "x1: wrong counts %d, %d", x1.Objs, x1.Bytes)
+	tassert.Errorf(t, x1.StartTime.Equal(now.Add(-2*time.Hour)), "x1: wrong start time %v", x1.StartTime)
+	tassert.Errorf(t, x1.EndTime.Equal(now.Add(-time.Second)), "x1: wrong end time %v", x1.EndTime)
+	tassert.Errorf(t, !x1.Running && x1.Aborted && x1.AbortErr == "aborted", "x1: wrong state %+v", x1)
+
+	// still running on t1
+	tassert.Errorf(t, x2.Running && !x2.Aborted, "x2: wrong state %+v", x2)
+	tassert.Errorf(t, x2.EndTime.IsZero(), "x2: expected zero end time, got %v", x2.EndTime)
+}
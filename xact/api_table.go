@@ -129,6 +129,16 @@ var Table = map[string]Descriptor{
 	apc.ActResilver: {Scope: ScopeT, Startable: true, Resilver: true}, // ICMode: ICNone - ScopeT, single-target, no aggregation
 	apc.ActRechunk:  {Scope: ScopeB, Startable: true, RefreshCap: true, ConflictRebRes: true, AbortByReb: true, ICMode: ICUponTerm},
 
+	// post-rebalance warm-up (see Rebalance.WarmupBudget) starts only after rebalance is done;
+	// a subsequent rebalance aborts it (no ConflictRebRes: may run alongside resilver)
+	apc.ActWarmup: {
+		DisplayName: "warm-up",
+		Scope:       ScopeT,
+		Startable:   false,
+		RefreshCap:  true,
+		AbortByReb:  true,
+	},
+
 	// IndexShard is a best-effort build: stale entries are detected via LOM checksum
 	// and fall back to tar.Next() scan. A partial index remains useful, and resumed
 	// builds atomically skip already-indexed LOMs (lom.md.flags&Indexed + index object).
@@ -142,6 +152,12 @@ var Table = map[string]Descriptor{
 		ICMode:         ICUponTerm,
 	},
 
+	// validate checksums of present objects; optionally, repair corrupted ones
+	apc.ActScrub: {Scope: ScopeB, Startable: false, ConflictRebRes: true, AbortByReb: true, ICMode: ICUponTerm},
+
+	// consistency check of chunked objects: missing chunks, orphan chunks, partial manifests
+	apc.ActVerifyChunks: {Scope: ScopeB, Startable: false, ConflictRebRes: true, AbortByReb: true, ICMode: ICUponTerm},
+
 	// on-demand EC and n-way replication
 	// (non-startable, triggered by PUT => erasure-coded or mirrored bucket)
 	apc.ActECGet:     {Scope: ScopeB, Startable: false, Idles: true, ExtendedStats: true},
@@ -242,6 +258,15 @@ var Table = map[string]Descriptor{
 		ICMode:      ICUponTerm,
 	},
 
+	apc.ActSyncRemote: {
+		DisplayName: "sync-remote",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   false,
+		RefreshCap:  true,
+		ICMode:      ICUponTerm,
+	},
+
 	// entire bucket (storage svcs)
 	apc.ActECEncode: {
 		DisplayName:    "ec-bucket",
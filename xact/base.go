@@ -43,12 +43,21 @@ type (
 		kind string
 		_nam string
 		err  cos.Errs
+		oerr objErrs
 		// TODO: add archived files counts
 		stats core.Stats
 		// starting and stopping
 		sutime atomic.Int64
 		eutime atomic.Uint64
 	}
+	// bounded ring of the most recent per-object failures
+	objErrs struct {
+		ring []core.ObjErr
+		max  apc.MaxErrs
+		cnt  atomic.Int64 // total
+		next int
+		mu   sync.Mutex
+	}
 	Marked struct {
 		Xact        core.Xact
 		Interrupted bool // (rebalance | resilver) interrupted
@@ -269,6 +278,37 @@ func (xctn *Base) AddErr(err error, logExtra ...int) {
 	}
 }
 
+// AddErrObj records a per-object failure (retrievable via api.GetXactionErrors)
+// and, in addition, does AddErr;
+// returns non-nil when exceeding the configured (apc.MaxErrs) thresholds
+func (xctn *Base) AddErrObj(objName string, err error, logExtra ...int) error {
+	if xctn.IsAborted() {
+		return nil
+	}
+	xctn.oerr.add(objName, err)
+	xctn.AddErr(err, logExtra...)
+
+	m := &xctn.oerr.max
+	if !m.IsSet() {
+		return nil
+	}
+	nerr := xctn.oerr.cnt.Load()
+	if errN := m.Check(nerr, nerr+xctn.Objs()); errN != nil {
+		return fmt.Errorf("%s: %w", xctn.Name(), errN)
+	}
+	return nil
+}
+
+// user-specified thresholds - zero values are ignored
+// (not thread-safe; to be called prior to visiting objects)
+func (xctn *Base) SetMaxErrs(m *apc.MaxErrs) {
+	if m.IsSet() {
+		xctn.oerr.max = *m
+	}
+}
+
+func (xctn *Base) ErrObjs() []core.ObjErr { return xctn.oerr.get() }
+
 func (xctn *Base) _nerr() (n int, err error) {
 	if n = xctn.ErrCnt(); n > 0 {
 		err = &xctn.err
@@ -421,3 +461,34 @@ func CompareRebIDs(someID, fltID string) int {
 	}
 	return 0
 }
+
+/////////////
+// objErrs //
+/////////////
+
+const maxObjErrs = 256
+
+func (oe *objErrs) add(objName string, err error) {
+	e := core.ObjErr{ObjName: objName, Err: err.Error()}
+	oe.cnt.Inc()
+	oe.mu.Lock()
+	if len(oe.ring) < maxObjErrs {
+		oe.ring = append(oe.ring, e)
+	} else {
+		oe.ring[oe.next] = e
+		oe.next = (oe.next + 1) % maxObjErrs
+	}
+	oe.mu.Unlock()
+}
+
+// oldest to newest
+func (oe *objErrs) get() (out []core.ObjErr) {
+	oe.mu.Lock()
+	if l := len(oe.ring); l > 0 {
+		out = make([]core.ObjErr, 0, l)
+		out = append(out, oe.ring[oe.next:]...)
+		out = append(out, oe.ring[:oe.next]...)
+	}
+	oe.mu.Unlock()
+	return out
+}
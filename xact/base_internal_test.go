@@ -0,0 +1,51 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjErrsRing(t *testing.T) {
+	var oe objErrs
+	tassert.Fatalf(t, len(oe.get()) == 0, "expecting no errors")
+
+	for i := range 10 {
+		oe.add("o"+strconv.Itoa(i), errors.New("e"+strconv.Itoa(i)))
+	}
+	out := oe.get()
+	tassert.Fatalf(t, len(out) == 10, "expecting 10 errors, got %d", len(out))
+	tassert.Errorf(t, out[0].ObjName == "o0" && out[9].ObjName == "o9", "unexpected order: %v", out)
+
+	// wrap around: retain the most recent, oldest first
+	total := maxObjErrs + 10
+	for i := 10; i < total; i++ {
+		oe.add("o"+strconv.Itoa(i), errors.New("e"+strconv.Itoa(i)))
+	}
+	out = oe.get()
+	tassert.Fatalf(t, len(out) == maxObjErrs, "expecting %d errors, got %d", maxObjErrs, len(out))
+	tassert.Errorf(t, out[0].ObjName == "o"+strconv.Itoa(total-maxObjErrs), "oldest: %s", out[0].ObjName)
+	tassert.Errorf(t, out[maxObjErrs-1].ObjName == "o"+strconv.Itoa(total-1), "newest: %s", out[maxObjErrs-1].ObjName)
+	tassert.Errorf(t, out[maxObjErrs-1].Err == "e"+strconv.Itoa(total-1), "newest err: %s", out[maxObjErrs-1].Err)
+}
+
+func TestMaxErrs(t *testing.T) {
+	m := apc.MaxErrs{MaxErrors: 10}
+	tassert.Errorf(t, m.Check(10, 10) == nil, "10 errors must be tolerated")
+	tassert.Errorf(t, m.Check(11, 1000) != nil, "11 errors must exceed max-errors")
+
+	m = apc.MaxErrs{MaxErrorPct: 5}
+	tassert.Errorf(t, m.Check(50, 50) == nil, "expecting no percentage check below %d visited", apc.MaxErrsMinVisited)
+	tassert.Errorf(t, m.Check(5, 100) == nil, "5%% must be tolerated")
+	tassert.Errorf(t, m.Check(6, 100) != nil, "6%% must exceed max-error-pct")
+
+	tassert.Errorf(t, (&apc.MaxErrs{MaxErrorPct: 101}).Validate() != nil, "expecting invalid pct")
+	tassert.Errorf(t, (&apc.MaxErrs{MaxErrors: -1}).Validate() != nil, "expecting invalid max-errors")
+}
@@ -41,6 +41,7 @@ import (
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -62,6 +63,11 @@ type BckJogRunnerOpts struct {
 	// CbObj is called for each visited object.
 	CbObj func(*core.LOM, []byte) error
 
+	// CTs, when non-empty, overrides the default (fs.ObjCT only) content types to visit.
+	// CbCT is then called inline (by the jogger, not by workers) for each non-object content.
+	CTs  []string
+	CbCT func(*core.CT, []byte) error
+
 	// WalkBck, when non-nil, overrides the traversal bucket.
 	// Use when the xaction's logical bucket differs from the walk source
 	// (e.g. copy-bucket registers the destination but walks the source).
@@ -115,10 +121,16 @@ func (r *BckJogRunner) Init(id, kind string, bck *meta.Bck, opts BckJogRunnerOpt
 		}
 	}
 
+	cts := opts.CTs
+	if len(cts) == 0 {
+		cts = []string{fs.ObjCT}
+	}
+	debug.Assert(len(opts.CTs) == 0 || opts.CbCT != nil, id, " CTs: ", opts.CTs)
 	mpopts := &mpather.JgroupOpts{
 		Parent:   r,
-		CTs:      []string{fs.ObjCT}, // TODO: make this configurable in `BckJogRunnerOpts` when needed
+		CTs:      cts,
 		VisitObj: r.dispatch,
+		VisitCT:  opts.CbCT,
 		Prefix:   opts.Prefix,
 		RW:       opts.RW,
 	}
@@ -0,0 +1,68 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"sync"
+)
+
+// pause/resume (see apc.ActXactPause and apc.ActXactResume)
+// - optional capability: xactions that support it embed `Pauser`
+// - pausing does not tear down any state; the xaction's own work loop
+//   calls WaitResumed between work items and idles while paused
+// - abort always takes precedence
+
+type (
+	Pausable interface {
+		Pause()
+		Resume()
+		IsPaused() bool
+		WaitResumed(abortCh <-chan error)
+	}
+	Pauser struct {
+		ch chan struct{} // non-nil while paused
+		mu sync.Mutex
+	}
+)
+
+// interface guard
+var _ Pausable = (*Pauser)(nil)
+
+func (p *Pauser) Pause() {
+	p.mu.Lock()
+	if p.ch == nil {
+		p.ch = make(chan struct{})
+	}
+	p.mu.Unlock()
+}
+
+func (p *Pauser) Resume() {
+	p.mu.Lock()
+	if p.ch != nil {
+		close(p.ch)
+		p.ch = nil
+	}
+	p.mu.Unlock()
+}
+
+func (p *Pauser) IsPaused() bool {
+	p.mu.Lock()
+	paused := p.ch != nil
+	p.mu.Unlock()
+	return paused
+}
+
+func (p *Pauser) WaitResumed(abortCh <-chan error) {
+	p.mu.Lock()
+	ch := p.ch
+	p.mu.Unlock()
+	if ch == nil {
+		return
+	}
+	select {
+	case <-ch:
+	case <-abortCh:
+	}
+}
@@ -0,0 +1,45 @@
+// Package xact_test: pause/resume
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestPauser(t *testing.T) {
+	var (
+		p       xact.Pauser
+		abortCh = make(chan error, 1)
+		done    = make(chan struct{})
+	)
+	p.WaitResumed(abortCh) // not paused: returns immediately
+
+	p.Pause()
+	p.Pause() // idempotent
+	tassert.Fatalf(t, p.IsPaused(), "expecting paused")
+	go func() {
+		p.WaitResumed(abortCh)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("returned while paused")
+	case <-time.After(100 * time.Millisecond):
+	}
+	p.Resume()
+	<-done
+	tassert.Fatalf(t, !p.IsPaused(), "expecting resumed")
+
+	// abort takes precedence
+	p.Pause()
+	close(abortCh)
+	p.WaitResumed(abortCh)
+	p.Resume()
+	p.Resume() // ditto
+}
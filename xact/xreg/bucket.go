@@ -89,6 +89,14 @@ func RenewBckShardIndex(bck *meta.Bck, uuid string, msg *apc.IndexShardMsg) Rene
 	return RenewBucketXact(apc.ActIndexShard, bck, Args{Custom: msg, UUID: uuid})
 }
 
+func RenewBckScrub(bck *meta.Bck, uuid string, msg *apc.ScrubMsg) RenewRes {
+	return RenewBucketXact(apc.ActScrub, bck, Args{Custom: msg, UUID: uuid})
+}
+
+func RenewBckVerifyChunks(bck *meta.Bck, uuid string, msg *apc.VerifyChunksMsg) RenewRes {
+	return RenewBucketXact(apc.ActVerifyChunks, bck, Args{Custom: msg, UUID: uuid})
+}
+
 func RenewBckShardSumm(bck *meta.Bck, msg *apc.ShardSummMsg) RenewRes {
 	return RenewBucketXact(apc.ActSummaryShard, bck, Args{Custom: msg, UUID: msg.UUID})
 }
@@ -21,6 +21,10 @@ func RenewPrefetch(uuid string, bck *meta.Bck, msg *apc.PrefetchMsg) RenewRes {
 	return RenewBucketXact(apc.ActPrefetchObjects, bck, Args{UUID: uuid, Custom: msg})
 }
 
+func RenewSyncRemote(uuid string, bck *meta.Bck, msg *apc.SyncRemoteMsg) RenewRes {
+	return RenewBucketXact(apc.ActSyncRemote, bck, Args{UUID: uuid, Custom: msg})
+}
+
 // kind: (apc.ActCopyObjects | apc.ActETLObjects)
 func RenewTCObjs(kind string, custom *TCOArgs) RenewRes {
 	return RenewBucketXact(kind, custom.BckFrom, Args{Custom: custom}, custom.BckFrom, custom.BckTo)
@@ -26,6 +26,11 @@ func RenewResilver(id string, args *ResArgs) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewWarmup(id string, budget int64) RenewRes {
+	e := dreg.nonbckXacts[apc.ActWarmup].New(Args{UUID: id, Custom: budget}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewElection() RenewRes {
 	e := dreg.nonbckXacts[apc.ActElection].New(Args{}, nil)
 	return dreg.renew(e, nil)
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"sync"
 	"time"
@@ -60,6 +61,20 @@ type (
 		lrp       int
 		cnt       atomic.Int32 // num archived
 		refc      atomic.Int32 // finishing
+		merr      wiMaxErrs    // this request's apc.MaxErrs
+		// WebDataset key normalization (optional)
+		wds struct {
+			re    *regexp.Regexp
+			names map[string]string // in-archive name => source object name
+			err   error             // collision: fails this work item (see _fini)
+			mu    sync.Mutex
+		}
+		// table of contents (optional; see apc.ArchiveMsg.EmitTOC)
+		toc struct {
+			ws      cos.WriteSizer // counts bytes written to workFQN
+			entries []archive.TOCEntry
+			mu      sync.Mutex
+		}
 	}
 	archtask struct {
 		wi   *archwi
@@ -86,12 +101,25 @@ type (
 			m   map[string]*archwi
 			mtx sync.Mutex
 		}
+		// awaiting remote TOC store (see emitTOC)
+		tocAcks struct {
+			m   map[string]chan error // by txnUUID
+			mtx sync.Mutex
+		}
 		// coordinate finish, abort, progress (compare w/ tcb.go, tcobjs.go)
 		sntl sentinel
 		streamingX
 	}
 )
 
+// sidecar TOC (see apc.ArchiveMsg.EmitTOC) is sent to its HRW target as a regular
+// (non-control) payload with opcArchTOC; the target responds with (control) opcArchTOCAck
+// carrying the error, if any, in the ObjName (compare w/ sentinel bcast(abortErr))
+const (
+	opcArchTOC    = transport.OpcBatch - 1
+	opcArchTOCAck = transport.OpcResponse + 1
+)
+
 // interface guard
 var (
 	_ core.Xact      = (*XactArch)(nil)
@@ -167,6 +195,14 @@ func (r *XactArch) BeginMsg(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err erro
 	debug.Assert(archlom.Cname() == msg.Cname()) // relying on it
 
 	wi := &archwi{r: r, msg: msg, archlom: archlom, tarFormat: tar.FormatUnknown}
+	if wi.wds.re, err = msg.CompileWdsKey(); err != nil {
+		r.AddErr(err, 4, cos.ModXs)
+		return err
+	}
+	if wi.wds.re != nil {
+		wi.wds.names = make(map[string]string, 64)
+	}
+	wi.merr.max = msg.MaxErrs
 	wi.fqn = wi.archlom.GenFQN(fs.WorkCT, fs.WorkfileCreateArch)
 	wi.cksum.Init(archlom.CksumType())
 
@@ -216,6 +252,11 @@ func (r *XactArch) BeginMsg(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err erro
 			s    string
 			lmfh cos.LomReader
 		)
+		if wi.msg.Collision == apc.CollisionFail && !wi.msg.AppendIfExists && wi.archlom.Load(false, false) == nil {
+			err = cos.NewErrAlreadyExists(core.T, msg.Cname())
+			r.AddErr(err, 4, cos.ModXs)
+			return err
+		}
 		switch {
 		case !wi.msg.AppendIfExists:
 			wi.wfh, err = wi.archlom.CreateWork(wi.fqn)
@@ -234,7 +275,13 @@ func (r *XactArch) BeginMsg(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err erro
 
 		// construct format-specific writer; serialize for multi-target conc. writing
 		opts := archive.Opts{Serialize: true, TarFormat: wi.tarFormat}
-		wi.writer = archive.NewWriter(msg.Mime, wi.wfh, &wi.cksum, &opts)
+		var w io.Writer = wi.wfh
+		if msg.EmitTOC {
+			debug.Assert(lmfh == nil && wi.appendPos == 0) // (validated by proxy)
+			wi.toc.ws = cos.NewWriteSizer(wi.wfh)
+			w = wi.toc.ws
+		}
+		wi.writer = archive.NewWriter(msg.Mime, w, &wi.cksum, &opts)
 
 		// append case (above)
 		if lmfh != nil {
@@ -464,6 +511,14 @@ func (r *XactArch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 			r.p.dm.Bcast(o, nil)
 		case transport.OpcResponse:
 			r.sntl.rxProgress(hdr)
+		case opcArchTOC:
+			err := r.putTOC(&hdr.Bck, hdr.ObjName, objReader, hdr.ObjAttrs.Size)
+			if err != nil {
+				r.AddErr(err, 5, cos.ModXs)
+			}
+			r.ackTOC(hdr, err)
+		case opcArchTOCAck:
+			r.rxAckTOC(hdr)
 		default:
 			return abortOpcode(r, hdr.Opcode)
 		}
@@ -487,15 +542,127 @@ func (r *XactArch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 	}
 	debug.Assert(wi.tsi.ID() == core.T.SID() && wi.msg.TxnUUID == cos.UnsafeS(hdr.Opaque))
 
-	err := wi.writer.Write(wi.nameInArch(hdr.ObjName), &hdr.ObjAttrs, objReader)
+	nameInArch, err := wi.nameInArch(hdr.ObjName)
 	if err == nil {
+		err = wi.write(nameInArch, &hdr.ObjAttrs, objReader)
+	}
+	switch {
+	case err == nil:
 		wi.cnt.Inc()
-	} else {
+	case err == cmn.ErrSkip: // (failed work item)
+	default:
 		r.AddErr(err, 5, cos.ModXs)
 	}
 	return nil
 }
 
+// store the shard's table of contents - locally or at its HRW target;
+// in the latter case, wait for the target to respond (see ackTOC)
+func (r *XactArch) emitTOC(wi *archwi) error {
+	var (
+		toc  = archive.TOC{Archive: wi.msg.ArchName, Entries: wi.toc.entries}
+		b    = cos.MustMarshal(&toc)
+		name = archive.TOCName(wi.msg.ArchName)
+	)
+	tsi, err := r.smap.HrwName2T(wi.msg.ToBck.MakeUname(name))
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == core.T.SID() {
+		return r.putTOC(&wi.msg.ToBck, name, cos.NewByteReader(b), int64(len(b)))
+	}
+	debug.Assert(r.p.dm != nil)
+	o := transport.AllocSend()
+	hdr := &o.Hdr
+	{
+		hdr.Bck = wi.msg.ToBck
+		hdr.ObjName = name
+		hdr.ObjAttrs.Size = int64(len(b))
+		hdr.Opcode = opcArchTOC
+		hdr.Opaque = []byte(wi.msg.TxnUUID)
+	}
+
+	ch := make(chan error, 1)
+	r.tocAcks.mtx.Lock()
+	if r.tocAcks.m == nil {
+		r.tocAcks.m = make(map[string]chan error, 4)
+	}
+	r.tocAcks.m[wi.msg.TxnUUID] = ch
+	r.tocAcks.mtx.Unlock()
+	defer func() {
+		r.tocAcks.mtx.Lock()
+		delete(r.tocAcks.m, wi.msg.TxnUUID)
+		r.tocAcks.mtx.Unlock()
+	}()
+
+	if err := r.p.dm.Send(o, cos.NewByteReader(b), tsi); err != nil {
+		return err
+	}
+	timeout := r.config.Timeout.SendFile.D()
+	select {
+	case err := <-ch:
+		return err
+	case <-r.ChanAbort():
+		return r.AbortErr()
+	case <-time.After(timeout):
+		return fmt.Errorf("%s: timed out waiting for %s to store %s (%v)", r, tsi.StringEx(), name, timeout)
+	}
+}
+
+// respond to the TOC sender (compare w/ OpcRequest => OpcResponse)
+func (r *XactArch) ackTOC(hdr *transport.ObjHdr, errTOC error) {
+	tsi := r.smap.GetTarget(hdr.SID)
+	if tsi == nil {
+		return // (unlikely; the sender times out)
+	}
+	o := transport.AllocSend()
+	o.Hdr.Opcode = opcArchTOCAck
+	o.Hdr.Opaque = append([]byte(nil), hdr.Opaque...) // (copy: hdr is consumed synchronously)
+	if errTOC != nil {
+		o.Hdr.ObjName = errTOC.Error()
+	}
+	if err := r.p.dm.Send(o, nil, tsi); err != nil {
+		r.AddErr(err, 5, cos.ModXs)
+	}
+}
+
+func (r *XactArch) rxAckTOC(hdr *transport.ObjHdr) {
+	r.tocAcks.mtx.Lock()
+	ch, ok := r.tocAcks.m[cos.UnsafeS(hdr.Opaque)]
+	r.tocAcks.mtx.Unlock()
+	if !ok {
+		return // (the sender has timed out or aborted)
+	}
+	var err error
+	if hdr.ObjName != "" {
+		err = fmt.Errorf("%s: %s", meta.Tname(hdr.SID), hdr.ObjName)
+	}
+	select {
+	case ch <- err:
+	default:
+	}
+}
+
+func (r *XactArch) putTOC(bck *cmn.Bck, name string, reader io.Reader, size int64) error {
+	lom := core.AllocLOM(name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitCmnBck(bck); err != nil {
+		return err
+	}
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfilePut
+		params.Reader = io.NopCloser(reader)
+		params.Atime = time.Now()
+		params.Xact = r
+		params.Size = size
+		params.OWT = cmn.OwtArchive
+	}
+	err := core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	return err
+}
+
 // NOTE: in goroutine
 func (r *XactArch) finalize(wi *archwi) {
 	q := wi.quiesce()
@@ -535,6 +702,11 @@ func (r *XactArch) _fini(wi *archwi) (ecode int, err error) {
 		core.FreeLOM(wi.archlom)
 		return 0, err
 	}
+	if err = wi.wdsErr(); err != nil {
+		wi.cleanup()
+		core.FreeLOM(wi.archlom)
+		return http.StatusConflict, err
+	}
 
 	var size int64
 	if wi.cnt.Load() == 0 {
@@ -557,6 +729,15 @@ func (r *XactArch) _fini(wi *archwi) (ecode int, err error) {
 	}
 	debug.Assert(wi.wfh == nil)
 
+	// table of contents first - a finalized (visible) shard always has one
+	if wi.toc.ws != nil {
+		if err = r.emitTOC(wi); err != nil {
+			wi.cleanup()
+			core.FreeLOM(wi.archlom)
+			return http.StatusInternalServerError, fmt.Errorf("%s: failed to store table of contents: %w", wi.msg.Cname(), err)
+		}
+	}
+
 	wi.archlom.SetSize(size)
 	ecode, err = core.T.FinalizeObj(wi.archlom, wi.fqn, r, cmn.OwtArchive)
 	core.FreeLOM(wi.archlom)
@@ -666,7 +847,7 @@ func (j *jogger) do(archtask *archtask) {
 // 3. error
 func (wi *archwi) beginAppend() (lmfh cos.LomReader, err error) {
 	msg := wi.msg
-	if msg.Mime == archive.ExtTar && !wi.archlom.IsChunked() {
+	if msg.Mime == archive.ExtTar && !wi.archlom.IsChunked() && !wi.archlom.IsEncoded() {
 		// (special)
 		err = wi.openTarForAppend()
 		if err == nil /*can append*/ || err != archive.ErrTarIsEmpty /*fail XactArch.Begin*/ {
@@ -717,15 +898,16 @@ func (wi *archwi) openTarForAppend() (err error) {
 // multi-object iterator i/f: "handle work item"
 func (wi *archwi) do(lom *core.LOM, lrit *lrit, _ []byte) {
 	var coldGet bool
+	wi.merr.visit()
 	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 		if !cos.IsNotExist(err) {
-			wi.r.AddErr(err, 5, cos.ModXs)
+			wi.merr.addErrObj(wi.r, lom, err)
 			return
 		}
 		if coldGet = lom.Bck().IsRemote(); !coldGet {
 			if lrit.lrp == lrpList {
 				// listed, not found
-				wi.r.AddErr(err, 5, cos.ModXs)
+				wi.merr.addErrObj(wi.r, lom, err)
 			}
 			return
 		}
@@ -737,7 +919,7 @@ func (wi *archwi) do(lom *core.LOM, lrit *lrit, _ []byte) {
 			if lrit.lrp != lrpList && cos.IsNotExist(err, ecode) {
 				return // range or prefix, not found
 			}
-			wi.r.AddErr(err, 5, cos.ModXs)
+			wi.merr.addErrObj(wi.r, lom, err)
 			return
 		}
 	}
@@ -746,7 +928,7 @@ func (wi *archwi) do(lom *core.LOM, lrit *lrit, _ []byte) {
 	lh, err := lom.NewHandle(false /*loaded*/)
 	if err != nil {
 		lom.Unlock(false)
-		wi.r.AddErr(err, 5, cos.ModXs)
+		wi.merr.addErrObj(wi.r, lom, err)
 		return
 	}
 	if core.T.SID() != wi.tsi.ID() {
@@ -762,13 +944,18 @@ func (wi *archwi) do(lom *core.LOM, lrit *lrit, _ []byte) {
 		wi.r.Abort(err)
 		return
 	}
-	err = wi.writer.Write(wi.nameInArch(lom.ObjName), lom, lh /*reader*/)
+	var nameInArch string
+	if nameInArch, err = wi.nameInArch(lom.ObjName); err == nil {
+		err = wi.write(nameInArch, lom, lh /*reader*/)
+	}
 	cos.Close(lh)
 	lom.Unlock(false)
-	if err == nil {
+	switch {
+	case err == nil:
 		wi.cnt.Inc()
-	} else {
-		wi.r.AddErr(err, 5, cos.ModXs)
+	case err == cmn.ErrSkip: // (failed work item)
+	default:
+		wi.merr.addErrObj(wi.r, lom, err)
 	}
 }
 
@@ -782,18 +969,68 @@ func (wi *archwi) quiesce() core.QuiRes {
 	})
 }
 
-func (wi *archwi) nameInArch(objName string) string {
-	if wi.msg.BaseNameOnly {
-		objName = filepath.Base(objName)
+func (wi *archwi) nameInArch(objName string) (string, error) {
+	name := objName
+	switch {
+	case wi.wds.re != nil:
+		var err error
+		if name, err = apc.WdsName(wi.wds.re, objName); err != nil {
+			return "", err
+		}
+	case wi.msg.BaseNameOnly:
+		name = filepath.Base(objName)
+	}
+	if wi.msg.InclSrcBname {
+		buf := make([]byte, 0, len(wi.msg.FromBckName)+1+len(name))
+		buf = append(buf, wi.msg.FromBckName...)
+		buf = append(buf, filepath.Separator)
+		buf = append(buf, name...)
+		name = cos.UnsafeS(buf)
+	}
+	if wi.wds.re == nil {
+		return name, nil
+	}
+
+	// reject collisions: different source objects that map to the same in-archive name
+	// (only tracking entries added by this job, not pre-existing ones when appending);
+	// the collision fails this work item (archive) - other requests served by the same
+	// xaction continue
+	wi.wds.mu.Lock()
+	defer wi.wds.mu.Unlock()
+	if wi.wds.err != nil {
+		return "", cmn.ErrSkip
+	}
+	if src, ok := wi.wds.names[name]; ok && src != objName {
+		wi.wds.err = fmt.Errorf("%s: wds-key collision: %q and %q both map to %q", wi.msg.Cname(), src, objName, name)
+		return "", cmn.ErrSkip
+	}
+	wi.wds.names[name] = objName
+	return name, nil
+}
+
+func (wi *archwi) wdsErr() (err error) {
+	if wi.wds.re != nil {
+		wi.wds.mu.Lock()
+		err = wi.wds.err
+		wi.wds.mu.Unlock()
+	}
+	return err
+}
+
+// when emitting TOC, record the entry's content offset: tar writer pads lazily,
+// so the byte count right after writing marks the end of the entry's content
+func (wi *archwi) write(nameInArch string, oah cos.OAH, reader io.Reader) error {
+	if wi.toc.ws == nil {
+		return wi.writer.Write(nameInArch, oah, reader)
 	}
-	if !wi.msg.InclSrcBname {
-		return objName
+	wi.toc.mu.Lock()
+	err := wi.writer.Write(nameInArch, oah, reader)
+	if err == nil {
+		size := oah.Lsize()
+		wi.toc.entries = append(wi.toc.entries, archive.TOCEntry{Name: nameInArch, Offset: wi.toc.ws.Size() - size, Size: size})
 	}
-	buf := make([]byte, 0, len(wi.msg.FromBckName)+1+len(objName))
-	buf = append(buf, wi.msg.FromBckName...)
-	buf = append(buf, filepath.Separator)
-	buf = append(buf, objName...)
-	return cos.UnsafeS(buf)
+	wi.toc.mu.Unlock()
+	return err
 }
 
 func (wi *archwi) cleanup() {
@@ -864,4 +1101,11 @@ func (wi *archwi) append(sb *cos.SB) {
 		}
 		sb.WriteString("append-iff")
 	}
+	if msg.EmitTOC {
+		sb.WriteString(", toc")
+	}
+	if msg.WdsKey != "" {
+		sb.WriteString(", wds-key:")
+		sb.WriteString(msg.WdsKey)
+	}
 }
@@ -0,0 +1,147 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// records PUT (table of contents) and finalize (shard) calls, in order
+type tocTarget struct {
+	*mock.TargetMock
+	calls  []string
+	toc    []byte
+	putErr error
+}
+
+func (t *tocTarget) PutObject(lom *core.LOM, params *core.PutParams) error {
+	t.calls = append(t.calls, "put:"+lom.ObjName)
+	if t.putErr != nil {
+		return t.putErr
+	}
+	var err error
+	t.toc, err = io.ReadAll(params.Reader)
+	return err
+}
+
+func (t *tocTarget) FinalizeObj(lom *core.LOM, _ string, _ core.Xact, _ cmn.OWT) (int, error) {
+	t.calls = append(t.calls, "finalize:"+lom.ObjName)
+	return 0, nil
+}
+
+// archive entries via the TOC-recording writer
+func writeTOC(t *testing.T, wi *archwi, w io.Writer, entries map[string][]byte, order []string) {
+	t.Helper()
+	wi.cksum.Init(cos.ChecksumNone)
+	wi.toc.ws = cos.NewWriteSizer(w)
+	wi.writer = archive.NewWriter(archive.ExtTar, wi.toc.ws, &wi.cksum, &archive.Opts{Serialize: true})
+	for _, name := range order {
+		data := entries[name]
+		oah := &cmn.ObjAttrs{Size: int64(len(data))}
+		tassert.CheckFatal(t, wi.write(name, oah, bytes.NewReader(data)))
+	}
+	tassert.CheckFatal(t, wi.writer.Fini())
+}
+
+func TestArchTOCOffsets(t *testing.T) {
+	var (
+		entries = map[string][]byte{
+			"a.txt":     []byte("hello"),
+			"dir/b.bin": bytes.Repeat([]byte{0xab}, 1000),
+			"c":         {},
+			"d.json":    []byte(strings.Repeat(`{"k":"v"}`, 100)),
+		}
+		order = []string{"a.txt", "dir/b.bin", "c", "d.json"}
+		buf   bytes.Buffer
+		wi    = &archwi{}
+	)
+	writeTOC(t, wi, &buf, entries, order)
+
+	tassert.Fatalf(t, len(wi.toc.entries) == len(order), "expected %d entries, got %d", len(order), len(wi.toc.entries))
+	shard := buf.Bytes()
+	for i, e := range wi.toc.entries {
+		tassert.Errorf(t, e.Name == order[i], "entry %d: expected %q, got %q", i, order[i], e.Name)
+		tassert.Fatalf(t, e.Offset+e.Size <= int64(len(shard)), "%s: out of bounds (%d, %d)", e.Name, e.Offset, e.Size)
+		got := shard[e.Offset : e.Offset+e.Size]
+		tassert.Errorf(t, bytes.Equal(got, entries[e.Name]), "%s: content at offset %d mismatch", e.Name, e.Offset)
+	}
+
+	// still a valid TAR
+	tr := tar.NewReader(bytes.NewReader(shard))
+	for _, name := range order {
+		hdr, err := tr.Next()
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, hdr.Name == name, "expected %q, got %q", name, hdr.Name)
+	}
+}
+
+// the table of contents gets stored prior to finalizing the shard;
+// failure to store it fails the shard
+func TestArchTOCBeforeFinalize(t *testing.T) {
+	bck := meta.NewBck("arch-toc", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x73})
+	tmock, _ := NewTestTarget(t, []*meta.Bck{bck})
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	tt := &tocTarget{TargetMock: tmock}
+	core.Tinit(tt, nil /*config*/, false /*run HK*/)
+	smap := tmock.SO.Get()
+
+	fini := func(r *XactArch) error {
+		archlom := core.AllocLOM("shard.tar")
+		tassert.CheckFatal(t, archlom.InitBck(bck))
+		wi := &archwi{
+			r:       r,
+			archlom: archlom,
+			msg:     &cmn.ArchiveBckMsg{ToBck: *bck.Bucket()},
+			fqn:     archlom.GenFQN(fs.WorkCT, "toc-test"),
+		}
+		wi.msg.ArchName = "shard.tar"
+		wfh, err := os.Create(wi.fqn)
+		tassert.CheckFatal(t, err)
+		wi.wfh = wfh
+		wi.cksum.Init(archlom.CksumType())
+		wi.toc.ws = cos.NewWriteSizer(wfh)
+		wi.writer = archive.NewWriter(archive.ExtTar, wi.toc.ws, &wi.cksum, &archive.Opts{Serialize: true})
+		data := []byte("content")
+		tassert.CheckFatal(t, wi.write("obj", &cmn.ObjAttrs{Size: int64(len(data))}, bytes.NewReader(data)))
+		wi.cnt.Inc()
+		_, err = r._fini(wi)
+		return err
+	}
+
+	// ok: TOC, then shard
+	r := &XactArch{smap: smap}
+	tassert.CheckFatal(t, fini(r))
+	expected := []string{"put:" + archive.TOCName("shard.tar"), "finalize:shard.tar"}
+	tassert.Fatalf(t, strings.Join(tt.calls, ",") == strings.Join(expected, ","), "expected %v, got %v", expected, tt.calls)
+	var toc archive.TOC
+	tassert.CheckFatal(t, jsoniter.Unmarshal(tt.toc, &toc))
+	tassert.Errorf(t, toc.Archive == "shard.tar" && len(toc.Entries) == 1 && toc.Entries[0].Name == "obj",
+		"unexpected table of contents: %+v", toc)
+
+	// failing to store TOC fails the shard (not finalized)
+	tt.calls, tt.putErr = nil, errors.New("out of space")
+	err := fini(&XactArch{smap: smap})
+	tassert.Fatalf(t, err != nil, "expected failure to store table of contents")
+	tassert.Errorf(t, len(tt.calls) == 1, "expected shard not finalized, got %v", tt.calls)
+}
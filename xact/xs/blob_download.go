@@ -82,6 +82,7 @@ type (
 		workCh   chan *blobWI
 		doneCh   chan *blobWI
 		manifest *core.Ufest
+		expCksum *cos.Cksum // backend-provided (see CksumConf.PreferBackend)
 		uploadID string
 		cname    string
 		workers  []*blobWorker
@@ -173,6 +174,7 @@ func RenewBlobDl(xid string, params *core.BlobParams, oa *cmn.ObjAttrs) xreg.Ren
 	// and separately:
 	debug.Assert(oa.Size > 0)
 	pre.fullSize = oa.Size
+	pre.expCksum = oa.Cksum
 
 	if params.Msg.FullSize > 0 && params.Msg.FullSize != pre.fullSize {
 		name := xact.Cname(apc.ActBlobDl, xid) + "/" + lom.Cname()
@@ -544,10 +546,22 @@ func (r *XactBlobDl) _errStats() {
 }
 
 func (r *XactBlobDl) _fini(lom *core.LOM) (err error) {
-	if ty := lom.CksumConf().Type; ty != cos.ChecksumNone {
+	var (
+		ckconf = lom.CksumConf()
+		useExp = ckconf.UseBackendCksum(r.expCksum)
+		ty     = ckconf.Type
+	)
+	if useExp {
+		ty = r.expCksum.Type() // compute backend's type (only) to verify it
+	}
+	if ty != cos.ChecksumNone {
 		cksumH := cos.NewCksumHash(ty)
 		if err = r.manifest.ComputeWholeChecksum(cksumH); err == nil {
-			lom.SetCksum(&cksumH.Cksum)
+			if useExp && !cksumH.Equal(r.expCksum) {
+				err = cos.NewErrDataCksum(r.expCksum, &cksumH.Cksum, lom.Cname())
+			} else {
+				lom.SetCksum(&cksumH.Cksum)
+			}
 		}
 	}
 	if err == nil {
@@ -6,8 +6,13 @@
 package xs
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
@@ -29,6 +34,9 @@ type (
 		core.PutWOC
 		ETLArgs         *core.ETLArgs
 		ObjnameTo       string
+		CacheTag        string // ETL name(s) when caching transform results (apc.TCBMsg.CacheResults)
+		ArgsFrom        string // custom metadata key => per-object ETL args (apc.TCBMsg.ArgsFrom)
+		Collision       string // destination name collision policy (apc.CopyBckMsg.Collision)
 		Buf             []byte
 		OWT             cmn.OWT
 		Finalize        bool // copies and EC (as in poi.finalize())
@@ -36,12 +44,18 @@ type (
 		LatestVer       bool // can be used without changing bucket's 'versioning.validate_warm_get'; see also: QparamLatestVer
 		Sync            bool // see core.GetROC at core/ldp.go
 		ContinueOnError bool // when false, a failure to copy triggers abort
+		BackendMeta     bool // retain backend-specific custom metadata (apc.TCBMsg.PreserveBackendMeta)
+		Resume          bool // completed by the previous run - skip if the destination is up to date (apc.TCBMsg.Resume)
 	}
 	CoiRes struct {
-		Err   error
-		Lsize int64
-		Ecode int
-		RGET  bool // when reading source via backend.GetObjReader
+		Err      error
+		Lsize    int64
+		Ecode    int
+		RGET     bool // when reading source via backend.GetObjReader
+		Cached   bool // destination holds up-to-date transform result (apc.TCBMsg.CacheResults)
+		Collided bool // destination name was taken (apc.CopyBckMsg.Collision)
+		Skipped  bool // ditto, and skipped as per apc.CollisionSkip
+		Resumed  bool // skipped: copied by the previous run (apc.TCBMsg.Resume)
 	}
 
 	COI interface {
@@ -67,6 +81,12 @@ type (
 		putWOC core.PutWOC
 		rate   tcrate
 		vlabs  map[string]string
+		// source objects with extensions not in apc.TCBMsg.Ext (when ExtStrict)
+		unmapped atomic.Int64
+		// destination name collisions (apc.CopyBckMsg.Collision other than overwrite)
+		collisions atomic.Int64
+		// skipped when resuming: already copied by the previous run (apc.TCBMsg.Resume)
+		resumed atomic.Int64
 	}
 )
 
@@ -94,6 +114,17 @@ func (tc *copier) prepare(lom *core.LOM, bckTo *meta.Bck, msg *apc.TCBMsg, confi
 		a.Sync = msg.Sync
 		a.Finalize = false
 		a.ContinueOnError = msg.ContinueOnError
+		a.Collision = msg.Collision
+		a.BackendMeta = msg.PreserveBackendMeta
+	}
+	if msg.Transform.Name != "" {
+		a.ArgsFrom = msg.ArgsFrom
+	}
+	if msg.CacheResults && msg.Transform.Name != "" && bckTo.IsAIS() {
+		a.CacheTag = msg.Transform.Name
+		if len(msg.Transform.Pipeline) > 0 {
+			a.CacheTag += "," + strings.Join(msg.Transform.Pipeline, ",")
+		}
 	}
 
 	if msg.Transform.Pipeline != nil {
@@ -108,13 +139,55 @@ func (tc *copier) prepare(lom *core.LOM, bckTo *meta.Bck, msg *apc.TCBMsg, confi
 	return a, nil
 }
 
-func (tc *copier) do(a *CoiParams, lom *core.LOM, dm *bundle.DM) (err error) {
+// apc.TCBMsg.ExtStrict: returns false for source objects whose extensions are
+// not in the Ext map; depending on ContinueOnError, the job either counts it as
+// error and keeps going, or aborts
+func (tc *copier) extOK(lom *core.LOM, msg *apc.TCBMsg, merr *wiMaxErrs) bool {
+	if !msg.ExtStrict || msg.Transform.Name == "" || msg.ExtMapped(lom.ObjName) {
+		return true
+	}
+	tc.unmapped.Inc()
+	err := fmt.Errorf("%s: extension not in the mapping %v (ext-strict)", lom.Cname(), msg.Ext)
+	if tc.xetl != nil {
+		tc.xetl.AddObjErr(tc.r.ID(), &etl.ObjErr{
+			ObjName: lom.Cname(),
+			Message: err.Error(),
+			Ecode:   http.StatusBadRequest,
+		})
+	}
+	if msg.ContinueOnError {
+		merr.addErrObj(tc.r, lom, err)
+	} else {
+		tc.r.Abort(err)
+	}
+	return false
+}
+
+// merr: per-request thresholds (x-tco), nil otherwise
+func (tc *copier) do(a *CoiParams, lom *core.LOM, dm *bundle.DM, merr *wiMaxErrs) (err error) {
 	started := mono.NanoTime()
 	res := gcoi.CopyObject(lom, dm, a)
 	contOnErr := a.ContinueOnError
 	FreeCOI(a)
 
+	if res.Collided {
+		tc.collisions.Inc()
+	}
 	switch {
+	case res.Resumed:
+		tc.resumed.Inc()
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(tc.r.Name(), lom.Cname(), "- skipping: copied by the previous run")
+		}
+	case res.Skipped:
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(tc.r.Name(), lom.Cname(), "- skipping: destination exists")
+		}
+	case res.Cached:
+		// up-to-date result already exists at the destination
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(tc.r.Name(), lom.Cname(), "- skipping: cached")
+		}
 	case res.Err == nil:
 		debug.Assert(res.Lsize != cos.ContentLengthUnknown)
 		tc.r.ObjsAdd(1, res.Lsize)
@@ -157,8 +230,12 @@ func (tc *copier) do(a *CoiParams, lom *core.LOM, dm *bundle.DM) (err error) {
 				Ecode:   res.Ecode,
 			})
 		}
+		if cmn.IsErrETLTimeout(res.Err) {
+			// counted separately to tell slow transformer from failing one
+			core.T.StatsUpdater().IncWith(stats.ErrETLTimeoutCount, tc.vlabs)
+		}
 		if contOnErr {
-			tc.r.AddErr(res.Err, 5, cos.ModXs)
+			merr.addErrObj(tc.r, lom, res.Err)
 		} else {
 			err = res.Err
 			tc.r.Abort(err)
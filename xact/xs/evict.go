@@ -31,12 +31,14 @@ type (
 		ctlmsg string
 		lrit
 		xact.Base
+		xact.Pauser
 	}
 )
 
 // interface guard
 var (
 	_ core.Xact      = (*evictDelete)(nil)
+	_ xact.Pausable  = (*evictDelete)(nil)
 	_ xreg.Renewable = (*evdFactory)(nil)
 	_ lrwi           = (*evictDelete)(nil)
 )
@@ -82,6 +84,7 @@ func newEvictDelete(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.EvdMs
 		return nil, err
 	}
 	r.InitBase(xargs.UUID, kind, bck)
+	r.SetMaxErrs(&msg.MaxErrs)
 	_ = r.CtlMsg()
 
 	return r, nil
@@ -124,7 +127,7 @@ func (r *evictDelete) do(lom *core.LOM, lrit *lrit, _ []byte) {
 		return
 	}
 eret:
-	r.AddErr(err, 5, cos.ModXs)
+	addErrObj(r, lom, err)
 }
 
 func (r *evictDelete) Snap() (snap *core.Snap) {
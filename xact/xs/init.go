@@ -34,16 +34,19 @@ var (
 func Tinit(coi COI) {
 	xreg.Init()
 
-	// init static map
-	allLsoFlags = make(map[string]cos.BitFlags, len(apc.GetPropsAll))
+	// init static map (including opt-in apc.GetPropsNumChunks and apc.GetPropsECStatus that are not part of GetPropsAll)
+	allLsoFlags = make(map[string]cos.BitFlags, len(apc.GetPropsAll)+2)
 	for i, n := range apc.GetPropsAll {
 		allLsoFlags[n] = cos.BitFlags(1) << i
 	}
+	allLsoFlags[apc.GetPropsNumChunks] = cos.BitFlags(1) << len(apc.GetPropsAll)
+	allLsoFlags[apc.GetPropsECStatus] = cos.BitFlags(1) << (len(apc.GetPropsAll) + 1)
 
 	// xreg scope: global and multi-bucket
 	xreg.RegNonBckXact(&eleFactory{})
 	xreg.RegNonBckXact(&resFactory{})
 	xreg.RegNonBckXact(&rebFactory{})
+	xreg.RegNonBckXact(&warmupFactory{})
 	xreg.RegNonBckXact(&nsummFactory{})
 	xreg.RegNonBckXact(&mossFactory{})
 
@@ -53,6 +56,7 @@ func Tinit(coi COI) {
 	xreg.RegBckXact(&evdFactory{kind: apc.ActDeleteObjects})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictRemoteBck})
 	xreg.RegBckXact(&prfFactory{})
+	xreg.RegBckXact(&syncrFactory{})
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
 
@@ -65,6 +69,8 @@ func Tinit(coi COI) {
 	xreg.RegBckXact(&rechunkFactory{kind: apc.ActRechunk})
 	xreg.RegBckXact(&shardSummFactory{})
 	xreg.RegBckXact(&shardIndexFactory{kind: apc.ActIndexShard})
+	xreg.RegBckXact(&scrubFactory{})
+	xreg.RegBckXact(&vchunksFactory{})
 
 	// assign COI singleton
 	gcoi = coi
@@ -63,6 +63,7 @@ type (
 	// common multi-object operation context and list|range|prefix logic
 	lrit struct {
 		parent cos.Stopper
+		pauser xact.Pausable // nil when parent does not support pausing
 		bck    *meta.Bck
 		msg    *apc.ListRange      // traverse: msg
 		pt     *cos.ParsedTemplate // traverse: template
@@ -74,7 +75,7 @@ type (
 			chanFull cos.ChanFull
 			wg       sync.WaitGroup
 		}
-		lsflags uint64 // traverse: assorted lsmsg flags (`LsNoRecursion`)
+		lsflags uint64 // traverse: assorted lsmsg flags (`LsNoRecursion`, `LsCached`)
 		lrp     int    // enum { lrpList, ... }
 	}
 )
@@ -90,6 +91,7 @@ func (r *lrit) init(xctn cos.Stopper, msg *apc.ListRange, bck *meta.Bck, lsflags
 		return cmn.ErrNoMountpaths
 	}
 	r.parent = xctn
+	r.pauser, _ = xctn.(xact.Pausable)
 	r.msg = msg
 	r.bck = bck
 	r.lsflags = lsflags
@@ -110,6 +112,7 @@ func (r *lrit) init(xctn cos.Stopper, msg *apc.ListRange, bck *meta.Bck, lsflags
 	}
 
 	// tune up: media-aware default + load-based clamping
+	auto := numWorkers == xact.NwpDflt
 	numWorkers, err := xact.TuneNumWorkers(r.parent.Name(), numWorkers, l)
 	if err != nil {
 		return err
@@ -119,7 +122,8 @@ func (r *lrit) init(xctn cos.Stopper, msg *apc.ListRange, bck *meta.Bck, lsflags
 	}
 
 	// bump for large workloads when there's parallelism headroom
-	if a := sys.MaxParallelism(); a > numWorkers+8 {
+	// (but never exceed user-specified number - e.g., throttling rate-limited backend)
+	if a := sys.MaxParallelism(); auto && a > numWorkers+8 {
 		var bump bool
 		a <<= 1
 		switch r.lrp {
@@ -269,7 +273,7 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 		lst     *cmn.LsoRes
 		lsmsg   = &apc.LsoMsg{Prefix: r.prefix, Props: apc.GetPropsStatus, Flags: r.lsflags | apc.LsNoDirs}
 		npg     = newNpgCtx(r.bck, lsmsg, nil /*bp: see below*/)
-		bremote = r.bck.IsRemote()
+		bremote = r.bck.IsRemote() && r.lsflags&apc.LsCached == 0 // LsCached: walk in-cluster content only
 	)
 	if err := r.bck.Init(core.T.Bowner()); err != nil {
 		return err
@@ -393,6 +397,12 @@ func tooManyReqs(ecode int, err error) bool {
 }
 
 func (r *lrit) do(lom *core.LOM, wi lrwi, smap *meta.Smap) (bool /*this lom done*/, error) {
+	if r.pauser != nil {
+		r.pauser.WaitResumed(r.parent.ChanAbort())
+		if r.done() {
+			return true, nil
+		}
+	}
 	if err := lom.InitBck(r.bck); err != nil {
 		return false, err
 	}
@@ -73,6 +73,7 @@ type (
 		stopCh    cos.StopCh        // to stop xaction
 		vlabs     map[string]string // -> Prometheus
 		stats     lsoStats          // -> CtlMsg (`ais show job`) observability
+		mf        *apc.MetaFilter   // filter by custom metadata (see apc.LsoMsg.MetaFilter)
 		smap      *meta.Smap
 		walk      struct {
 			bp           core.Backend     // t.Backend(bck)
@@ -146,6 +147,12 @@ func (p *lsoFactory) Start() error {
 		respCh:     make(chan *LsoRsp),     // ditto: one caller-requested page at a time
 	}
 
+	mf, err := p.msg.ParseMetaFilter()
+	if err != nil {
+		return err
+	}
+	r.mf = mf
+
 	r.stopCh.Init()
 
 	// idle timeout vs delayed next-page request
@@ -561,6 +568,12 @@ func (r *LsoXact) doPageNBI() *LsoRsp {
 	if err != nil {
 		return &LsoRsp{Lst: lst, Status: http.StatusInternalServerError, Err: err}
 	}
+	if r.msg.HasSizeRange() {
+		lst.Entries = filterSize(r.msg, lst.Entries)
+	}
+	if r.mf != nil {
+		lst.Entries = filterMeta(r.mf, lst.Entries)
+	}
 	r.page = lst.Entries
 	return &LsoRsp{Lst: lst, Status: http.StatusOK}
 }
@@ -636,12 +649,53 @@ ex:
 		r.walk.done = true
 		r.resetIdle()
 	}
+	if r.msg.HasSizeRange() {
+		page.Entries = filterSize(r.msg, page.Entries)
+	}
+	if r.mf != nil {
+		page.Entries = filterMeta(r.mf, page.Entries)
+	}
 	r.page = page.Entries
 	r.nextToken = page.ContinuationToken
 
 	return err
 }
 
+// in place; virtual directories are kept
+func filterSize(msg *apc.LsoMsg, entries cmn.LsoEntries) cmn.LsoEntries {
+	var j int
+	for _, en := range entries {
+		if en.IsAnyFlagSet(apc.EntryIsDir) || msg.InSizeRange(en.Size) {
+			entries[j] = en
+			j++
+		}
+	}
+	clear(entries[j:])
+	return entries[:j]
+}
+
+// ditto; matching custom metadata of remote entries
+// (compare w/ walkInfo._cb that matches in-cluster objects)
+func filterMeta(mf *apc.MetaFilter, entries cmn.LsoEntries) cmn.LsoEntries {
+	var (
+		j  int
+		md = make(cos.StrKVs, 8)
+	)
+	for _, en := range entries {
+		if !en.IsAnyFlagSet(apc.EntryIsDir) {
+			clear(md)
+			cmn.S2CustomMD(md, en.Custom, "")
+			if !mf.Match(md) {
+				continue
+			}
+		}
+		entries[j] = en
+		j++
+	}
+	clear(entries[j:])
+	return entries[:j]
+}
+
 func (r *LsoXact) thisPageR(npg *npgCtx) (page *cmn.LsoRes, err error) {
 	if cap(r.page) > maxPageCap {
 		r.page = make(cmn.LsoEntries, 0, apc.MaxPageSizeGlobal)
@@ -875,6 +929,11 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 		r.walk.lastDir = dirName
 
 		entry := &cmn.LsoEnt{Name: dirName, Flags: apc.EntryIsDir}
+		if r.walk.wi.msg.WantProp(apc.GetPropsDirSummary) {
+			if err := r.dirSummary(entry); err != nil {
+				return err
+			}
+		}
 		select {
 		case r.walk.pageCh <- entry:
 		case <-r.walk.stopCh.Listen():
@@ -892,6 +951,9 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	if entry.Name <= msg.StartAfter {
 		return nil
 	}
+	if msg.HasSizeRange() && !msg.InSizeRange(entry.Size) {
+		return nil
+	}
 
 	select {
 	case r.walk.pageCh <- entry:
@@ -933,6 +995,42 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// apc.GetPropsDirSummary: recursive count and size of the local objects under a given virtual dir
+// (this target only - proxy sums up the results; misplaced objects and copies are not counted)
+func (r *LsoXact) dirSummary(entry *cmn.LsoEnt) error {
+	var (
+		bck  = r.Bck().Bucket()
+		smap = r.walk.wi.smap
+	)
+	cb := func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		if r.walk.stopCh.Stopped() {
+			return errLsoStopped
+		}
+		lom := core.AllocLOM("")
+		if lom.InitFQN(fqn, bck) == nil {
+			if _, local, err := lom.HrwTarget(smap); err == nil && local && lom.IsHRW() {
+				if lom.Load(false /*cache it*/, false /*locked*/) == nil {
+					entry.Count++
+					entry.Size += lom.Lsize()
+				}
+			}
+		}
+		core.FreeLOM(lom)
+		return nil
+	}
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjCT}, Prefix: entry.Name, Callback: cb}
+		opts.Bck.Copy(bck)
+		if err := fs.Walk(opts); err != nil && !cos.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *LsoXact) Snap() *core.Snap { return r.Base.NewSnap(r) }
 
 //
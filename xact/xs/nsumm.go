@@ -227,6 +227,8 @@ func (r *XactNsumm) Run(started *sync.WaitGroup) {
 	err := r.BckJog.Wait()
 	if err != nil {
 		r.AddErr(err)
+	} else if r.p.msg.Prefix == "" && !r.IsAborted() {
+		r.reconcile()
 	}
 
 	lwg.Wait()
@@ -359,6 +361,21 @@ func (r *XactNsumm) visitObj(lom *core.LOM, _ []byte) error {
 	return nil
 }
 
+// full local walk => exact in-cluster counts (see core/bcount)
+func (r *XactNsumm) reconcile() {
+	if r.single {
+		if r.p.Bck.Props != nil {
+			core.BcountSet(r.p.Bck, int64(ratomic.LoadUint64(&r.oneRes.ObjCount.Present)))
+		}
+		return
+	}
+	for _, bck := range r.buckets {
+		if res, ok := r.mapRes[bck.Props.BID]; ok {
+			core.BcountSet(bck, int64(ratomic.LoadUint64(&res.ObjCount.Present)))
+		}
+	}
+}
+
 //
 // listRemote
 //
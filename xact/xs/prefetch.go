@@ -69,6 +69,7 @@ type (
 		stats  prfStats
 		lrit
 		xact.Base
+		xact.Pauser
 		latestVer bool
 	}
 )
@@ -76,6 +77,7 @@ type (
 // interface guard
 var (
 	_ core.Xact      = (*prefetch)(nil)
+	_ xact.Pausable  = (*prefetch)(nil)
 	_ xreg.Renewable = (*prfFactory)(nil)
 	_ lrwi           = (*prefetch)(nil)
 )
@@ -134,6 +136,7 @@ func newPrefetch(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.Prefetch
 		return nil, err
 	}
 	r.InitBase(xargs.UUID, kind, bck)
+	r.SetMaxErrs(&msg.MaxErrs)
 	r.latestVer = bck.VersionConf().ValidateWarmGet || msg.LatestVer
 
 	r.bp = core.T.Backend(bck)
@@ -193,7 +196,7 @@ func (r *prefetch) do(lom *core.LOM, lrit *lrit, _ []byte) {
 		if lrit.lrp != lrpList {
 			return // deleted or not found remotely, prefix or range
 		}
-		r.AddErr(err, 5, cos.ModXs)
+		addErrObj(r, lom, err)
 		r.errStats()
 		return
 	case oa != nil:
@@ -202,7 +205,7 @@ func (r *prefetch) do(lom *core.LOM, lrit *lrit, _ []byte) {
 	case err == nil:
 		return // nothing to do
 	case !cmn.IsErrObjNought(err):
-		r.AddErr(err, 5, cos.ModXs)
+		addErrObj(r, lom, err)
 		r.errStats()
 		return
 	}
@@ -231,13 +234,13 @@ func (r *prefetch) do(lom *core.LOM, lrit *lrit, _ []byte) {
 	switch {
 	case cos.IsNotExist(err, ecode) || cmn.IsErrBusy(err) || err == cmn.ErrSkip:
 		if lrit.lrp == lrpList {
-			r.AddErr(err, 5, cos.ModXs)
+			addErrObj(r, lom, err)
 		}
 	case cos.IsErrOOS(err):
 		r.Abort(err)
 		r.errStats()
 	default:
-		r.AddErr(err, 5, cos.ModXs)
+		addErrObj(r, lom, err)
 		r.errStats()
 	}
 }
@@ -0,0 +1,252 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// max number of corrupted object names reported via CtlMsg (and, therefore, snapshot)
+const scrubMaxReported = 16
+
+type (
+	scrubFactory struct {
+		xreg.RenewBase
+		xctn *xactScrub
+	}
+	xactScrub struct {
+		msg   *apc.ScrubMsg
+		vlabs map[string]string
+		xact.BckJogRunner
+		corrupted struct {
+			names []string // first scrubMaxReported
+			mu    sync.Mutex
+		}
+		cntOK       atomic.Int64 // validated
+		cntCorrupt  atomic.Int64 // checksum mismatch (content or metadata)
+		cntRepaired atomic.Int64 // corrupted and successfully repaired (msg.Repair)
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*xactScrub)(nil)
+	_ xreg.Renewable = (*scrubFactory)(nil)
+)
+
+//////////////////
+// scrubFactory //
+//////////////////
+
+func (*scrubFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &scrubFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *scrubFactory) Start() (err error) {
+	p.xctn, err = newXactScrub(p)
+	return err
+}
+
+func (*scrubFactory) Kind() string     { return apc.ActScrub }
+func (p *scrubFactory) Get() core.Xact { return p.xctn }
+
+func (p *scrubFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	if p.UUID() == prevEntry.UUID() {
+		return xreg.WprUse, nil
+	}
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+///////////////
+// xactScrub //
+///////////////
+
+func newXactScrub(p *scrubFactory) (*xactScrub, error) {
+	msg := p.Args.Custom.(*apc.ScrubMsg)
+	r := &xactScrub{msg: msg}
+	err := r.BckJogRunner.Init(p.UUID(), apc.ActScrub, p.Bck, xact.BckJogRunnerOpts{
+		CbObj:  r.do,
+		Prefix: msg.Prefix,
+		RW:     msg.Repair,
+	}, cmn.GCO.Get())
+	if err != nil {
+		return nil, err
+	}
+	r.vlabs = map[string]string{
+		stats.VlabBucket: p.Bck.Cname(""),
+		stats.VlabXkind:  r.Kind(),
+	}
+	return r, nil
+}
+
+func (r *xactScrub) do(lom *core.LOM, _ []byte) error {
+	tstats := core.T.StatsUpdater()
+	err := r.validate(lom)
+	switch {
+	case err == nil:
+		r.cntOK.Inc()
+		r.ObjsAdd(1, lom.Lsize())
+		tstats.IncWith(stats.ScrubOKCount, r.vlabs)
+		return nil
+	case cos.IsNotExist(err) || err == errScrubSkip:
+		return nil
+	case !cos.IsErrBadCksum(err):
+		r.AddErr(err, 4)
+		return nil
+	}
+
+	r.cntCorrupt.Inc()
+	tstats.IncWith(stats.ScrubCorruptCount, r.vlabs)
+	r.corrupted.mu.Lock()
+	if len(r.corrupted.names) < scrubMaxReported {
+		r.corrupted.names = append(r.corrupted.names, lom.ObjName)
+	}
+	r.corrupted.mu.Unlock()
+	nlog.Warningln(r.Name(), err)
+
+	if !r.msg.Repair {
+		r.AddErr(err, 5)
+		return nil
+	}
+	if err := r.repair(lom); err != nil {
+		r.AddErr(cmn.NewErrFailedTo(core.T, "repair", lom.Cname(), err), 0)
+		return nil
+	}
+	r.cntRepaired.Inc()
+	tstats.IncWith(stats.ScrubRepairedCount, r.vlabs)
+	nlog.Infoln(r.Name(), "repaired", lom.Cname())
+	return nil
+}
+
+var errScrubSkip = fmt.Errorf("%s: skip", apc.ActScrub)
+
+// validate both metadata and content checksums; unlike lom.ValidateContentChecksum,
+// never stores (persists) a computed checksum
+func (r *xactScrub) validate(lom *core.LOM) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	// jogger does not pre-load
+	if err := lom.Load(false /*cache*/, true /*locked*/); err != nil {
+		return err
+	}
+	if lom.IsCopy() {
+		return errScrubSkip // validating main replicas only
+	}
+	cksum := lom.Checksum()
+	if cos.NoneC(cksum) {
+		return errScrubSkip // nothing to validate against
+	}
+	if err := lom.ValidateMetaChecksum(); err != nil {
+		return err
+	}
+	comp, err := lom.ComputeCksum(cksum.Ty(), true /*locked*/)
+	if err != nil {
+		return err
+	}
+	if !comp.Equal(cksum) {
+		lom.UncacheDel()
+		return cos.NewErrDataCksum(&comp.Cksum, cksum, lom.Cname())
+	}
+	return nil
+}
+
+// recover corrupted object from (in this order):
+// - remote backend (remote bucket)
+// - local replicas (n-way mirror)
+// - EC slices
+// and validate the result
+func (r *xactScrub) repair(lom *core.LOM) error {
+	switch {
+	case lom.Bck().IsRemote() && !lom.IsFeatureSet(feat.DisableColdGET):
+		if _, err := core.T.GetCold(context.Background(), lom, r.Kind(), cmn.OwtGetLock); err != nil {
+			return err
+		}
+	case lom.HasCopies() && !lom.IsChunked():
+		lom.Lock(true)
+		err := lom.RemoveMain()
+		lom.Unlock(true)
+		if err != nil {
+			return err
+		}
+		if !lom.RestoreToLocation() {
+			return fmt.Errorf("%s: failed to restore from local replicas", lom.Cname())
+		}
+	case lom.ECEnabled():
+		lom.Lock(true)
+		err := lom.RemoveMain()
+		lom.Unlock(true)
+		if err != nil {
+			return err
+		}
+		if err := ec.ECM.Recover(lom); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%s: no redundancy (not mirrored, not erasure coded, not remote)", lom.Cname())
+	}
+	return r.validate(lom)
+}
+
+func (r *xactScrub) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), "prefix:", r.msg.Prefix, "repair:", r.msg.Repair)
+	r.BckJogRunner.Run()
+	if errJog := r.BckJogRunner.Wait(); errJog != nil && !r.IsAborted() {
+		r.AddErr(errJog)
+	}
+	nlog.Infoln("finish", r.Name(), r.CtlMsg())
+	r.Finish()
+}
+
+func (r *xactScrub) Snap() *core.Snap {
+	snap := r.Base.NewSnap(r)
+	snap.Pack(r.BckJogRunner.NumJoggers(), r.BckJogRunner.NumWorkers(), r.BckJogRunner.WorkChanFull())
+	return snap
+}
+
+// reports counters and (up to scrubMaxReported) names of corrupted objects
+func (r *xactScrub) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(128)
+	if r.msg.Prefix != "" {
+		idxAppend(&sb, "prefix", r.msg.Prefix)
+	}
+	if r.msg.Repair {
+		idxAppend(&sb, "repair", "true")
+	}
+	if n := r.cntOK.Load(); n > 0 {
+		idxAppend(&sb, "ok", strconv.FormatInt(n, 10))
+	}
+	if n := r.cntCorrupt.Load(); n > 0 {
+		idxAppend(&sb, "corrupt", strconv.FormatInt(n, 10))
+	}
+	if n := r.cntRepaired.Load(); n > 0 {
+		idxAppend(&sb, "repaired", strconv.FormatInt(n, 10))
+	}
+	r.corrupted.mu.Lock()
+	if len(r.corrupted.names) > 0 {
+		idxAppend(&sb, "corrupted", fmt.Sprintf("%v", r.corrupted.names))
+	}
+	r.corrupted.mu.Unlock()
+	return sb.String()
+}
@@ -0,0 +1,272 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// one-shot reconciliation of in-cluster content with its remote bucket
+// - pass 1 (remote listing): prefetch new and/or re-fetch changed objects
+// - pass 2 (in-cluster walk): evict objects that were deleted remotely
+
+type (
+	syncrFactory struct {
+		xctn *XactSyncRemote
+		msg  *apc.SyncRemoteMsg
+		xreg.RenewBase
+	}
+	XactSyncRemote struct {
+		ctx    context.Context
+		bp     core.Backend
+		msg    *apc.SyncRemoteMsg
+		xlabs  map[string]string
+		brl    *cos.BurstRateLim
+		local  *lrit // pass 2 (when msg.EvictDeleted)
+		counts struct {
+			prefetched atomic.Int64 // new objects
+			updated    atomic.Int64 // changed remote versions
+			evicted    atomic.Int64 // remotely deleted
+		}
+		lrit // pass 1 (when msg.PrefetchNew or msg.UpdateChanged)
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactSyncRemote)(nil)
+	_ xreg.Renewable = (*syncrFactory)(nil)
+	_ lrwi           = (*XactSyncRemote)(nil)
+)
+
+func (*syncrFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.SyncRemoteMsg)
+	return &syncrFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *syncrFactory) Start() (err error) {
+	b := p.Bck
+	if err := b.Init(core.T.Bowner()); err != nil {
+		return err
+	}
+	if !b.IsCloud() && !b.IsRemoteAIS() {
+		return fmt.Errorf("can only synchronize Cloud and remote AIS buckets (have %s)", b.Cname(""))
+	}
+	if err := p.msg.Validate(); err != nil {
+		return err
+	}
+	p.xctn, err = newSyncRemote(&p.Args, p.Kind(), b, p.msg)
+	return err
+}
+
+func (*syncrFactory) Kind() string     { return apc.ActSyncRemote }
+func (p *syncrFactory) Get() core.Xact { return p.xctn }
+
+func (*syncrFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+func newSyncRemote(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.SyncRemoteMsg) (r *XactSyncRemote, err error) {
+	r = &XactSyncRemote{msg: msg}
+
+	smap := core.T.Sowner().Get()
+	r.brl, err = bck.NewFrontendRateLim(smap.CountActiveTs())
+	if err != nil {
+		return nil, err
+	}
+
+	lrmsg := &apc.ListRange{Template: msg.Prefix}
+	if msg.PrefetchNew || msg.UpdateChanged {
+		if err := r.lrit.init(r, lrmsg, bck, 0 /*lsflags*/, msg.NumWorkers, 0 /*burst*/); err != nil {
+			return nil, err
+		}
+	}
+	if msg.EvictDeleted {
+		r.local = &lrit{}
+		if err := r.local.init(r, lrmsg, bck, apc.LsCached, msg.NumWorkers, 0 /*burst*/); err != nil {
+			return nil, err
+		}
+	}
+
+	r.InitBase(xargs.UUID, kind, bck)
+	r.SetMaxErrs(&msg.MaxErrs)
+
+	r.bp = core.T.Backend(bck)
+	r.xlabs = map[string]string{
+		stats.VlabBucket: bck.Cname(""),
+		stats.VlabXkind:  r.Kind(),
+	}
+	r.ctx = xact.NewCtxVlabs(r.xlabs)
+	return r, nil
+}
+
+func (r *XactSyncRemote) Run(wg *sync.WaitGroup) {
+	nlog.Infoln(r.Name(), r.msg.Str())
+
+	wg.Done()
+
+	if r.msg.PrefetchNew || r.msg.UpdateChanged {
+		if err := r.lrit.run(r, core.T.Sowner().Get(), false /*prealloc buf*/); err != nil {
+			r.AddErr(err, 5, cos.ModXs)
+		}
+		r.lrit.wait()
+	}
+	if r.local != nil && !r.IsAborted() {
+		if err := r.local.run(r, nil /*smap: walking local content*/, false /*prealloc buf*/); err != nil {
+			r.AddErr(err, 5, cos.ModXs)
+		}
+		r.local.wait()
+	}
+
+	nlog.Infoln(r.Name(), "done:", r.countsStr())
+	r.Finish()
+}
+
+func (r *XactSyncRemote) do(lom *core.LOM, lrit *lrit, _ []byte) {
+	if lrit == r.local {
+		r.evict(lom)
+		return
+	}
+
+	lom.Lock(false)
+	err := lom.Load(true /*cache it*/, true /*locked*/)
+	switch {
+	case err == nil:
+		if !r.msg.UpdateChanged {
+			lom.Unlock(false)
+			return
+		}
+		res := lom.CheckRemoteMD(true /*locked*/, false /*sync*/, nil /*origReq*/)
+		lom.Unlock(false)
+		if res.Eq {
+			return
+		}
+		if res.Err != nil {
+			if !cos.IsNotExist(res.Err, res.ErrCode) { // (remotely deleted: see pass 2)
+				addErrObj(r, lom, res.Err)
+			}
+			return
+		}
+		lom.UncacheDel()
+		if r.getCold(lom) {
+			r.counts.updated.Inc()
+		}
+	case cmn.IsErrObjNought(err):
+		lom.Unlock(false)
+		if r.msg.PrefetchNew && r.getCold(lom) {
+			r.counts.prefetched.Inc()
+		}
+	default:
+		lom.Unlock(false)
+		addErrObj(r, lom, err)
+	}
+}
+
+// remove in-cluster copy iff the remote object no longer exists
+// (see also: versioning.synchronize)
+func (r *XactSyncRemote) evict(lom *core.LOM) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err) {
+			addErrObj(r, lom, err)
+		}
+		return
+	}
+	res := lom.CheckRemoteMD(true /*locked*/, true /*sync*/, nil /*origReq*/)
+	switch {
+	case res.Err == nil:
+	case cos.IsNotExist(res.Err, res.ErrCode):
+		r.counts.evicted.Inc()
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(r.Name(), "evicted remotely deleted", lom.Cname())
+		}
+	default:
+		addErrObj(r, lom, res.Err)
+	}
+}
+
+func (r *XactSyncRemote) getCold(lom *core.LOM) bool {
+	if r.brl != nil {
+		r.brl.RetryAcquire(time.Second)
+	}
+	started := mono.NanoTime()
+	ecode, err := r.bp.GetObj(r.ctx, lom, cmn.OwtGetPrefetchLock, nil /*origReq*/)
+	if err != nil {
+		lom.UncacheDel()
+		switch {
+		case cos.IsNotExist(err, ecode) || cmn.IsErrBusy(err) || err == cmn.ErrSkip:
+			// deleted or busy in the meantime
+		case cos.IsErrOOS(err):
+			r.Abort(err)
+		default:
+			addErrObj(r, lom, err)
+		}
+		return false
+	}
+	size := lom.Lsize()
+	rgetstats(r.bp, r.xlabs, size, started)
+	r.ObjsAdd(1, size)
+	return true
+}
+
+func (r *XactSyncRemote) Snap() (snap *core.Snap) {
+	snap = r.Base.NewSnap(r)
+	snap.Pack(0, len(r.lrit.nwp.workers), r.lrit.nwp.chanFull.Load())
+	return
+}
+
+// counts for each enabled action, e.g. "prefetched:10 updated:2 evicted:0"
+func (r *XactSyncRemote) countsStr() string {
+	var sb cos.SB
+	sb.Init(64)
+	if r.msg.PrefetchNew {
+		sb.WriteString("prefetched:")
+		sb.WriteString(strconv.FormatInt(r.counts.prefetched.Load(), 10))
+	}
+	if r.msg.UpdateChanged {
+		if sb.Len() > 0 {
+			sb.WriteUint8(' ')
+		}
+		sb.WriteString("updated:")
+		sb.WriteString(strconv.FormatInt(r.counts.updated.Load(), 10))
+	}
+	if r.msg.EvictDeleted {
+		if sb.Len() > 0 {
+			sb.WriteUint8(' ')
+		}
+		sb.WriteString("evicted:")
+		sb.WriteString(strconv.FormatInt(r.counts.evicted.Load(), 10))
+	}
+	return sb.String()
+}
+
+func (r *XactSyncRemote) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(ctlMsgBufSize)
+	sb.WriteString(r.msg.Str())
+	sb.WriteString(" job:[")
+	sb.WriteString(r.countsStr())
+	sb.WriteUint8(']')
+	return sb.String()
+}
@@ -50,6 +50,7 @@ type (
 		ctlmsg    string
 		prune     prune    // function: sync
 		sntl      sentinel // function: coordinate finish, abort, progress
+		ckpt      *tcbCkpt // copy-bck checkpoints (see apc.TCBMsg.Resume)
 		copyErr   atomic.Int64
 
 		xact.BckJogRunner // mountpath joggers + managed worker pool
@@ -119,6 +120,10 @@ func newXactTCB(uuid, kind string, args *xreg.TCBArgs) (*XactTCB, error) {
 		return nil, err
 	}
 
+	if kind == apc.ActCopyBck && !msg.DryRun && (msg.Checkpoint || msg.Resume) {
+		r.ckpt = newTCBCkpt(args.BckFrom, args.BckTo, msg)
+	}
+
 	// single-node cluster
 	if nat <= 1 {
 		return r, nil // ---->
@@ -141,6 +146,7 @@ func newXactTCB(uuid, kind string, args *xreg.TCBArgs) (*XactTCB, error) {
 		sizePDU = memsys.DefaultBufSize // `transport` to generate PDU-based traffic
 	}
 	if err := r.newDM(sizePDU, smap); err != nil {
+		r.ckpt.fini(false)
 		return nil, err
 	}
 
@@ -199,6 +205,7 @@ func (r *XactTCB) TxnAbort(err error) {
 	if r.transform != nil {
 		r.transform.Finish(err)
 	}
+	r.ckpt.fini(false)
 	r.Base.Finish()
 }
 
@@ -218,6 +225,7 @@ func (r *XactTCB) init(uuid, kind string, config *cmn.Config, smap *meta.Smap, n
 	if err := r.BckJogRunner.Init(uuid, kind, args.BckTo, opts, config); err != nil {
 		return err
 	}
+	r.SetMaxErrs(&msg.MaxErrs)
 
 	// xname
 	fromCname := args.BckFrom.Cname(msg.Prefix)
@@ -307,6 +315,9 @@ func (r *XactTCB) run(wg *sync.WaitGroup) {
 		r.transform.Finish(nil)
 	}
 
+	// keep checkpoints if aborted or incomplete
+	r.ckpt.fini(!r.IsAborted() && r.ErrCnt() == 0)
+
 	r.sntl.cleanup()
 }
 
@@ -327,16 +338,25 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) error {
 		return nil
 	}
 	args := r.args // TCBArgs
+	if !r.copier.extOK(lom, args.Msg, nil) {
+		r.copyErr.Inc()
+		return nil
+	}
 	a, err := r.copier.prepare(lom, args.BckTo, args.Msg, r.Config, buf, r.owt)
 	if err != nil {
 		return err
 	}
-	if err := r.copier.do(a, lom, r.dm); err != nil {
+	resumed := r.ckpt.isDone(lom)
+	a.Resume = resumed
+	if err := r.copier.do(a, lom, r.dm, nil); err != nil {
 		// Do not add to the filter if there was an error (e.g., "not found"),
 		// so that prune can recognize and delete destination objects whose sources have been removed.
 		r.copyErr.Inc()
 		return err
 	}
+	if !resumed {
+		r.ckpt.add(lom)
+	}
 	if args.Msg.Sync {
 		// Only successfully copied objects are added to the filter.
 		// Objects NOT in the filter will be checked against the source;
@@ -467,6 +487,18 @@ func (r *XactTCB) CtlMsg() string {
 	sb.WriteString(strconv.FormatInt(r.WorkChanFull(), 10))
 	sb.WriteString(" pruned:")
 	sb.WriteString(strconv.FormatInt(r.prune.pruned.Load(), 10))
+	if n := r.unmapped.Load(); n > 0 {
+		sb.WriteString(" ext-unmapped:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
+	if n := r.collisions.Load(); n > 0 {
+		sb.WriteString(" collisions:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
+	if n := r.resumed.Load(); n > 0 {
+		sb.WriteString(" resumed-skip:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
 	sb.WriteUint8(']')
 	return sb.String()
 }
@@ -500,6 +532,8 @@ func (r *XactTCB) Snap() (snap *core.Snap) {
 	snap = r.Base.NewSnap(r)
 	snap.Pack(fs.NumAvail(), r.BckJogRunner.NumWorkers(), r.BckJogRunner.WorkChanFull())
 
+	snap.Stats.SkipObjs = r.resumed.Load()
+
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
 	return
@@ -0,0 +1,156 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/prob"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// copy-bucket checkpoints (see apc.TCBMsg.Checkpoint and Resume)
+// - each target keeps per-mountpath journals of the (source) object names it has copied:
+//   <mountpath>/.ais.tcb/<id>, where id = hash(from-bucket, prefix, to-bucket, prepend)
+// - journals are flushed every ckptFlushCnt names, and removed upon successful completion
+// - when resuming, the journals are loaded into a probabilistic filter; false positives are
+//   harmless since each candidate for skipping gets first verified against its destination
+
+const ckptFlushCnt = 1024
+
+type (
+	tcbCkpt struct {
+		done  *prob.Filter        // completed by the previous run(s); nil unless resuming
+		jrnls map[string]*ckptJrl // by mountpath
+		id    string
+	}
+	ckptJrl struct {
+		fh   *os.File
+		bw   *bufio.Writer
+		fqn  string
+		cnt  int
+		mu   sync.Mutex
+		werr bool // write error (stop writing)
+	}
+)
+
+func newTCBCkpt(bckFrom, bckTo *meta.Bck, msg *apc.TCBMsg) *tcbCkpt {
+	var (
+		avail = fs.GetAvail()
+		from  = bckFrom.MakeUname(msg.Prefix)
+		to    = bckTo.MakeUname(msg.Prepend)
+		h     = onexxh.Checksum64S(append(append(from, '>'), to...), cos.MLCG32)
+		ckpt  = &tcbCkpt{id: strconv.FormatUint(h, 16), jrnls: make(map[string]*ckptJrl, len(avail))}
+		flag  = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	)
+	if msg.Resume {
+		ckpt.done = prob.NewDefaultFilter()
+		flag = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	for _, mi := range avail {
+		dir := filepath.Join(mi.Path, fname.TCBCkptDir)
+		if err := cos.CreateDir(dir); err != nil {
+			nlog.Errorln("tcb-ckpt:", err)
+			continue
+		}
+		fqn := filepath.Join(dir, ckpt.id)
+		if msg.Resume {
+			ckpt.load(fqn)
+		}
+		fh, err := os.OpenFile(fqn, flag, cos.PermRWR)
+		if err != nil {
+			nlog.Errorln("tcb-ckpt:", err)
+			continue
+		}
+		ckpt.jrnls[mi.Path] = &ckptJrl{fh: fh, bw: bufio.NewWriter(fh), fqn: fqn}
+	}
+	return ckpt
+}
+
+func (ckpt *tcbCkpt) load(fqn string) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln("tcb-ckpt:", err)
+		}
+		return
+	}
+	scanner := bufio.NewScanner(fh)
+	for scanner.Scan() {
+		if b := scanner.Bytes(); len(b) > 0 {
+			ckpt.done.Insert(b)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		nlog.Warningln("tcb-ckpt:", fqn, err) // (partially written last line, etc.)
+	}
+	cos.Close(fh)
+}
+
+// (nil-safe) whether the object was recorded as completed by the previous run
+func (ckpt *tcbCkpt) isDone(lom *core.LOM) bool {
+	return ckpt != nil && ckpt.done != nil && ckpt.done.Lookup(cos.UnsafeB(lom.ObjName))
+}
+
+// (nil-safe) record completed object
+func (ckpt *tcbCkpt) add(lom *core.LOM) {
+	if ckpt == nil || strings.IndexByte(lom.ObjName, '\n') >= 0 {
+		return
+	}
+	j, ok := ckpt.jrnls[lom.Mountpath().Path]
+	if !ok {
+		return // (mountpath added at runtime)
+	}
+	j.mu.Lock()
+	if !j.werr {
+		j.bw.WriteString(lom.ObjName)
+		j.bw.WriteByte('\n')
+		j.cnt++
+		if j.cnt%ckptFlushCnt == 0 {
+			if err := j.bw.Flush(); err != nil {
+				nlog.Errorln("tcb-ckpt:", j.fqn, err)
+				j.werr = true
+			}
+		}
+	}
+	j.mu.Unlock()
+}
+
+// (nil-safe) flush and close; remove journals when the copy has fully completed
+func (ckpt *tcbCkpt) fini(completed bool) {
+	if ckpt == nil {
+		return
+	}
+	for _, j := range ckpt.jrnls {
+		j.mu.Lock()
+		if !j.werr {
+			if err := j.bw.Flush(); err != nil {
+				nlog.Errorln("tcb-ckpt:", j.fqn, err)
+			}
+		}
+		cos.Close(j.fh)
+		if completed {
+			if err := os.Remove(j.fqn); err != nil && !os.IsNotExist(err) {
+				nlog.Errorln("tcb-ckpt:", err)
+			}
+		}
+		j.werr = true // no more writes
+		j.mu.Unlock()
+	}
+}
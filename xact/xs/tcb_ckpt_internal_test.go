@@ -0,0 +1,162 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// journal lifecycle: record => fini(incomplete) => resume (load, isDone) => fini(completed)
+func TestTCBCkptLifecycle(t *testing.T) {
+	var (
+		bckFrom = meta.NewBck("tcb-from", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x71})
+		bckTo   = meta.NewBck("tcb-to", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x72})
+		msg     = &apc.TCBMsg{}
+	)
+	_, mpath := NewTestTarget(t, []*meta.Bck{bckFrom, bckTo})
+	msg.Prefix = "a/"
+
+	newLOM := func(objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		tassert.CheckFatal(t, lom.InitBck(bckFrom))
+		return lom
+	}
+	a, b, c := newLOM("a/1"), newLOM("a/2"), newLOM("a/3")
+
+	// nil-safe (copy without checkpointing)
+	var none *tcbCkpt
+	none.add(a)
+	tassert.Errorf(t, !none.isDone(a), "nil checkpoint: unexpected done")
+	none.fini(true)
+
+	// 1. first run: interrupted after copying two objects
+	ckpt := newTCBCkpt(bckFrom, bckTo, msg)
+	tassert.Fatalf(t, len(ckpt.jrnls) == 1, "expected one journal, got %d", len(ckpt.jrnls))
+	fqn := filepath.Join(mpath, fname.TCBCkptDir, ckpt.id)
+	ckpt.add(a)
+	ckpt.add(b)
+	tassert.Errorf(t, !ckpt.isDone(a), "not resuming: unexpected done")
+	ckpt.fini(false /*completed*/)
+
+	data, err := os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(data) == "a/1\na/2\n", "unexpected journal %q", data)
+
+	// 2. resumed run: skips the two, copies the third, and completes
+	msg.Resume = true
+	ckpt = newTCBCkpt(bckFrom, bckTo, msg)
+	tassert.Errorf(t, filepath.Join(mpath, fname.TCBCkptDir, ckpt.id) == fqn, "resumed journal: expected %q", fqn)
+	tassert.Errorf(t, ckpt.isDone(a) && ckpt.isDone(b), "resumed: expected %s and %s done", a.ObjName, b.ObjName)
+	tassert.Errorf(t, !ckpt.isDone(c), "resumed: unexpected done %s", c.ObjName)
+	ckpt.add(c)
+	ckpt.fini(true /*completed*/)
+
+	_, err = os.Stat(fqn)
+	tassert.Errorf(t, os.IsNotExist(err), "completed: expected journal removed, got %v", err)
+
+	// 3. different prefix => different journal; non-resumed run truncates
+	msg.Resume = false
+	ckpt = newTCBCkpt(bckFrom, bckTo, msg)
+	ckpt.add(a)
+	ckpt.fini(false)
+	msg.Prefix = "b/"
+	other := newTCBCkpt(bckFrom, bckTo, msg)
+	tassert.Errorf(t, other.id != ckpt.id, "expected distinct journals for distinct prefixes")
+	other.fini(true)
+	msg.Prefix = "a/"
+	ckpt = newTCBCkpt(bckFrom, bckTo, msg)
+	ckpt.fini(false)
+	data, err = os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(data) == 0, "non-resumed run: expected truncated journal, got %q", data)
+
+	// names with newlines are never recorded
+	msg.Resume = true
+	ckpt = newTCBCkpt(bckFrom, bckTo, msg)
+	ckpt.add(newLOM("a/bad\nname"))
+	ckpt.fini(false)
+	data, err = os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !strings.Contains(string(data), "bad"), "unexpected journal %q", data)
+}
+
+// journals only when requested; resumed-skip count in the snapshot
+func TestTCBCkptOptIn(t *testing.T) {
+	var (
+		bckFrom = meta.NewBck("tcb-from", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x71})
+		bckTo   = meta.NewBck("tcb-to", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x72})
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TCB = &cmn.TCBConf{}
+	cmn.GCO.CommitUpdate(config)
+
+	_, mpath := NewTestTarget(t, []*meta.Bck{bckFrom, bckTo})
+
+	for _, msg := range []*apc.TCBMsg{{}, {Checkpoint: true}, {Resume: true}} {
+		r, err := newXactTCB(cos.GenUUID(), apc.ActCopyBck, &xreg.TCBArgs{BckFrom: bckFrom, BckTo: bckTo, Msg: msg})
+		tassert.CheckFatal(t, err)
+		expected := msg.Checkpoint || msg.Resume
+		tassert.Errorf(t, (r.ckpt != nil) == expected, "%+v: expected checkpointing %t", msg, expected)
+		entries, _ := os.ReadDir(filepath.Join(mpath, fname.TCBCkptDir))
+		tassert.Errorf(t, (len(entries) > 0) == expected, "%+v: expected journal %t, got %d entries", msg, expected, len(entries))
+
+		r.resumed.Store(3)
+		snap := r.Snap()
+		tassert.Errorf(t, snap.Stats.SkipObjs == 3, "expected 3 skipped objects in the snapshot, got %d", snap.Stats.SkipObjs)
+
+		r.ckpt.fini(true /*completed*/)
+	}
+}
+
+//
+// test fixture shared by xs tests, internal and external (xs_test)
+//
+
+type (
+	TestSowner    struct{ Smap *meta.Smap }
+	testListeners struct{}
+)
+
+func (so *TestSowner) Get() *meta.Smap            { return so.Smap }
+func (*TestSowner) Listeners() meta.SmapListeners { return &testListeners{} }
+func (*testListeners) Reg(meta.Slistener)         {}
+func (*testListeners) Unreg(meta.Slistener)       {}
+
+// NewTestTarget creates a single (temp) mountpath and a mock target that owns the specified buckets;
+// the target's Smap includes the target itself and the (optional) peers; returns the mountpath
+func NewTestTarget(t *testing.T, bcks []*meta.Bck, peers ...string) (*mock.TargetMock, string) {
+	t.Helper()
+	fs.NewTestMFS(mock.NewIOS())
+	mpath := filepath.Join(t.TempDir(), "mpath")
+	tassert.CheckFatal(t, cos.CreateDir(mpath))
+	_, err := fs.AddTestMpath(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { fs.Remove(mpath) })
+
+	tmock := mock.NewTarget(mock.NewBaseBownerMock(bcks...))
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, len(peers)+1), Version: 1}
+	for _, id := range append([]string{tmock.SID()}, peers...) {
+		si := &meta.Snode{}
+		si.Init(id, apc.Target, nil)
+		smap.Tmap[si.ID()] = si
+	}
+	tmock.SO = &TestSowner{Smap: smap}
+	return tmock, mpath
+}
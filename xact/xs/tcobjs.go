@@ -54,11 +54,13 @@ type (
 		chanFull cos.ChanFull
 		nworkers atomic.Int64 // total across all pending (currently, always zero)
 		ctl      tcoCtlStats
-		owt      cmn.OWT
+		xact.Pauser
+		owt cmn.OWT
 	}
 	tcowi struct {
 		r    *XactTCO
 		msg  *cmn.TCOMsg
+		merr wiMaxErrs // this request's apc.MaxErrs
 		pend struct {
 			n atomic.Int64
 		}
@@ -82,6 +84,7 @@ type (
 // interface guard
 var (
 	_ core.Xact      = (*XactTCO)(nil)
+	_ xact.Pausable  = (*XactTCO)(nil)
 	_ xreg.Renewable = (*tcoFactory)(nil)
 	_ lrwi           = (*tcowi)(nil)
 	_ lrwi           = (*syncwi)(nil)
@@ -204,6 +207,7 @@ func (r *XactTCO) Snap() (snap *core.Snap) {
 
 func (r *XactTCO) BeginMsg(msg *cmn.TCOMsg) {
 	wi := &tcowi{r: r, msg: msg}
+	wi.merr.max = msg.MaxErrs
 	r.pend.mtx.Lock()
 
 	r.pend.m[msg.TxnUUID] = wi
@@ -506,6 +510,14 @@ func (r *XactTCO) CtlMsg() string {
 	sb.WriteString(strconv.FormatInt(r.chanFull.Load(), 10))
 	sb.WriteString(" pruned:")
 	sb.WriteString(strconv.FormatInt(r.ctl.pruned.Load(), 10))
+	if n := r.unmapped.Load(); n > 0 {
+		sb.WriteString(" ext-unmapped:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
+	if n := r.collisions.Load(); n > 0 {
+		sb.WriteString(" collisions:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
 	sb.WriteUint8(']')
 
 	if n == 0 {
@@ -541,16 +553,20 @@ func (r *XactTCO) CtlMsg() string {
 
 func (wi *tcowi) do(lom *core.LOM, lrit *lrit, buf []byte) {
 	r := wi.r
-	a, err := r.copier.prepare(lom, r.args.BckTo, &r.args.Msg.TCBMsg, r.config, buf, r.owt)
+	wi.merr.visit()
+	// multiple messages per x-tco (compare w/ x-tcb) - use this request's own
+	// (ext map, ext-strict, prepend, latest-ver, sync, etc.)
+	msg := &wi.msg.TCBMsg
+	if !r.copier.extOK(lom, msg, &wi.merr) {
+		return
+	}
+	a, err := r.copier.prepare(lom, r.args.BckTo, msg, r.config, buf, r.owt)
 	if err != nil {
 		r.Abort(err)
 		return
 	}
 
-	// multiple messages per x-tco (compare w/ x-tcb)
-	a.LatestVer, a.Sync = wi.msg.LatestVer, wi.msg.Sync
-
-	err = r.copier.do(a, lom, r.p.dm)
+	err = r.copier.do(a, lom, r.p.dm, &wi.merr)
 	if cos.IsNotExist(err) && lrit.lrp == lrpList {
 		r.AddErr(err, 5, cos.ModXs)
 	}
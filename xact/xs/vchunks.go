@@ -0,0 +1,260 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// on-demand consistency check of chunked objects (compare with space cleanup that
+// performs similar checks at GC time and removes what it finds):
+// - for each chunked object: every chunk referenced by its completed manifest
+//   must exist with the right size and (if present) checksum
+// - for each chunk: must be referenced by either the completed manifest or a partial one
+// - for each partial manifest: counted (in-progress or abandoned multipart upload)
+// read-only: nothing gets removed or repaired
+
+// max number of inconsistent object names reported via CtlMsg (and, therefore, snapshot)
+const vchunksMaxReported = 16
+
+type (
+	vchunksFactory struct {
+		xreg.RenewBase
+		xctn *xactVchunks
+	}
+	xactVchunks struct {
+		msg *apc.VerifyChunksMsg
+		xact.BckJogRunner
+		missing struct {
+			names []string // first vchunksMaxReported
+			mu    sync.Mutex
+		}
+		cntOK      atomic.Int64 // chunked objects with all chunks present and valid
+		cntMissing atomic.Int64 // chunked objects with missing (or mismatching) chunks, or unreadable manifest
+		cntOrphan  atomic.Int64 // chunks not referenced by any manifest
+		cntPartial atomic.Int64 // partial manifests
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*xactVchunks)(nil)
+	_ xreg.Renewable = (*vchunksFactory)(nil)
+)
+
+////////////////////
+// vchunksFactory //
+////////////////////
+
+func (*vchunksFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &vchunksFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *vchunksFactory) Start() (err error) {
+	p.xctn, err = newXactVchunks(p)
+	return err
+}
+
+func (*vchunksFactory) Kind() string     { return apc.ActVerifyChunks }
+func (p *vchunksFactory) Get() core.Xact { return p.xctn }
+
+func (p *vchunksFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	if p.UUID() == prevEntry.UUID() {
+		return xreg.WprUse, nil
+	}
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////////
+// xactVchunks //
+/////////////////
+
+func newXactVchunks(p *vchunksFactory) (*xactVchunks, error) {
+	msg := p.Args.Custom.(*apc.VerifyChunksMsg)
+	r := &xactVchunks{msg: msg}
+	err := r.BckJogRunner.Init(p.UUID(), apc.ActVerifyChunks, p.Bck, xact.BckJogRunnerOpts{
+		CbObj:  r.do,
+		CTs:    []string{fs.ObjCT, fs.ChunkCT, fs.ChunkMetaCT},
+		CbCT:   r.doCT,
+		Prefix: msg.Prefix,
+	}, cmn.GCO.Get())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *xactVchunks) do(lom *core.LOM, _ []byte) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	// jogger does not pre-load
+	if err := lom.Load(false /*cache*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err) {
+			r.AddErr(err, 4)
+		}
+		return nil
+	}
+	if !lom.IsChunked() || lom.IsCopy() {
+		return nil
+	}
+	if err := r.verify(lom); err != nil {
+		r.cntMissing.Inc()
+		r.missing.mu.Lock()
+		if len(r.missing.names) < vchunksMaxReported {
+			r.missing.names = append(r.missing.names, lom.ObjName)
+		}
+		r.missing.mu.Unlock()
+		nlog.Warningln(r.Name(), lom.Cname(), err)
+		return nil
+	}
+	r.cntOK.Inc()
+	r.ObjsAdd(1, lom.Lsize())
+	return nil
+}
+
+// validate all chunks referenced by the completed manifest
+func (*xactVchunks) verify(lom *core.LOM) error {
+	ufest, err := core.NewUfest("", lom, true /*must-exist*/)
+	if err != nil {
+		return err
+	}
+	if err := ufest.LoadCompleted(lom); err != nil {
+		return err
+	}
+	for num := 1; num <= ufest.Count(); num++ {
+		c, err := ufest.GetChunk(num)
+		if err != nil {
+			return err
+		}
+		if err := _verifyChunk(c); err != nil {
+			return fmt.Errorf("chunk %d: %w", num, err)
+		}
+	}
+	return nil
+}
+
+func _verifyChunk(c *core.Uchunk) error {
+	finfo, err := os.Stat(c.Path())
+	if err != nil {
+		return err
+	}
+	if finfo.Size() != c.Size() {
+		return fmt.Errorf("size mismatch: %d vs %d (manifest)", finfo.Size(), c.Size())
+	}
+	cksum := c.Cksum()
+	if cos.NoneC(cksum) {
+		return nil // nothing to validate against
+	}
+	fh, err := os.Open(c.Path())
+	if err != nil {
+		return err
+	}
+	_, comp, err := cos.ChecksumReader(fh, cksum.Ty())
+	cos.Close(fh)
+	if err != nil {
+		return err
+	}
+	if !comp.Equal(cksum) {
+		return cos.NewErrDataCksum(&comp.Cksum, cksum, c.Path())
+	}
+	return nil
+}
+
+// chunks and partial manifests (note that jogger's prefix applies to fs.ObjCT only)
+func (r *xactVchunks) doCT(ct *core.CT, _ []byte) error {
+	contentInfo := fs.CSM.ParseUbase(ct.ObjectName(), ct.ContentType())
+	if !contentInfo.Ok {
+		return nil // (space cleanup's job)
+	}
+	if r.msg.Prefix != "" && !strings.HasPrefix(contentInfo.Base, r.msg.Prefix) {
+		return nil
+	}
+	switch ct.ContentType() {
+	case fs.ChunkCT:
+		if r.isOrphan(contentInfo.Base, contentInfo.Extras[0]) {
+			r.cntOrphan.Inc()
+			if cmn.Rom.V(4, cos.ModXs) {
+				nlog.Warningln(r.Name(), "orphan chunk", ct.FQN())
+			}
+		}
+	case fs.ChunkMetaCT:
+		if len(contentInfo.Extras) > 0 {
+			r.cntPartial.Inc()
+		}
+	}
+	return nil
+}
+
+// orphan: referenced by neither completed nor partial manifest (with the same upload ID)
+func (r *xactVchunks) isOrphan(objName, uploadID string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck()); err != nil {
+		return false
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	if err := lom.Load(false /*cache*/, true /*locked*/); err == nil && lom.IsChunked() {
+		ufest, err := core.NewUfest("", lom, true /*must-exist*/)
+		if err == nil && ufest.LoadCompleted(lom) == nil && ufest.ID() == uploadID {
+			return false
+		}
+	}
+	return cos.Stat(lom.GenFQN(fs.ChunkMetaCT, uploadID)) != nil
+}
+
+func (r *xactVchunks) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), "prefix:", r.msg.Prefix)
+	r.BckJogRunner.Run()
+	if errJog := r.BckJogRunner.Wait(); errJog != nil && !r.IsAborted() {
+		r.AddErr(errJog)
+	}
+	nlog.Infoln("finish", r.Name(), r.CtlMsg())
+	r.Finish()
+}
+
+func (r *xactVchunks) Snap() *core.Snap {
+	snap := r.Base.NewSnap(r)
+	snap.Pack(r.BckJogRunner.NumJoggers(), r.BckJogRunner.NumWorkers(), r.BckJogRunner.WorkChanFull())
+	return snap
+}
+
+// reports counters and (up to vchunksMaxReported) names of inconsistent objects
+func (r *xactVchunks) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(128)
+	if r.msg.Prefix != "" {
+		idxAppend(&sb, "prefix", r.msg.Prefix)
+	}
+	idxAppend(&sb, "ok", strconv.FormatInt(r.cntOK.Load(), 10))
+	idxAppend(&sb, "missing_chunk", strconv.FormatInt(r.cntMissing.Load(), 10))
+	idxAppend(&sb, "orphan", strconv.FormatInt(r.cntOrphan.Load(), 10))
+	idxAppend(&sb, "partial", strconv.FormatInt(r.cntPartial.Load(), 10))
+	r.missing.mu.Lock()
+	if len(r.missing.names) > 0 {
+		idxAppend(&sb, "inconsistent", fmt.Sprintf("%v", r.missing.names))
+	}
+	r.missing.mu.Unlock()
+	return sb.String()
+}
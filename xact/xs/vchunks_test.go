@@ -0,0 +1,93 @@
+// Package xs_test tests xaction implementations without a running cluster.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const vchunkSize = 4 * cos.KiB
+
+func writeChunk(t *testing.T, u *core.Ufest, lom *core.LOM, num int) {
+	t.Helper()
+	ch, err := u.NewChunk(num, lom)
+	tassert.CheckFatal(t, err)
+	fh, err := cos.CreateFile(ch.Path())
+	tassert.CheckFatal(t, err)
+	_, err = fh.Write(make([]byte, vchunkSize))
+	cos.Close(fh)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, u.Add(ch, vchunkSize, int64(num)))
+}
+
+func saveChunked(t *testing.T, bck *meta.Bck, objName string, numChunks int) (*core.LOM, *core.Ufest) {
+	t.Helper()
+	lom := core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	u, err := core.NewUfest("", lom, false /*must-exist*/)
+	tassert.CheckFatal(t, err)
+	for num := 1; num <= numChunks; num++ {
+		writeChunk(t, u, lom, num)
+	}
+	tassert.CheckFatal(t, lom.CompleteUfest(u, false /*locked*/))
+	return lom, u
+}
+
+func TestVerifyChunksCounts(t *testing.T) {
+	bck := newShardSummBucket(t)
+
+	// consistent
+	saveChunked(t, bck, "ok", 3)
+
+	// completed manifest referencing a chunk that's gone
+	_, u := saveChunked(t, bck, "missing", 3)
+	c, err := u.GetChunk(2)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, os.Remove(c.Path()))
+
+	// stray chunk: no manifest, completed or partial
+	lom := core.AllocLOM("orphan")
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	u, err = core.NewUfest("", lom, false)
+	tassert.CheckFatal(t, err)
+	writeChunk(t, u, lom, 1)
+
+	// upload in progress: partial manifest and its (non-orphan) chunk
+	lom = core.AllocLOM("partial")
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	u, err = core.NewUfest("", lom, false)
+	tassert.CheckFatal(t, err)
+	writeChunk(t, u, lom, 1)
+	tassert.CheckFatal(t, u.StorePartial(lom, false /*locked*/))
+
+	rns := xreg.RenewBckVerifyChunks(bck, cos.GenUUID(), &apc.VerifyChunksMsg{})
+	tassert.CheckFatal(t, rns.Err)
+	xctn := rns.Entry.Get()
+	if !rns.IsRunning() {
+		xact.GoRunW(xctn)
+	}
+	for deadline := time.Now().Add(5 * time.Second); !xctn.IsDone(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s did not finish", xctn.Name())
+		}
+	}
+	tassert.Errorf(t, xctn.ErrCnt() == 0, "%s: unexpected errors (%d)", xctn.Name(), xctn.ErrCnt())
+
+	msg := xctn.CtlMsg()
+	for _, want := range []string{"ok:1", "missing_chunk:1", "orphan:1", "partial:1", "inconsistent:[missing]"} {
+		tassert.Errorf(t, strings.Contains(msg, want), "expected %q in %q", want, msg)
+	}
+}
@@ -13,10 +13,11 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ec"
 )
 
 func wanted(msg *apc.LsoMsg) (flags cos.BitFlags) {
-	debug.Assert(len(allLsoFlags) == len(apc.GetPropsAll)) // (the map is statically initialized - see Tinit)
+	debug.Assert(len(allLsoFlags) == len(apc.GetPropsAll)+2) // (the map is statically initialized - see Tinit)
 	for prop, fl := range allLsoFlags {
 		if msg.WantProp(prop) {
 			flags = flags.Set(fl)
@@ -57,9 +58,19 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 			en.Location = lom.Location()
 		case apc.GetPropsCopies:
 			en.Copies = int16(lom.NumCopies())
+		case apc.GetPropsHeat:
+			en.Heat = uint32(lom.Heat(time.Now().UnixNano()))
+		case apc.GetPropsNumChunks:
+			if lom.IsChunked() {
+				en.Chunks = numChunks(lom)
+			}
 
 		case apc.GetPropsEC:
 			// TODO at the risk of significant slow-down
+		case apc.GetPropsECStatus:
+			if lom.ECEnabled() {
+				en.ECStatus = ecStatus(lom)
+			}
 
 		case apc.GetPropsCustom:
 			// en.Custom is set via one of the two alternative flows:
@@ -129,3 +140,32 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 		}
 	}
 }
+
+// best-effort: zero when the manifest cannot be loaded, or the object is currently being written
+func numChunks(lom *core.LOM) int32 {
+	if !lom.TryLock(false) {
+		return 0
+	}
+	defer lom.Unlock(false)
+	ufest, err := core.NewUfest("", lom, true /*must-exist*/)
+	if err != nil {
+		return 0
+	}
+	if err := ufest.LoadCompleted(lom); err != nil {
+		return 0
+	}
+	return int32(ufest.Count())
+}
+
+// replicated or erasure coded, as per EC metadata stored at PUT time
+// (empty when not yet encoded)
+func ecStatus(lom *core.LOM) string {
+	md, err := ec.ObjectMetadata(lom.Bck(), lom.ObjName)
+	if err != nil {
+		return ""
+	}
+	if md.IsCopy {
+		return apc.ECStatusReplicated
+	}
+	return apc.ECStatusEncoded
+}
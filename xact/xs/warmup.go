@@ -0,0 +1,173 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// post-rebalance warm-up of a (re)joined target (see Rebalance.WarmupBudget)
+// - for each remote bucket: list remote objects and keep those that HRW-map to this target
+// - cold-GET the ones that are not present locally
+// - stop when the (size) budget is exhausted, or upon abort
+
+type (
+	warmupFactory struct {
+		xctn *XactWarmup
+		xreg.RenewBase
+	}
+	XactWarmup struct {
+		budget int64 // bytes
+		used   int64
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactWarmup)(nil)
+	_ xreg.Renewable = (*warmupFactory)(nil)
+)
+
+///////////////////
+// warmupFactory //
+///////////////////
+
+func (*warmupFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &warmupFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *warmupFactory) Start() error {
+	budget, ok := p.Args.Custom.(int64)
+	debug.Assert(ok && budget > 0, p.Args.Custom)
+	r := &XactWarmup{budget: budget}
+	r.InitBase(p.UUID(), p.Kind(), nil /*bck*/)
+	p.xctn = r
+	return nil
+}
+
+func (*warmupFactory) Kind() string     { return apc.ActWarmup }
+func (p *warmupFactory) Get() core.Xact { return p.xctn }
+
+// a newer rebalance supersedes the previous warm-up
+func (*warmupFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprAbort, nil
+}
+
+////////////////
+// XactWarmup //
+////////////////
+
+func (r *XactWarmup) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name(), "budget:", cos.ToSizeIEC(r.budget, 2))
+
+	var (
+		bcks []*meta.Bck
+		bmd  = core.T.Bowner().Get()
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.IsRemote() {
+			bcks = append(bcks, bck)
+		}
+		return false
+	})
+	for _, bck := range bcks {
+		if r.IsAborted() || r.used >= r.budget {
+			break
+		}
+		if err := r.warmBck(bck); err != nil {
+			r.AddErr(err, 4, cos.ModXs)
+		}
+	}
+
+	nlog.Infoln(r.Name(), "done: warmed-up", r.Objs(), "objects,", cos.ToSizeIEC(r.used, 2))
+	r.Finish()
+}
+
+func (r *XactWarmup) warmBck(bck *meta.Bck) error {
+	var (
+		smap  = core.T.Sowner().Get()
+		sid   = core.T.SID()
+		bp    = core.T.Backend(bck)
+		ubuf  = bck.MakeUname("", true)
+		lsmsg = &apc.LsoMsg{Props: apc.GetPropsSize, PageSize: bck.MaxPageSize()}
+	)
+	for !r.IsAborted() {
+		lst := &cmn.LsoRes{}
+		if _, err := bp.ListObjects(context.Background(), bck, lsmsg, lst); err != nil {
+			return err
+		}
+		for _, en := range lst.Entries {
+			if r.IsAborted() || r.used >= r.budget {
+				return nil
+			}
+			if en.IsAnyFlagSet(apc.EntryIsDir) || r.used+en.Size > r.budget {
+				continue
+			}
+			uname := append(ubuf, en.Name...) //nolint:gocritic // reusing ubuf - intentionally not assigning
+			si, err := smap.HrwName2T(uname)
+			if err != nil {
+				return err
+			}
+			if si.ID() != sid {
+				continue
+			}
+			r.getCold(bck, en.Name)
+		}
+		if lst.ContinuationToken == "" {
+			return nil
+		}
+		lsmsg.ContinuationToken = lst.ContinuationToken
+	}
+	return nil
+}
+
+func (r *XactWarmup) getCold(bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		addErrObj(r, lom, err)
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+		return // present
+	}
+	ecode, err := core.T.GetCold(context.Background(), lom, r.Kind(), cmn.OwtGetTryLock)
+	if err != nil {
+		switch {
+		case cos.IsNotExist(err, ecode) || cmn.IsErrBusy(err) || err == cmn.ErrSkip:
+			// deleted or busy in the meantime
+		case cos.IsErrOOS(err):
+			r.Abort(err)
+		default:
+			addErrObj(r, lom, err)
+		}
+		return
+	}
+	size := lom.Lsize()
+	r.used += size
+	r.ObjsAdd(1, size)
+}
+
+func (r *XactWarmup) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+func (r *XactWarmup) CtlMsg() string {
+	return "budget:" + cos.ToSizeIEC(r.budget, 2)
+}
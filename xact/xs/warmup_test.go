@@ -0,0 +1,147 @@
+// Package xs_test tests xaction implementations without a running cluster.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+const (
+	warmupObjSize  = cos.KiB
+	warmupNumObjs  = 40
+	warmupPageSize = warmupNumObjs / 2
+)
+
+type (
+	// remote backend: lists warmupNumObjs objects, two pages
+	warmBackend struct {
+		core.Backend
+	}
+	// records cold GETs
+	warmTarget struct {
+		*mock.TargetMock
+		cold []string
+	}
+)
+
+func (*warmBackend) ListObjects(_ context.Context, _ *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	start := 0
+	if msg.ContinuationToken != "" {
+		start = warmupPageSize
+	}
+	for i := start; i < start+warmupPageSize; i++ {
+		lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: warmupObjName(i), Size: warmupObjSize})
+	}
+	lst.ContinuationToken = ""
+	if start == 0 {
+		lst.ContinuationToken = "page-2"
+	}
+	return 0, nil
+}
+
+func (t *warmTarget) GetCold(_ context.Context, lom *core.LOM, _ string, _ cmn.OWT) (int, error) {
+	t.cold = append(t.cold, lom.ObjName)
+	lom.SetSize(warmupObjSize)
+	return 0, nil
+}
+
+func warmupObjName(i int) string { return fmt.Sprintf("obj-%03d", i) }
+
+// returns remote bucket and the names of (listed) objects that HRW-map to this target
+func newWarmupTarget(t *testing.T) (*warmTarget, *meta.Bck, []string) {
+	t.Helper()
+	xreg.Init()
+	xs.Tinit(nil)
+	bck := meta.NewBck("warmup", apc.AWS, cmn.NsGlobal, &cmn.Bprops{
+		Cksum: cmn.CksumConf{Type: cos.ChecksumNone},
+		BID:   0x61,
+	})
+
+	// two-target cluster: this (mock) target and a peer
+	tmock, _ := xs.NewTestTarget(t, []*meta.Bck{bck}, "peer-id")
+	for _, mi := range fs.GetAvail() {
+		tassert.CheckFatal(t, mi.CreateMissingBckDirs(bck.Bucket()))
+	}
+	tmock.Backends = map[string]core.Backend{apc.AWS: &warmBackend{}}
+
+	smap := tmock.SO.Get()
+	tw := &warmTarget{TargetMock: tmock}
+	core.T = tw
+
+	var mine []string
+	for i := range warmupNumObjs {
+		name := warmupObjName(i)
+		si, err := smap.HrwName2T(bck.MakeUname(name))
+		tassert.CheckFatal(t, err)
+		if si.ID() == tmock.SID() {
+			mine = append(mine, name)
+		}
+	}
+	if len(mine) < 4 {
+		t.Skipf("HRW: only %d (out of %d) objects map to %s", len(mine), warmupNumObjs, tmock)
+	}
+	return tw, bck, mine
+}
+
+func runWarmup(t *testing.T, budget int64) core.Xact {
+	t.Helper()
+	rns := xreg.RenewWarmup(cos.GenUUID(), budget)
+	tassert.CheckFatal(t, rns.Err)
+	xctn := rns.Entry.Get()
+	xact.GoRunW(xctn)
+	for deadline := time.Now().Add(5 * time.Second); !xctn.IsDone(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s did not finish", xctn.Name())
+		}
+	}
+	tassert.Errorf(t, xctn.ErrCnt() == 0, "%s: unexpected errors (%d)", xctn.Name(), xctn.ErrCnt())
+	return xctn
+}
+
+// cold-GET only those objects that are newly assigned to this target and not present locally
+func TestWarmupHRW(t *testing.T) {
+	tw, bck, mine := newWarmupTarget(t)
+
+	// already present
+	saveObject(t, bck, mine[0], warmupObjSize)
+
+	xctn := runWarmup(t, cos.MiB)
+
+	want := cos.NewStrSet(mine[1:]...)
+	tassert.Fatalf(t, len(tw.cold) == len(want), "expected %d cold GETs, got %d: %v", len(want), len(tw.cold), tw.cold)
+	for _, name := range tw.cold {
+		tassert.Errorf(t, want.Contains(name), "unexpected cold GET %q (present or not mapped to %s)", name, tw.SID())
+	}
+	tassert.Errorf(t, xctn.Objs() == int64(len(want)), "expected %d warmed-up objects, got %d", len(want), xctn.Objs())
+}
+
+// stop when the size budget is exhausted
+func TestWarmupBudget(t *testing.T) {
+	const n = 3
+	tw, _, mine := newWarmupTarget(t)
+
+	xctn := runWarmup(t, n*warmupObjSize)
+
+	tassert.Fatalf(t, len(tw.cold) == n, "expected %d cold GETs (budget), got %d: %v", n, len(tw.cold), tw.cold)
+	for i, name := range tw.cold {
+		tassert.Errorf(t, name == mine[i], "expected %q, got %q", mine[i], name)
+	}
+	tassert.Errorf(t, xctn.Objs() == n, "expected %d warmed-up objects, got %d", n, xctn.Objs())
+}
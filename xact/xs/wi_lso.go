@@ -29,6 +29,7 @@ type (
 		msg          *apc.LsoMsg
 		lomVisitedCb lomVisitedCb // A-flow: xact.Base.ObjsAdd (via xact.Base.LomAdd); R-flow: n/a
 		custom       cos.StrKVs
+		mf           *apc.MetaFilter // (see apc.LsoMsg.MetaFilter)
 		markerDir    string
 		wanted       cos.BitFlags
 	}
@@ -54,6 +55,9 @@ func newWalkInfo(msg *apc.LsoMsg, lomVisitedCb lomVisitedCb) (wi *walkInfo) {
 	if msg.IsFlagSet(apc.LsDiff) {
 		wi.custom = make(cos.StrKVs)
 	}
+	mf, err := msg.ParseMetaFilter()
+	debug.AssertNoErr(err) // validated by proxy
+	wi.mf = mf
 	return
 }
 
@@ -93,6 +97,10 @@ func (wi *walkInfo) ls(lom *core.LOM, status uint16) (en *cmn.LsoEnt) {
 	if lom.IsChunked(true /*allow not loaded*/) {
 		en.SetFlag(apc.EntryIsChunked)
 	}
+	objName, isAlias := lom.AliasOf()
+	if isAlias {
+		en.SetFlag(apc.EntryIsAlias)
+	}
 
 	if lom.IsFntl() {
 		orig := lom.OrigFntl()
@@ -116,6 +124,11 @@ func (wi *walkInfo) ls(lom *core.LOM, status uint16) (en *cmn.LsoEnt) {
 	// fill out even more of `en`
 	wi.setWanted(en, lom)
 
+	// alias: always show the aliased object
+	if isAlias && en.Custom == "" {
+		en.Custom = cmn.CustomProps2S(cmn.AliasObjMD, objName)
+	}
+
 	if wi.lomVisitedCb != nil {
 		wi.lomVisitedCb(lom)
 	}
@@ -195,6 +208,9 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 		}
 		return nil, err
 	}
+	if wi.mf != nil && !wi.mf.Match(lom.GetCustomMD()) {
+		return nil, nil
+	}
 	if lom.IsFntl() {
 		// FIXME: revisit
 		status = apc.LocOK
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
@@ -69,3 +71,40 @@ func abortOpcode(r core.Xact, opcode int) error {
 	r.Abort(err)
 	return err
 }
+
+// record per-object failure; abort upon exceeding user-specified apc.MaxErrs, if any
+func addErrObj(r core.Xact, lom *core.LOM, err error) {
+	if errN := r.AddErrObj(lom.Cname(), err, 5, cos.ModXs); errN != nil {
+		r.Abort(errN)
+	}
+}
+
+// apc.MaxErrs of a given request executed by a multi-request xaction (x-tco, x-archive);
+// counted separately for each request (work item) - compare with xact.Base.SetMaxErrs
+type wiMaxErrs struct {
+	max      apc.MaxErrs
+	nerr     atomic.Int64
+	nvisited atomic.Int64
+}
+
+func (m *wiMaxErrs) visit() {
+	if m != nil {
+		m.nvisited.Inc()
+	}
+}
+
+// same as addErrObj above, with nil receiver falling back to the xaction-wide thresholds
+func (m *wiMaxErrs) addErrObj(r core.Xact, lom *core.LOM, err error) {
+	if m == nil {
+		addErrObj(r, lom, err)
+		return
+	}
+	r.AddErrObj(lom.Cname(), err, 5, cos.ModXs)
+	if !m.max.IsSet() {
+		return
+	}
+	nerr := m.nerr.Inc()
+	if errN := m.max.Check(nerr, max(m.nvisited.Load(), nerr)); errN != nil {
+		r.Abort(fmt.Errorf("%s: %w", r.Name(), errN))
+	}
+}